@@ -0,0 +1,8 @@
+data "linear_cycle" "active" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+data "linear_cycle" "upcoming" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  state   = "next"
+}
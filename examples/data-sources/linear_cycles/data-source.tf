@@ -0,0 +1,4 @@
+data "linear_cycles" "upcoming" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  state   = "upcoming"
+}
@@ -0,0 +1,18 @@
+data "linear_issues" "open_incidents" {
+  team_id  = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  label_id = "4c2b6a1e-5d3f-4e8a-9b7c-0d1e2f3a4b5c"
+
+  filter = jsonencode({
+    priority = { eq = 1 }
+    state    = { type = { nin = ["completed", "canceled"] } }
+  })
+}
+
+resource "terraform_data" "release_gate" {
+  lifecycle {
+    precondition {
+      condition     = length(data.linear_issues.open_incidents.issues) == 0
+      error_message = "Urgent incidents are still open."
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "linear_project" "launch" {
+  slug_id = "4f8c1d2e7a3b"
+}
+
+data "linear_project" "roadmap" {
+  name    = "Roadmap"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
@@ -0,0 +1,9 @@
+data "linear_project_statuses" "planned" {
+  type = "planned"
+}
+
+resource "linear_project" "launch" {
+  name      = "Launch"
+  team_ids  = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+  status_id = data.linear_project_statuses.planned.statuses[0].id
+}
@@ -0,0 +1,8 @@
+data "linear_projects" "started" {
+  team_id     = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  status_type = "started"
+}
+
+output "started_project_urls" {
+  value = { for project in data.linear_projects.started.projects : project.slug_id => project.url }
+}
@@ -0,0 +1,7 @@
+data "linear_team" "engineering" {
+  key = "ENG"
+}
+
+data "linear_team" "design" {
+  name = "Design"
+}
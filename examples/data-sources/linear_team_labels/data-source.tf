@@ -0,0 +1,7 @@
+data "linear_team_labels" "engineering" {
+  team_id = data.linear_team.engineering.id
+}
+
+locals {
+  engineering_label_ids = { for label in data.linear_team_labels.engineering.labels : label.name => label.id }
+}
@@ -0,0 +1,9 @@
+data "linear_teams" "all" {}
+
+resource "linear_team_label" "security" {
+  for_each = { for team in data.linear_teams.all.teams : team.key => team.id }
+
+  name    = "Security"
+  color   = "#eb5757"
+  team_id = each.value
+}
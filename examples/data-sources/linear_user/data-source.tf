@@ -0,0 +1,8 @@
+data "linear_user" "jane" {
+  email = "jane@example.com"
+}
+
+resource "linear_project_membership" "jane" {
+  project_id = linear_project.example.id
+  user_id    = data.linear_user.jane.id
+}
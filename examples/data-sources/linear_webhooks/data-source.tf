@@ -0,0 +1,5 @@
+data "linear_webhooks" "all" {}
+
+output "disabled_webhook_urls" {
+  value = [for webhook in data.linear_webhooks.all.webhooks : webhook.url if !webhook.enabled]
+}
@@ -0,0 +1,4 @@
+data "linear_workflow_state" "done" {
+  team_key = "ENG"
+  name     = "Done"
+}
@@ -0,0 +1,5 @@
+data "linear_workspace_labels" "all" {}
+
+locals {
+  workspace_label_ids = { for label in data.linear_workspace_labels.all.labels : label.name => label.id }
+}
@@ -0,0 +1 @@
+terraform import linear_custom_emoji.example shipit
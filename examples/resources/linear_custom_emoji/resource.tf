@@ -0,0 +1,4 @@
+resource "linear_custom_emoji" "example" {
+  name = "shipit"
+  url  = "https://example.com/emojis/shipit.png"
+}
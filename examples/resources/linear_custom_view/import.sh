@@ -0,0 +1 @@
+terraform import linear_custom_view.example 4f8a3f55-60e5-4a6c-8b0d-4e6d2c5a1b9e
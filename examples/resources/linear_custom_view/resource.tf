@@ -0,0 +1,14 @@
+resource "linear_custom_view" "example" {
+  for_each = {
+    eng = linear_team.eng.id
+    ops = linear_team.ops.id
+  }
+
+  name    = "Ready for QA"
+  team_id = each.value
+  shared  = true
+
+  filter_data = jsonencode({
+    state = { name = { eq = "Ready for QA" } }
+  })
+}
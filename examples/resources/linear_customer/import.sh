@@ -0,0 +1 @@
+terraform import linear_customer.example 5b2e8c4d-1a7f-4d3e-9c6b-8f0a2e4d6c13
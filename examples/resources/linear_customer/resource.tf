@@ -0,0 +1,5 @@
+resource "linear_customer" "example" {
+  name         = "Acme"
+  domains      = ["acme.com"]
+  external_ids = ["crm-42"]
+}
@@ -0,0 +1 @@
+terraform import linear_customer_need.example a7f7b4a4-9c7b-4a6b-8d2a-0f0c3f4e5d6a
@@ -0,0 +1,5 @@
+resource "linear_customer_need" "example" {
+  customer_id = "a7f7b4a4-9c7b-4a6b-8d2a-0f0c3f4e5d6a"
+  issue_id    = "2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d"
+  priority    = 1
+}
@@ -0,0 +1 @@
+terraform import linear_customer_status.example 6c3f9d5e-2b8a-4e4f-8d7c-9a1b3f5e7d24
@@ -0,0 +1,6 @@
+resource "linear_customer_status" "example" {
+  name     = "Prospect"
+  type     = "active"
+  position = 10
+  color    = "#00ff00"
+}
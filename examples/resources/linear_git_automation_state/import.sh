@@ -0,0 +1 @@
+terraform import linear_git_automation_state.pr_opened "ENG:start"
@@ -0,0 +1,11 @@
+resource "linear_git_automation_state" "pr_opened" {
+  team_id  = linear_team.example.id
+  event    = "start"
+  state_id = linear_workflow_state.in_review.id
+}
+
+resource "linear_git_automation_state" "pr_merged" {
+  team_id  = linear_team.example.id
+  event    = "merge"
+  state_id = linear_workflow_state.done.id
+}
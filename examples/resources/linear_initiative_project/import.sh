@@ -0,0 +1 @@
+terraform import linear_initiative_project.example 7a1d5e3c-4f2b-4c86-9e0a-3b6d8f1c5e47
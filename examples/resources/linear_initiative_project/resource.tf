@@ -0,0 +1,5 @@
+resource "linear_initiative_project" "example" {
+  initiative_id = "5c3e8a1f-2b7d-4f96-a0c4-8e1d3b5f7a92"
+  project_id    = linear_project.example.id
+  sort_order    = 1
+}
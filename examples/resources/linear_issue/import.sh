@@ -0,0 +1 @@
+terraform import linear_issue.example ENG-123
@@ -0,0 +1,6 @@
+resource "linear_issue" "example" {
+  title       = "Rotate production credentials"
+  description = "Recurring task, managed by Terraform."
+  team_id     = linear_team.example.id
+  priority    = 2
+}
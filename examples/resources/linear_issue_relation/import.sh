@@ -0,0 +1 @@
+terraform import linear_issue_relation.example 8e4a2c6f-5d1b-4f9a-b3e7-0c2d4f6a8b15
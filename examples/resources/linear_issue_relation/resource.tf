@@ -0,0 +1,5 @@
+resource "linear_issue_relation" "example" {
+  type             = "blocks"
+  issue_id         = "ENG-42"
+  related_issue_id = linear_issue.release.identifier
+}
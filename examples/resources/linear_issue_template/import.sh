@@ -0,0 +1 @@
+terraform import linear_issue_template.example "ENG:Incident"
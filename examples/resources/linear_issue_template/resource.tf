@@ -0,0 +1,10 @@
+resource "linear_issue_template" "example" {
+  name        = "Incident"
+  description = "Template for incident reports"
+  team_id     = linear_team.example.id
+
+  template_data = jsonencode({
+    title    = "Incident: "
+    priority = 1
+  })
+}
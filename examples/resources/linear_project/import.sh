@@ -0,0 +1 @@
+terraform import linear_project.example 0cbd9b0a-c4e8-4b9f-8c2d-7b3a8f7d9a11
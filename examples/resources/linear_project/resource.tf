@@ -0,0 +1,7 @@
+resource "linear_project" "example" {
+  name        = "Platform"
+  description = "Platform work"
+  team_ids    = [linear_team.example.id]
+  start_date  = "2024-01-01"
+  target_date = "2024-03-31"
+}
@@ -0,0 +1 @@
+terraform import linear_project_membership.example "mobile-app-5e2a1c9d4b7f:jane@example.com"
@@ -0,0 +1,9 @@
+resource "linear_project_membership" "example" {
+  for_each = toset([
+    "3f6b2d8e-1c4a-4e97-b5d0-9a2e7c1f4b63",
+    "8d1e5a3c-7b2f-4c09-a6e4-2f9b3d7c5e18",
+  ])
+
+  project_id = linear_project.example.id
+  user_id    = each.value
+}
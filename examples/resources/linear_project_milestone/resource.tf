@@ -0,0 +1,6 @@
+resource "linear_project_milestone" "example" {
+  name        = "Beta"
+  description = "Feature complete"
+  target_date = "2024-03-31"
+  project_id  = linear_project.example.id
+}
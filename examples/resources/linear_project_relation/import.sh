@@ -0,0 +1 @@
+terraform import linear_project_relation.example 2b7f4c1e-9a3d-4e85-b6f0-7d1c3a5e9b24
@@ -0,0 +1,7 @@
+resource "linear_project_relation" "example" {
+  type                = "blocks"
+  project_id          = linear_project.api.id
+  anchor_type         = "end"
+  related_project_id  = linear_project.mobile.id
+  related_anchor_type = "start"
+}
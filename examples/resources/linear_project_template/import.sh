@@ -0,0 +1 @@
+terraform import linear_project_template.example 7d1e5a0c-3f2b-4c8d-9e6a-1b4f7c2d8e35
@@ -0,0 +1,13 @@
+resource "linear_project_template" "example" {
+  name        = "Service launch"
+  description = "Template for launching a new service"
+  team_id     = linear_team.example.id
+
+  template_data = jsonencode({
+    name       = "Launch: "
+    priority   = 2
+    statusId   = "9c5a1f21-6c3b-4a5e-9d2e-0f3b8e6a7c14"
+    memberIds  = ["2e7c9d10-8f4b-4c1a-b0f6-5a2d3e4f6a71"]
+    milestones = [{ name = "Design" }, { name = "Beta" }, { name = "GA" }]
+  })
+}
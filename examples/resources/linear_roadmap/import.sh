@@ -0,0 +1 @@
+terraform import linear_roadmap.example 3a6f2d1e-9b8c-4e7a-a5d4-2c1b0f9e8d76
@@ -0,0 +1,9 @@
+resource "linear_roadmap" "example" {
+  name        = "2024 Q1"
+  description = "Quarterly planning"
+
+  project_ids = [
+    linear_project.platform.id,
+    linear_project.billing.id,
+  ]
+}
@@ -0,0 +1,2 @@
+terraform import linear_team_membership.example "jane@example.com:ENG"
+terraform import linear_team_membership.example 6a9c2e4f-8b1d-4f3a-9e7c-5d2b8a1f3c60
@@ -0,0 +1,5 @@
+resource "linear_team_membership" "example" {
+  team_id = linear_team.example.id
+  user_id = "3f6b2d8e-1c4a-4e97-b5d0-9a2e7c1f4b63"
+  owner   = true
+}
@@ -0,0 +1 @@
+terraform import linear_team_notification_subscription.example 3fa85f64-5717-4562-b3fc-2c963f66afa6
@@ -0,0 +1,6 @@
+resource "linear_team_notification_subscription" "example" {
+  team_id              = linear_team.example.id
+  slack_new_issue      = true
+  slack_issue_comments = false
+  slack_issue_statuses = true
+}
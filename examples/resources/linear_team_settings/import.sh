@@ -0,0 +1 @@
+terraform import linear_team_settings.example ff0a060a-eceb-4b34-9140-fd7231f0cd28
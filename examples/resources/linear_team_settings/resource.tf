@@ -0,0 +1,12 @@
+resource "linear_team_settings" "example" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+
+  cycles = {
+    enabled  = true
+    duration = 2
+  }
+
+  estimation = {
+    type = "fibonacci"
+  }
+}
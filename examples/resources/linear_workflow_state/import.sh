@@ -1 +1,2 @@
 terraform import linear_worflow_state.example Done:SOME
+terraform import linear_worflow_state.example 0b1b1f53-3c6f-4a5e-9d2c-6d1c33a48f1e
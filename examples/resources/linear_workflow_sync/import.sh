@@ -0,0 +1 @@
+terraform import linear_workflow_sync.example team:ENG
@@ -0,0 +1,20 @@
+resource "linear_workflow_sync" "example" {
+  team_ids = [
+    linear_team.example.id,
+    linear_team.other.id,
+  ]
+
+  states = [
+    {
+      name  = "Todo"
+      type  = "unstarted"
+      color = "#e2e2e2"
+    },
+    {
+      name        = "Deployed"
+      type        = "completed"
+      color       = "#ffff00"
+      description = "Released to production."
+    },
+  ]
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// readCache remembers the responses of queries for the lifetime of the
+// provider, which is a single plan or apply, so that identical lookups are
+// only sent once. Every mutation clears it.
+type readCache struct {
+	mu         sync.Mutex
+	generation uint64
+	entries    map[string][]byte
+}
+
+func newReadCache() *readCache {
+	return &readCache{entries: map[string][]byte{}}
+}
+
+// key identifies a query by its document and variables, it returns false
+// when the variables can not be encoded.
+func (c *readCache) key(req *graphql.Request) (string, bool) {
+	variables, err := json.Marshal(req.Variables)
+
+	if err != nil {
+		return "", false
+	}
+
+	return req.OpName + "\x00" + req.Query + "\x00" + string(variables), true
+}
+
+// load fills the response data from the cache, and otherwise returns the
+// generation to pass to store once the query has been sent.
+func (c *readCache) load(key string, resp *graphql.Response) (uint64, bool) {
+	c.mu.Lock()
+	data, ok := c.entries[key]
+	generation := c.generation
+	c.mu.Unlock()
+
+	if !ok {
+		return generation, false
+	}
+
+	return generation, json.Unmarshal(data, resp.Data) == nil
+}
+
+// store saves the response data, unless a mutation happened since the query
+// was sent, in which case it may already be stale.
+func (c *readCache) store(key string, generation uint64, resp *graphql.Response) {
+	data, err := json.Marshal(resp.Data)
+
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generation == generation {
+		c.entries[key] = data
+	}
+}
+
+func (c *readCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.entries = map[string][]byte{}
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+func TestReadCache(t *testing.T) {
+	ctx := context.Background()
+	requests := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			OpName    string                 `json:"operationName"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("unable to decode request: %s", err)
+		}
+
+		switch body.OpName {
+		case "getViewer":
+			requests["getViewer"]++
+			w.Write([]byte(`{"data": {` + testViewerData + `}}`))
+		case "getWorkflowSyncStates":
+			requests["getWorkflowSyncStates "+body.Variables["teamId"].(string)]++
+			w.Write([]byte(`{"data": {"workflowStates": {"nodes": []}}}`))
+		case "deleteLabel":
+			requests["deleteLabel"]++
+			w.Write([]byte(`{"data": {"issueLabelDelete": {"success": true}}}`))
+		default:
+			t.Fatalf("unexpected operation %s", body.OpName)
+		}
+	}))
+
+	defer server.Close()
+
+	client := &linearClient{wrapped: graphql.NewClient(server.URL, server.Client()), cache: newReadCache()}
+
+	expect := func(operation string, count int) {
+		t.Helper()
+
+		if requests[operation] != count {
+			t.Fatalf("expected %s to be sent %d times, got %d", operation, count, requests[operation])
+		}
+	}
+
+	// Hit
+	for i := 0; i < 2; i++ {
+		viewer, err := getViewer(ctx, client)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if viewer.Viewer.Email != "user@example.com" {
+			t.Fatalf("expected the cached response to be decoded, got %+v", viewer.Viewer)
+		}
+	}
+
+	expect("getViewer", 1)
+
+	// Miss on other variables
+	for _, teamId := range []string{"a", "b", "a"} {
+		if _, err := getWorkflowSyncStates(ctx, client, teamId); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	expect("getWorkflowSyncStates a", 1)
+	expect("getWorkflowSyncStates b", 1)
+
+	// Invalidation on mutation, which are never cached
+	for i := 0; i < 2; i++ {
+		if _, err := deleteLabel(ctx, client, "id"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	expect("deleteLabel", 2)
+
+	if _, err := getViewer(ctx, client); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expect("getViewer", 2)
+}
+
+func TestReadCacheSkipsStaleResponses(t *testing.T) {
+	cache := newReadCache()
+	req := &graphql.Request{OpName: "getViewer", Query: "query getViewer { viewer { id } }"}
+
+	key, ok := cache.key(req)
+
+	if !ok {
+		t.Fatal("expected the request to have a key")
+	}
+
+	var data map[string]interface{}
+
+	generation, hit := cache.load(key, &graphql.Response{Data: &data})
+
+	if hit {
+		t.Fatal("expected a miss on an empty cache")
+	}
+
+	// A mutation finished while the query was in flight
+	cache.clear()
+	cache.store(key, generation, &graphql.Response{Data: map[string]interface{}{"viewer": "stale"}})
+
+	if _, hit := cache.load(key, &graphql.Response{Data: &data}); hit {
+		t.Fatal("expected the response of the query sent before the mutation not to be stored")
+	}
+}
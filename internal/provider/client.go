@@ -1,14 +1,363 @@
 package provider
 
-import "net/http"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
 
 type authedTransport struct {
-	token   string
+	token string
+
+	// oauth provides the token instead when authenticating with OAuth, so
+	// that it can be refreshed.
+	oauth *oauthTokenSource
+
 	wrapped http.RoundTripper
 }
 
 func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", t.token)
+	authorization := t.token
+
+	if t.oauth != nil {
+		var err error
+
+		if authorization, err = t.oauth.token(req.Context()); err != nil {
+			return nil, fmt.Errorf("unable to obtain an oauth token: %w", err)
+		}
+	}
+
+	req.Header.Set("Authorization", authorization)
+
+	response, err := t.wrapped.RoundTrip(req)
+
+	// The token may have been revoked or expired earlier than announced,
+	// in which case the request is sent once more with a new one.
+	if t.oauth != nil && err == nil && response.StatusCode == http.StatusUnauthorized && req.GetBody != nil {
+		if fresh, refreshErr := t.oauth.refresh(req.Context(), authorization); refreshErr == nil && fresh != authorization {
+			if body, bodyErr := req.GetBody(); bodyErr == nil {
+				response.Body.Close()
+
+				retry := req.Clone(req.Context())
+				retry.Body = body
+				retry.Header.Set("Authorization", fresh)
+
+				response, err = t.wrapped.RoundTrip(retry)
+			}
+		}
+	}
+
+	if meta, ok := req.Context().Value(responseMetaKey{}).(*responseMeta); ok && response != nil {
+		meta.requestId = response.Header.Get("X-Request-Id")
+		meta.rayId = response.Header.Get("CF-Ray")
+		meta.complexity, _ = strconv.ParseInt(response.Header.Get("X-Complexity"), 10, 64)
+	}
+
+	return response, err
+}
+
+// headersTransport adds the extra headers configured for the provider to
+// every request. The headers set by the provider itself are kept.
+type headersTransport struct {
+	headers map[string]string
+	wrapped http.RoundTripper
+}
+
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for name, value := range t.headers {
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
 
 	return t.wrapped.RoundTrip(req)
 }
+
+type responseMetaKey struct{}
+
+// responseMeta holds the details of an API response which are not part of
+// the GraphQL payload, but are needed when reporting errors to Linear.
+type responseMeta struct {
+	requestId  string
+	rayId      string
+	complexity int64
+
+	// attempts is how many times the request was sent, including retries.
+	attempts int
+}
+
+func (m *responseMeta) String() string {
+	parts := []string{}
+
+	if m.requestId != "" {
+		parts = append(parts, "request id: "+m.requestId)
+	}
+
+	if m.rayId != "" {
+		parts = append(parts, "ray id: "+m.rayId)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+type requestError struct {
+	err  error
+	meta *responseMeta
+}
+
+func (e *requestError) Error() string {
+	return fmt.Sprintf("%s (%s)", e.err, e.meta)
+}
+
+func (e *requestError) Unwrap() error {
+	return e.err
+}
+
+// apiUsage counts the API consumption of a provider instance.
+type apiUsage struct {
+	calls      atomic.Int64
+	errors     atomic.Int64
+	retries    atomic.Int64
+	complexity atomic.Int64
+
+	// waitMs is the time spent waiting before retrying requests.
+	waitMs atomic.Int64
+
+	// summarized is the number of calls when the usage was last logged.
+	summarized atomic.Int64
+}
+
+func (u *apiUsage) fields() map[string]interface{} {
+	return map[string]interface{}{
+		"api_calls":      u.calls.Load(),
+		"api_errors":     u.errors.Load(),
+		"api_retries":    u.retries.Load(),
+		"api_complexity": u.complexity.Load(),
+		"api_wait_ms":    u.waitMs.Load(),
+	}
+}
+
+// configuredClients are the clients configured in this provider process, so
+// that their usage can be summarized.
+var configuredClients struct {
+	sync.Mutex
+	clients []*linearClient
+}
+
+func registerClient(client *linearClient) {
+	configuredClients.Lock()
+	defer configuredClients.Unlock()
+
+	configuredClients.clients = append(configuredClients.clients, client)
+}
+
+// logUsage logs a summary of the API usage of every client configured in
+// this provider process which made calls since it was last logged.
+func logUsage(ctx context.Context) {
+	configuredClients.Lock()
+	defer configuredClients.Unlock()
+
+	for _, client := range configuredClients.clients {
+		calls := client.usage.calls.Load()
+
+		if client.usage.summarized.Swap(calls) == calls {
+			continue
+		}
+
+		tflog.Info(ctx, "api usage summary", client.usage.fields())
+	}
+}
+
+// linearClient wraps the genqlient client so that errors carry the
+// identifiers Linear support asks for when investigating failed requests,
+// keeps a running summary of the API usage of the current run and skips the
+// fields the API of the workspace does not support.
+type linearClient struct {
+	wrapped     graphql.Client
+	usage       apiUsage
+	unsupported map[string]map[string]bool
+
+	// slots limits the number of requests in flight, it is nil when they
+	// are not limited.
+	slots chan struct{}
+
+	checkCollisions bool
+	defaultTeam     *defaultTeam
+	readOnly        bool
+
+	// managed holds the identifiers of the objects in the state, which the
+	// collision checks leave out.
+	managed sync.Map
+
+	// cache holds the responses of queries, it is nil when they are not
+	// cached.
+	cache *readCache
+}
+
+type readOnlyError struct {
+	operation string
+}
+
+func (e *readOnlyError) Error() string {
+	return fmt.Sprintf("the provider is read-only, refusing to run %s", e.operation)
+}
+
+// readOnlyEnabled reports whether the provider was configured with read_only.
+func readOnlyEnabled(client graphql.Client) bool {
+	linear, ok := client.(*linearClient)
+
+	return ok && linear.readOnly
+}
+
+func (c *linearClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	mutation := strings.HasPrefix(strings.TrimSpace(req.Query), "mutation")
+
+	if c.readOnly && mutation {
+		return &readOnlyError{operation: req.OpName}
+	}
+
+	if c.cache != nil && mutation {
+		defer c.cache.clear()
+	}
+
+	var cacheKey string
+	var cacheGeneration uint64
+	cacheable := c.cache != nil && !mutation
+
+	if cacheable {
+		cacheKey, cacheable = c.cache.key(req)
+	}
+
+	if cacheable {
+		var hit bool
+
+		if cacheGeneration, hit = c.cache.load(cacheKey, resp); hit {
+			tflog.Debug(ctx, fmt.Sprintf("api cache hit %s", req.OpName), map[string]interface{}{
+				"operation": req.OpName,
+			})
+
+			return nil
+		}
+	}
+
+	meta := &responseMeta{}
+	start := time.Now()
+
+	if len(c.unsupported) > 0 {
+		stripped := *req
+		stripped.Query = c.stripUnsupported(req.Query)
+
+		if mutation {
+			variables, err := c.stripUnsupportedVariables(req)
+
+			if err != nil {
+				return err
+			}
+
+			stripped.Variables = variables
+		}
+
+		req = &stripped
+	}
+
+	if c.slots != nil {
+		select {
+		case c.slots <- struct{}{}:
+			defer func() { <-c.slots }()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	requestCtx := context.WithValue(ctx, responseMetaKey{}, meta)
+	requestCtx = context.WithValue(requestCtx, mutationKey{}, mutation)
+
+	err := c.wrapped.MakeRequest(requestCtx, req, resp)
+
+	c.usage.calls.Add(1)
+	c.usage.complexity.Add(meta.complexity)
+
+	if err != nil {
+		c.usage.errors.Add(1)
+	}
+
+	fields := c.usage.fields()
+	fields["operation"] = req.OpName
+	fields["variables"] = redactedVariables(req.Variables)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	fields["complexity"] = meta.complexity
+	fields["request_id"] = meta.requestId
+	fields["attempt"] = meta.attempts
+	fields["error"] = err != nil
+
+	tflog.Debug(ctx, fmt.Sprintf("api request %s", req.OpName), fields)
+
+	if err == nil && cacheable {
+		c.cache.store(cacheKey, cacheGeneration, resp)
+	}
+
+	if err != nil && meta.String() != "" {
+		return &requestError{err: err, meta: meta}
+	}
+
+	return err
+}
+
+var secretVariableRegex = regexp.MustCompile(`(?i)secret|token|password|authorization|api[-_]?key`)
+
+// secretValueRegex matches Linear keys and bearer tokens, which are redacted
+// wherever they appear, such as in the headers or body of a webhook.
+var secretValueRegex = regexp.MustCompile(`^(?i:bearer\s+\S+|lin_(api|oauth)_\S+)$`)
+
+// redactedVariables encodes the variables of a request for the logs, with the
+// values of the secret looking fields replaced.
+func redactedVariables(variables interface{}) string {
+	encoded, err := json.Marshal(variables)
+
+	if err != nil {
+		return ""
+	}
+
+	var decoded interface{}
+
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return string(encoded)
+	}
+
+	encoded, _ = json.Marshal(redactSecrets(decoded))
+
+	return string(encoded)
+}
+
+func redactSecrets(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if secretVariableRegex.MatchString(key) {
+				value[key] = "***"
+			} else {
+				value[key] = redactSecrets(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactSecrets(item)
+		}
+	case string:
+		if secretValueRegex.MatchString(value) {
+			return "***"
+		}
+	}
+
+	return value
+}
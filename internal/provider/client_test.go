@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+func TestReadOnlyClient(t *testing.T) {
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"data": {` + testViewerData + `}}`))
+	}))
+
+	defer server.Close()
+
+	client := &linearClient{wrapped: graphql.NewClient(server.URL, server.Client()), readOnly: true}
+
+	if !readOnlyEnabled(client) || readOnlyEnabled(&linearClient{}) {
+		t.Fatal("expected only the read-only client to be reported as read-only")
+	}
+
+	if _, err := getViewer(context.Background(), client); err != nil {
+		t.Fatalf("expected queries to be sent, got error: %s", err)
+	}
+
+	var readOnlyErr *readOnlyError
+
+	if _, err := deleteLabel(context.Background(), client, "id"); !errors.As(err, &readOnlyErr) {
+		t.Fatalf("expected mutations to be refused, got error: %v", err)
+	}
+
+	if requests.Load() != 1 {
+		t.Fatalf("expected only the query to be sent, got %d requests", requests.Load())
+	}
+}
+
+func TestRedactedVariables(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables interface{}
+		expected  string
+	}{
+		{
+			name:      "plain",
+			variables: map[string]interface{}{"id": "abc", "input": map[string]interface{}{"name": "Todo"}},
+			expected:  `{"id":"abc","input":{"name":"Todo"}}`,
+		},
+		{
+			name:      "secret fields",
+			variables: map[string]interface{}{"input": map[string]interface{}{"secret": "s3cr3t", "accessToken": "abc", "password": "hunter2"}},
+			expected:  `{"input":{"accessToken":"***","password":"***","secret":"***"}}`,
+		},
+		{
+			name:      "headers",
+			variables: map[string]interface{}{"headers": map[string]interface{}{"Authorization": "abc", "X-Api-Key": "abc", "api_key": "abc", "Accept": "application/json"}},
+			expected:  `{"headers":{"Accept":"application/json","Authorization":"***","X-Api-Key":"***","api_key":"***"}}`,
+		},
+		{
+			name:      "tokens in bodies",
+			variables: map[string]interface{}{"input": map[string]interface{}{"body": "lin_api_0123456789", "items": []interface{}{"Bearer abc", "Bearer", "lin_api"}}},
+			expected:  `{"input":{"body":"***","items":["***","Bearer","lin_api"]}}`,
+		},
+		{
+			name:      "structs",
+			variables: struct{ Input WorkflowStateUpdateInput }{Input: WorkflowStateUpdateInput{Name: "lin_oauth_abc"}},
+			expected:  `{"Input":{"description":null,"name":"***","position":0}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if redacted := redactedVariables(test.variables); redacted != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, redacted)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// collisionCandidate is an existing object of a team which a planned object
+// is compared against.
+type collisionCandidate struct {
+	id    string
+	name  string
+	color string
+}
+
+// collisionChecksEnabled reports whether the provider was configured with
+// check_collisions.
+func collisionChecksEnabled(client graphql.Client) bool {
+	linear, ok := client.(*linearClient)
+
+	return ok && linear.checkCollisions
+}
+
+// registerManaged records an object read into the state, so the collision
+// checks leave it out. The objects are read while refreshing, before they are
+// planned.
+func registerManaged(client graphql.Client, id string) {
+	if linear, ok := client.(*linearClient); ok {
+		linear.managed.Store(id, true)
+	}
+}
+
+// isManaged reports whether the object was registered with registerManaged.
+func isManaged(client graphql.Client, id string) bool {
+	linear, ok := client.(*linearClient)
+
+	if !ok {
+		return false
+	}
+
+	_, managed := linear.managed.Load(id)
+
+	return managed
+}
+
+// collisionWarnings warns about the unmanaged candidates, other than the
+// object itself, which have the same name or color as the planned object.
+func collisionWarnings(client graphql.Client, kind string, id string, name string, color string, candidates []collisionCandidate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, candidate := range candidates {
+		if candidate.id == id || isManaged(client, candidate.id) {
+			continue
+		}
+
+		if name != "" && strings.EqualFold(candidate.name, name) {
+			diags.AddWarning(
+				fmt.Sprintf("Duplicate %s Name", kind),
+				fmt.Sprintf("The team already has an unmanaged %s named %q (%s).", strings.ToLower(kind), candidate.name, candidate.id),
+			)
+		}
+
+		if color != "" && strings.EqualFold(candidate.color, color) {
+			diags.AddWarning(
+				fmt.Sprintf("Duplicate %s Color", kind),
+				fmt.Sprintf("The team already has an unmanaged %s with the color %s: %q (%s).", strings.ToLower(kind), candidate.color, candidate.name, candidate.id),
+			)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestCollisionWarnings(t *testing.T) {
+	client := &linearClient{}
+
+	candidates := []collisionCandidate{
+		{id: "self", name: "Todo", color: "#000000"},
+		{id: "managed", name: "Todo", color: "#000000"},
+		{id: "unmanaged", name: "todo", color: "#FFFFFF"},
+	}
+
+	registerManaged(client, "managed")
+
+	tests := []struct {
+		name     string
+		color    string
+		warnings []string
+	}{
+		{name: "Todo", color: "#000000", warnings: []string{"Duplicate Workflow State Name"}},
+		{name: "Done", color: "#ffffff", warnings: []string{"Duplicate Workflow State Color"}},
+		{name: "TODO", color: "#ffffff", warnings: []string{"Duplicate Workflow State Name", "Duplicate Workflow State Color"}},
+		{name: "Done", color: "#000000"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+test.color, func(t *testing.T) {
+			diags := collisionWarnings(client, "Workflow State", "self", test.name, test.color, candidates)
+
+			if len(diags) != len(test.warnings) {
+				t.Fatalf("expected %d warnings, got %v", len(test.warnings), diags)
+			}
+
+			for i, warning := range test.warnings {
+				if diags[i].Summary() != warning {
+					t.Fatalf("expected warning %q, got %q", warning, diags[i].Summary())
+				}
+			}
+		})
+	}
+
+	if !isManaged(client, "managed") || isManaged(client, "unmanaged") {
+		t.Fatal("expected only the registered object to be managed")
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// tokenFromCommand runs the command through the shell and returns its output
+// as the token, so that it can come from a secret manager at runtime.
+func tokenFromCommand(ctx context.Context, command string) (string, error) {
+	var cmd *exec.Cmd
+
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("%w: %s", err, message)
+		}
+
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(output))
+
+	if token == "" {
+		return "", fmt.Errorf("command printed no token")
+	}
+
+	return token, nil
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestTokenFromCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the commands are written for sh")
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		command string
+		token   string
+		err     string
+	}{
+		{name: "trimmed", command: "printf '  lin_api_token\\n\\n'", token: "lin_api_token"},
+		{name: "empty", command: "true", err: "command printed no token"},
+		{name: "failed", command: "echo 'not logged in' >&2; exit 3", err: "exit status 3: not logged in"},
+		{name: "failed silently", command: "exit 1", err: "exit status 1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			token, err := tokenFromCommand(ctx, test.command)
+
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("expected error %q, got %v", test.err, err)
+				}
+
+				return
+			}
+
+			if err != nil || token != test.token {
+				t.Fatalf("expected token %q, got %q with error: %v", test.token, token, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CustomViewsDataSource{}
+
+func NewCustomViewsDataSource() datasource.DataSource {
+	return &CustomViewsDataSource{}
+}
+
+type CustomViewsDataSource struct {
+	client *graphql.Client
+}
+
+type CustomViewsDataSourceModel struct {
+	Id          types.String                           `tfsdk:"id"`
+	Name        types.String                           `tfsdk:"name"`
+	OwnerId     types.String                           `tfsdk:"owner_id"`
+	TeamId      types.String                           `tfsdk:"team_id"`
+	CustomViews []CustomViewsDataSourceCustomViewModel `tfsdk:"custom_views"`
+}
+
+type CustomViewsDataSourceCustomViewModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Icon        types.String `tfsdk:"icon"`
+	Color       types.String `tfsdk:"color"`
+	FilterData  types.String `tfsdk:"filter_data"`
+	TeamId      types.String `tfsdk:"team_id"`
+	OwnerId     types.String `tfsdk:"owner_id"`
+	Shared      types.Bool   `tfsdk:"shared"`
+}
+
+func (d *CustomViewsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_views"
+}
+
+func (d *CustomViewsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear custom views visible to the authenticated user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return the custom views with this name, matched case-insensitively.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the custom views owned by this user.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the custom views scoped to this team.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"custom_views": schema.ListNestedAttribute{
+				MarkdownDescription: "Custom views, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the custom view.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the custom view.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the custom view.",
+							Computed:            true,
+						},
+						"icon": schema.StringAttribute{
+							MarkdownDescription: "Icon of the custom view.",
+							Computed:            true,
+						},
+						"color": schema.StringAttribute{
+							MarkdownDescription: "Color of the custom view.",
+							Computed:            true,
+						},
+						"filter_data": schema.StringAttribute{
+							MarkdownDescription: "Issue filter of the custom view as a JSON encoded object.",
+							Computed:            true,
+						},
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the team the custom view is scoped to.",
+							Computed:            true,
+						},
+						"owner_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the user owning the custom view.",
+							Computed:            true,
+						},
+						"shared": schema.BoolAttribute{
+							MarkdownDescription: "Whether the custom view is shared with everyone in the workspace.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomViewsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomViewsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *CustomViewsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace, err := getWorkspace(ctx, *d.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(workspace.Organization.Id)
+	data.CustomViews = []CustomViewsDataSourceCustomViewModel{}
+
+	var after *string
+
+	for {
+		response, err := listCustomViews(ctx, *d.client, after)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list custom views, got error: %s", err))
+			return
+		}
+
+		for _, customView := range response.CustomViews.Nodes {
+			if !data.Name.IsNull() && !strings.EqualFold(customView.Name, data.Name.ValueString()) {
+				continue
+			}
+
+			if !data.OwnerId.IsNull() && customView.Owner.Id != data.OwnerId.ValueString() {
+				continue
+			}
+
+			if !data.TeamId.IsNull() && (customView.Team == nil || customView.Team.Id != data.TeamId.ValueString()) {
+				continue
+			}
+
+			filterData, err := jsonStringValue(types.StringNull(), customView.FilterData)
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode custom view filter, got error: %s", err))
+				return
+			}
+
+			item := CustomViewsDataSourceCustomViewModel{
+				Id:          types.StringValue(customView.Id),
+				Name:        types.StringValue(customView.Name),
+				Description: types.StringPointerValue(customView.Description),
+				Icon:        types.StringPointerValue(customView.Icon),
+				Color:       types.StringPointerValue(customView.Color),
+				FilterData:  filterData,
+				TeamId:      types.StringNull(),
+				OwnerId:     types.StringValue(customView.Owner.Id),
+				Shared:      types.BoolValue(customView.Shared),
+			}
+
+			if customView.Team != nil {
+				item.TeamId = types.StringValue(customView.Team.Id)
+			}
+
+			data.CustomViews = append(data.CustomViews, item)
+		}
+
+		if !response.CustomViews.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := response.CustomViews.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	sort.Slice(data.CustomViews, func(i, j int) bool {
+		if data.CustomViews[i].Name.ValueString() == data.CustomViews[j].Name.ValueString() {
+			return data.CustomViews[i].Id.ValueString() < data.CustomViews[j].Id.ValueString()
+		}
+
+		return data.CustomViews[i].Name.ValueString() < data.CustomViews[j].Name.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomViewsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCustomViewsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_custom_views.test", "id", "1e73fcad-aac6-4bbe-a5e1-e08cffe04eb5"),
+					resource.TestCheckResourceAttr("data.linear_custom_views.test", "custom_views.#", "1"),
+					resource.TestCheckResourceAttrPair("data.linear_custom_views.test", "custom_views.0.id", "linear_custom_view.test", "id"),
+					resource.TestCheckResourceAttr("data.linear_custom_views.test", "custom_views.0.name", "Custom Views Data Source"),
+					resource.TestCheckResourceAttr("data.linear_custom_views.test", "custom_views.0.filter_data", "{\"priority\":{\"eq\":1}}"),
+					resource.TestCheckResourceAttr("data.linear_custom_views.test", "custom_views.0.team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttrPair("data.linear_custom_views.test", "custom_views.0.owner_id", "linear_custom_view.test", "owner_id"),
+					resource.TestCheckResourceAttr("data.linear_custom_views.other_team", "custom_views.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCustomViewsDataSourceConfig = `
+resource "linear_custom_view" "test" {
+  name = "Custom Views Data Source"
+  filter_data = jsonencode({ priority = { eq = 1 } })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+data "linear_custom_views" "test" {
+  name = "custom views data source"
+  owner_id = linear_custom_view.test.owner_id
+}
+
+data "linear_custom_views" "other_team" {
+  name = "custom views data source"
+  team_id = "00000000-0000-0000-0000-000000000000"
+
+  depends_on = [linear_custom_view.test]
+}
+`
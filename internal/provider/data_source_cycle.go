@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CycleDataSource{}
+
+func NewCycleDataSource() datasource.DataSource {
+	return &CycleDataSource{}
+}
+
+type CycleDataSource struct {
+	client *graphql.Client
+}
+
+type CycleDataSourceModel struct {
+	Id          types.String  `tfsdk:"id"`
+	TeamId      types.String  `tfsdk:"team_id"`
+	State       types.String  `tfsdk:"state"`
+	Number      types.Int64   `tfsdk:"number"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	StartsAt    types.String  `tfsdk:"starts_at"`
+	EndsAt      types.String  `tfsdk:"ends_at"`
+	CompletedAt types.String  `tfsdk:"completed_at"`
+	Progress    types.Float64 `tfsdk:"progress"`
+}
+
+func (d *CycleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cycle"
+}
+
+func (d *CycleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Current, next or previous cycle of a Linear team.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the cycle.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Which cycle of the team to return, one of `current`, `next` or `previous`. Defaults to `current`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("current", "next", "previous"),
+				},
+			},
+			"number": schema.Int64Attribute{
+				MarkdownDescription: "Number of the cycle.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Custom name of the cycle.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the cycle.",
+				Computed:            true,
+			},
+			"starts_at": schema.StringAttribute{
+				MarkdownDescription: "Start time of the cycle.",
+				Computed:            true,
+			},
+			"ends_at": schema.StringAttribute{
+				MarkdownDescription: "End time of the cycle.",
+				Computed:            true,
+			},
+			"completed_at": schema.StringAttribute{
+				MarkdownDescription: "Completion time of the cycle.",
+				Computed:            true,
+			},
+			"progress": schema.Float64Attribute{
+				MarkdownDescription: "Progress of the cycle, between 0 and 1.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CycleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CycleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *CycleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.State.IsNull() || data.State.IsUnknown() {
+		data.State = types.StringValue("current")
+	}
+
+	field := map[string]string{
+		"current":  "isActive",
+		"next":     "isNext",
+		"previous": "isPrevious",
+	}[data.State.ValueString()]
+
+	filter := map[string]interface{}{field: map[string]interface{}{"eq": true}}
+
+	response, err := findCycle(ctx, *d.client, data.TeamId.ValueString(), filter)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find cycle, got error: %s", err))
+		return
+	}
+
+	if len(response.Team.Cycles.Nodes) == 0 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find cycle, team has no %s cycle", data.State.ValueString()))
+		return
+	}
+
+	cycle := response.Team.Cycles.Nodes[0].CycleDetails
+
+	data.Id = types.StringValue(cycle.Id)
+	data.Number = types.Int64Value(int64(cycle.Number))
+	data.Name = types.StringPointerValue(cycle.Name)
+	data.Description = types.StringPointerValue(cycle.Description)
+	data.StartsAt = types.StringValue(cycle.StartsAt.Format(time.RFC3339))
+	data.EndsAt = types.StringValue(cycle.EndsAt.Format(time.RFC3339))
+	data.CompletedAt = types.StringNull()
+	data.Progress = types.Float64Value(cycle.Progress)
+
+	if cycle.CompletedAt != nil {
+		data.CompletedAt = types.StringValue(cycle.CompletedAt.Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCycleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCycleDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.linear_cycle.current", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("data.linear_cycle.current", "state", "current"),
+					resource.TestCheckResourceAttr("data.linear_cycle.current", "number", "1"),
+					resource.TestCheckResourceAttrSet("data.linear_cycle.current", "starts_at"),
+					resource.TestCheckResourceAttrSet("data.linear_cycle.current", "ends_at"),
+					resource.TestCheckNoResourceAttr("data.linear_cycle.current", "completed_at"),
+					resource.TestCheckResourceAttr("data.linear_cycle.next", "state", "next"),
+					resource.TestCheckResourceAttr("data.linear_cycle.next", "number", "2"),
+					resource.TestCheckResourceAttrPair("data.linear_cycle.next", "starts_at", "data.linear_cycle.current", "ends_at"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCycleDataSourceConfig = `
+resource "linear_team" "test" {
+  key = "CYC"
+  name = "Cycle Data Source"
+
+  cycles = {
+    enabled = true
+  }
+}
+
+data "linear_cycle" "current" {
+  team_id = linear_team.test.id
+}
+
+data "linear_cycle" "next" {
+  team_id = linear_team.test.id
+  state = "next"
+}
+`
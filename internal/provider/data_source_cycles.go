@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CyclesDataSource{}
+
+func NewCyclesDataSource() datasource.DataSource {
+	return &CyclesDataSource{}
+}
+
+type CyclesDataSource struct {
+	client *graphql.Client
+}
+
+type CyclesDataSourceModel struct {
+	Id     types.String                 `tfsdk:"id"`
+	TeamId types.String                 `tfsdk:"team_id"`
+	State  types.String                 `tfsdk:"state"`
+	Cycles []CyclesDataSourceCycleModel `tfsdk:"cycles"`
+}
+
+type CyclesDataSourceCycleModel struct {
+	Id          types.String  `tfsdk:"id"`
+	Number      types.Int64   `tfsdk:"number"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	StartsAt    types.String  `tfsdk:"starts_at"`
+	EndsAt      types.String  `tfsdk:"ends_at"`
+	CompletedAt types.String  `tfsdk:"completed_at"`
+	Progress    types.Float64 `tfsdk:"progress"`
+}
+
+func (d *CyclesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cycles"
+}
+
+func (d *CyclesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear cycles of a team.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Only return the cycles in this state, one of `active`, `upcoming` or `past`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "upcoming", "past"),
+				},
+			},
+			"cycles": schema.ListNestedAttribute{
+				MarkdownDescription: "Cycles, sorted by number.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the cycle.",
+							Computed:            true,
+						},
+						"number": schema.Int64Attribute{
+							MarkdownDescription: "Number of the cycle.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Custom name of the cycle.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the cycle.",
+							Computed:            true,
+						},
+						"starts_at": schema.StringAttribute{
+							MarkdownDescription: "Start time of the cycle.",
+							Computed:            true,
+						},
+						"ends_at": schema.StringAttribute{
+							MarkdownDescription: "End time of the cycle.",
+							Computed:            true,
+						},
+						"completed_at": schema.StringAttribute{
+							MarkdownDescription: "Completion time of the cycle.",
+							Computed:            true,
+						},
+						"progress": schema.Float64Attribute{
+							MarkdownDescription: "Progress of the cycle, between 0 and 1.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CyclesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CyclesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *CyclesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := map[string]interface{}{}
+
+	if !data.State.IsNull() {
+		field := map[string]string{
+			"active":   "isActive",
+			"upcoming": "isFuture",
+			"past":     "isPast",
+		}[data.State.ValueString()]
+
+		filter[field] = map[string]interface{}{"eq": true}
+	}
+
+	data.Id = data.TeamId
+	data.Cycles = []CyclesDataSourceCycleModel{}
+
+	var after *string
+
+	for {
+		response, err := listCycles(ctx, *d.client, data.TeamId.ValueString(), filter, after)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list cycles, got error: %s", err))
+			return
+		}
+
+		for _, node := range response.Team.Cycles.Nodes {
+			data.Cycles = append(data.Cycles, readCyclesDataSourceCycle(node.CycleDetails))
+		}
+
+		if !response.Team.Cycles.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := response.Team.Cycles.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	sort.Slice(data.Cycles, func(i, j int) bool {
+		return data.Cycles[i].Number.ValueInt64() < data.Cycles[j].Number.ValueInt64()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func readCyclesDataSourceCycle(cycle CycleDetails) CyclesDataSourceCycleModel {
+	data := CyclesDataSourceCycleModel{
+		Id:          types.StringValue(cycle.Id),
+		Number:      types.Int64Value(int64(cycle.Number)),
+		Name:        types.StringPointerValue(cycle.Name),
+		Description: types.StringPointerValue(cycle.Description),
+		StartsAt:    types.StringValue(cycle.StartsAt.Format(time.RFC3339)),
+		EndsAt:      types.StringValue(cycle.EndsAt.Format(time.RFC3339)),
+		CompletedAt: types.StringNull(),
+		Progress:    types.Float64Value(cycle.Progress),
+	}
+
+	if cycle.CompletedAt != nil {
+		data.CompletedAt = types.StringValue(cycle.CompletedAt.Format(time.RFC3339))
+	}
+
+	return data
+}
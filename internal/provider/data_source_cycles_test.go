@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCyclesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCyclesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.linear_cycles.all", "id", "linear_team.test", "id"),
+					resource.TestCheckResourceAttr("data.linear_cycles.all", "cycles.#", "3"),
+					resource.TestCheckResourceAttr("data.linear_cycles.all", "cycles.0.number", "1"),
+					resource.TestCheckResourceAttr("data.linear_cycles.all", "cycles.2.number", "3"),
+					resource.TestCheckResourceAttr("data.linear_cycles.active", "cycles.#", "1"),
+					resource.TestCheckResourceAttr("data.linear_cycles.active", "cycles.0.number", "1"),
+					resource.TestCheckResourceAttr("data.linear_cycles.upcoming", "cycles.#", "2"),
+					resource.TestCheckResourceAttr("data.linear_cycles.past", "cycles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCyclesDataSourceConfig = `
+resource "linear_team" "test" {
+  key = "CYS"
+  name = "Cycles Data Source"
+
+  cycles = {
+    enabled = true
+    upcoming = 2
+  }
+}
+
+data "linear_cycles" "all" {
+  team_id = linear_team.test.id
+}
+
+data "linear_cycles" "active" {
+  team_id = linear_team.test.id
+  state = "active"
+}
+
+data "linear_cycles" "upcoming" {
+  team_id = linear_team.test.id
+  state = "upcoming"
+}
+
+data "linear_cycles" "past" {
+  team_id = linear_team.test.id
+  state = "past"
+}
+`
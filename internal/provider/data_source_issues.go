@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &IssuesDataSource{}
+
+func NewIssuesDataSource() datasource.DataSource {
+	return &IssuesDataSource{}
+}
+
+type IssuesDataSource struct {
+	client *graphql.Client
+}
+
+type IssuesDataSourceModel struct {
+	Id           types.String                 `tfsdk:"id"`
+	Filter       types.String                 `tfsdk:"filter"`
+	TeamId       types.String                 `tfsdk:"team_id"`
+	StateType    types.String                 `tfsdk:"state_type"`
+	LabelId      types.String                 `tfsdk:"label_id"`
+	AssigneeId   types.String                 `tfsdk:"assignee_id"`
+	CreatedAfter types.String                 `tfsdk:"created_after"`
+	Limit        types.Int64                  `tfsdk:"limit"`
+	Issues       []IssuesDataSourceIssueModel `tfsdk:"issues"`
+}
+
+type IssuesDataSourceIssueModel struct {
+	Id         types.String  `tfsdk:"id"`
+	Identifier types.String  `tfsdk:"identifier"`
+	Title      types.String  `tfsdk:"title"`
+	Priority   types.Float64 `tfsdk:"priority"`
+	TeamId     types.String  `tfsdk:"team_id"`
+	StateId    types.String  `tfsdk:"state_id"`
+	StateType  types.String  `tfsdk:"state_type"`
+	AssigneeId types.String  `tfsdk:"assignee_id"`
+	LabelIds   types.Set     `tfsdk:"label_ids"`
+	CreatedAt  types.String  `tfsdk:"created_at"`
+	Url        types.String  `tfsdk:"url"`
+}
+
+func (d *IssuesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issues"
+}
+
+func (d *IssuesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear issues of the workspace matching a filter.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace.",
+				Computed:            true,
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "Issue filter of the Linear API as a JSON encoded object, e.g. built with `jsonencode`. It is combined with the other filters of the data source.",
+				Optional:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the issues of this team.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"state_type": schema.StringAttribute{
+				MarkdownDescription: "Only return the issues whose workflow state has this type, one of `triage`, `backlog`, `unstarted`, `started`, `completed` or `canceled`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("triage", "backlog", "unstarted", "started", "completed", "canceled"),
+				},
+			},
+			"label_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the issues having this label.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"assignee_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the issues assigned to this user.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only return the issues created on or after this date.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex(), "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of issues to read. A warning is raised when more issues match the filters. **Default** `250`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"issues": schema.ListNestedAttribute{
+				MarkdownDescription: "Issues, sorted by creation date.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the issue.",
+							Computed:            true,
+						},
+						"identifier": schema.StringAttribute{
+							MarkdownDescription: "Human readable identifier of the issue, e.g. `ENG-123`.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the issue.",
+							Computed:            true,
+						},
+						"priority": schema.Float64Attribute{
+							MarkdownDescription: "Priority of the issue.",
+							Computed:            true,
+						},
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the team of the issue.",
+							Computed:            true,
+						},
+						"state_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the workflow state of the issue.",
+							Computed:            true,
+						},
+						"state_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the workflow state of the issue.",
+							Computed:            true,
+						},
+						"assignee_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the assignee of the issue.",
+							Computed:            true,
+						},
+						"label_ids": schema.SetAttribute{
+							MarkdownDescription: "Identifiers of the labels of the issue, at most 250.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation time of the issue.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL of the issue.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IssuesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IssuesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *IssuesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := map[string]interface{}{}
+
+	if !data.Filter.IsNull() {
+		raw, diags := jsonObjectValue(path.Root("filter"), data.Filter)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var custom map[string]interface{}
+
+		if err := json.Unmarshal(raw, &custom); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filter"), "Invalid JSON Object", fmt.Sprintf("Expected a JSON encoded object, got error: %s", err))
+			return
+		}
+
+		// Keep the custom filter apart so that it can not clash with the other filters.
+		filter["and"] = []interface{}{custom}
+	}
+
+	if !data.TeamId.IsNull() {
+		filter["team"] = map[string]interface{}{"id": map[string]interface{}{"eq": data.TeamId.ValueString()}}
+	}
+
+	if !data.StateType.IsNull() {
+		filter["state"] = map[string]interface{}{"type": map[string]interface{}{"eq": data.StateType.ValueString()}}
+	}
+
+	if !data.LabelId.IsNull() {
+		filter["labels"] = map[string]interface{}{"some": map[string]interface{}{"id": map[string]interface{}{"eq": data.LabelId.ValueString()}}}
+	}
+
+	if !data.AssigneeId.IsNull() {
+		filter["assignee"] = map[string]interface{}{"id": map[string]interface{}{"eq": data.AssigneeId.ValueString()}}
+	}
+
+	if !data.CreatedAfter.IsNull() {
+		filter["createdAt"] = map[string]interface{}{"gte": data.CreatedAfter.ValueString()}
+	}
+
+	workspace, err := getWorkspace(ctx, *d.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(workspace.Organization.Id)
+
+	limit := 250
+
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	var issues []IssueSummary
+	var after *string
+
+	for {
+		// Ask for one more issue than left to tell whether the limit cuts off any.
+		first := limit - len(issues) + 1
+
+		if first > 250 {
+			first = 250
+		}
+
+		response, err := listIssues(ctx, *d.client, filter, first, after)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list issues, got error: %s", err))
+			return
+		}
+
+		for _, node := range response.Issues.Nodes {
+			issues = append(issues, node.IssueSummary)
+		}
+
+		if len(issues) > limit {
+			issues = issues[:limit]
+
+			resp.Diagnostics.AddWarning(
+				"Issues Truncated",
+				fmt.Sprintf("More than %d issues match the filters, only the first %d are returned. Narrow down the filters or raise limit to read them all.", limit, limit),
+			)
+
+			break
+		}
+
+		if !response.Issues.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := response.Issues.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].CreatedAt.Equal(issues[j].CreatedAt) {
+			return issues[i].Id < issues[j].Id
+		}
+
+		return issues[i].CreatedAt.Before(issues[j].CreatedAt)
+	})
+
+	data.Issues = []IssuesDataSourceIssueModel{}
+
+	for _, issue := range issues {
+		item, diags := readIssuesDataSourceIssue(ctx, issue)
+		resp.Diagnostics.Append(diags...)
+
+		data.Issues = append(data.Issues, item)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func readIssuesDataSourceIssue(ctx context.Context, issue IssueSummary) (IssuesDataSourceIssueModel, diag.Diagnostics) {
+	labelIds := []string{}
+
+	for _, label := range issue.Labels.Nodes {
+		labelIds = append(labelIds, label.Id)
+	}
+
+	labelIdsValue, diags := types.SetValueFrom(ctx, types.StringType, labelIds)
+
+	data := IssuesDataSourceIssueModel{
+		Id:         types.StringValue(issue.Id),
+		Identifier: types.StringValue(issue.Identifier),
+		Title:      types.StringValue(issue.Title),
+		Priority:   types.Float64Value(issue.Priority),
+		TeamId:     types.StringValue(issue.Team.Id),
+		StateId:    types.StringValue(issue.State.Id),
+		StateType:  types.StringValue(issue.State.Type),
+		AssigneeId: types.StringNull(),
+		LabelIds:   labelIdsValue,
+		CreatedAt:  types.StringValue(issue.CreatedAt.Format(time.RFC3339)),
+		Url:        types.StringValue(issue.Url),
+	}
+
+	if issue.Assignee != nil {
+		data.AssigneeId = types.StringValue(issue.Assignee.Id)
+	}
+
+	return data, diags
+}
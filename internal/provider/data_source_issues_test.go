@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIssuesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccIssuesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_issues.test", "id", "1e73fcad-aac6-4bbe-a5e1-e08cffe04eb5"),
+					resource.TestCheckResourceAttr("data.linear_issues.test", "issues.#", "1"),
+					resource.TestCheckResourceAttrPair("data.linear_issues.test", "issues.0.id", "linear_issue.test", "id"),
+					resource.TestCheckResourceAttrPair("data.linear_issues.test", "issues.0.identifier", "linear_issue.test", "identifier"),
+					resource.TestCheckResourceAttr("data.linear_issues.test", "issues.0.title", "Issues data source"),
+					resource.TestCheckResourceAttr("data.linear_issues.test", "issues.0.team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttrPair("data.linear_issues.test", "issues.0.state_id", "linear_issue.test", "state_id"),
+					resource.TestCheckResourceAttr("data.linear_issues.test", "issues.0.label_ids.#", "0"),
+					resource.TestCheckNoResourceAttr("data.linear_issues.test", "issues.0.assignee_id"),
+					resource.TestCheckResourceAttrSet("data.linear_issues.test", "issues.0.created_at"),
+					resource.TestCheckResourceAttrSet("data.linear_issues.test", "issues.0.url"),
+					resource.TestCheckResourceAttr("data.linear_issues.completed", "issues.#", "0"),
+					resource.TestCheckResourceAttr("data.linear_issues.limited", "issues.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIssuesDataSourceConfig = `
+resource "linear_issue" "test" {
+  title = "Issues data source"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+data "linear_issues" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  filter = jsonencode({ title = { eq = "Issues data source" } })
+
+  depends_on = [linear_issue.test]
+}
+
+data "linear_issues" "completed" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  state_type = "completed"
+  filter = jsonencode({ title = { eq = "Issues data source" } })
+
+  depends_on = [linear_issue.test]
+}
+
+data "linear_issues" "limited" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  limit = 1
+
+  depends_on = [linear_issue.test]
+}
+`
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ProjectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+type ProjectDataSource struct {
+	client *graphql.Client
+}
+
+type ProjectDataSourceModel struct {
+	Id          types.String  `tfsdk:"id"`
+	SlugId      types.String  `tfsdk:"slug_id"`
+	Name        types.String  `tfsdk:"name"`
+	TeamId      types.String  `tfsdk:"team_id"`
+	Description types.String  `tfsdk:"description"`
+	Icon        types.String  `tfsdk:"icon"`
+	Color       types.String  `tfsdk:"color"`
+	Priority    types.Float64 `tfsdk:"priority"`
+	Progress    types.Float64 `tfsdk:"progress"`
+	StatusId    types.String  `tfsdk:"status_id"`
+	StatusType  types.String  `tfsdk:"status_type"`
+	LeadId      types.String  `tfsdk:"lead_id"`
+	StartDate   types.String  `tfsdk:"start_date"`
+	TargetDate  types.String  `tfsdk:"target_date"`
+	Url         types.String  `tfsdk:"url"`
+	TeamIds     types.Set     `tfsdk:"team_ids"`
+}
+
+func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear project, looked up by slug or by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project.",
+				Computed:            true,
+			},
+			"slug_id": schema.StringAttribute{
+				MarkdownDescription: "Slug of the project, as found at the end of its URL. Exactly one of `slug_id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the project, matched case-insensitively.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of a team of the project, to tell apart projects with the same name.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+					stringvalidator.ConflictsWith(path.MatchRoot("slug_id")),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the project.",
+				Computed:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the project.",
+				Computed:            true,
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Color of the project.",
+				Computed:            true,
+			},
+			"priority": schema.Float64Attribute{
+				MarkdownDescription: "Priority of the project. No priority is 0, urgent is 1, high is 2, medium is 3, low is 4.",
+				Computed:            true,
+			},
+			"progress": schema.Float64Attribute{
+				MarkdownDescription: "Progress of the project, between 0 and 1.",
+				Computed:            true,
+			},
+			"status_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the status of the project.",
+				Computed:            true,
+			},
+			"status_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the status of the project, one of `backlog`, `planned`, `started`, `paused`, `completed` or `canceled`.",
+				Computed:            true,
+			},
+			"lead_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the lead of the project.",
+				Computed:            true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "Planned start date of the project.",
+				Computed:            true,
+			},
+			"target_date": schema.StringAttribute{
+				MarkdownDescription: "Planned completion date of the project.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the project.",
+				Computed:            true,
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the teams of the project.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ProjectDataSourceModel
+	var projects []ProjectDetails
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := data.SlugId.ValueString()
+
+	if !data.SlugId.IsNull() {
+		response, err := findProjectBySlug(ctx, *d.client, data.SlugId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find project, got error: %s", err))
+			return
+		}
+
+		for _, node := range response.Projects.Nodes {
+			projects = append(projects, node.ProjectDetails)
+		}
+	} else {
+		query = data.Name.ValueString()
+
+		response, err := findProjectByName(ctx, *d.client, data.Name.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find project, got error: %s", err))
+			return
+		}
+
+		for _, node := range response.Projects.Nodes {
+			if data.TeamId.IsNull() || projectHasTeam(node.ProjectDetails, data.TeamId.ValueString()) {
+				projects = append(projects, node.ProjectDetails)
+			}
+		}
+	}
+
+	if len(projects) != 1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find project, expected exactly one project matching %q, got: %d", query, len(projects)))
+		return
+	}
+
+	project := projects[0]
+
+	teamIdsValue, diags := types.SetValueFrom(ctx, types.StringType, projectDetailsTeamIds(project))
+	resp.Diagnostics.Append(diags...)
+
+	data.Id = types.StringValue(project.Id)
+	data.SlugId = types.StringValue(project.SlugId)
+	data.Name = types.StringValue(project.Name)
+	data.Description = types.StringValue(project.Description)
+	data.Icon = types.StringPointerValue(project.Icon)
+	data.Color = types.StringValue(project.Color)
+	data.Priority = types.Float64Value(float64(project.Priority))
+	data.Progress = types.Float64Value(project.Progress)
+	data.StatusId = types.StringValue(project.Status.Id)
+	data.StatusType = types.StringValue(string(project.Status.Type))
+	data.LeadId = types.StringNull()
+	data.StartDate = types.StringPointerValue(project.StartDate)
+	data.TargetDate = types.StringPointerValue(project.TargetDate)
+	data.Url = types.StringValue(project.Url)
+	data.TeamIds = teamIdsValue
+
+	if project.Lead != nil {
+		data.LeadId = types.StringValue(project.Lead.Id)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func projectHasTeam(project ProjectDetails, teamId string) bool {
+	for _, team := range project.Teams.Nodes {
+		if team.Id == teamId {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ProjectStatusesDataSource{}
+
+func NewProjectStatusesDataSource() datasource.DataSource {
+	return &ProjectStatusesDataSource{}
+}
+
+type ProjectStatusesDataSource struct {
+	client *graphql.Client
+}
+
+type ProjectStatusesDataSourceModel struct {
+	Id       types.String                           `tfsdk:"id"`
+	Type     types.String                           `tfsdk:"type"`
+	Statuses []ProjectStatusesDataSourceStatusModel `tfsdk:"statuses"`
+}
+
+type ProjectStatusesDataSourceStatusModel struct {
+	Id          types.String  `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Color       types.String  `tfsdk:"color"`
+	Description types.String  `tfsdk:"description"`
+	Position    types.Float64 `tfsdk:"position"`
+	Type        types.String  `tfsdk:"type"`
+	Indefinite  types.Bool    `tfsdk:"indefinite"`
+}
+
+func (d *ProjectStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_statuses"
+}
+
+func (d *ProjectStatusesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear project statuses of the workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return the project statuses of this type, one of `backlog`, `planned`, `started`, `paused`, `completed` or `canceled`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("backlog", "planned", "started", "paused", "completed", "canceled"),
+				},
+			},
+			"statuses": schema.ListNestedAttribute{
+				MarkdownDescription: "Project statuses, sorted by position.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the project status.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the project status.",
+							Computed:            true,
+						},
+						"color": schema.StringAttribute{
+							MarkdownDescription: "Color of the project status.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the project status.",
+							Computed:            true,
+						},
+						"position": schema.Float64Attribute{
+							MarkdownDescription: "Position of the project status in the project flow.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the project status.",
+							Computed:            true,
+						},
+						"indefinite": schema.BoolAttribute{
+							MarkdownDescription: "Whether a project can stay in this status indefinitely.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ProjectStatusesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := listProjectStatuses(ctx, *d.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list project statuses, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(response.Organization.Id)
+	data.Statuses = []ProjectStatusesDataSourceStatusModel{}
+
+	for _, status := range response.Organization.ProjectStatuses {
+		if !data.Type.IsNull() && string(status.Type) != data.Type.ValueString() {
+			continue
+		}
+
+		data.Statuses = append(data.Statuses, ProjectStatusesDataSourceStatusModel{
+			Id:          types.StringValue(status.Id),
+			Name:        types.StringValue(status.Name),
+			Color:       types.StringValue(status.Color),
+			Description: types.StringPointerValue(status.Description),
+			Position:    types.Float64Value(status.Position),
+			Type:        types.StringValue(string(status.Type)),
+			Indefinite:  types.BoolValue(status.Indefinite),
+		})
+	}
+
+	sort.Slice(data.Statuses, func(i, j int) bool {
+		return data.Statuses[i].Position.ValueFloat64() < data.Statuses[j].Position.ValueFloat64()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectStatusesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProjectStatusesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_project_statuses.all", "id", "1e73fcad-aac6-4bbe-a5e1-e08cffe04eb5"),
+					resource.TestCheckResourceAttrSet("data.linear_project_statuses.all", "statuses.#"),
+					resource.TestCheckResourceAttr("data.linear_project_statuses.started", "statuses.0.type", "started"),
+					resource.TestMatchResourceAttr("data.linear_project_statuses.started", "statuses.0.id", uuidRegex()),
+					resource.TestMatchResourceAttr("data.linear_project_statuses.started", "statuses.0.color", colorRegex()),
+					resource.TestCheckResourceAttrSet("data.linear_project_statuses.started", "statuses.0.name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProjectStatusesDataSourceConfig = `
+data "linear_project_statuses" "all" {}
+
+data "linear_project_statuses" "started" {
+  type = "started"
+}
+`
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProjectDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.linear_project.name", "id", "linear_project.test", "id"),
+					resource.TestCheckResourceAttr("data.linear_project.name", "name", "Data Source Project"),
+					resource.TestCheckResourceAttrSet("data.linear_project.name", "slug_id"),
+					resource.TestCheckResourceAttrSet("data.linear_project.name", "status_id"),
+					resource.TestCheckResourceAttrSet("data.linear_project.name", "url"),
+					resource.TestCheckResourceAttr("data.linear_project.name", "team_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.linear_project.name", "team_ids.0", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckNoResourceAttr("data.linear_project.name", "lead_id"),
+					resource.TestCheckResourceAttrPair("data.linear_project.slug", "id", "linear_project.test", "id"),
+					resource.TestCheckResourceAttrPair("data.linear_project.slug", "name", "data.linear_project.name", "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProjectDataSourceConfig = `
+resource "linear_project" "test" {
+  name = "Data Source Project"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+data "linear_project" "name" {
+  name = "data source project"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+
+  depends_on = [linear_project.test]
+}
+
+data "linear_project" "slug" {
+  slug_id = data.linear_project.name.slug_id
+}
+`
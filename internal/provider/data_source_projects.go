@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ProjectsDataSource{}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+type ProjectsDataSource struct {
+	client *graphql.Client
+}
+
+type ProjectsDataSourceModel struct {
+	Id           types.String                     `tfsdk:"id"`
+	TeamId       types.String                     `tfsdk:"team_id"`
+	StatusType   types.String                     `tfsdk:"status_type"`
+	LeadId       types.String                     `tfsdk:"lead_id"`
+	InitiativeId types.String                     `tfsdk:"initiative_id"`
+	UpdatedSince types.String                     `tfsdk:"updated_since"`
+	Projects     []ProjectsDataSourceProjectModel `tfsdk:"projects"`
+}
+
+type ProjectsDataSourceProjectModel struct {
+	Id          types.String  `tfsdk:"id"`
+	SlugId      types.String  `tfsdk:"slug_id"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	Icon        types.String  `tfsdk:"icon"`
+	Color       types.String  `tfsdk:"color"`
+	Priority    types.Float64 `tfsdk:"priority"`
+	Progress    types.Float64 `tfsdk:"progress"`
+	StatusId    types.String  `tfsdk:"status_id"`
+	StatusType  types.String  `tfsdk:"status_type"`
+	LeadId      types.String  `tfsdk:"lead_id"`
+	StartDate   types.String  `tfsdk:"start_date"`
+	TargetDate  types.String  `tfsdk:"target_date"`
+	Url         types.String  `tfsdk:"url"`
+	TeamIds     types.Set     `tfsdk:"team_ids"`
+}
+
+func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear projects of the workspace, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the projects of this team.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"status_type": schema.StringAttribute{
+				MarkdownDescription: "Only return the projects whose status has this type, one of `backlog`, `planned`, `started`, `paused`, `completed` or `canceled`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("backlog", "planned", "started", "paused", "completed", "canceled"),
+				},
+			},
+			"lead_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the projects led by this user.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"initiative_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the projects of this initiative.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"updated_since": schema.StringAttribute{
+				MarkdownDescription: "Only return the projects updated on or after this date.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex(), "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "Projects, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the project.",
+							Computed:            true,
+						},
+						"slug_id": schema.StringAttribute{
+							MarkdownDescription: "Slug of the project.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the project.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the project.",
+							Computed:            true,
+						},
+						"icon": schema.StringAttribute{
+							MarkdownDescription: "Icon of the project.",
+							Computed:            true,
+						},
+						"color": schema.StringAttribute{
+							MarkdownDescription: "Color of the project.",
+							Computed:            true,
+						},
+						"priority": schema.Float64Attribute{
+							MarkdownDescription: "Priority of the project.",
+							Computed:            true,
+						},
+						"progress": schema.Float64Attribute{
+							MarkdownDescription: "Progress of the project, between 0 and 1.",
+							Computed:            true,
+						},
+						"status_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the status of the project.",
+							Computed:            true,
+						},
+						"status_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the status of the project.",
+							Computed:            true,
+						},
+						"lead_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the lead of the project.",
+							Computed:            true,
+						},
+						"start_date": schema.StringAttribute{
+							MarkdownDescription: "Planned start date of the project.",
+							Computed:            true,
+						},
+						"target_date": schema.StringAttribute{
+							MarkdownDescription: "Planned completion date of the project.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL of the project.",
+							Computed:            true,
+						},
+						"team_ids": schema.SetAttribute{
+							MarkdownDescription: "Identifiers of the teams of the project.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ProjectsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the filters which are set are sent, an empty filter matches every project.
+	filter := map[string]interface{}{}
+
+	if !data.TeamId.IsNull() {
+		filter["accessibleTeams"] = map[string]interface{}{"some": map[string]interface{}{"id": map[string]interface{}{"eq": data.TeamId.ValueString()}}}
+	}
+
+	if !data.StatusType.IsNull() {
+		filter["status"] = map[string]interface{}{"type": map[string]interface{}{"eq": data.StatusType.ValueString()}}
+	}
+
+	if !data.LeadId.IsNull() {
+		filter["lead"] = map[string]interface{}{"id": map[string]interface{}{"eq": data.LeadId.ValueString()}}
+	}
+
+	if !data.InitiativeId.IsNull() {
+		filter["initiatives"] = map[string]interface{}{"some": map[string]interface{}{"id": map[string]interface{}{"eq": data.InitiativeId.ValueString()}}}
+	}
+
+	if !data.UpdatedSince.IsNull() {
+		filter["updatedAt"] = map[string]interface{}{"gte": data.UpdatedSince.ValueString()}
+	}
+
+	workspace, err := getWorkspace(ctx, *d.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(workspace.Organization.Id)
+	data.Projects = []ProjectsDataSourceProjectModel{}
+
+	var after *string
+
+	for {
+		response, err := listProjects(ctx, *d.client, filter, after)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list projects, got error: %s", err))
+			return
+		}
+
+		for _, node := range response.Projects.Nodes {
+			project, diags := readProjectsDataSourceProject(ctx, node.ProjectDetails)
+			resp.Diagnostics.Append(diags...)
+
+			data.Projects = append(data.Projects, project)
+		}
+
+		if !response.Projects.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := response.Projects.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sort.Slice(data.Projects, func(i, j int) bool {
+		if data.Projects[i].Name.ValueString() == data.Projects[j].Name.ValueString() {
+			return data.Projects[i].Id.ValueString() < data.Projects[j].Id.ValueString()
+		}
+
+		return data.Projects[i].Name.ValueString() < data.Projects[j].Name.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func readProjectsDataSourceProject(ctx context.Context, project ProjectDetails) (ProjectsDataSourceProjectModel, diag.Diagnostics) {
+	teamIds, diags := types.SetValueFrom(ctx, types.StringType, projectDetailsTeamIds(project))
+
+	data := ProjectsDataSourceProjectModel{
+		Id:          types.StringValue(project.Id),
+		SlugId:      types.StringValue(project.SlugId),
+		Name:        types.StringValue(project.Name),
+		Description: types.StringValue(project.Description),
+		Icon:        types.StringPointerValue(project.Icon),
+		Color:       types.StringValue(project.Color),
+		Priority:    types.Float64Value(float64(project.Priority)),
+		Progress:    types.Float64Value(project.Progress),
+		StatusId:    types.StringValue(project.Status.Id),
+		StatusType:  types.StringValue(string(project.Status.Type)),
+		LeadId:      types.StringNull(),
+		StartDate:   types.StringPointerValue(project.StartDate),
+		TargetDate:  types.StringPointerValue(project.TargetDate),
+		Url:         types.StringValue(project.Url),
+		TeamIds:     teamIds,
+	}
+
+	if project.Lead != nil {
+		data.LeadId = types.StringValue(project.Lead.Id)
+	}
+
+	return data, diags
+}
+
+func projectDetailsTeamIds(project ProjectDetails) []string {
+	teamIds := []string{}
+
+	for _, team := range project.Teams.Nodes {
+		teamIds = append(teamIds, team.Id)
+	}
+
+	return teamIds
+}
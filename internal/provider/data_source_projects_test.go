@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProjectsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_projects.all", "id", "1e73fcad-aac6-4bbe-a5e1-e08cffe04eb5"),
+					resource.TestCheckResourceAttrSet("data.linear_projects.all", "projects.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.linear_projects.team", "projects.*", map[string]string{
+						"name":     "Projects Data Source",
+						"progress": "0",
+					}),
+					resource.TestCheckResourceAttr("data.linear_projects.lead", "projects.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProjectsDataSourceConfig = `
+resource "linear_project" "test" {
+  name = "Projects Data Source"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+data "linear_projects" "all" {
+  depends_on = [linear_project.test]
+}
+
+data "linear_projects" "team" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  updated_since = "2023-01-01"
+
+  depends_on = [linear_project.test]
+}
+
+data "linear_projects" "lead" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  lead_id = "00000000-0000-0000-0000-000000000000"
+
+  depends_on = [linear_project.test]
+}
+`
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TeamDataSource{}
+
+func NewTeamDataSource() datasource.DataSource {
+	return &TeamDataSource{}
+}
+
+type TeamDataSource struct {
+	client *graphql.Client
+}
+
+type TeamDataSourceModel struct {
+	Id                  types.String  `tfsdk:"id"`
+	Key                 types.String  `tfsdk:"key"`
+	Name                types.String  `tfsdk:"name"`
+	Private             types.Bool    `tfsdk:"private"`
+	Description         types.String  `tfsdk:"description"`
+	Icon                types.String  `tfsdk:"icon"`
+	Color               types.String  `tfsdk:"color"`
+	Timezone            types.String  `tfsdk:"timezone"`
+	AutoArchivePeriod   types.Float64 `tfsdk:"auto_archive_period"`
+	AutoClosePeriod     types.Float64 `tfsdk:"auto_close_period"`
+	TriageEnabled       types.Bool    `tfsdk:"triage_enabled"`
+	CyclesEnabled       types.Bool    `tfsdk:"cycles_enabled"`
+	IssueEstimationType types.String  `tfsdk:"issue_estimation_type"`
+}
+
+func (d *TeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear team, looked up by key or by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Computed:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Key of the team. Exactly one of `key` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the team, matched case-insensitively.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"private": schema.BoolAttribute{
+				MarkdownDescription: "Whether the team is private.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the team.",
+				Computed:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the team.",
+				Computed:            true,
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Color of the team.",
+				Computed:            true,
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "Timezone of the team.",
+				Computed:            true,
+			},
+			"auto_archive_period": schema.Float64Attribute{
+				MarkdownDescription: "Period after which closed and completed issues are automatically archived, in months.",
+				Computed:            true,
+			},
+			"auto_close_period": schema.Float64Attribute{
+				MarkdownDescription: "Period after which issues are automatically closed, in months. `0` means disabled.",
+				Computed:            true,
+			},
+			"triage_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether triage mode is enabled for the team.",
+				Computed:            true,
+			},
+			"cycles_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether cycles are enabled for the team.",
+				Computed:            true,
+			},
+			"issue_estimation_type": schema.StringAttribute{
+				MarkdownDescription: "Issue estimation type of the team.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TeamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *TeamDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Teams can be fetched by key directly, names have to be searched.
+	id := data.Key.ValueString()
+
+	if !data.Name.IsNull() {
+		response, err := findTeamByName(ctx, *d.client, data.Name.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find team, got error: %s", err))
+			return
+		}
+
+		if len(response.Teams.Nodes) != 1 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find team, expected exactly one team named %q, got: %d", data.Name.ValueString(), len(response.Teams.Nodes)))
+			return
+		}
+
+		id = response.Teams.Nodes[0].Id
+	}
+
+	response, err := getTeam(ctx, *d.client, id)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
+		return
+	}
+
+	team := response.Team.Team
+
+	data.Id = types.StringValue(team.Id)
+	data.Key = types.StringValue(team.Key)
+	data.Name = types.StringValue(team.Name)
+	data.Private = types.BoolValue(team.Private)
+	data.Description = types.StringPointerValue(team.Description)
+	data.Icon = types.StringPointerValue(team.Icon)
+	data.Color = types.StringPointerValue(team.Color)
+	data.Timezone = types.StringValue(team.Timezone)
+	data.AutoArchivePeriod = types.Float64Value(team.AutoArchivePeriod)
+	data.AutoClosePeriod = types.Float64Value(0)
+	data.TriageEnabled = types.BoolValue(team.TriageEnabled)
+	data.CyclesEnabled = types.BoolValue(team.CyclesEnabled)
+	data.IssueEstimationType = types.StringValue(team.IssueEstimationType)
+
+	if team.AutoClosePeriod != nil {
+		data.AutoClosePeriod = types.Float64Value(*team.AutoClosePeriod)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *d.client, req.Config, &resp.State, teamDataSourceOptionalAttributes)...)
+}
+
+// teamDataSourceOptionalAttributes are the attributes of a team backed by the
+// optional fields of the API.
+var teamDataSourceOptionalAttributes = []optionalAttribute{
+	{path.MatchRoot("timezone"), "Team", "timezone"},
+}
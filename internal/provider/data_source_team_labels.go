@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TeamLabelsDataSource{}
+
+func NewTeamLabelsDataSource() datasource.DataSource {
+	return &TeamLabelsDataSource{}
+}
+
+type TeamLabelsDataSource struct {
+	client *graphql.Client
+}
+
+type TeamLabelsDataSourceModel struct {
+	Id     types.String                 `tfsdk:"id"`
+	TeamId types.String                 `tfsdk:"team_id"`
+	Labels []LabelsDataSourceLabelModel `tfsdk:"labels"`
+}
+
+type LabelsDataSourceLabelModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+	ParentId    types.String `tfsdk:"parent_id"`
+	IsGroup     types.Bool   `tfsdk:"is_group"`
+}
+
+func (d *TeamLabelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_labels"
+}
+
+func (d *TeamLabelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear labels of a team.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"labels": labelsDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *TeamLabelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *TeamLabelsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.TeamId
+	data.Labels = []LabelsDataSourceLabelModel{}
+
+	var after *string
+
+	for {
+		response, err := listLabelsOfTeam(ctx, *d.client, data.TeamId.ValueString(), after)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list team labels, got error: %s", err))
+			return
+		}
+
+		for _, node := range response.IssueLabels.Nodes {
+			data.Labels = append(data.Labels, readLabelsDataSourceLabel(node.IssueLabel, node.IsGroup))
+		}
+
+		if !response.IssueLabels.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := response.IssueLabels.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	sortLabelsDataSourceLabels(data.Labels)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func labelsDataSourceAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Labels, sorted by name.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					MarkdownDescription: "Identifier of the label.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of the label.",
+					Computed:            true,
+				},
+				"description": schema.StringAttribute{
+					MarkdownDescription: "Description of the label.",
+					Computed:            true,
+				},
+				"color": schema.StringAttribute{
+					MarkdownDescription: "Color of the label.",
+					Computed:            true,
+				},
+				"parent_id": schema.StringAttribute{
+					MarkdownDescription: "Identifier of the label group the label belongs to.",
+					Computed:            true,
+				},
+				"is_group": schema.BoolAttribute{
+					MarkdownDescription: "Whether the label is a group of labels.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func readLabelsDataSourceLabel(label IssueLabel, isGroup bool) LabelsDataSourceLabelModel {
+	data := LabelsDataSourceLabelModel{
+		Id:          types.StringValue(label.Id),
+		Name:        types.StringValue(label.Name),
+		Description: types.StringPointerValue(label.Description),
+		Color:       types.StringPointerValue(label.Color),
+		ParentId:    types.StringNull(),
+		IsGroup:     types.BoolValue(isGroup),
+	}
+
+	if label.Parent != nil {
+		data.ParentId = types.StringValue(label.Parent.Id)
+	}
+
+	return data
+}
+
+func sortLabelsDataSourceLabels(labels []LabelsDataSourceLabelModel) {
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].Name.ValueString() < labels[j].Name.ValueString()
+	})
+}
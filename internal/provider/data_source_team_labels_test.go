@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamLabelsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeamLabelsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_team_labels.test", "id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.linear_team_labels.test", "labels.*", map[string]string{
+						"name":        "Listed",
+						"description": "Managed by Terraform",
+						"color":       "#00ff00",
+						"is_group":    "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccTeamLabelsDataSourceConfig = `
+resource "linear_team_label" "test" {
+  name = "Listed"
+  description = "Managed by Terraform"
+  color = "#00ff00"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+data "linear_team_labels" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+
+  depends_on = [linear_team_label.test]
+}
+`
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeamDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_team.key", "id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("data.linear_team.key", "key", "DEF"),
+					resource.TestCheckResourceAttrSet("data.linear_team.key", "name"),
+					resource.TestCheckResourceAttrSet("data.linear_team.key", "timezone"),
+					resource.TestCheckResourceAttrPair("data.linear_team.name", "id", "data.linear_team.key", "id"),
+					resource.TestCheckResourceAttrPair("data.linear_team.name", "key", "data.linear_team.key", "key"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTeamDataSourceConfig = `
+data "linear_team" "key" {
+  key = "DEF"
+}
+
+data "linear_team" "name" {
+  name = data.linear_team.key.name
+}
+`
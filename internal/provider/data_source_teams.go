@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TeamsDataSource{}
+
+func NewTeamsDataSource() datasource.DataSource {
+	return &TeamsDataSource{}
+}
+
+type TeamsDataSource struct {
+	client *graphql.Client
+}
+
+type TeamsDataSourceModel struct {
+	Id           types.String               `tfsdk:"id"`
+	Keys         types.Set                  `tfsdk:"keys"`
+	NameContains types.String               `tfsdk:"name_contains"`
+	Teams        []TeamsDataSourceTeamModel `tfsdk:"teams"`
+}
+
+type TeamsDataSourceTeamModel struct {
+	Id      types.String `tfsdk:"id"`
+	Key     types.String `tfsdk:"key"`
+	Name    types.String `tfsdk:"name"`
+	Private types.Bool   `tfsdk:"private"`
+}
+
+func (d *TeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (d *TeamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear teams of the workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace.",
+				Computed:            true,
+			},
+			"keys": schema.SetAttribute{
+				MarkdownDescription: "Only return the teams with these keys.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"name_contains": schema.StringAttribute{
+				MarkdownDescription: "Only return the teams whose name contains this string, case-insensitively.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"teams": schema.ListNestedAttribute{
+				MarkdownDescription: "Teams, sorted by key.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the team.",
+							Computed:            true,
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Key of the team.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the team.",
+							Computed:            true,
+						},
+						"private": schema.BoolAttribute{
+							MarkdownDescription: "Whether the team is private.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TeamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *TeamsDataSourceModel
+	var keys []string
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Keys.IsNull() {
+		resp.Diagnostics.Append(data.Keys.ElementsAs(ctx, &keys, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace, err := getWorkspace(ctx, *d.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(workspace.Organization.Id)
+	data.Teams = []TeamsDataSourceTeamModel{}
+
+	var after *string
+
+	for {
+		response, err := listTeams(ctx, *d.client, after)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list teams, got error: %s", err))
+			return
+		}
+
+		for _, team := range response.Teams.Nodes {
+			if keys != nil && !containsString(keys, team.Key) {
+				continue
+			}
+
+			if !data.NameContains.IsNull() && !strings.Contains(strings.ToLower(team.Name), strings.ToLower(data.NameContains.ValueString())) {
+				continue
+			}
+
+			data.Teams = append(data.Teams, TeamsDataSourceTeamModel{
+				Id:      types.StringValue(team.Id),
+				Key:     types.StringValue(team.Key),
+				Name:    types.StringValue(team.Name),
+				Private: types.BoolValue(team.Private),
+			})
+		}
+
+		if !response.Teams.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := response.Teams.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	sort.Slice(data.Teams, func(i, j int) bool {
+		return data.Teams[i].Key.ValueString() < data.Teams[j].Key.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeamsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_teams.all", "id", "1e73fcad-aac6-4bbe-a5e1-e08cffe04eb5"),
+					resource.TestCheckResourceAttrSet("data.linear_teams.all", "teams.#"),
+					resource.TestCheckResourceAttr("data.linear_teams.keys", "teams.#", "1"),
+					resource.TestCheckResourceAttr("data.linear_teams.keys", "teams.0.id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("data.linear_teams.keys", "teams.0.key", "DEF"),
+					resource.TestCheckResourceAttrSet("data.linear_teams.keys", "teams.0.name"),
+					resource.TestCheckResourceAttrSet("data.linear_teams.keys", "teams.0.private"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTeamsDataSourceConfig = `
+data "linear_teams" "all" {}
+
+data "linear_teams" "keys" {
+  keys = ["DEF"]
+}
+`
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+type UserDataSource struct {
+	client *graphql.Client
+}
+
+type UserDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Email       types.String `tfsdk:"email"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Name        types.String `tfsdk:"name"`
+	Admin       types.Bool   `tfsdk:"admin"`
+	Guest       types.Bool   `tfsdk:"guest"`
+	Active      types.Bool   `tfsdk:"active"`
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear user, looked up by email or by display name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user.",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the user, matched case-insensitively. Exactly one of `email` or `display_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("display_name")),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the user, matched case-insensitively.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Full name of the user.",
+				Computed:            true,
+			},
+			"admin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is an admin of the workspace.",
+				Computed:            true,
+			},
+			"guest": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a guest of the workspace.",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is active, i.e. has not been suspended.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *UserDataSourceModel
+	var users []User
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := data.Email.ValueString()
+
+	if !data.Email.IsNull() {
+		response, err := findUserByEmail(ctx, *d.client, data.Email.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user, got error: %s", err))
+			return
+		}
+
+		for _, node := range response.Users.Nodes {
+			users = append(users, node.User)
+		}
+	} else {
+		query = data.DisplayName.ValueString()
+
+		response, err := findUserByDisplayName(ctx, *d.client, data.DisplayName.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user, got error: %s", err))
+			return
+		}
+
+		for _, node := range response.Users.Nodes {
+			users = append(users, node.User)
+		}
+	}
+
+	if len(users) != 1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user, expected exactly one user matching %q, got: %d", query, len(users)))
+		return
+	}
+
+	data.Id = types.StringValue(users[0].Id)
+	data.Email = types.StringValue(users[0].Email)
+	data.DisplayName = types.StringValue(users[0].DisplayName)
+	data.Name = types.StringValue(users[0].Name)
+	data.Admin = types.BoolValue(users[0].Admin)
+	data.Guest = types.BoolValue(users[0].Guest)
+	data.Active = types.BoolValue(users[0].Active)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource(t *testing.T) {
+	email := os.Getenv("LINEAR_TEST_USER_EMAIL")
+
+	if email == "" {
+		t.Skip("LINEAR_TEST_USER_EMAIL must be set for user acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUserDataSourceConfig(strings.ToUpper(email)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.linear_user.email", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("data.linear_user.email", "email", email),
+					resource.TestCheckResourceAttrSet("data.linear_user.email", "display_name"),
+					resource.TestCheckResourceAttrSet("data.linear_user.email", "name"),
+					resource.TestCheckResourceAttr("data.linear_user.email", "active", "true"),
+					resource.TestCheckResourceAttrPair("data.linear_user.display_name", "id", "data.linear_user.email", "id"),
+					resource.TestCheckResourceAttrPair("data.linear_user.display_name", "email", "data.linear_user.email", "email"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserDataSourceConfig(email string) string {
+	return fmt.Sprintf(`
+data "linear_user" "email" {
+  email = "%s"
+}
+
+data "linear_user" "display_name" {
+  display_name = data.linear_user.email.display_name
+}
+`, email)
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &WebhooksDataSource{}
+
+func NewWebhooksDataSource() datasource.DataSource {
+	return &WebhooksDataSource{}
+}
+
+type WebhooksDataSource struct {
+	client *graphql.Client
+}
+
+type WebhooksDataSourceModel struct {
+	Id       types.String                     `tfsdk:"id"`
+	Url      types.String                     `tfsdk:"url"`
+	TeamId   types.String                     `tfsdk:"team_id"`
+	Webhooks []WebhooksDataSourceWebhookModel `tfsdk:"webhooks"`
+}
+
+type WebhooksDataSourceWebhookModel struct {
+	Id             types.String `tfsdk:"id"`
+	Label          types.String `tfsdk:"label"`
+	Url            types.String `tfsdk:"url"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	TeamId         types.String `tfsdk:"team_id"`
+	AllPublicTeams types.Bool   `tfsdk:"all_public_teams"`
+	ResourceTypes  types.Set    `tfsdk:"resource_types"`
+}
+
+func (d *WebhooksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks"
+}
+
+func (d *WebhooksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear webhooks of the workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Only return the webhooks sending to this URL.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the webhooks of this team.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"webhooks": schema.ListNestedAttribute{
+				MarkdownDescription: "Webhooks, sorted by URL.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the webhook.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Label of the webhook.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL the webhook sends to.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the webhook is enabled.",
+							Computed:            true,
+						},
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the team of the webhook.",
+							Computed:            true,
+						},
+						"all_public_teams": schema.BoolAttribute{
+							MarkdownDescription: "Whether the webhook is enabled for all public teams.",
+							Computed:            true,
+						},
+						"resource_types": schema.SetAttribute{
+							MarkdownDescription: "Resource types the webhook is subscribed to.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WebhooksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WebhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *WebhooksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace, err := getWorkspace(ctx, *d.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(workspace.Organization.Id)
+	data.Webhooks = []WebhooksDataSourceWebhookModel{}
+
+	var after *string
+
+	for {
+		response, err := listWebhooks(ctx, *d.client, after)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list webhooks, got error: %s", err))
+			return
+		}
+
+		for _, webhook := range response.Webhooks.Nodes {
+			if !data.Url.IsNull() && (webhook.Url == nil || *webhook.Url != data.Url.ValueString()) {
+				continue
+			}
+
+			if !data.TeamId.IsNull() && (webhook.Team == nil || webhook.Team.Id != data.TeamId.ValueString()) {
+				continue
+			}
+
+			resourceTypes, diags := types.SetValueFrom(ctx, types.StringType, webhook.ResourceTypes)
+			resp.Diagnostics.Append(diags...)
+
+			item := WebhooksDataSourceWebhookModel{
+				Id:             types.StringValue(webhook.Id),
+				Label:          types.StringPointerValue(webhook.Label),
+				Url:            types.StringPointerValue(webhook.Url),
+				Enabled:        types.BoolValue(webhook.Enabled),
+				TeamId:         types.StringNull(),
+				AllPublicTeams: types.BoolValue(webhook.AllPublicTeams),
+				ResourceTypes:  resourceTypes,
+			}
+
+			if webhook.Team != nil {
+				item.TeamId = types.StringValue(webhook.Team.Id)
+			}
+
+			data.Webhooks = append(data.Webhooks, item)
+		}
+
+		if !response.Webhooks.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := response.Webhooks.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sort.Slice(data.Webhooks, func(i, j int) bool {
+		if data.Webhooks[i].Url.ValueString() == data.Webhooks[j].Url.ValueString() {
+			return data.Webhooks[i].Id.ValueString() < data.Webhooks[j].Id.ValueString()
+		}
+
+		return data.Webhooks[i].Url.ValueString() < data.Webhooks[j].Url.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
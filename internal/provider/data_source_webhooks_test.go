@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhooksDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccWebhooksDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_webhooks.all", "id", "1e73fcad-aac6-4bbe-a5e1-e08cffe04eb5"),
+					resource.TestCheckResourceAttrSet("data.linear_webhooks.all", "webhooks.#"),
+					resource.TestCheckResourceAttr("data.linear_webhooks.url", "webhooks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccWebhooksDataSourceConfig = `
+data "linear_webhooks" "all" {}
+
+data "linear_webhooks" "url" {
+  url = "https://example.com/unknown"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &WorkflowStateDataSource{}
+
+func NewWorkflowStateDataSource() datasource.DataSource {
+	return &WorkflowStateDataSource{}
+}
+
+type WorkflowStateDataSource struct {
+	client *graphql.Client
+}
+
+type WorkflowStateDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	TeamKey     types.String `tfsdk:"team_key"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+	Position    types.Number `tfsdk:"position"`
+	TeamId      types.String `tfsdk:"team_id"`
+}
+
+func (d *WorkflowStateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_state"
+}
+
+func (d *WorkflowStateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear workflow state, looked up by team key and name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workflow state.",
+				Computed:            true,
+			},
+			"team_key": schema.StringAttribute{
+				MarkdownDescription: "Key of the team.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(5),
+					stringvalidator.RegexMatches(regexp.MustCompile("^[A-Z0-9]+$"), "must only contain uppercase letters and numbers"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the workflow state.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the workflow state.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the workflow state.",
+				Computed:            true,
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Color of the workflow state.",
+				Computed:            true,
+			},
+			"position": schema.NumberAttribute{
+				MarkdownDescription: "Position of the workflow state.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *WorkflowStateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkflowStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *WorkflowStateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := findWorkflowState(ctx, *d.client, data.Name.ValueString(), data.TeamKey.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find workflow state, got error: %s", err))
+		return
+	}
+
+	if len(found.WorkflowStates.Nodes) != 1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find workflow state, expected exactly one workflow state named %q in team %s, got: %d", data.Name.ValueString(), data.TeamKey.ValueString(), len(found.WorkflowStates.Nodes)))
+		return
+	}
+
+	response, err := getWorkflowState(ctx, *d.client, found.WorkflowStates.Nodes[0].Id)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow state, got error: %s", err))
+		return
+	}
+
+	workflowState := response.WorkflowState.WorkflowState
+
+	data.Id = types.StringValue(workflowState.Id)
+	data.Name = types.StringValue(workflowState.Name)
+	data.Type = types.StringValue(workflowState.Type)
+	data.Description = types.StringPointerValue(workflowState.Description)
+	data.Color = types.StringValue(workflowState.Color)
+	data.Position = types.NumberValue(big.NewFloat(workflowState.Position))
+	data.TeamId = types.StringValue(workflowState.Team.Id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkflowStateDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccWorkflowStateDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.linear_workflow_state.test", "id", "linear_workflow_state.test", "id"),
+					resource.TestCheckResourceAttr("data.linear_workflow_state.test", "type", "started"),
+					resource.TestCheckResourceAttr("data.linear_workflow_state.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("data.linear_workflow_state.test", "color", "#ffff00"),
+					resource.TestCheckResourceAttr("data.linear_workflow_state.test", "position", "40"),
+					resource.TestCheckResourceAttr("data.linear_workflow_state.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+				),
+			},
+		},
+	})
+}
+
+const testAccWorkflowStateDataSourceConfig = `
+resource "linear_workflow_state" "test" {
+  name = "Looked Up"
+  type = "started"
+  description = "Managed by Terraform"
+  color = "#ffff00"
+  position = 40
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+data "linear_workflow_state" "test" {
+  team_key = "DEF"
+  name = "Looked Up"
+
+  depends_on = [linear_workflow_state.test]
+}
+`
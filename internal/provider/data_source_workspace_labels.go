@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &WorkspaceLabelsDataSource{}
+
+func NewWorkspaceLabelsDataSource() datasource.DataSource {
+	return &WorkspaceLabelsDataSource{}
+}
+
+type WorkspaceLabelsDataSource struct {
+	client *graphql.Client
+}
+
+type WorkspaceLabelsDataSourceModel struct {
+	Id     types.String                 `tfsdk:"id"`
+	Labels []LabelsDataSourceLabelModel `tfsdk:"labels"`
+}
+
+func (d *WorkspaceLabelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_labels"
+}
+
+func (d *WorkspaceLabelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear workspace labels, i.e. the labels which do not belong to a team.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace.",
+				Computed:            true,
+			},
+			"labels": labelsDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *WorkspaceLabelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkspaceLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *WorkspaceLabelsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace, err := getWorkspace(ctx, *d.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(workspace.Organization.Id)
+	data.Labels = []LabelsDataSourceLabelModel{}
+
+	var after *string
+
+	for {
+		response, err := listWorkspaceLabels(ctx, *d.client, after)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list workspace labels, got error: %s", err))
+			return
+		}
+
+		for _, node := range response.IssueLabels.Nodes {
+			data.Labels = append(data.Labels, readLabelsDataSourceLabel(node.IssueLabel, node.IsGroup))
+		}
+
+		if !response.IssueLabels.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := response.IssueLabels.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	sortLabelsDataSourceLabels(data.Labels)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
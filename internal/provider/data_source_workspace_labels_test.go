@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkspaceLabelsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccWorkspaceLabelsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_workspace_labels.test", "id", "1e73fcad-aac6-4bbe-a5e1-e08cffe04eb5"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.linear_workspace_labels.test", "labels.*", map[string]string{
+						"name":        "Compliance",
+						"description": "Managed by Terraform",
+						"color":       "#0000ff",
+						"is_group":    "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccWorkspaceLabelsDataSourceConfig = `
+resource "linear_workspace_label" "test" {
+  name = "Compliance"
+  description = "Managed by Terraform"
+  color = "#0000ff"
+}
+
+data "linear_workspace_labels" "test" {
+  depends_on = [linear_workspace_label.test]
+}
+`
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTeam is the team the resources fall back to when their team_id is
+// not set. It is configured by key or identifier, and the key is only
+// resolved once it is needed.
+type defaultTeam struct {
+	value string
+
+	once sync.Once
+	id   string
+	err  error
+}
+
+func (t *defaultTeam) resolve(ctx context.Context, client graphql.Client) (string, error) {
+	t.once.Do(func() {
+		if uuidRegex().MatchString(t.value) {
+			t.id = t.value
+			return
+		}
+
+		response, err := findTeam(ctx, client, t.value)
+
+		if err != nil {
+			t.err = err
+			return
+		}
+
+		if len(response.Teams.Nodes) != 1 {
+			t.err = fmt.Errorf("no team with key %q", t.value)
+			return
+		}
+
+		t.id = response.Teams.Nodes[0].Id
+	})
+
+	return t.id, t.err
+}
+
+// planDefaultTeam sets the team_id of a resource being created to the
+// default team of the provider when it is not configured.
+func planDefaultTeam(ctx context.Context, client graphql.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var teamId types.String
+
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return diags
+	}
+
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("team_id"), &teamId)...)
+
+	if diags.HasError() || !teamId.IsNull() {
+		return diags
+	}
+
+	linear, ok := client.(*linearClient)
+
+	if !ok || linear.defaultTeam == nil {
+		diags.AddAttributeError(path.Root("team_id"), "Missing Team", "The team_id attribute must be set when the provider has no default_team.")
+		return diags
+	}
+
+	id, err := linear.defaultTeam.resolve(ctx, client)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to find default team, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("team_id"), id)...)
+
+	return diags
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// driftKey is the private state key holding the values to restore on the next
+// apply of a resource with auto_correct enabled.
+const driftKey = "drift"
+
+// driftedValues are the values of a resource which were changed outside of
+// Terraform.
+type driftedValues struct {
+	Color *string `json:"color,omitempty"`
+}
+
+// privateState is the private state of a request or response, which is not
+// exported by the framework.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// readDrift returns the values recorded by recordDrift, if any.
+func readDrift(ctx context.Context, private privateState) (*driftedValues, diag.Diagnostics) {
+	var drift *driftedValues
+
+	value, diags := private.GetKey(ctx, driftKey)
+
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	if err := json.Unmarshal(value, &drift); err != nil {
+		diags.AddError("Invalid Private State", "Unable to read the values to restore, got error: "+err.Error())
+	}
+
+	return drift, diags
+}
+
+// recordDrift compares the actual values of a resource with the expected ones
+// and records the expected values which differ, so they are restored on the
+// next apply. The values recorded while refreshing before are kept, as the
+// state holds the drifted values since. It reports whether anything drifted.
+func recordDrift(ctx context.Context, private privateState, expected driftedValues, actual driftedValues) (bool, diag.Diagnostics) {
+	recorded, diags := readDrift(ctx, private)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if recorded != nil && recorded.Color != nil {
+		expected.Color = recorded.Color
+	}
+
+	drift := driftedValues{}
+
+	if expected.Color != nil && actual.Color != nil && *expected.Color != *actual.Color {
+		drift.Color = expected.Color
+	}
+
+	if drift.Color == nil {
+		if recorded != nil {
+			diags.Append(clearDrift(ctx, private)...)
+		}
+
+		return false, diags
+	}
+
+	value, err := json.Marshal(drift)
+
+	if err != nil {
+		diags.AddError("Invalid Private State", "Unable to record the values to restore, got error: "+err.Error())
+		return false, diags
+	}
+
+	diags.Append(private.SetKey(ctx, driftKey, value)...)
+
+	return true, diags
+}
+
+// clearDrift forgets the recorded values once they were restored.
+func clearDrift(ctx context.Context, private privateState) diag.Diagnostics {
+	return private.SetKey(ctx, driftKey, []byte("null"))
+}
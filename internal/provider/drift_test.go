@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestRecordDrift(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+
+	color, changed, other := "#000000", "#ffffff", "#ff0000"
+
+	record := func(expected driftedValues, actual driftedValues) bool {
+		drifted, diags := recordDrift(ctx, private, expected, actual)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		return drifted
+	}
+
+	read := func() *driftedValues {
+		drift, diags := readDrift(ctx, private)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		return drift
+	}
+
+	if record(driftedValues{Color: &color}, driftedValues{Color: &color}) || read() != nil {
+		t.Fatal("expected nothing to be recorded without a change")
+	}
+
+	if !record(driftedValues{Color: &color}, driftedValues{Color: &changed}) {
+		t.Fatal("expected the color to have drifted")
+	}
+
+	if drift := read(); drift == nil || drift.Color == nil || *drift.Color != color {
+		t.Fatalf("expected the color to be recorded, got %+v", drift)
+	}
+
+	// The state holds the drifted values after refreshing
+	if !record(driftedValues{Color: &changed}, driftedValues{Color: &other}) {
+		t.Fatal("expected the drift to be kept")
+	}
+
+	if drift := read(); drift == nil || drift.Color == nil || *drift.Color != color {
+		t.Fatalf("expected the original color to be recorded, got %+v", drift)
+	}
+
+	// The values were restored outside of Terraform
+	if record(driftedValues{Color: &other}, driftedValues{Color: &color}) || read() != nil {
+		t.Fatal("expected the drift to be cleared")
+	}
+
+	record(driftedValues{Color: &color}, driftedValues{Color: &changed})
+
+	if diags := clearDrift(ctx, private); diags.HasError() || read() != nil {
+		t.Fatal("expected the drift to be cleared after applying")
+	}
+}
@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// optionalFields are the fields of the API which the provider can do without.
+// They were added to Linear after the resources using them, so older
+// workspaces may not know them yet. When one is missing, it is neither sent
+// nor read, and the attribute backed by it keeps its prior value.
+var optionalFields = map[string][]string{
+	"Team": {
+		"timezone",
+		"issueOrderingNoPriorityFirst",
+		"groupIssueHistory",
+		"setIssueSortOrderOnStateChange",
+		"cycleLockToActive",
+		"issueEstimationExtended",
+	},
+	"WorkflowState": {
+		"description",
+	},
+	"IssueLabel": {
+		"description",
+	},
+}
+
+// optionalInputTypes are the input types which carry the optional fields,
+// with the type the fields belong to.
+var optionalInputTypes = map[string]string{
+	"TeamCreateInput":          "Team",
+	"TeamUpdateInput":          "Team",
+	"WorkflowStateCreateInput": "WorkflowState",
+	"WorkflowStateUpdateInput": "WorkflowState",
+	"IssueLabelCreateInput":    "IssueLabel",
+	"IssueLabelUpdateInput":    "IssueLabel",
+}
+
+// detectFeaturesQuery authenticates like getViewer, and introspects the types
+// with optional fields in the same request.
+const detectFeaturesQuery = `query detectFeatures {
+  viewer {
+    id
+    name
+    email
+    organization {
+      id
+      name
+      urlKey
+    }
+  }
+  team: __type(name: "Team") { fields { name } }
+  workflowState: __type(name: "WorkflowState") { fields { name } }
+  issueLabel: __type(name: "IssueLabel") { fields { name } }
+}`
+
+type introspectedType struct {
+	Fields []struct {
+		Name string `json:"name"`
+	} `json:"fields"`
+}
+
+type detectFeaturesData struct {
+	Viewer        getViewerViewerUser `json:"viewer"`
+	Team          *introspectedType   `json:"team"`
+	WorkflowState *introspectedType   `json:"workflowState"`
+	IssueLabel    *introspectedType   `json:"issueLabel"`
+}
+
+// validateCredentials checks that the provider can authenticate with Linear,
+// so that a bad token fails once instead of failing every resource. It looks
+// up which of the optional fields the API supports at the same time, and
+// warns about the ones it does not.
+func (c *linearClient) validateCredentials(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data := &detectFeaturesData{}
+	req := &graphql.Request{OpName: "detectFeatures", Query: detectFeaturesQuery}
+
+	err := c.MakeRequest(ctx, req, &graphql.Response{Data: data})
+
+	// Introspection may be disabled, in which case everything is assumed to
+	// be supported as before.
+	if err != nil {
+		tflog.Debug(ctx, "unable to detect api features", map[string]interface{}{"error": err.Error()})
+
+		response, err := getViewer(ctx, c)
+
+		if err != nil {
+			diags.AddError("Unable to Authenticate", fmt.Sprintf("Unable to authenticate with Linear, please check the token or OAuth application, got error: %s", err))
+			return diags
+		}
+
+		data = &detectFeaturesData{Viewer: response.Viewer}
+	}
+
+	tflog.Info(ctx, "authenticated with linear", map[string]interface{}{
+		"actor":     data.Viewer.Name,
+		"actor_id":  data.Viewer.Id,
+		"workspace": data.Viewer.Organization.Name,
+		"url_key":   data.Viewer.Organization.UrlKey,
+	})
+
+	introspected := map[string]*introspectedType{"Team": data.Team, "WorkflowState": data.WorkflowState, "IssueLabel": data.IssueLabel}
+	unsupported := map[string]map[string]bool{}
+
+	for typeName, introspectedType := range introspected {
+		if introspectedType == nil {
+			continue
+		}
+
+		known := map[string]bool{}
+
+		for _, field := range introspectedType.Fields {
+			known[field.Name] = true
+		}
+
+		for _, field := range optionalFields[typeName] {
+			if known[field] {
+				continue
+			}
+
+			if unsupported[typeName] == nil {
+				unsupported[typeName] = map[string]bool{}
+			}
+
+			unsupported[typeName][field] = true
+		}
+	}
+
+	typeNames := make([]string, 0, len(unsupported))
+
+	for typeName := range unsupported {
+		typeNames = append(typeNames, typeName)
+	}
+
+	sort.Strings(typeNames)
+
+	for _, typeName := range typeNames {
+		fields := make([]string, 0, len(unsupported[typeName]))
+
+		for field := range unsupported[typeName] {
+			fields = append(fields, field)
+		}
+
+		sort.Strings(fields)
+
+		diags.AddWarning(
+			"Unsupported API Fields",
+			fmt.Sprintf("The Linear API of this workspace does not support %s on %s. The attributes backed by them are neither set nor read, and keep their prior value.", strings.Join(fields, ", "), typeName),
+		)
+	}
+
+	c.unsupported = unsupported
+
+	return diags
+}
+
+// fieldSupported reports whether the API of the workspace supports the given
+// field. Everything is supported when it could not be detected.
+func fieldSupported(client graphql.Client, typeName string, field string) bool {
+	linear, ok := client.(*linearClient)
+
+	return !ok || !linear.unsupported[typeName][field]
+}
+
+var fragmentRegex = regexp.MustCompile(`^\s*fragment\s+\w+\s+on\s+(\w+)\s*{`)
+
+// stripUnsupported removes the unsupported fields from the top level of the
+// fragments in the given query.
+func (c *linearClient) stripUnsupported(query string) string {
+	if len(c.unsupported) == 0 {
+		return query
+	}
+
+	lines := strings.Split(query, "\n")
+	kept := make([]string, 0, len(lines))
+	typeName := ""
+	depth := 0
+
+	for _, line := range lines {
+		if depth == 0 {
+			typeName = ""
+
+			if match := fragmentRegex.FindStringSubmatch(line); match != nil {
+				typeName = match[1]
+			}
+		} else if depth == 1 && c.unsupported[typeName][strings.TrimSpace(line)] {
+			continue
+		}
+
+		depth += strings.Count(line, "{") - strings.Count(line, "}")
+		kept = append(kept, line)
+	}
+
+	return strings.Join(kept, "\n")
+}
+
+var variableRegex = regexp.MustCompile(`\$(\w+)\s*:\s*\[?\s*(\w+)`)
+
+// stripUnsupportedVariables removes the unsupported fields from the inputs of
+// the given request, so that mutations do not send them.
+func (c *linearClient) stripUnsupportedVariables(req *graphql.Request) (interface{}, error) {
+	if len(c.unsupported) == 0 || req.Variables == nil {
+		return req.Variables, nil
+	}
+
+	// The variables are declared before the selection of the operation.
+	header, _, _ := strings.Cut(req.Query, "{")
+	inputs := map[string]string{}
+
+	for _, match := range variableRegex.FindAllStringSubmatch(header, -1) {
+		if typeName, ok := optionalInputTypes[match[2]]; ok && len(c.unsupported[typeName]) > 0 {
+			inputs[match[1]] = typeName
+		}
+	}
+
+	if len(inputs) == 0 {
+		return req.Variables, nil
+	}
+
+	encoded, err := json.Marshal(req.Variables)
+
+	if err != nil {
+		return nil, err
+	}
+
+	variables := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&variables); err != nil {
+		return nil, err
+	}
+
+	for name, typeName := range inputs {
+		input, ok := variables[name].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for field := range c.unsupported[typeName] {
+			delete(input, field)
+		}
+	}
+
+	return variables, nil
+}
+
+// optionalAttribute is an attribute backed by one of the optional fields.
+type optionalAttribute struct {
+	expression path.Expression
+	typeName   string
+	field      string
+}
+
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// keepUnsupportedAttributes sets the attributes backed by the fields the API
+// does not support back to their prior value, as they always read as empty.
+// The ones without a known prior value are set to null.
+func keepUnsupportedAttributes(ctx context.Context, client graphql.Client, prior attributeGetter, state *tfsdk.State, attributes []optionalAttribute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, attribute := range attributes {
+		if fieldSupported(client, attribute.typeName, attribute.field) {
+			continue
+		}
+
+		paths, pathDiags := state.PathMatches(ctx, attribute.expression)
+
+		// The attribute may be nested in an object which is null.
+		if pathDiags.HasError() {
+			continue
+		}
+
+		for _, attributePath := range paths {
+			var current attr.Value
+
+			diags.Append(state.GetAttribute(ctx, attributePath, &current)...)
+
+			if diags.HasError() {
+				return diags
+			}
+
+			var value attr.Value
+
+			// The prior value may not exist, like for a new element of a list.
+			if priorDiags := prior.GetAttribute(ctx, attributePath, &value); priorDiags.HasError() || value == nil || value.IsUnknown() {
+				attrType := current.Type(ctx)
+				null, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+
+				if err != nil {
+					diags.AddAttributeError(attributePath, "Unsupported API Field", fmt.Sprintf("Unable to clear the attribute, got error: %s", err))
+					return diags
+				}
+
+				value = null
+			}
+
+			diags.Append(state.SetAttribute(ctx, attributePath, value)...)
+		}
+	}
+
+	return diags
+}
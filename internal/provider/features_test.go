@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testViewerData = `"viewer": {"id": "user", "name": "User", "email": "user@example.com", "organization": {"id": "org", "name": "Org", "urlKey": "org"}}`
+
+// testFeaturesServer answers the feature detection with the given data, and
+// getViewer with a fixed viewer.
+func testFeaturesServer(t *testing.T, detectFeatures string) *linearClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			OpName string `json:"operationName"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("unable to decode request: %s", err)
+		}
+
+		switch body.OpName {
+		case "detectFeatures":
+			w.Write([]byte(detectFeatures))
+		case "getViewer":
+			w.Write([]byte(`{"data": {` + testViewerData + `}}`))
+		default:
+			t.Fatalf("unexpected operation %s", body.OpName)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return &linearClient{wrapped: graphql.NewClient(server.URL, server.Client())}
+}
+
+func TestValidateCredentialsDetectsFeatures(t *testing.T) {
+	client := testFeaturesServer(t, `{"data": {`+testViewerData+`,
+		"team": {"fields": [{"name": "id"}, {"name": "groupIssueHistory"}, {"name": "issueOrderingNoPriorityFirst"}, {"name": "setIssueSortOrderOnStateChange"}, {"name": "cycleLockToActive"}, {"name": "issueEstimationExtended"}]},
+		"workflowState": {"fields": [{"name": "id"}, {"name": "description"}]},
+		"issueLabel": {"fields": [{"name": "id"}]}
+	}}`)
+
+	diags := client.validateCredentials(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if diags.WarningsCount() != 2 {
+		t.Fatalf("expected 2 warnings, got %v", diags)
+	}
+
+	if !client.unsupported["Team"]["timezone"] || !client.unsupported["IssueLabel"]["description"] || len(client.unsupported) != 2 {
+		t.Fatalf("unexpected unsupported fields: %v", client.unsupported)
+	}
+
+	if fieldSupported(client, "Team", "timezone") || !fieldSupported(client, "WorkflowState", "description") {
+		t.Fatal("expected timezone to be unsupported and the workflow state description to be supported")
+	}
+}
+
+func TestValidateCredentialsWithoutIntrospection(t *testing.T) {
+	client := testFeaturesServer(t, `{"errors": [{"message": "introspection is disabled"}]}`)
+
+	diags := client.validateCredentials(context.Background())
+
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(client.unsupported) != 0 {
+		t.Fatalf("expected every field to be supported, got %v", client.unsupported)
+	}
+}
+
+func TestStripUnsupported(t *testing.T) {
+	client := &linearClient{unsupported: map[string]map[string]bool{"Team": {"timezone": true}}}
+
+	query := `query getTeam($key: String!) {
+  team(id: $key) {
+    ...Team
+  }
+}
+fragment Team on Team {
+  id
+  timezone
+  organization {
+    timezone
+  }
+}
+fragment WorkflowState on WorkflowState {
+  id
+  timezone
+}`
+
+	expected := `query getTeam($key: String!) {
+  team(id: $key) {
+    ...Team
+  }
+}
+fragment Team on Team {
+  id
+  organization {
+    timezone
+  }
+}
+fragment WorkflowState on WorkflowState {
+  id
+  timezone
+}`
+
+	if stripped := client.stripUnsupported(query); stripped != expected {
+		t.Fatalf("unexpected query:\n%s", stripped)
+	}
+}
+
+func TestStripUnsupportedVariables(t *testing.T) {
+	client := &linearClient{unsupported: map[string]map[string]bool{"Team": {"timezone": true}}}
+
+	req := &graphql.Request{
+		Query: `mutation updateTeam($input: TeamUpdateInput!, $id: String!) {
+  teamUpdate(id: $id, input: $input) {
+    success
+  }
+}`,
+		Variables: &__updateTeamInput{
+			Input: TeamUpdateInput{Name: "Team", Timezone: "Europe/Berlin", AutoArchivePeriod: 6},
+			Id:    "id",
+		},
+	}
+
+	variables, err := client.stripUnsupportedVariables(req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	encoded, _ := json.Marshal(variables)
+
+	if strings.Contains(string(encoded), "timezone") {
+		t.Fatalf("expected timezone to be stripped, got %s", encoded)
+	}
+
+	if !strings.Contains(string(encoded), `"autoArchivePeriod":6`) || !strings.Contains(string(encoded), `"id":"id"`) {
+		t.Fatalf("expected other variables to be kept, got %s", encoded)
+	}
+
+	req.Query = strings.Replace(req.Query, "TeamUpdateInput", "ProjectUpdateInput", 1)
+
+	if variables, _ := client.stripUnsupportedVariables(req); variables != req.Variables {
+		t.Fatal("expected variables of other input types to be kept as they are")
+	}
+}
+
+func TestKeepUnsupportedAttributes(t *testing.T) {
+	ctx := context.Background()
+	client := &linearClient{unsupported: map[string]map[string]bool{"Team": {"timezone": true, "cycleLockToActive": true}}}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":     schema.StringAttribute{Optional: true},
+			"timezone": schema.StringAttribute{Optional: true, Computed: true},
+			"cycles": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"need_for_active": schema.BoolAttribute{Optional: true, Computed: true},
+				},
+			},
+		},
+	}
+
+	type cyclesModel struct {
+		NeedForActive types.Bool `tfsdk:"need_for_active"`
+	}
+
+	type model struct {
+		Name     types.String `tfsdk:"name"`
+		Timezone types.String `tfsdk:"timezone"`
+		Cycles   *cyclesModel `tfsdk:"cycles"`
+	}
+
+	attributes := []optionalAttribute{
+		{path.MatchRoot("timezone"), "Team", "timezone"},
+		{path.MatchRoot("cycles").AtName("need_for_active"), "Team", "cycleLockToActive"},
+		{path.MatchRoot("name"), "Team", "name"},
+	}
+
+	newState := func(data model) tfsdk.State {
+		state := tfsdk.State{Schema: testSchema, Raw: tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil)}
+
+		if diags := state.Set(ctx, &data); diags.HasError() {
+			t.Fatalf("unable to set state: %v", diags)
+		}
+
+		return state
+	}
+
+	read := func(state tfsdk.State) model {
+		var data model
+
+		if diags := state.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("unable to get state: %v", diags)
+		}
+
+		return data
+	}
+
+	t.Run("prior", func(t *testing.T) {
+		prior := newState(model{Name: types.StringValue("a"), Timezone: types.StringValue("Europe/Berlin"), Cycles: &cyclesModel{NeedForActive: types.BoolValue(true)}})
+		state := newState(model{Name: types.StringValue("b"), Timezone: types.StringValue(""), Cycles: &cyclesModel{NeedForActive: types.BoolValue(false)}})
+
+		if diags := keepUnsupportedAttributes(ctx, client, prior, &state, attributes); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		data := read(state)
+
+		if data.Timezone.ValueString() != "Europe/Berlin" || !data.Cycles.NeedForActive.ValueBool() || data.Name.ValueString() != "b" {
+			t.Fatalf("unexpected state: %+v %+v", data, data.Cycles)
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		prior := newState(model{Name: types.StringValue("a"), Timezone: types.StringUnknown(), Cycles: nil})
+		state := newState(model{Name: types.StringValue("b"), Timezone: types.StringValue(""), Cycles: &cyclesModel{NeedForActive: types.BoolValue(false)}})
+
+		if diags := keepUnsupportedAttributes(ctx, client, prior, &state, attributes); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		data := read(state)
+
+		if !data.Timezone.IsNull() || !data.Cycles.NeedForActive.IsNull() {
+			t.Fatalf("expected unsupported attributes to be null, got %+v %+v", data, data.Cycles)
+		}
+	})
+}
@@ -10,3103 +10,16166 @@ import (
 	"github.com/Khan/genqlient/graphql"
 )
 
-// The day of the week.
-type Day string
-
-const (
-	DaySunday    Day = "Sunday"
-	DayMonday    Day = "Monday"
-	DayTuesday   Day = "Tuesday"
-	DayWednesday Day = "Wednesday"
-	DayThursday  Day = "Thursday"
-	DayFriday    Day = "Friday"
-	DaySaturday  Day = "Saturday"
-)
-
-// IssueLabel includes the GraphQL fields of IssueLabel requested by the fragment IssueLabel.
+// CustomView includes the GraphQL fields of CustomView requested by the fragment CustomView.
 // The GraphQL type's documentation follows.
 //
-// Labels that can be associated with issues.
-type IssueLabel struct {
+// A custom view that has been saved by a user.
+type CustomView struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
-	// The label's name.
+	// The name of the custom view.
 	Name string `json:"name"`
-	// The label's description.
+	// The description of the custom view.
 	Description *string `json:"description"`
-	// The label's color as a HEX string.
+	// The icon of the custom view.
+	Icon *string `json:"icon"`
+	// The color of the icon of the custom view.
 	Color *string `json:"color"`
-	// The parent label.
-	Parent *IssueLabelParentIssueLabel `json:"parent"`
-	// The team that the label is associated with. If null, the label is associated with the global workspace.
-	Team *IssueLabelTeam `json:"team"`
+	// Whether the custom view is shared with everyone in the organization.
+	Shared bool `json:"shared"`
+	// The filter applied to issues in the custom view.
+	FilterData map[string]interface{} `json:"filterData"`
+	// The team associated with the custom view.
+	Team *CustomViewTeam `json:"team"`
+	// The user who owns the custom view.
+	Owner CustomViewOwnerUser `json:"owner"`
 }
 
-// GetId returns IssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetId() string { return v.Id }
+// GetId returns CustomView.Id, and is useful for accessing the field via an interface.
+func (v *CustomView) GetId() string { return v.Id }
 
-// GetName returns IssueLabel.Name, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetName() string { return v.Name }
+// GetName returns CustomView.Name, and is useful for accessing the field via an interface.
+func (v *CustomView) GetName() string { return v.Name }
 
-// GetDescription returns IssueLabel.Description, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetDescription() *string { return v.Description }
+// GetDescription returns CustomView.Description, and is useful for accessing the field via an interface.
+func (v *CustomView) GetDescription() *string { return v.Description }
 
-// GetColor returns IssueLabel.Color, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetColor() *string { return v.Color }
+// GetIcon returns CustomView.Icon, and is useful for accessing the field via an interface.
+func (v *CustomView) GetIcon() *string { return v.Icon }
 
-// GetParent returns IssueLabel.Parent, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetParent() *IssueLabelParentIssueLabel { return v.Parent }
+// GetColor returns CustomView.Color, and is useful for accessing the field via an interface.
+func (v *CustomView) GetColor() *string { return v.Color }
 
-// GetTeam returns IssueLabel.Team, and is useful for accessing the field via an interface.
-func (v *IssueLabel) GetTeam() *IssueLabelTeam { return v.Team }
+// GetShared returns CustomView.Shared, and is useful for accessing the field via an interface.
+func (v *CustomView) GetShared() bool { return v.Shared }
 
-type IssueLabelCreateInput struct {
+// GetFilterData returns CustomView.FilterData, and is useful for accessing the field via an interface.
+func (v *CustomView) GetFilterData() map[string]interface{} { return v.FilterData }
+
+// GetTeam returns CustomView.Team, and is useful for accessing the field via an interface.
+func (v *CustomView) GetTeam() *CustomViewTeam { return v.Team }
+
+// GetOwner returns CustomView.Owner, and is useful for accessing the field via an interface.
+func (v *CustomView) GetOwner() CustomViewOwnerUser { return v.Owner }
+
+type CustomViewCreateInput struct {
 	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
 	Id string `json:"id,omitempty"`
-	// The name of the label.
+	// The name of the custom view.
 	Name string `json:"name"`
-	// The description of the label.
+	// The description of the custom view.
 	Description *string `json:"description"`
-	// The color of the label.
-	Color *string `json:"color,omitempty"`
-	// The identifier of the parent label.
-	ParentId *string `json:"parentId"`
-	// The team associated with the label. If not given, the label will be associated with the entire workspace.
+	// The icon of the custom view.
+	Icon *string `json:"icon"`
+	// The color of the icon of the custom view.
+	Color *string `json:"color"`
+	// The id of the team associated with the custom view.
 	TeamId *string `json:"teamId"`
+	// The id of the project associated with the custom view.
+	ProjectId *string `json:"projectId,omitempty"`
+	// The id of the initiative associated with the custom view.
+	InitiativeId *string `json:"initiativeId,omitempty"`
+	// The owner of the custom view.
+	OwnerId *string `json:"ownerId,omitempty"`
+	// The filters applied to issues in the custom view.
+	Filters map[string]interface{} `json:"filters,omitempty"`
+	// The filter applied to issues in the custom view.
+	FilterData json.RawMessage `json:"filterData"`
+	// The project filter applied to issues in the custom view.
+	ProjectFilterData json.RawMessage `json:"projectFilterData,omitempty"`
+	// Whether the custom view is shared with everyone in the organization.
+	Shared bool `json:"shared"`
 }
 
-// GetId returns IssueLabelCreateInput.Id, and is useful for accessing the field via an interface.
-func (v *IssueLabelCreateInput) GetId() string { return v.Id }
+// GetId returns CustomViewCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetId() string { return v.Id }
 
-// GetName returns IssueLabelCreateInput.Name, and is useful for accessing the field via an interface.
-func (v *IssueLabelCreateInput) GetName() string { return v.Name }
+// GetName returns CustomViewCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetName() string { return v.Name }
 
-// GetDescription returns IssueLabelCreateInput.Description, and is useful for accessing the field via an interface.
-func (v *IssueLabelCreateInput) GetDescription() *string { return v.Description }
+// GetDescription returns CustomViewCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetDescription() *string { return v.Description }
 
-// GetColor returns IssueLabelCreateInput.Color, and is useful for accessing the field via an interface.
-func (v *IssueLabelCreateInput) GetColor() *string { return v.Color }
+// GetIcon returns CustomViewCreateInput.Icon, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetIcon() *string { return v.Icon }
 
-// GetParentId returns IssueLabelCreateInput.ParentId, and is useful for accessing the field via an interface.
-func (v *IssueLabelCreateInput) GetParentId() *string { return v.ParentId }
+// GetColor returns CustomViewCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetColor() *string { return v.Color }
 
-// GetTeamId returns IssueLabelCreateInput.TeamId, and is useful for accessing the field via an interface.
-func (v *IssueLabelCreateInput) GetTeamId() *string { return v.TeamId }
+// GetTeamId returns CustomViewCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetTeamId() *string { return v.TeamId }
 
-// IssueLabelParentIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// GetProjectId returns CustomViewCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetProjectId() *string { return v.ProjectId }
+
+// GetInitiativeId returns CustomViewCreateInput.InitiativeId, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetInitiativeId() *string { return v.InitiativeId }
+
+// GetOwnerId returns CustomViewCreateInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetOwnerId() *string { return v.OwnerId }
+
+// GetFilters returns CustomViewCreateInput.Filters, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetFilters() map[string]interface{} { return v.Filters }
+
+// GetFilterData returns CustomViewCreateInput.FilterData, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetFilterData() json.RawMessage { return v.FilterData }
+
+// GetProjectFilterData returns CustomViewCreateInput.ProjectFilterData, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetProjectFilterData() json.RawMessage { return v.ProjectFilterData }
+
+// GetShared returns CustomViewCreateInput.Shared, and is useful for accessing the field via an interface.
+func (v *CustomViewCreateInput) GetShared() bool { return v.Shared }
+
+// CustomViewOwnerUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
-// Labels that can be associated with issues.
-type IssueLabelParentIssueLabel struct {
+// A user that has access to the the resources of an organization.
+type CustomViewOwnerUser struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
 }
 
-// GetId returns IssueLabelParentIssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *IssueLabelParentIssueLabel) GetId() string { return v.Id }
+// GetId returns CustomViewOwnerUser.Id, and is useful for accessing the field via an interface.
+func (v *CustomViewOwnerUser) GetId() string { return v.Id }
 
-// IssueLabelTeam includes the requested fields of the GraphQL type Team.
+// CustomViewTeam includes the requested fields of the GraphQL type Team.
 // The GraphQL type's documentation follows.
 //
 // An organizational unit that contains issues.
-type IssueLabelTeam struct {
+type CustomViewTeam struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
 }
 
-// GetId returns IssueLabelTeam.Id, and is useful for accessing the field via an interface.
-func (v *IssueLabelTeam) GetId() string { return v.Id }
+// GetId returns CustomViewTeam.Id, and is useful for accessing the field via an interface.
+func (v *CustomViewTeam) GetId() string { return v.Id }
 
-type IssueLabelUpdateInput struct {
-	// The name of the label.
+type CustomViewUpdateInput struct {
+	// The name of the custom view.
 	Name string `json:"name,omitempty"`
-	// The description of the label.
+	// The description of the custom view.
 	Description *string `json:"description"`
-	// The identifier of the parent label.
-	ParentId *string `json:"parentId"`
-	// The color of the label.
-	Color *string `json:"color,omitempty"`
+	// The icon of the custom view.
+	Icon *string `json:"icon"`
+	// The color of the icon of the custom view.
+	Color *string `json:"color"`
+	// The id of the team associated with the custom view.
+	TeamId *string `json:"teamId"`
+	// [Internal] The id of the project associated with the custom view.
+	ProjectId *string `json:"projectId,omitempty"`
+	// [Internal] The id of the initiative associated with the custom view.
+	InitiativeId *string `json:"initiativeId,omitempty"`
+	// The owner of the custom view.
+	OwnerId *string `json:"ownerId,omitempty"`
+	// The filters applied to issues in the custom view.
+	Filters map[string]interface{} `json:"filters,omitempty"`
+	// The filter applied to issues in the custom view.
+	FilterData json.RawMessage `json:"filterData,omitempty"`
+	// The project filter applied to issues in the custom view.
+	ProjectFilterData json.RawMessage `json:"projectFilterData,omitempty"`
+	// Whether the custom view is shared with everyone in the organization.
+	Shared bool `json:"shared"`
 }
 
-// GetName returns IssueLabelUpdateInput.Name, and is useful for accessing the field via an interface.
-func (v *IssueLabelUpdateInput) GetName() string { return v.Name }
+// GetName returns CustomViewUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetName() string { return v.Name }
 
-// GetDescription returns IssueLabelUpdateInput.Description, and is useful for accessing the field via an interface.
-func (v *IssueLabelUpdateInput) GetDescription() *string { return v.Description }
+// GetDescription returns CustomViewUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetDescription() *string { return v.Description }
 
-// GetParentId returns IssueLabelUpdateInput.ParentId, and is useful for accessing the field via an interface.
-func (v *IssueLabelUpdateInput) GetParentId() *string { return v.ParentId }
+// GetIcon returns CustomViewUpdateInput.Icon, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetIcon() *string { return v.Icon }
 
-// GetColor returns IssueLabelUpdateInput.Color, and is useful for accessing the field via an interface.
-func (v *IssueLabelUpdateInput) GetColor() *string { return v.Color }
+// GetColor returns CustomViewUpdateInput.Color, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetColor() *string { return v.Color }
 
-// Organization includes the GraphQL fields of Organization requested by the fragment Organization.
+// GetTeamId returns CustomViewUpdateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetTeamId() *string { return v.TeamId }
+
+// GetProjectId returns CustomViewUpdateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetProjectId() *string { return v.ProjectId }
+
+// GetInitiativeId returns CustomViewUpdateInput.InitiativeId, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetInitiativeId() *string { return v.InitiativeId }
+
+// GetOwnerId returns CustomViewUpdateInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetOwnerId() *string { return v.OwnerId }
+
+// GetFilters returns CustomViewUpdateInput.Filters, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetFilters() map[string]interface{} { return v.Filters }
+
+// GetFilterData returns CustomViewUpdateInput.FilterData, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetFilterData() json.RawMessage { return v.FilterData }
+
+// GetProjectFilterData returns CustomViewUpdateInput.ProjectFilterData, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetProjectFilterData() json.RawMessage { return v.ProjectFilterData }
+
+// GetShared returns CustomViewUpdateInput.Shared, and is useful for accessing the field via an interface.
+func (v *CustomViewUpdateInput) GetShared() bool { return v.Shared }
+
+// Customer includes the GraphQL fields of Customer requested by the fragment Customer.
 // The GraphQL type's documentation follows.
 //
-// An organization. Organizations are root-level objects that contain user accounts and teams.
-type Organization struct {
+// [ALPHA] A customer whose needs will be tied to issues or projects.
+type Customer struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
-	// Whether member users are allowed to send invites.
-	AllowMembersToInvite bool `json:"allowMembersToInvite"`
-	// Whether the organization is using a roadmap.
-	RoadmapEnabled bool `json:"roadmapEnabled"`
-	// Whether the Git integration linkback messages should be sent to private repositories.
-	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
-	// Whether the Git integration linkback messages should be sent to public repositories.
-	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
+	// The customer's name.
+	Name string `json:"name"`
+	// The domains associated with this customer.
+	Domains []string `json:"domains"`
+	// The ids of the customers in external systems.
+	ExternalIds []string `json:"externalIds"`
+	// The ID of the Slack channel used to interact with the customer.
+	SlackChannelId *string `json:"slackChannelId"`
+	// The user who owns the customer.
+	Owner CustomerOwnerUser `json:"owner"`
+	// The current status of the customer.
+	Status CustomerStatusRef `json:"status"`
 }
 
-// GetId returns Organization.Id, and is useful for accessing the field via an interface.
-func (v *Organization) GetId() string { return v.Id }
+// GetId returns Customer.Id, and is useful for accessing the field via an interface.
+func (v *Customer) GetId() string { return v.Id }
 
-// GetAllowMembersToInvite returns Organization.AllowMembersToInvite, and is useful for accessing the field via an interface.
-func (v *Organization) GetAllowMembersToInvite() bool { return v.AllowMembersToInvite }
+// GetName returns Customer.Name, and is useful for accessing the field via an interface.
+func (v *Customer) GetName() string { return v.Name }
 
-// GetRoadmapEnabled returns Organization.RoadmapEnabled, and is useful for accessing the field via an interface.
-func (v *Organization) GetRoadmapEnabled() bool { return v.RoadmapEnabled }
+// GetDomains returns Customer.Domains, and is useful for accessing the field via an interface.
+func (v *Customer) GetDomains() []string { return v.Domains }
 
-// GetGitLinkbackMessagesEnabled returns Organization.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *Organization) GetGitLinkbackMessagesEnabled() bool { return v.GitLinkbackMessagesEnabled }
+// GetExternalIds returns Customer.ExternalIds, and is useful for accessing the field via an interface.
+func (v *Customer) GetExternalIds() []string { return v.ExternalIds }
 
-// GetGitPublicLinkbackMessagesEnabled returns Organization.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *Organization) GetGitPublicLinkbackMessagesEnabled() bool {
-	return v.GitPublicLinkbackMessagesEnabled
-}
+// GetSlackChannelId returns Customer.SlackChannelId, and is useful for accessing the field via an interface.
+func (v *Customer) GetSlackChannelId() *string { return v.SlackChannelId }
 
-// [INTERNAL] Organization IP restriction configuration.
-type OrganizationIpRestrictionInput struct {
-	// IP range in CIDR format.
-	Range string `json:"range"`
-	// Restriction type.
-	Type string `json:"type"`
-	// Optional restriction description.
-	Description string `json:"description"`
-	// Whether the restriction is enabled.
-	Enabled bool `json:"enabled"`
-}
+// GetOwner returns Customer.Owner, and is useful for accessing the field via an interface.
+func (v *Customer) GetOwner() CustomerOwnerUser { return v.Owner }
 
-// GetRange returns OrganizationIpRestrictionInput.Range, and is useful for accessing the field via an interface.
-func (v *OrganizationIpRestrictionInput) GetRange() string { return v.Range }
+// GetStatus returns Customer.Status, and is useful for accessing the field via an interface.
+func (v *Customer) GetStatus() CustomerStatusRef { return v.Status }
 
-// GetType returns OrganizationIpRestrictionInput.Type, and is useful for accessing the field via an interface.
-func (v *OrganizationIpRestrictionInput) GetType() string { return v.Type }
+type CustomerCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the customer.
+	Name string `json:"name"`
+	// The domains associated with this customer.
+	Domains []string `json:"domains"`
+	// The ids of the customers in external systems.
+	ExternalIds []string `json:"externalIds"`
+	// The ID of the Slack channel used to interact with the customer.
+	SlackChannelId *string `json:"slackChannelId"`
+	// The user who owns the customer.
+	OwnerId *string `json:"ownerId,omitempty"`
+	// The status of the customer.
+	StatusId *string `json:"statusId,omitempty"`
+}
 
-// GetDescription returns OrganizationIpRestrictionInput.Description, and is useful for accessing the field via an interface.
-func (v *OrganizationIpRestrictionInput) GetDescription() string { return v.Description }
+// GetId returns CustomerCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *CustomerCreateInput) GetId() string { return v.Id }
 
-// GetEnabled returns OrganizationIpRestrictionInput.Enabled, and is useful for accessing the field via an interface.
-func (v *OrganizationIpRestrictionInput) GetEnabled() bool { return v.Enabled }
+// GetName returns CustomerCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *CustomerCreateInput) GetName() string { return v.Name }
 
-type OrganizationUpdateInput struct {
-	// The name of the organization.
-	Name string `json:"name,omitempty"`
-	// The logo of the organization.
-	LogoUrl string `json:"logoUrl,omitempty"`
-	// The URL key of the organization.
-	UrlKey string `json:"urlKey,omitempty"`
-	// How git branches are formatted. If null, default formatting will be used.
-	GitBranchFormat string `json:"gitBranchFormat,omitempty"`
-	// Whether the Git integration linkback messages should be sent for private repositories.
-	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
-	// Whether the Git integration linkback messages should be sent for public repositories.
-	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
-	// Whether the organization is using roadmap.
-	RoadmapEnabled bool `json:"roadmapEnabled"`
-	// The n-weekly frequency at which to prompt for project updates.
-	ProjectUpdateReminderFrequencyInWeeks float64 `json:"projectUpdateReminderFrequencyInWeeks,omitempty"`
-	// The day at which project updates are sent.
-	ProjectUpdateRemindersDay Day `json:"projectUpdateRemindersDay,omitempty"`
-	// The hour at which project updates are sent.
-	ProjectUpdateRemindersHour float64 `json:"projectUpdateRemindersHour,omitempty"`
-	// The month at which the fiscal year starts.
-	FiscalYearStartMonth float64 `json:"fiscalYearStartMonth"`
-	// Whether the organization has opted for reduced customer support attachment information.
-	ReducedPersonalInformation bool `json:"reducedPersonalInformation,omitempty"`
-	// Whether the organization has opted for having to approve all OAuth applications for install.
-	OauthAppReview bool `json:"oauthAppReview,omitempty"`
-	// List of services that are allowed to be used for login.
-	AllowedAuthServices []string `json:"allowedAuthServices,omitempty"`
-	// Internal. Whether SLAs have been enabled for the organization.
-	SlaEnabled bool `json:"slaEnabled,omitempty"`
-	// Which day count to use for SLA calculation.
-	SlaDayCount SLADayCountType `json:"slaDayCount,omitempty"`
-	// Whether member users are allowed to send invites.
-	AllowMembersToInvite bool `json:"allowMembersToInvite"`
-	// IP restriction configurations controlling allowed access the workspace.
-	IpRestrictions []OrganizationIpRestrictionInput `json:"ipRestrictions"`
-	// [ALPHA] Theme settings for the organization.
-	ThemeSettings map[string]interface{} `json:"themeSettings"`
-}
+// GetDomains returns CustomerCreateInput.Domains, and is useful for accessing the field via an interface.
+func (v *CustomerCreateInput) GetDomains() []string { return v.Domains }
 
-// GetName returns OrganizationUpdateInput.Name, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetName() string { return v.Name }
+// GetExternalIds returns CustomerCreateInput.ExternalIds, and is useful for accessing the field via an interface.
+func (v *CustomerCreateInput) GetExternalIds() []string { return v.ExternalIds }
 
-// GetLogoUrl returns OrganizationUpdateInput.LogoUrl, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetLogoUrl() string { return v.LogoUrl }
+// GetSlackChannelId returns CustomerCreateInput.SlackChannelId, and is useful for accessing the field via an interface.
+func (v *CustomerCreateInput) GetSlackChannelId() *string { return v.SlackChannelId }
 
-// GetUrlKey returns OrganizationUpdateInput.UrlKey, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetUrlKey() string { return v.UrlKey }
+// GetOwnerId returns CustomerCreateInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *CustomerCreateInput) GetOwnerId() *string { return v.OwnerId }
 
-// GetGitBranchFormat returns OrganizationUpdateInput.GitBranchFormat, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetGitBranchFormat() string { return v.GitBranchFormat }
+// GetStatusId returns CustomerCreateInput.StatusId, and is useful for accessing the field via an interface.
+func (v *CustomerCreateInput) GetStatusId() *string { return v.StatusId }
 
-// GetGitLinkbackMessagesEnabled returns OrganizationUpdateInput.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetGitLinkbackMessagesEnabled() bool {
-	return v.GitLinkbackMessagesEnabled
+// CustomerNeed includes the GraphQL fields of CustomerNeed requested by the fragment CustomerNeed.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer need, expressed through a reference to an issue, project, or comment.
+type CustomerNeed struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The priority of the need.
+	Priority float64 `json:"priority"`
+	// The customer that this need is attached to.
+	Customer CustomerNeedCustomer `json:"customer"`
+	// The issue this need is referencing.
+	Issue *CustomerNeedIssue `json:"issue"`
+	// The project this need is referencing.
+	Project *CustomerNeedProject `json:"project"`
+	// The comment this need is referencing.
+	Comment *CustomerNeedComment `json:"comment"`
 }
 
-// GetGitPublicLinkbackMessagesEnabled returns OrganizationUpdateInput.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetGitPublicLinkbackMessagesEnabled() bool {
-	return v.GitPublicLinkbackMessagesEnabled
-}
+// GetId returns CustomerNeed.Id, and is useful for accessing the field via an interface.
+func (v *CustomerNeed) GetId() string { return v.Id }
 
-// GetRoadmapEnabled returns OrganizationUpdateInput.RoadmapEnabled, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetRoadmapEnabled() bool { return v.RoadmapEnabled }
+// GetPriority returns CustomerNeed.Priority, and is useful for accessing the field via an interface.
+func (v *CustomerNeed) GetPriority() float64 { return v.Priority }
 
-// GetProjectUpdateReminderFrequencyInWeeks returns OrganizationUpdateInput.ProjectUpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetProjectUpdateReminderFrequencyInWeeks() float64 {
-	return v.ProjectUpdateReminderFrequencyInWeeks
-}
+// GetCustomer returns CustomerNeed.Customer, and is useful for accessing the field via an interface.
+func (v *CustomerNeed) GetCustomer() CustomerNeedCustomer { return v.Customer }
 
-// GetProjectUpdateRemindersDay returns OrganizationUpdateInput.ProjectUpdateRemindersDay, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetProjectUpdateRemindersDay() Day {
-	return v.ProjectUpdateRemindersDay
-}
+// GetIssue returns CustomerNeed.Issue, and is useful for accessing the field via an interface.
+func (v *CustomerNeed) GetIssue() *CustomerNeedIssue { return v.Issue }
 
-// GetProjectUpdateRemindersHour returns OrganizationUpdateInput.ProjectUpdateRemindersHour, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetProjectUpdateRemindersHour() float64 {
-	return v.ProjectUpdateRemindersHour
+// GetProject returns CustomerNeed.Project, and is useful for accessing the field via an interface.
+func (v *CustomerNeed) GetProject() *CustomerNeedProject { return v.Project }
+
+// GetComment returns CustomerNeed.Comment, and is useful for accessing the field via an interface.
+func (v *CustomerNeed) GetComment() *CustomerNeedComment { return v.Comment }
+
+// CustomerNeedComment includes the requested fields of the GraphQL type Comment.
+// The GraphQL type's documentation follows.
+//
+// A comment associated with an issue.
+type CustomerNeedComment struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetFiscalYearStartMonth returns OrganizationUpdateInput.FiscalYearStartMonth, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetFiscalYearStartMonth() float64 { return v.FiscalYearStartMonth }
+// GetId returns CustomerNeedComment.Id, and is useful for accessing the field via an interface.
+func (v *CustomerNeedComment) GetId() string { return v.Id }
 
-// GetReducedPersonalInformation returns OrganizationUpdateInput.ReducedPersonalInformation, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetReducedPersonalInformation() bool {
-	return v.ReducedPersonalInformation
+type CustomerNeedCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The uuid of the customer the need belongs to.
+	CustomerId string `json:"customerId"`
+	// The issue this need is referencing.
+	IssueId *string `json:"issueId"`
+	// The project this need is referencing.
+	ProjectId *string `json:"projectId"`
+	// The comment this need is referencing.
+	CommentId *string `json:"commentId"`
+	// The priority of the need.
+	Priority float64 `json:"priority"`
 }
 
-// GetOauthAppReview returns OrganizationUpdateInput.OauthAppReview, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetOauthAppReview() bool { return v.OauthAppReview }
+// GetId returns CustomerNeedCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *CustomerNeedCreateInput) GetId() string { return v.Id }
 
-// GetAllowedAuthServices returns OrganizationUpdateInput.AllowedAuthServices, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetAllowedAuthServices() []string { return v.AllowedAuthServices }
+// GetCustomerId returns CustomerNeedCreateInput.CustomerId, and is useful for accessing the field via an interface.
+func (v *CustomerNeedCreateInput) GetCustomerId() string { return v.CustomerId }
 
-// GetSlaEnabled returns OrganizationUpdateInput.SlaEnabled, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetSlaEnabled() bool { return v.SlaEnabled }
+// GetIssueId returns CustomerNeedCreateInput.IssueId, and is useful for accessing the field via an interface.
+func (v *CustomerNeedCreateInput) GetIssueId() *string { return v.IssueId }
 
-// GetSlaDayCount returns OrganizationUpdateInput.SlaDayCount, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetSlaDayCount() SLADayCountType { return v.SlaDayCount }
+// GetProjectId returns CustomerNeedCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *CustomerNeedCreateInput) GetProjectId() *string { return v.ProjectId }
 
-// GetAllowMembersToInvite returns OrganizationUpdateInput.AllowMembersToInvite, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetAllowMembersToInvite() bool { return v.AllowMembersToInvite }
+// GetCommentId returns CustomerNeedCreateInput.CommentId, and is useful for accessing the field via an interface.
+func (v *CustomerNeedCreateInput) GetCommentId() *string { return v.CommentId }
 
-// GetIpRestrictions returns OrganizationUpdateInput.IpRestrictions, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetIpRestrictions() []OrganizationIpRestrictionInput {
-	return v.IpRestrictions
+// GetPriority returns CustomerNeedCreateInput.Priority, and is useful for accessing the field via an interface.
+func (v *CustomerNeedCreateInput) GetPriority() float64 { return v.Priority }
+
+// CustomerNeedCustomer includes the requested fields of the GraphQL type Customer.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer whose needs will be tied to issues or projects.
+type CustomerNeedCustomer struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetThemeSettings returns OrganizationUpdateInput.ThemeSettings, and is useful for accessing the field via an interface.
-func (v *OrganizationUpdateInput) GetThemeSettings() map[string]interface{} { return v.ThemeSettings }
+// GetId returns CustomerNeedCustomer.Id, and is useful for accessing the field via an interface.
+func (v *CustomerNeedCustomer) GetId() string { return v.Id }
 
-// Which day count to use for SLA calculations.
-type SLADayCountType string
-
-const (
-	SLADayCountTypeAll              SLADayCountType = "all"
-	SLADayCountTypeOnlybusinessdays SLADayCountType = "onlyBusinessDays"
-)
-
-// Team includes the GraphQL fields of Team requested by the fragment Team.
+// CustomerNeedIssue includes the requested fields of the GraphQL type Issue.
 // The GraphQL type's documentation follows.
 //
-// An organizational unit that contains issues.
-type Team struct {
+// An issue.
+type CustomerNeedIssue struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
-	// The team's name.
-	Name string `json:"name"`
-	// The team's unique key. The key is used in URLs.
-	Key string `json:"key"`
-	// Whether the team is private or not.
-	Private bool `json:"private"`
-	// The team's description.
-	Description *string `json:"description"`
-	// The icon of the team.
-	Icon *string `json:"icon"`
-	// The team's color.
-	Color *string `json:"color"`
-	// The timezone of the team. Defaults to "America/Los_Angeles"
-	Timezone string `json:"timezone"`
-	// Whether issues without priority should be sorted first.
-	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
-	// Whether to group recent issue history entries.
-	GroupIssueHistory bool `json:"groupIssueHistory"`
-	// Where to move issues when changing state.
-	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
-	// Period after which automatically closed and completed issues are automatically archived in months.
-	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
-	// Period after which issues are automatically closed in months. Null/undefined means disabled.
-	AutoClosePeriod *float64 `json:"autoClosePeriod"`
-	// Whether triage mode is enabled for the team or not.
-	TriageEnabled bool `json:"triageEnabled"`
-	// Whether the team uses cycles.
-	CyclesEnabled bool `json:"cyclesEnabled"`
-	// The day of the week that a new cycle starts.
-	CycleStartDay float64 `json:"cycleStartDay"`
-	// The duration of a cycle in weeks.
-	CycleDuration float64 `json:"cycleDuration"`
-	// The cooldown time after each cycle in weeks.
-	CycleCooldownTime float64 `json:"cycleCooldownTime"`
-	// How many upcoming cycles to create.
-	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
-	// Auto assign started issues to current cycle.
-	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
-	// Auto assign completed issues to current cycle.
-	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
-	// Auto assign issues to current cycle if in active status.
-	CycleLockToActive bool `json:"cycleLockToActive"`
-	// The issue estimation type to use. Must be one of "notUsed", "exponential", "fibonacci", "linear", "tShirt".
-	IssueEstimationType string `json:"issueEstimationType"`
-	// Whether to allow zeros in issues estimates.
-	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
-	// Whether to add additional points to the estimate scale.
-	IssueEstimationExtended bool `json:"issueEstimationExtended"`
-	// What to use as an default estimate for unestimated issues.
-	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
 }
 
-// GetId returns Team.Id, and is useful for accessing the field via an interface.
-func (v *Team) GetId() string { return v.Id }
+// GetId returns CustomerNeedIssue.Id, and is useful for accessing the field via an interface.
+func (v *CustomerNeedIssue) GetId() string { return v.Id }
 
-// GetName returns Team.Name, and is useful for accessing the field via an interface.
-func (v *Team) GetName() string { return v.Name }
+// CustomerNeedProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type CustomerNeedProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
 
-// GetKey returns Team.Key, and is useful for accessing the field via an interface.
-func (v *Team) GetKey() string { return v.Key }
+// GetId returns CustomerNeedProject.Id, and is useful for accessing the field via an interface.
+func (v *CustomerNeedProject) GetId() string { return v.Id }
 
-// GetPrivate returns Team.Private, and is useful for accessing the field via an interface.
-func (v *Team) GetPrivate() bool { return v.Private }
+type CustomerNeedUpdateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The priority of the need.
+	Priority float64 `json:"priority"`
+}
 
-// GetDescription returns Team.Description, and is useful for accessing the field via an interface.
-func (v *Team) GetDescription() *string { return v.Description }
+// GetId returns CustomerNeedUpdateInput.Id, and is useful for accessing the field via an interface.
+func (v *CustomerNeedUpdateInput) GetId() string { return v.Id }
 
-// GetIcon returns Team.Icon, and is useful for accessing the field via an interface.
-func (v *Team) GetIcon() *string { return v.Icon }
+// GetPriority returns CustomerNeedUpdateInput.Priority, and is useful for accessing the field via an interface.
+func (v *CustomerNeedUpdateInput) GetPriority() float64 { return v.Priority }
 
-// GetColor returns Team.Color, and is useful for accessing the field via an interface.
-func (v *Team) GetColor() *string { return v.Color }
+// CustomerOwnerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type CustomerOwnerUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
 
-// GetTimezone returns Team.Timezone, and is useful for accessing the field via an interface.
-func (v *Team) GetTimezone() string { return v.Timezone }
+// GetId returns CustomerOwnerUser.Id, and is useful for accessing the field via an interface.
+func (v *CustomerOwnerUser) GetId() string { return v.Id }
 
-// GetIssueOrderingNoPriorityFirst returns Team.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
-func (v *Team) GetIssueOrderingNoPriorityFirst() bool { return v.IssueOrderingNoPriorityFirst }
+// CustomerStatus includes the GraphQL fields of CustomerStatus requested by the fragment CustomerStatus.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer status.
+type CustomerStatus struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The name of the status.
+	Name string `json:"name"`
+	// The UI color of the status as a HEX string.
+	Color string `json:"color"`
+	// Description of the status.
+	Description *string `json:"description"`
+	// The type of the customer status.
+	Type CustomerStatusType `json:"type"`
+	// The position of the status in the workspace's customers flow.
+	Position float64 `json:"position"`
+}
 
-// GetGroupIssueHistory returns Team.GroupIssueHistory, and is useful for accessing the field via an interface.
-func (v *Team) GetGroupIssueHistory() bool { return v.GroupIssueHistory }
+// GetId returns CustomerStatus.Id, and is useful for accessing the field via an interface.
+func (v *CustomerStatus) GetId() string { return v.Id }
 
-// GetSetIssueSortOrderOnStateChange returns Team.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
-func (v *Team) GetSetIssueSortOrderOnStateChange() string { return v.SetIssueSortOrderOnStateChange }
+// GetName returns CustomerStatus.Name, and is useful for accessing the field via an interface.
+func (v *CustomerStatus) GetName() string { return v.Name }
 
-// GetAutoArchivePeriod returns Team.AutoArchivePeriod, and is useful for accessing the field via an interface.
-func (v *Team) GetAutoArchivePeriod() float64 { return v.AutoArchivePeriod }
+// GetColor returns CustomerStatus.Color, and is useful for accessing the field via an interface.
+func (v *CustomerStatus) GetColor() string { return v.Color }
 
-// GetAutoClosePeriod returns Team.AutoClosePeriod, and is useful for accessing the field via an interface.
-func (v *Team) GetAutoClosePeriod() *float64 { return v.AutoClosePeriod }
+// GetDescription returns CustomerStatus.Description, and is useful for accessing the field via an interface.
+func (v *CustomerStatus) GetDescription() *string { return v.Description }
 
-// GetTriageEnabled returns Team.TriageEnabled, and is useful for accessing the field via an interface.
-func (v *Team) GetTriageEnabled() bool { return v.TriageEnabled }
+// GetType returns CustomerStatus.Type, and is useful for accessing the field via an interface.
+func (v *CustomerStatus) GetType() CustomerStatusType { return v.Type }
 
-// GetCyclesEnabled returns Team.CyclesEnabled, and is useful for accessing the field via an interface.
-func (v *Team) GetCyclesEnabled() bool { return v.CyclesEnabled }
+// GetPosition returns CustomerStatus.Position, and is useful for accessing the field via an interface.
+func (v *CustomerStatus) GetPosition() float64 { return v.Position }
 
-// GetCycleStartDay returns Team.CycleStartDay, and is useful for accessing the field via an interface.
-func (v *Team) GetCycleStartDay() float64 { return v.CycleStartDay }
+type CustomerStatusCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the status.
+	Name string `json:"name"`
+	// The UI color of the status as a HEX string.
+	Color string `json:"color"`
+	// Description of the status.
+	Description *string `json:"description"`
+	// The position of the status in the workspace's customer flow.
+	Position float64 `json:"position"`
+	// The type of the customer status.
+	Type CustomerStatusType `json:"type"`
+}
 
-// GetCycleDuration returns Team.CycleDuration, and is useful for accessing the field via an interface.
-func (v *Team) GetCycleDuration() float64 { return v.CycleDuration }
+// GetId returns CustomerStatusCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *CustomerStatusCreateInput) GetId() string { return v.Id }
 
-// GetCycleCooldownTime returns Team.CycleCooldownTime, and is useful for accessing the field via an interface.
-func (v *Team) GetCycleCooldownTime() float64 { return v.CycleCooldownTime }
+// GetName returns CustomerStatusCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *CustomerStatusCreateInput) GetName() string { return v.Name }
 
-// GetUpcomingCycleCount returns Team.UpcomingCycleCount, and is useful for accessing the field via an interface.
-func (v *Team) GetUpcomingCycleCount() float64 { return v.UpcomingCycleCount }
+// GetColor returns CustomerStatusCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *CustomerStatusCreateInput) GetColor() string { return v.Color }
 
-// GetCycleIssueAutoAssignStarted returns Team.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
-func (v *Team) GetCycleIssueAutoAssignStarted() bool { return v.CycleIssueAutoAssignStarted }
+// GetDescription returns CustomerStatusCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *CustomerStatusCreateInput) GetDescription() *string { return v.Description }
 
-// GetCycleIssueAutoAssignCompleted returns Team.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
-func (v *Team) GetCycleIssueAutoAssignCompleted() bool { return v.CycleIssueAutoAssignCompleted }
+// GetPosition returns CustomerStatusCreateInput.Position, and is useful for accessing the field via an interface.
+func (v *CustomerStatusCreateInput) GetPosition() float64 { return v.Position }
 
-// GetCycleLockToActive returns Team.CycleLockToActive, and is useful for accessing the field via an interface.
-func (v *Team) GetCycleLockToActive() bool { return v.CycleLockToActive }
+// GetType returns CustomerStatusCreateInput.Type, and is useful for accessing the field via an interface.
+func (v *CustomerStatusCreateInput) GetType() CustomerStatusType { return v.Type }
 
-// GetIssueEstimationType returns Team.IssueEstimationType, and is useful for accessing the field via an interface.
-func (v *Team) GetIssueEstimationType() string { return v.IssueEstimationType }
+// CustomerStatusRef includes the requested fields of the GraphQL type CustomerStatus.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer status.
+type CustomerStatusRef struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
 
-// GetIssueEstimationAllowZero returns Team.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
-func (v *Team) GetIssueEstimationAllowZero() bool { return v.IssueEstimationAllowZero }
+// GetId returns CustomerStatusRef.Id, and is useful for accessing the field via an interface.
+func (v *CustomerStatusRef) GetId() string { return v.Id }
 
-// GetIssueEstimationExtended returns Team.IssueEstimationExtended, and is useful for accessing the field via an interface.
-func (v *Team) GetIssueEstimationExtended() bool { return v.IssueEstimationExtended }
+// A type of customer status.
+type CustomerStatusType string
 
-// GetDefaultIssueEstimate returns Team.DefaultIssueEstimate, and is useful for accessing the field via an interface.
-func (v *Team) GetDefaultIssueEstimate() float64 { return v.DefaultIssueEstimate }
+const (
+	CustomerStatusTypeActive   CustomerStatusType = "active"
+	CustomerStatusTypeInactive CustomerStatusType = "inactive"
+)
 
-type TeamCreateInput struct {
-	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
-	Id string `json:"id,omitempty"`
-	// The name of the team.
-	Name string `json:"name"`
-	// The description of the team.
+type CustomerStatusUpdateInput struct {
+	// The name of the status.
+	Name string `json:"name,omitempty"`
+	// The UI color of the status as a HEX string.
+	Color string `json:"color,omitempty"`
+	// Description of the status.
 	Description *string `json:"description"`
-	// The key of the team. If not given, the key will be generated based on the name of the team.
-	Key string `json:"key"`
-	// The icon of the team.
-	Icon *string `json:"icon,omitempty"`
-	// The color of the team.
-	Color *string `json:"color,omitempty"`
-	// The organization associated with the team.
-	OrganizationId string `json:"organizationId,omitempty"`
-	// Whether the team uses cycles.
-	CyclesEnabled bool `json:"cyclesEnabled"`
-	// The day of the week that a new cycle starts.
-	CycleStartDay float64 `json:"cycleStartDay"`
-	// The duration of each cycle in weeks.
-	CycleDuration int `json:"cycleDuration"`
-	// The cooldown time after each cycle in weeks.
-	CycleCooldownTime int `json:"cycleCooldownTime"`
-	// Auto assign started issues to current active cycle setting.
-	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
-	// Auto assign completed issues to current active cycle setting.
-	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
-	// Only allow issues issues with cycles in Active Issues.
-	CycleLockToActive bool `json:"cycleLockToActive"`
-	// How many upcoming cycles to create.
-	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
-	// Whether triage mode is enabled for the team.
-	TriageEnabled bool `json:"triageEnabled"`
-	// Whether an issue needs to have a priority set before leaving triage.
-	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
-	// The timezone of the team.
-	Timezone string `json:"timezone"`
-	// Whether issues without priority should be sorted first.
-	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
-	// The issue estimation type to use. Must be one of "notUsed", "exponential", "fibonacci", "linear", "tShirt".
-	IssueEstimationType string `json:"issueEstimationType"`
-	// Whether to allow zeros in issues estimates.
-	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
-	// Whether to move issues to bottom of the column when changing state.
-	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
-	// Whether to add additional points to the estimate scale.
-	IssueEstimationExtended bool `json:"issueEstimationExtended"`
-	// What to use as an default estimate for unestimated issues.
-	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
-	// Whether to group recent issue history entries.
-	GroupIssueHistory bool `json:"groupIssueHistory"`
-	// The identifier of the default template for members of this team.
-	DefaultTemplateForMembersId string `json:"defaultTemplateForMembersId,omitempty"`
-	// The identifier of the default template for non-members of this team.
-	DefaultTemplateForNonMembersId string `json:"defaultTemplateForNonMembersId,omitempty"`
-	// The identifier of the default project template of this team.
-	DefaultProjectTemplateId string `json:"defaultProjectTemplateId,omitempty"`
-	// Internal. Whether the team is private or not.
-	Private bool `json:"private"`
-	// Period after which issues are automatically closed, in months.
-	AutoClosePeriod *float64 `json:"autoClosePeriod"`
-	// The canceled workflow state which auto closed issues will be set to.
-	AutoCloseStateId string `json:"autoCloseStateId,omitempty"`
-	// Period after which closed and completed issues are automatically archived, in months. 0 means disabled.
-	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
-	// The workflow state into which issues are moved when they are marked as a duplicate of another issue.
-	MarkedAsDuplicateWorkflowStateId string `json:"markedAsDuplicateWorkflowStateId,omitempty"`
+	// The position of the status in the workspace's customer flow.
+	Position float64 `json:"position"`
+	// The type of the customer status.
+	Type CustomerStatusType `json:"type,omitempty"`
 }
 
-// GetId returns TeamCreateInput.Id, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetId() string { return v.Id }
+// GetName returns CustomerStatusUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *CustomerStatusUpdateInput) GetName() string { return v.Name }
 
-// GetName returns TeamCreateInput.Name, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetName() string { return v.Name }
+// GetColor returns CustomerStatusUpdateInput.Color, and is useful for accessing the field via an interface.
+func (v *CustomerStatusUpdateInput) GetColor() string { return v.Color }
 
-// GetDescription returns TeamCreateInput.Description, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetDescription() *string { return v.Description }
+// GetDescription returns CustomerStatusUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *CustomerStatusUpdateInput) GetDescription() *string { return v.Description }
 
-// GetKey returns TeamCreateInput.Key, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetKey() string { return v.Key }
+// GetPosition returns CustomerStatusUpdateInput.Position, and is useful for accessing the field via an interface.
+func (v *CustomerStatusUpdateInput) GetPosition() float64 { return v.Position }
 
-// GetIcon returns TeamCreateInput.Icon, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetIcon() *string { return v.Icon }
+// GetType returns CustomerStatusUpdateInput.Type, and is useful for accessing the field via an interface.
+func (v *CustomerStatusUpdateInput) GetType() CustomerStatusType { return v.Type }
 
-// GetColor returns TeamCreateInput.Color, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetColor() *string { return v.Color }
+type CustomerUpdateInput struct {
+	// The name of the customer.
+	Name string `json:"name,omitempty"`
+	// The domains associated with this customer.
+	Domains []string `json:"domains"`
+	// The ids of the customers in external systems.
+	ExternalIds []string `json:"externalIds"`
+	// The ID of the Slack channel used to interact with the customer.
+	SlackChannelId *string `json:"slackChannelId"`
+	// The user who owns the customer.
+	OwnerId *string `json:"ownerId,omitempty"`
+	// The status of the customer.
+	StatusId *string `json:"statusId,omitempty"`
+}
 
-// GetOrganizationId returns TeamCreateInput.OrganizationId, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetOrganizationId() string { return v.OrganizationId }
+// GetName returns CustomerUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *CustomerUpdateInput) GetName() string { return v.Name }
 
-// GetCyclesEnabled returns TeamCreateInput.CyclesEnabled, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetCyclesEnabled() bool { return v.CyclesEnabled }
+// GetDomains returns CustomerUpdateInput.Domains, and is useful for accessing the field via an interface.
+func (v *CustomerUpdateInput) GetDomains() []string { return v.Domains }
 
-// GetCycleStartDay returns TeamCreateInput.CycleStartDay, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetCycleStartDay() float64 { return v.CycleStartDay }
+// GetExternalIds returns CustomerUpdateInput.ExternalIds, and is useful for accessing the field via an interface.
+func (v *CustomerUpdateInput) GetExternalIds() []string { return v.ExternalIds }
 
-// GetCycleDuration returns TeamCreateInput.CycleDuration, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetCycleDuration() int { return v.CycleDuration }
+// GetSlackChannelId returns CustomerUpdateInput.SlackChannelId, and is useful for accessing the field via an interface.
+func (v *CustomerUpdateInput) GetSlackChannelId() *string { return v.SlackChannelId }
 
-// GetCycleCooldownTime returns TeamCreateInput.CycleCooldownTime, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetCycleCooldownTime() int { return v.CycleCooldownTime }
+// GetOwnerId returns CustomerUpdateInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *CustomerUpdateInput) GetOwnerId() *string { return v.OwnerId }
 
-// GetCycleIssueAutoAssignStarted returns TeamCreateInput.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetCycleIssueAutoAssignStarted() bool { return v.CycleIssueAutoAssignStarted }
+// GetStatusId returns CustomerUpdateInput.StatusId, and is useful for accessing the field via an interface.
+func (v *CustomerUpdateInput) GetStatusId() *string { return v.StatusId }
 
-// GetCycleIssueAutoAssignCompleted returns TeamCreateInput.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetCycleIssueAutoAssignCompleted() bool {
-	return v.CycleIssueAutoAssignCompleted
+// CycleDetails includes the GraphQL fields of Cycle requested by the fragment CycleDetails.
+// The GraphQL type's documentation follows.
+//
+// A set of issues to be resolved in a specified amount of time.
+type CycleDetails struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The number of the cycle.
+	Number float64 `json:"number"`
+	// The custom name of the cycle.
+	Name *string `json:"name"`
+	// The cycle's description.
+	Description *string `json:"description"`
+	// The start time of the cycle.
+	StartsAt time.Time `json:"startsAt"`
+	// The end time of the cycle.
+	EndsAt time.Time `json:"endsAt"`
+	// The completion time of the cycle. If null, the cycle hasn't been completed.
+	CompletedAt *time.Time `json:"completedAt"`
+	// The overall progress of the cycle. This is the (completed estimate points +
+	// 0.25 * in progress estimate points) / total estimate points.
+	Progress float64 `json:"progress"`
 }
 
-// GetCycleLockToActive returns TeamCreateInput.CycleLockToActive, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetCycleLockToActive() bool { return v.CycleLockToActive }
+// GetId returns CycleDetails.Id, and is useful for accessing the field via an interface.
+func (v *CycleDetails) GetId() string { return v.Id }
 
-// GetUpcomingCycleCount returns TeamCreateInput.UpcomingCycleCount, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetUpcomingCycleCount() float64 { return v.UpcomingCycleCount }
+// GetNumber returns CycleDetails.Number, and is useful for accessing the field via an interface.
+func (v *CycleDetails) GetNumber() float64 { return v.Number }
 
-// GetTriageEnabled returns TeamCreateInput.TriageEnabled, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetTriageEnabled() bool { return v.TriageEnabled }
+// GetName returns CycleDetails.Name, and is useful for accessing the field via an interface.
+func (v *CycleDetails) GetName() *string { return v.Name }
 
-// GetRequirePriorityToLeaveTriage returns TeamCreateInput.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetRequirePriorityToLeaveTriage() bool {
-	return v.RequirePriorityToLeaveTriage
-}
+// GetDescription returns CycleDetails.Description, and is useful for accessing the field via an interface.
+func (v *CycleDetails) GetDescription() *string { return v.Description }
 
-// GetTimezone returns TeamCreateInput.Timezone, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetTimezone() string { return v.Timezone }
+// GetStartsAt returns CycleDetails.StartsAt, and is useful for accessing the field via an interface.
+func (v *CycleDetails) GetStartsAt() time.Time { return v.StartsAt }
 
-// GetIssueOrderingNoPriorityFirst returns TeamCreateInput.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetIssueOrderingNoPriorityFirst() bool {
-	return v.IssueOrderingNoPriorityFirst
-}
+// GetEndsAt returns CycleDetails.EndsAt, and is useful for accessing the field via an interface.
+func (v *CycleDetails) GetEndsAt() time.Time { return v.EndsAt }
 
-// GetIssueEstimationType returns TeamCreateInput.IssueEstimationType, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetIssueEstimationType() string { return v.IssueEstimationType }
+// GetCompletedAt returns CycleDetails.CompletedAt, and is useful for accessing the field via an interface.
+func (v *CycleDetails) GetCompletedAt() *time.Time { return v.CompletedAt }
 
-// GetIssueEstimationAllowZero returns TeamCreateInput.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetIssueEstimationAllowZero() bool { return v.IssueEstimationAllowZero }
+// GetProgress returns CycleDetails.Progress, and is useful for accessing the field via an interface.
+func (v *CycleDetails) GetProgress() float64 { return v.Progress }
 
-// GetSetIssueSortOrderOnStateChange returns TeamCreateInput.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetSetIssueSortOrderOnStateChange() string {
-	return v.SetIssueSortOrderOnStateChange
-}
+// [INTERNAL] By which resolution is a date defined.
+type DateResolutionType string
 
-// GetIssueEstimationExtended returns TeamCreateInput.IssueEstimationExtended, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetIssueEstimationExtended() bool { return v.IssueEstimationExtended }
+const (
+	DateResolutionTypeMonth    DateResolutionType = "month"
+	DateResolutionTypeQuarter  DateResolutionType = "quarter"
+	DateResolutionTypeHalfyear DateResolutionType = "halfYear"
+	DateResolutionTypeYear     DateResolutionType = "year"
+)
 
-// GetDefaultIssueEstimate returns TeamCreateInput.DefaultIssueEstimate, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetDefaultIssueEstimate() float64 { return v.DefaultIssueEstimate }
+// The day of the week.
+type Day string
 
-// GetGroupIssueHistory returns TeamCreateInput.GroupIssueHistory, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetGroupIssueHistory() bool { return v.GroupIssueHistory }
+const (
+	DaySunday    Day = "Sunday"
+	DayMonday    Day = "Monday"
+	DayTuesday   Day = "Tuesday"
+	DayWednesday Day = "Wednesday"
+	DayThursday  Day = "Thursday"
+	DayFriday    Day = "Friday"
+	DaySaturday  Day = "Saturday"
+)
 
-// GetDefaultTemplateForMembersId returns TeamCreateInput.DefaultTemplateForMembersId, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetDefaultTemplateForMembersId() string {
-	return v.DefaultTemplateForMembersId
+// Emoji includes the GraphQL fields of Emoji requested by the fragment Emoji.
+// The GraphQL type's documentation follows.
+//
+// A custom emoji.
+type Emoji struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The emoji's name.
+	Name string `json:"name"`
+	// The emoji image URL.
+	Url string `json:"url"`
+	// The source of the emoji.
+	Source string `json:"source"`
 }
 
-// GetDefaultTemplateForNonMembersId returns TeamCreateInput.DefaultTemplateForNonMembersId, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetDefaultTemplateForNonMembersId() string {
-	return v.DefaultTemplateForNonMembersId
-}
+// GetId returns Emoji.Id, and is useful for accessing the field via an interface.
+func (v *Emoji) GetId() string { return v.Id }
 
-// GetDefaultProjectTemplateId returns TeamCreateInput.DefaultProjectTemplateId, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetDefaultProjectTemplateId() string { return v.DefaultProjectTemplateId }
+// GetName returns Emoji.Name, and is useful for accessing the field via an interface.
+func (v *Emoji) GetName() string { return v.Name }
 
-// GetPrivate returns TeamCreateInput.Private, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetPrivate() bool { return v.Private }
+// GetUrl returns Emoji.Url, and is useful for accessing the field via an interface.
+func (v *Emoji) GetUrl() string { return v.Url }
 
-// GetAutoClosePeriod returns TeamCreateInput.AutoClosePeriod, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetAutoClosePeriod() *float64 { return v.AutoClosePeriod }
+// GetSource returns Emoji.Source, and is useful for accessing the field via an interface.
+func (v *Emoji) GetSource() string { return v.Source }
 
-// GetAutoCloseStateId returns TeamCreateInput.AutoCloseStateId, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetAutoCloseStateId() string { return v.AutoCloseStateId }
+type EmojiCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the custom emoji.
+	Name string `json:"name"`
+	// The URL for the emoji.
+	Url string `json:"url"`
+}
 
-// GetAutoArchivePeriod returns TeamCreateInput.AutoArchivePeriod, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetAutoArchivePeriod() float64 { return v.AutoArchivePeriod }
+// GetId returns EmojiCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *EmojiCreateInput) GetId() string { return v.Id }
 
-// GetMarkedAsDuplicateWorkflowStateId returns TeamCreateInput.MarkedAsDuplicateWorkflowStateId, and is useful for accessing the field via an interface.
-func (v *TeamCreateInput) GetMarkedAsDuplicateWorkflowStateId() string {
-	return v.MarkedAsDuplicateWorkflowStateId
-}
+// GetName returns EmojiCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *EmojiCreateInput) GetName() string { return v.Name }
 
-type TeamUpdateInput struct {
-	// The name of the team.
-	Name string `json:"name,omitempty"`
-	// The description of the team.
-	Description *string `json:"description"`
-	// The key of the team.
-	Key string `json:"key,omitempty"`
-	// The icon of the team.
-	Icon *string `json:"icon,omitempty"`
-	// The color of the team.
-	Color *string `json:"color,omitempty"`
-	// Whether the team uses cycles.
-	CyclesEnabled bool `json:"cyclesEnabled"`
-	// The day of the week that a new cycle starts.
-	CycleStartDay float64 `json:"cycleStartDay"`
-	// The duration of each cycle in weeks.
-	CycleDuration int `json:"cycleDuration"`
-	// The cooldown time after each cycle in weeks.
-	CycleCooldownTime int `json:"cycleCooldownTime"`
-	// Auto assign started issues to current active cycle setting.
-	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
-	// Auto assign completed issues to current active cycle setting.
-	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
-	// Only allow issues with cycles in Active Issues.
-	CycleLockToActive bool `json:"cycleLockToActive"`
-	// [DEPRECATED] Whether the first cycle should start in the current or the next week.
-	CycleEnabledStartWeek string `json:"cycleEnabledStartWeek,omitempty"`
-	// The date to begin cycles on.
-	CycleEnabledStartDate time.Time `json:"cycleEnabledStartDate"`
-	// How many upcoming cycles to create.
-	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
-	// The timezone of the team.
-	Timezone string `json:"timezone"`
-	// Whether issues without priority should be sorted first.
-	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
-	// The issue estimation type to use. Must be one of "notUsed", "exponential", "fibonacci", "linear", "tShirt".
-	IssueEstimationType string `json:"issueEstimationType"`
-	// Whether to allow zeros in issues estimates.
-	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
-	// Whether to move issues to bottom of the column when changing state.
-	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
-	// Whether to add additional points to the estimate scale.
-	IssueEstimationExtended bool `json:"issueEstimationExtended"`
-	// What to use as an default estimate for unestimated issues.
-	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
-	// The workflow state into which issues are moved when a draft PR has been opened.
-	DraftWorkflowStateId string `json:"draftWorkflowStateId,omitempty"`
-	// The workflow state into which issues are moved when a PR has been opened.
-	StartWorkflowStateId string `json:"startWorkflowStateId,omitempty"`
-	// The workflow state into which issues are moved when a review has been requested for the PR.
-	ReviewWorkflowStateId string `json:"reviewWorkflowStateId,omitempty"`
-	// The workflow state into which issues are moved when a PR is ready to be merged.
-	MergeableWorkflowStateId string `json:"mergeableWorkflowStateId,omitempty"`
-	// The workflow state into which issues are moved when a PR has been merged.
-	MergeWorkflowStateId string `json:"mergeWorkflowStateId,omitempty"`
-	// Whether to send new issue notifications to Slack.
-	SlackNewIssue bool `json:"slackNewIssue"`
-	// Whether to send new issue comment notifications to Slack.
-	SlackIssueComments bool `json:"slackIssueComments"`
-	// Whether to send issue status update notifications to Slack.
-	SlackIssueStatuses bool `json:"slackIssueStatuses"`
-	// Whether to group recent issue history entries.
-	GroupIssueHistory bool `json:"groupIssueHistory"`
-	// The identifier of the default template for members of this team.
-	DefaultTemplateForMembersId string `json:"defaultTemplateForMembersId,omitempty"`
-	// The identifier of the default template for non-members of this team.
-	DefaultTemplateForNonMembersId string `json:"defaultTemplateForNonMembersId,omitempty"`
-	// The identifier of the default project template of this team.
-	DefaultProjectTemplateId string `json:"defaultProjectTemplateId,omitempty"`
-	// Whether the team is private or not.
-	Private bool `json:"private"`
-	// Whether triage mode is enabled for the team.
-	TriageEnabled bool `json:"triageEnabled"`
-	// Whether an issue needs to have a priority set before leaving triage.
-	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
-	// Default status for newly created issues.
-	DefaultIssueStateId string `json:"defaultIssueStateId,omitempty"`
-	// Period after which issues are automatically closed, in months.
-	AutoClosePeriod *float64 `json:"autoClosePeriod"`
-	// The canceled workflow state which auto closed issues will be set to.
-	AutoCloseStateId string `json:"autoCloseStateId,omitempty"`
-	// Period after which closed and completed issues are automatically archived, in months.
-	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
-	// The workflow state into which issues are moved when they are marked as a duplicate of another issue.
-	MarkedAsDuplicateWorkflowStateId string `json:"markedAsDuplicateWorkflowStateId,omitempty"`
-	// Whether new users should join this team by default. Mutation restricted to workspace admins!
-	JoinByDefault bool `json:"joinByDefault"`
-	// Whether the team is managed by SCIM integration. Mutation restricted to workspace admins and only unsetting is allowed!
-	ScimManaged bool `json:"scimManaged"`
+// GetUrl returns EmojiCreateInput.Url, and is useful for accessing the field via an interface.
+func (v *EmojiCreateInput) GetUrl() string { return v.Url }
+
+// GitAutomationState includes the GraphQL fields of GitAutomationState requested by the fragment GitAutomationState.
+// The GraphQL type's documentation follows.
+//
+// A trigger that updates the issue status according to Git automations.
+type GitAutomationState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The event that triggers the automation.
+	Event GitAutomationStates `json:"event"`
+	// The associated workflow state.
+	State *GitAutomationStateStateWorkflowState `json:"state"`
+	// The team to which this automation state belongs.
+	Team GitAutomationStateTeam `json:"team"`
+	// The target branch associated to this automation state.
+	TargetBranch *GitAutomationStateTargetBranchGitAutomationTargetBranch `json:"targetBranch"`
 }
 
-// GetName returns TeamUpdateInput.Name, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetName() string { return v.Name }
+// GetId returns GitAutomationState.Id, and is useful for accessing the field via an interface.
+func (v *GitAutomationState) GetId() string { return v.Id }
 
-// GetDescription returns TeamUpdateInput.Description, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetDescription() *string { return v.Description }
+// GetEvent returns GitAutomationState.Event, and is useful for accessing the field via an interface.
+func (v *GitAutomationState) GetEvent() GitAutomationStates { return v.Event }
 
-// GetKey returns TeamUpdateInput.Key, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetKey() string { return v.Key }
+// GetState returns GitAutomationState.State, and is useful for accessing the field via an interface.
+func (v *GitAutomationState) GetState() *GitAutomationStateStateWorkflowState { return v.State }
 
-// GetIcon returns TeamUpdateInput.Icon, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetIcon() *string { return v.Icon }
+// GetTeam returns GitAutomationState.Team, and is useful for accessing the field via an interface.
+func (v *GitAutomationState) GetTeam() GitAutomationStateTeam { return v.Team }
 
-// GetColor returns TeamUpdateInput.Color, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetColor() *string { return v.Color }
+// GetTargetBranch returns GitAutomationState.TargetBranch, and is useful for accessing the field via an interface.
+func (v *GitAutomationState) GetTargetBranch() *GitAutomationStateTargetBranchGitAutomationTargetBranch {
+	return v.TargetBranch
+}
 
-// GetCyclesEnabled returns TeamUpdateInput.CyclesEnabled, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetCyclesEnabled() bool { return v.CyclesEnabled }
+type GitAutomationStateCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The team associated with the automation state.
+	TeamId string `json:"teamId"`
+	// The associated workflow state. If null, will override default behaviour and take no action.
+	StateId *string `json:"stateId"`
+	// [DEPRECATED] The target branch pattern. If null, all branches are targeted.
+	BranchPattern *string `json:"branchPattern,omitempty"`
+	// The associated target branch. If null, all branches are targeted.
+	TargetBranchId *string `json:"targetBranchId"`
+	// The event that triggers the automation.
+	Event GitAutomationStates `json:"event"`
+}
 
-// GetCycleStartDay returns TeamUpdateInput.CycleStartDay, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetCycleStartDay() float64 { return v.CycleStartDay }
+// GetId returns GitAutomationStateCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateCreateInput) GetId() string { return v.Id }
 
-// GetCycleDuration returns TeamUpdateInput.CycleDuration, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetCycleDuration() int { return v.CycleDuration }
+// GetTeamId returns GitAutomationStateCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateCreateInput) GetTeamId() string { return v.TeamId }
 
-// GetCycleCooldownTime returns TeamUpdateInput.CycleCooldownTime, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetCycleCooldownTime() int { return v.CycleCooldownTime }
+// GetStateId returns GitAutomationStateCreateInput.StateId, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateCreateInput) GetStateId() *string { return v.StateId }
 
-// GetCycleIssueAutoAssignStarted returns TeamUpdateInput.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetCycleIssueAutoAssignStarted() bool { return v.CycleIssueAutoAssignStarted }
+// GetBranchPattern returns GitAutomationStateCreateInput.BranchPattern, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateCreateInput) GetBranchPattern() *string { return v.BranchPattern }
 
-// GetCycleIssueAutoAssignCompleted returns TeamUpdateInput.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetCycleIssueAutoAssignCompleted() bool {
-	return v.CycleIssueAutoAssignCompleted
+// GetTargetBranchId returns GitAutomationStateCreateInput.TargetBranchId, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateCreateInput) GetTargetBranchId() *string { return v.TargetBranchId }
+
+// GetEvent returns GitAutomationStateCreateInput.Event, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateCreateInput) GetEvent() GitAutomationStates { return v.Event }
+
+// GitAutomationStateStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type GitAutomationStateStateWorkflowState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetCycleLockToActive returns TeamUpdateInput.CycleLockToActive, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetCycleLockToActive() bool { return v.CycleLockToActive }
+// GetId returns GitAutomationStateStateWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateStateWorkflowState) GetId() string { return v.Id }
 
-// GetCycleEnabledStartWeek returns TeamUpdateInput.CycleEnabledStartWeek, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetCycleEnabledStartWeek() string { return v.CycleEnabledStartWeek }
+// GitAutomationStateTargetBranchGitAutomationTargetBranch includes the requested fields of the GraphQL type GitAutomationTargetBranch.
+// The GraphQL type's documentation follows.
+//
+// A Git target branch for which there are automations (GitAutomationState).
+type GitAutomationStateTargetBranchGitAutomationTargetBranch struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
 
-// GetCycleEnabledStartDate returns TeamUpdateInput.CycleEnabledStartDate, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetCycleEnabledStartDate() time.Time { return v.CycleEnabledStartDate }
+// GetId returns GitAutomationStateTargetBranchGitAutomationTargetBranch.Id, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateTargetBranchGitAutomationTargetBranch) GetId() string { return v.Id }
 
-// GetUpcomingCycleCount returns TeamUpdateInput.UpcomingCycleCount, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetUpcomingCycleCount() float64 { return v.UpcomingCycleCount }
+// GitAutomationStateTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type GitAutomationStateTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
 
-// GetTimezone returns TeamUpdateInput.Timezone, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetTimezone() string { return v.Timezone }
+// GetId returns GitAutomationStateTeam.Id, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateTeam) GetId() string { return v.Id }
 
-// GetIssueOrderingNoPriorityFirst returns TeamUpdateInput.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetIssueOrderingNoPriorityFirst() bool {
-	return v.IssueOrderingNoPriorityFirst
+type GitAutomationStateUpdateInput struct {
+	// The associated workflow state.
+	StateId *string `json:"stateId"`
+	// [DEPRECATED] The target branch pattern. If null, all branches are targeted.
+	BranchPattern *string `json:"branchPattern,omitempty"`
+	// The associated target branch. If null, all branches are targeted.
+	TargetBranchId *string `json:"targetBranchId"`
+	// The event that triggers the automation.
+	Event GitAutomationStates `json:"event"`
 }
 
-// GetIssueEstimationType returns TeamUpdateInput.IssueEstimationType, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetIssueEstimationType() string { return v.IssueEstimationType }
+// GetStateId returns GitAutomationStateUpdateInput.StateId, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateUpdateInput) GetStateId() *string { return v.StateId }
 
-// GetIssueEstimationAllowZero returns TeamUpdateInput.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetIssueEstimationAllowZero() bool { return v.IssueEstimationAllowZero }
+// GetBranchPattern returns GitAutomationStateUpdateInput.BranchPattern, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateUpdateInput) GetBranchPattern() *string { return v.BranchPattern }
 
-// GetSetIssueSortOrderOnStateChange returns TeamUpdateInput.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetSetIssueSortOrderOnStateChange() string {
-	return v.SetIssueSortOrderOnStateChange
-}
+// GetTargetBranchId returns GitAutomationStateUpdateInput.TargetBranchId, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateUpdateInput) GetTargetBranchId() *string { return v.TargetBranchId }
 
-// GetIssueEstimationExtended returns TeamUpdateInput.IssueEstimationExtended, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetIssueEstimationExtended() bool { return v.IssueEstimationExtended }
+// GetEvent returns GitAutomationStateUpdateInput.Event, and is useful for accessing the field via an interface.
+func (v *GitAutomationStateUpdateInput) GetEvent() GitAutomationStates { return v.Event }
 
-// GetDefaultIssueEstimate returns TeamUpdateInput.DefaultIssueEstimate, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetDefaultIssueEstimate() float64 { return v.DefaultIssueEstimate }
+// The various states of a pull/merge request.
+type GitAutomationStates string
 
-// GetDraftWorkflowStateId returns TeamUpdateInput.DraftWorkflowStateId, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetDraftWorkflowStateId() string { return v.DraftWorkflowStateId }
+const (
+	GitAutomationStatesDraft     GitAutomationStates = "draft"
+	GitAutomationStatesStart     GitAutomationStates = "start"
+	GitAutomationStatesReview    GitAutomationStates = "review"
+	GitAutomationStatesMergeable GitAutomationStates = "mergeable"
+	GitAutomationStatesMerge     GitAutomationStates = "merge"
+)
 
-// GetStartWorkflowStateId returns TeamUpdateInput.StartWorkflowStateId, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetStartWorkflowStateId() string { return v.StartWorkflowStateId }
+// InitiativeToProject includes the GraphQL fields of InitiativeToProject requested by the fragment InitiativeToProject.
+// The GraphQL type's documentation follows.
+//
+// Join table between projects and initiatives.
+type InitiativeToProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The sort order of the project within the initiative.
+	SortOrder string `json:"sortOrder"`
+	// The initiative that the project is associated with.
+	Initiative InitiativeToProjectInitiative `json:"initiative"`
+	// The project that the initiative is associated with.
+	Project InitiativeToProjectProject `json:"project"`
+}
 
-// GetReviewWorkflowStateId returns TeamUpdateInput.ReviewWorkflowStateId, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetReviewWorkflowStateId() string { return v.ReviewWorkflowStateId }
+// GetId returns InitiativeToProject.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeToProject) GetId() string { return v.Id }
 
-// GetMergeableWorkflowStateId returns TeamUpdateInput.MergeableWorkflowStateId, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetMergeableWorkflowStateId() string { return v.MergeableWorkflowStateId }
+// GetSortOrder returns InitiativeToProject.SortOrder, and is useful for accessing the field via an interface.
+func (v *InitiativeToProject) GetSortOrder() string { return v.SortOrder }
 
-// GetMergeWorkflowStateId returns TeamUpdateInput.MergeWorkflowStateId, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetMergeWorkflowStateId() string { return v.MergeWorkflowStateId }
+// GetInitiative returns InitiativeToProject.Initiative, and is useful for accessing the field via an interface.
+func (v *InitiativeToProject) GetInitiative() InitiativeToProjectInitiative { return v.Initiative }
 
-// GetSlackNewIssue returns TeamUpdateInput.SlackNewIssue, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetSlackNewIssue() bool { return v.SlackNewIssue }
+// GetProject returns InitiativeToProject.Project, and is useful for accessing the field via an interface.
+func (v *InitiativeToProject) GetProject() InitiativeToProjectProject { return v.Project }
 
-// GetSlackIssueComments returns TeamUpdateInput.SlackIssueComments, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetSlackIssueComments() bool { return v.SlackIssueComments }
+// The properties of the initiativeToProject to create.
+type InitiativeToProjectCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The identifier of the project.
+	ProjectId string `json:"projectId"`
+	// The identifier of the initiative.
+	InitiativeId string `json:"initiativeId"`
+	// The sort order for the project within its organization.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+}
 
-// GetSlackIssueStatuses returns TeamUpdateInput.SlackIssueStatuses, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetSlackIssueStatuses() bool { return v.SlackIssueStatuses }
+// GetId returns InitiativeToProjectCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectCreateInput) GetId() string { return v.Id }
 
-// GetGroupIssueHistory returns TeamUpdateInput.GroupIssueHistory, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetGroupIssueHistory() bool { return v.GroupIssueHistory }
+// GetProjectId returns InitiativeToProjectCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectCreateInput) GetProjectId() string { return v.ProjectId }
 
-// GetDefaultTemplateForMembersId returns TeamUpdateInput.DefaultTemplateForMembersId, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetDefaultTemplateForMembersId() string {
-	return v.DefaultTemplateForMembersId
+// GetInitiativeId returns InitiativeToProjectCreateInput.InitiativeId, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectCreateInput) GetInitiativeId() string { return v.InitiativeId }
+
+// GetSortOrder returns InitiativeToProjectCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectCreateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// InitiativeToProjectInitiative includes the requested fields of the GraphQL type Initiative.
+// The GraphQL type's documentation follows.
+//
+// An initiative to group projects.
+type InitiativeToProjectInitiative struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetDefaultTemplateForNonMembersId returns TeamUpdateInput.DefaultTemplateForNonMembersId, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetDefaultTemplateForNonMembersId() string {
-	return v.DefaultTemplateForNonMembersId
+// GetId returns InitiativeToProjectInitiative.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectInitiative) GetId() string { return v.Id }
+
+// InitiativeToProjectProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type InitiativeToProjectProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetDefaultProjectTemplateId returns TeamUpdateInput.DefaultProjectTemplateId, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetDefaultProjectTemplateId() string { return v.DefaultProjectTemplateId }
+// GetId returns InitiativeToProjectProject.Id, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectProject) GetId() string { return v.Id }
 
-// GetPrivate returns TeamUpdateInput.Private, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetPrivate() bool { return v.Private }
+// The properties of the initiativeToProject to update.
+type InitiativeToProjectUpdateInput struct {
+	// The sort order for the project within its organization.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+}
 
-// GetTriageEnabled returns TeamUpdateInput.TriageEnabled, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetTriageEnabled() bool { return v.TriageEnabled }
+// GetSortOrder returns InitiativeToProjectUpdateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *InitiativeToProjectUpdateInput) GetSortOrder() *float64 { return v.SortOrder }
 
-// GetRequirePriorityToLeaveTriage returns TeamUpdateInput.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetRequirePriorityToLeaveTriage() bool {
-	return v.RequirePriorityToLeaveTriage
+// Issue includes the GraphQL fields of Issue requested by the fragment Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type Issue struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+	// The issue's title.
+	Title string `json:"title"`
+	// The issue's description in markdown format.
+	Description *string `json:"description"`
+	// The priority of the issue. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+	Priority float64 `json:"priority"`
+	// The team that the issue is associated with.
+	Team IssueTeam `json:"team"`
+	// The workflow state that the issue is associated with.
+	State IssueStateWorkflowState `json:"state"`
 }
 
-// GetDefaultIssueStateId returns TeamUpdateInput.DefaultIssueStateId, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetDefaultIssueStateId() string { return v.DefaultIssueStateId }
+// GetId returns Issue.Id, and is useful for accessing the field via an interface.
+func (v *Issue) GetId() string { return v.Id }
 
-// GetAutoClosePeriod returns TeamUpdateInput.AutoClosePeriod, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetAutoClosePeriod() *float64 { return v.AutoClosePeriod }
+// GetIdentifier returns Issue.Identifier, and is useful for accessing the field via an interface.
+func (v *Issue) GetIdentifier() string { return v.Identifier }
 
-// GetAutoCloseStateId returns TeamUpdateInput.AutoCloseStateId, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetAutoCloseStateId() string { return v.AutoCloseStateId }
+// GetTitle returns Issue.Title, and is useful for accessing the field via an interface.
+func (v *Issue) GetTitle() string { return v.Title }
 
-// GetAutoArchivePeriod returns TeamUpdateInput.AutoArchivePeriod, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetAutoArchivePeriod() float64 { return v.AutoArchivePeriod }
+// GetDescription returns Issue.Description, and is useful for accessing the field via an interface.
+func (v *Issue) GetDescription() *string { return v.Description }
 
-// GetMarkedAsDuplicateWorkflowStateId returns TeamUpdateInput.MarkedAsDuplicateWorkflowStateId, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetMarkedAsDuplicateWorkflowStateId() string {
-	return v.MarkedAsDuplicateWorkflowStateId
-}
+// GetPriority returns Issue.Priority, and is useful for accessing the field via an interface.
+func (v *Issue) GetPriority() float64 { return v.Priority }
 
-// GetJoinByDefault returns TeamUpdateInput.JoinByDefault, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetJoinByDefault() bool { return v.JoinByDefault }
+// GetTeam returns Issue.Team, and is useful for accessing the field via an interface.
+func (v *Issue) GetTeam() IssueTeam { return v.Team }
 
-// GetScimManaged returns TeamUpdateInput.ScimManaged, and is useful for accessing the field via an interface.
-func (v *TeamUpdateInput) GetScimManaged() bool { return v.ScimManaged }
+// GetState returns Issue.State, and is useful for accessing the field via an interface.
+func (v *Issue) GetState() IssueStateWorkflowState { return v.State }
 
-// TeamWorkflow includes the GraphQL fields of Team requested by the fragment TeamWorkflow.
+type IssueCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The title of the issue.
+	Title string `json:"title"`
+	// The issue description in markdown format.
+	Description *string `json:"description,omitempty"`
+	// [Internal] The issue description as a Prosemirror document.
+	DescriptionData json.RawMessage `json:"descriptionData,omitempty"`
+	// The identifier of the user to assign the issue to.
+	AssigneeId *string `json:"assigneeId,omitempty"`
+	// The identifier of the parent issue.
+	ParentId *string `json:"parentId,omitempty"`
+	// The priority of the issue. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+	Priority *int `json:"priority,omitempty"`
+	// The estimated complexity of the issue.
+	Estimate *int `json:"estimate,omitempty"`
+	// The identifiers of the users subscribing to this ticket.
+	SubscriberIds []string `json:"subscriberIds,omitempty"`
+	// The identifiers of the issue labels associated with this ticket.
+	LabelIds []string `json:"labelIds,omitempty"`
+	// The identifier of the team associated with the issue.
+	TeamId string `json:"teamId"`
+	// The cycle associated with the issue.
+	CycleId *string `json:"cycleId,omitempty"`
+	// The project associated with the issue.
+	ProjectId *string `json:"projectId,omitempty"`
+	// The project milestone associated with the issue.
+	ProjectMilestoneId *string `json:"projectMilestoneId,omitempty"`
+	// The ID of the last template applied to the issue.
+	LastAppliedTemplateId string `json:"lastAppliedTemplateId,omitempty"`
+	// The team state of the issue.
+	StateId *string `json:"stateId,omitempty"`
+	// The comment the issue is referencing.
+	ReferenceCommentId string `json:"referenceCommentId,omitempty"`
+	// The comment the issue is created from.
+	SourceCommentId string `json:"sourceCommentId,omitempty"`
+	// The position of the issue in its column on the board view.
+	BoardOrder *float64 `json:"boardOrder,omitempty"`
+	// The position of the issue related to other issues.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// [ALPHA] The position of the issue related to other issues, when ordered by priority.
+	PrioritySortOrder *float64 `json:"prioritySortOrder,omitempty"`
+	// The position of the issue in parent's sub-issue list.
+	SubIssueSortOrder *float64 `json:"subIssueSortOrder,omitempty"`
+	// The date at which the issue is due.
+	DueDate *string `json:"dueDate,omitempty"`
+	// Create issue as a user with the provided name. This option is only available
+	// to OAuth applications creating issues in `actor=application` mode.
+	CreateAsUser string `json:"createAsUser,omitempty"`
+	// Provide an external user avatar URL. Can only be used in conjunction with the
+	// `createAsUser` options. This option is only available to OAuth applications
+	// creating comments in `actor=application` mode.
+	DisplayIconUrl string `json:"displayIconUrl,omitempty"`
+	// Whether the passed sort order should be preserved.
+	PreserveSortOrderOnCreate *bool `json:"preserveSortOrderOnCreate,omitempty"`
+	// The date when the issue was created (e.g. if importing from another system).
+	// Must be a date in the past. If none is provided, the backend will generate the time as now.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// [Internal] The timestamp at which an issue will be considered in breach of SLA.
+	SlaBreachesAt *time.Time `json:"slaBreachesAt,omitempty"`
+	// The identifier of a template the issue should be created from. If other values
+	// are provided in the input, they will override template values.
+	TemplateId string `json:"templateId,omitempty"`
+}
+
+// GetId returns IssueCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetId() string { return v.Id }
+
+// GetTitle returns IssueCreateInput.Title, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetTitle() string { return v.Title }
+
+// GetDescription returns IssueCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetDescription() *string { return v.Description }
+
+// GetDescriptionData returns IssueCreateInput.DescriptionData, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetDescriptionData() json.RawMessage { return v.DescriptionData }
+
+// GetAssigneeId returns IssueCreateInput.AssigneeId, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetAssigneeId() *string { return v.AssigneeId }
+
+// GetParentId returns IssueCreateInput.ParentId, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetParentId() *string { return v.ParentId }
+
+// GetPriority returns IssueCreateInput.Priority, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetPriority() *int { return v.Priority }
+
+// GetEstimate returns IssueCreateInput.Estimate, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetEstimate() *int { return v.Estimate }
+
+// GetSubscriberIds returns IssueCreateInput.SubscriberIds, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetSubscriberIds() []string { return v.SubscriberIds }
+
+// GetLabelIds returns IssueCreateInput.LabelIds, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetLabelIds() []string { return v.LabelIds }
+
+// GetTeamId returns IssueCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetTeamId() string { return v.TeamId }
+
+// GetCycleId returns IssueCreateInput.CycleId, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetCycleId() *string { return v.CycleId }
+
+// GetProjectId returns IssueCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetProjectId() *string { return v.ProjectId }
+
+// GetProjectMilestoneId returns IssueCreateInput.ProjectMilestoneId, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetProjectMilestoneId() *string { return v.ProjectMilestoneId }
+
+// GetLastAppliedTemplateId returns IssueCreateInput.LastAppliedTemplateId, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetLastAppliedTemplateId() string { return v.LastAppliedTemplateId }
+
+// GetStateId returns IssueCreateInput.StateId, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetStateId() *string { return v.StateId }
+
+// GetReferenceCommentId returns IssueCreateInput.ReferenceCommentId, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetReferenceCommentId() string { return v.ReferenceCommentId }
+
+// GetSourceCommentId returns IssueCreateInput.SourceCommentId, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetSourceCommentId() string { return v.SourceCommentId }
+
+// GetBoardOrder returns IssueCreateInput.BoardOrder, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetBoardOrder() *float64 { return v.BoardOrder }
+
+// GetSortOrder returns IssueCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// GetPrioritySortOrder returns IssueCreateInput.PrioritySortOrder, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetPrioritySortOrder() *float64 { return v.PrioritySortOrder }
+
+// GetSubIssueSortOrder returns IssueCreateInput.SubIssueSortOrder, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetSubIssueSortOrder() *float64 { return v.SubIssueSortOrder }
+
+// GetDueDate returns IssueCreateInput.DueDate, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetDueDate() *string { return v.DueDate }
+
+// GetCreateAsUser returns IssueCreateInput.CreateAsUser, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetCreateAsUser() string { return v.CreateAsUser }
+
+// GetDisplayIconUrl returns IssueCreateInput.DisplayIconUrl, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetDisplayIconUrl() string { return v.DisplayIconUrl }
+
+// GetPreserveSortOrderOnCreate returns IssueCreateInput.PreserveSortOrderOnCreate, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetPreserveSortOrderOnCreate() *bool { return v.PreserveSortOrderOnCreate }
+
+// GetCreatedAt returns IssueCreateInput.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetCreatedAt() *time.Time { return v.CreatedAt }
+
+// GetSlaBreachesAt returns IssueCreateInput.SlaBreachesAt, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetSlaBreachesAt() *time.Time { return v.SlaBreachesAt }
+
+// GetTemplateId returns IssueCreateInput.TemplateId, and is useful for accessing the field via an interface.
+func (v *IssueCreateInput) GetTemplateId() string { return v.TemplateId }
+
+// IssueLabel includes the GraphQL fields of IssueLabel requested by the fragment IssueLabel.
 // The GraphQL type's documentation follows.
 //
-// An organizational unit that contains issues.
-type TeamWorkflow struct {
+// Labels that can be associated with issues.
+type IssueLabel struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
-	// The team's unique key. The key is used in URLs.
-	Key string `json:"key"`
-	// The workflow state into which issues are moved when a PR has been opened as draft.
-	DraftWorkflowState *TeamWorkflowDraftWorkflowState `json:"draftWorkflowState"`
-	// The workflow state into which issues are moved when a PR has been opened.
-	StartWorkflowState *TeamWorkflowStartWorkflowState `json:"startWorkflowState"`
-	// The workflow state into which issues are moved when a review has been requested for the PR.
-	ReviewWorkflowState *TeamWorkflowReviewWorkflowState `json:"reviewWorkflowState"`
-	// The workflow state into which issues are moved when a PR has been merged.
-	MergeWorkflowState *TeamWorkflowMergeWorkflowState `json:"mergeWorkflowState"`
+	// The label's name.
+	Name string `json:"name"`
+	// The label's description.
+	Description *string `json:"description"`
+	// The label's color as a HEX string.
+	Color *string `json:"color"`
+	// The parent label.
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+	// The team that the label is associated with. If null, the label is associated with the global workspace.
+	Team *IssueLabelTeam `json:"team"`
 }
 
-// GetId returns TeamWorkflow.Id, and is useful for accessing the field via an interface.
-func (v *TeamWorkflow) GetId() string { return v.Id }
+// GetId returns IssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetId() string { return v.Id }
 
-// GetKey returns TeamWorkflow.Key, and is useful for accessing the field via an interface.
-func (v *TeamWorkflow) GetKey() string { return v.Key }
+// GetName returns IssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetName() string { return v.Name }
 
-// GetDraftWorkflowState returns TeamWorkflow.DraftWorkflowState, and is useful for accessing the field via an interface.
-func (v *TeamWorkflow) GetDraftWorkflowState() *TeamWorkflowDraftWorkflowState {
-	return v.DraftWorkflowState
+// GetDescription returns IssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetDescription() *string { return v.Description }
+
+// GetColor returns IssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetColor() *string { return v.Color }
+
+// GetParent returns IssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetParent() *IssueLabelParentIssueLabel { return v.Parent }
+
+// GetTeam returns IssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *IssueLabel) GetTeam() *IssueLabelTeam { return v.Team }
+
+type IssueLabelCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the label.
+	Name string `json:"name"`
+	// The description of the label.
+	Description *string `json:"description"`
+	// The color of the label.
+	Color *string `json:"color,omitempty"`
+	// The identifier of the parent label.
+	ParentId *string `json:"parentId"`
+	// The team associated with the label. If not given, the label will be associated with the entire workspace.
+	TeamId *string `json:"teamId"`
 }
 
-// GetStartWorkflowState returns TeamWorkflow.StartWorkflowState, and is useful for accessing the field via an interface.
-func (v *TeamWorkflow) GetStartWorkflowState() *TeamWorkflowStartWorkflowState {
-	return v.StartWorkflowState
+// GetId returns IssueLabelCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *IssueLabelCreateInput) GetId() string { return v.Id }
+
+// GetName returns IssueLabelCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *IssueLabelCreateInput) GetName() string { return v.Name }
+
+// GetDescription returns IssueLabelCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *IssueLabelCreateInput) GetDescription() *string { return v.Description }
+
+// GetColor returns IssueLabelCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *IssueLabelCreateInput) GetColor() *string { return v.Color }
+
+// GetParentId returns IssueLabelCreateInput.ParentId, and is useful for accessing the field via an interface.
+func (v *IssueLabelCreateInput) GetParentId() *string { return v.ParentId }
+
+// GetTeamId returns IssueLabelCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *IssueLabelCreateInput) GetTeamId() *string { return v.TeamId }
+
+// IssueLabelParentIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type IssueLabelParentIssueLabel struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetReviewWorkflowState returns TeamWorkflow.ReviewWorkflowState, and is useful for accessing the field via an interface.
-func (v *TeamWorkflow) GetReviewWorkflowState() *TeamWorkflowReviewWorkflowState {
-	return v.ReviewWorkflowState
+// GetId returns IssueLabelParentIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *IssueLabelParentIssueLabel) GetId() string { return v.Id }
+
+// IssueLabelTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type IssueLabelTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetMergeWorkflowState returns TeamWorkflow.MergeWorkflowState, and is useful for accessing the field via an interface.
-func (v *TeamWorkflow) GetMergeWorkflowState() *TeamWorkflowMergeWorkflowState {
-	return v.MergeWorkflowState
+// GetId returns IssueLabelTeam.Id, and is useful for accessing the field via an interface.
+func (v *IssueLabelTeam) GetId() string { return v.Id }
+
+type IssueLabelUpdateInput struct {
+	// The name of the label.
+	Name string `json:"name,omitempty"`
+	// The description of the label.
+	Description *string `json:"description"`
+	// The identifier of the parent label.
+	ParentId *string `json:"parentId"`
+	// The color of the label.
+	Color *string `json:"color,omitempty"`
 }
 
-// TeamWorkflowDraftWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// GetName returns IssueLabelUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *IssueLabelUpdateInput) GetName() string { return v.Name }
+
+// GetDescription returns IssueLabelUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *IssueLabelUpdateInput) GetDescription() *string { return v.Description }
+
+// GetParentId returns IssueLabelUpdateInput.ParentId, and is useful for accessing the field via an interface.
+func (v *IssueLabelUpdateInput) GetParentId() *string { return v.ParentId }
+
+// GetColor returns IssueLabelUpdateInput.Color, and is useful for accessing the field via an interface.
+func (v *IssueLabelUpdateInput) GetColor() *string { return v.Color }
+
+// IssueRelation includes the GraphQL fields of IssueRelation requested by the fragment IssueRelation.
 // The GraphQL type's documentation follows.
 //
-// A state in a team workflow.
-type TeamWorkflowDraftWorkflowState struct {
+// A relation between two issues.
+type IssueRelation struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
+	// The relationship of the issue with the related issue.
+	Type string `json:"type"`
+	// The issue whose relationship is being described.
+	Issue IssueRelationIssue `json:"issue"`
+	// The related issue.
+	RelatedIssue IssueRelationRelatedIssue `json:"relatedIssue"`
 }
 
-// GetId returns TeamWorkflowDraftWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *TeamWorkflowDraftWorkflowState) GetId() string { return v.Id }
+// GetId returns IssueRelation.Id, and is useful for accessing the field via an interface.
+func (v *IssueRelation) GetId() string { return v.Id }
 
-// TeamWorkflowMergeWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// GetType returns IssueRelation.Type, and is useful for accessing the field via an interface.
+func (v *IssueRelation) GetType() string { return v.Type }
+
+// GetIssue returns IssueRelation.Issue, and is useful for accessing the field via an interface.
+func (v *IssueRelation) GetIssue() IssueRelationIssue { return v.Issue }
+
+// GetRelatedIssue returns IssueRelation.RelatedIssue, and is useful for accessing the field via an interface.
+func (v *IssueRelation) GetRelatedIssue() IssueRelationRelatedIssue { return v.RelatedIssue }
+
+type IssueRelationCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The type of relation of the issue to the related issue.
+	Type IssueRelationType `json:"type"`
+	// The identifier of the issue that is related to another issue.
+	IssueId string `json:"issueId"`
+	// The identifier of the related issue.
+	RelatedIssueId string `json:"relatedIssueId"`
+}
+
+// GetId returns IssueRelationCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *IssueRelationCreateInput) GetId() string { return v.Id }
+
+// GetType returns IssueRelationCreateInput.Type, and is useful for accessing the field via an interface.
+func (v *IssueRelationCreateInput) GetType() IssueRelationType { return v.Type }
+
+// GetIssueId returns IssueRelationCreateInput.IssueId, and is useful for accessing the field via an interface.
+func (v *IssueRelationCreateInput) GetIssueId() string { return v.IssueId }
+
+// GetRelatedIssueId returns IssueRelationCreateInput.RelatedIssueId, and is useful for accessing the field via an interface.
+func (v *IssueRelationCreateInput) GetRelatedIssueId() string { return v.RelatedIssueId }
+
+// IssueRelationIssue includes the requested fields of the GraphQL type Issue.
 // The GraphQL type's documentation follows.
 //
-// A state in a team workflow.
-type TeamWorkflowMergeWorkflowState struct {
+// An issue.
+type IssueRelationIssue struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
 }
 
-// GetId returns TeamWorkflowMergeWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *TeamWorkflowMergeWorkflowState) GetId() string { return v.Id }
+// GetId returns IssueRelationIssue.Id, and is useful for accessing the field via an interface.
+func (v *IssueRelationIssue) GetId() string { return v.Id }
 
-// TeamWorkflowReviewWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// GetIdentifier returns IssueRelationIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *IssueRelationIssue) GetIdentifier() string { return v.Identifier }
+
+// IssueRelationRelatedIssue includes the requested fields of the GraphQL type Issue.
 // The GraphQL type's documentation follows.
 //
-// A state in a team workflow.
-type TeamWorkflowReviewWorkflowState struct {
+// An issue.
+type IssueRelationRelatedIssue struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
 }
 
-// GetId returns TeamWorkflowReviewWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *TeamWorkflowReviewWorkflowState) GetId() string { return v.Id }
+// GetId returns IssueRelationRelatedIssue.Id, and is useful for accessing the field via an interface.
+func (v *IssueRelationRelatedIssue) GetId() string { return v.Id }
 
-// TeamWorkflowStartWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// GetIdentifier returns IssueRelationRelatedIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *IssueRelationRelatedIssue) GetIdentifier() string { return v.Identifier }
+
+// The type of the issue relation.
+type IssueRelationType string
+
+const (
+	IssueRelationTypeBlocks    IssueRelationType = "blocks"
+	IssueRelationTypeDuplicate IssueRelationType = "duplicate"
+	IssueRelationTypeRelated   IssueRelationType = "related"
+)
+
+type IssueRelationUpdateInput struct {
+	// The type of relation of the issue to the related issue.
+	Type string `json:"type"`
+	// The identifier of the issue that is related to another issue.
+	IssueId string `json:"issueId,omitempty"`
+	// The identifier of the related issue.
+	RelatedIssueId string `json:"relatedIssueId,omitempty"`
+}
+
+// GetType returns IssueRelationUpdateInput.Type, and is useful for accessing the field via an interface.
+func (v *IssueRelationUpdateInput) GetType() string { return v.Type }
+
+// GetIssueId returns IssueRelationUpdateInput.IssueId, and is useful for accessing the field via an interface.
+func (v *IssueRelationUpdateInput) GetIssueId() string { return v.IssueId }
+
+// GetRelatedIssueId returns IssueRelationUpdateInput.RelatedIssueId, and is useful for accessing the field via an interface.
+func (v *IssueRelationUpdateInput) GetRelatedIssueId() string { return v.RelatedIssueId }
+
+// IssueStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
 // The GraphQL type's documentation follows.
 //
 // A state in a team workflow.
-type TeamWorkflowStartWorkflowState struct {
+type IssueStateWorkflowState struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
 }
 
-// GetId returns TeamWorkflowStartWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *TeamWorkflowStartWorkflowState) GetId() string { return v.Id }
+// GetId returns IssueStateWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *IssueStateWorkflowState) GetId() string { return v.Id }
 
-// WorkflowState includes the GraphQL fields of WorkflowState requested by the fragment WorkflowState.
+// IssueSummary includes the GraphQL fields of Issue requested by the fragment IssueSummary.
 // The GraphQL type's documentation follows.
 //
-// A state in a team workflow.
-type WorkflowState struct {
+// An issue.
+type IssueSummary struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
-	// The state's name.
-	Name string `json:"name"`
-	// The state's UI color as a HEX string.
-	Color string `json:"color"`
-	// Description of the state.
-	Description *string `json:"description"`
-	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
-	Type string `json:"type"`
-	// The position of the state in the team flow.
-	Position float64 `json:"position"`
-	// The team to which this state belongs to.
-	Team WorkflowStateTeam `json:"team"`
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+	// The issue's title.
+	Title string `json:"title"`
+	// The priority of the issue. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+	Priority float64 `json:"priority"`
+	// Issue URL.
+	Url string `json:"url"`
+	// The time at which the entity was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// The team that the issue is associated with.
+	Team IssueSummaryTeam `json:"team"`
+	// The workflow state that the issue is associated with.
+	State IssueSummaryStateWorkflowState `json:"state"`
+	// The user to whom the issue is assigned to.
+	Assignee *IssueSummaryAssigneeUser `json:"assignee"`
+	// Labels associated with this issue.
+	Labels IssueSummaryLabelsIssueLabelConnection `json:"labels"`
 }
 
-// GetId returns WorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *WorkflowState) GetId() string { return v.Id }
+// GetId returns IssueSummary.Id, and is useful for accessing the field via an interface.
+func (v *IssueSummary) GetId() string { return v.Id }
 
-// GetName returns WorkflowState.Name, and is useful for accessing the field via an interface.
-func (v *WorkflowState) GetName() string { return v.Name }
+// GetIdentifier returns IssueSummary.Identifier, and is useful for accessing the field via an interface.
+func (v *IssueSummary) GetIdentifier() string { return v.Identifier }
 
-// GetColor returns WorkflowState.Color, and is useful for accessing the field via an interface.
-func (v *WorkflowState) GetColor() string { return v.Color }
+// GetTitle returns IssueSummary.Title, and is useful for accessing the field via an interface.
+func (v *IssueSummary) GetTitle() string { return v.Title }
 
-// GetDescription returns WorkflowState.Description, and is useful for accessing the field via an interface.
-func (v *WorkflowState) GetDescription() *string { return v.Description }
+// GetPriority returns IssueSummary.Priority, and is useful for accessing the field via an interface.
+func (v *IssueSummary) GetPriority() float64 { return v.Priority }
 
-// GetType returns WorkflowState.Type, and is useful for accessing the field via an interface.
-func (v *WorkflowState) GetType() string { return v.Type }
-
-// GetPosition returns WorkflowState.Position, and is useful for accessing the field via an interface.
-func (v *WorkflowState) GetPosition() float64 { return v.Position }
-
-// GetTeam returns WorkflowState.Team, and is useful for accessing the field via an interface.
-func (v *WorkflowState) GetTeam() WorkflowStateTeam { return v.Team }
-
-type WorkflowStateCreateInput struct {
-	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
-	Id string `json:"id,omitempty"`
-	// The workflow type.
-	Type string `json:"type"`
-	// The name of the state.
-	Name string `json:"name"`
-	// The color of the state.
-	Color string `json:"color"`
-	// The description of the state.
-	Description *string `json:"description"`
-	// The position of the state.
-	Position float64 `json:"position"`
-	// The team associated with the state.
-	TeamId string `json:"teamId"`
-}
+// GetUrl returns IssueSummary.Url, and is useful for accessing the field via an interface.
+func (v *IssueSummary) GetUrl() string { return v.Url }
 
-// GetId returns WorkflowStateCreateInput.Id, and is useful for accessing the field via an interface.
-func (v *WorkflowStateCreateInput) GetId() string { return v.Id }
-
-// GetType returns WorkflowStateCreateInput.Type, and is useful for accessing the field via an interface.
-func (v *WorkflowStateCreateInput) GetType() string { return v.Type }
-
-// GetName returns WorkflowStateCreateInput.Name, and is useful for accessing the field via an interface.
-func (v *WorkflowStateCreateInput) GetName() string { return v.Name }
+// GetCreatedAt returns IssueSummary.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IssueSummary) GetCreatedAt() time.Time { return v.CreatedAt }
 
-// GetColor returns WorkflowStateCreateInput.Color, and is useful for accessing the field via an interface.
-func (v *WorkflowStateCreateInput) GetColor() string { return v.Color }
+// GetTeam returns IssueSummary.Team, and is useful for accessing the field via an interface.
+func (v *IssueSummary) GetTeam() IssueSummaryTeam { return v.Team }
 
-// GetDescription returns WorkflowStateCreateInput.Description, and is useful for accessing the field via an interface.
-func (v *WorkflowStateCreateInput) GetDescription() *string { return v.Description }
+// GetState returns IssueSummary.State, and is useful for accessing the field via an interface.
+func (v *IssueSummary) GetState() IssueSummaryStateWorkflowState { return v.State }
 
-// GetPosition returns WorkflowStateCreateInput.Position, and is useful for accessing the field via an interface.
-func (v *WorkflowStateCreateInput) GetPosition() float64 { return v.Position }
+// GetAssignee returns IssueSummary.Assignee, and is useful for accessing the field via an interface.
+func (v *IssueSummary) GetAssignee() *IssueSummaryAssigneeUser { return v.Assignee }
 
-// GetTeamId returns WorkflowStateCreateInput.TeamId, and is useful for accessing the field via an interface.
-func (v *WorkflowStateCreateInput) GetTeamId() string { return v.TeamId }
+// GetLabels returns IssueSummary.Labels, and is useful for accessing the field via an interface.
+func (v *IssueSummary) GetLabels() IssueSummaryLabelsIssueLabelConnection { return v.Labels }
 
-// WorkflowStateTeam includes the requested fields of the GraphQL type Team.
+// IssueSummaryAssigneeUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
-// An organizational unit that contains issues.
-type WorkflowStateTeam struct {
+// A user that has access to the the resources of an organization.
+type IssueSummaryAssigneeUser struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
 }
 
-// GetId returns WorkflowStateTeam.Id, and is useful for accessing the field via an interface.
-func (v *WorkflowStateTeam) GetId() string { return v.Id }
-
-type WorkflowStateUpdateInput struct {
-	// The name of the state.
-	Name string `json:"name,omitempty"`
-	// The color of the state.
-	Color string `json:"color,omitempty"`
-	// The description of the state.
-	Description *string `json:"description"`
-	// The position of the state.
-	Position float64 `json:"position"`
-}
-
-// GetName returns WorkflowStateUpdateInput.Name, and is useful for accessing the field via an interface.
-func (v *WorkflowStateUpdateInput) GetName() string { return v.Name }
-
-// GetColor returns WorkflowStateUpdateInput.Color, and is useful for accessing the field via an interface.
-func (v *WorkflowStateUpdateInput) GetColor() string { return v.Color }
-
-// GetDescription returns WorkflowStateUpdateInput.Description, and is useful for accessing the field via an interface.
-func (v *WorkflowStateUpdateInput) GetDescription() *string { return v.Description }
-
-// GetPosition returns WorkflowStateUpdateInput.Position, and is useful for accessing the field via an interface.
-func (v *WorkflowStateUpdateInput) GetPosition() float64 { return v.Position }
+// GetId returns IssueSummaryAssigneeUser.Id, and is useful for accessing the field via an interface.
+func (v *IssueSummaryAssigneeUser) GetId() string { return v.Id }
 
-// __createLabelInput is used internally by genqlient
-type __createLabelInput struct {
-	Input IssueLabelCreateInput `json:"input"`
+// IssueSummaryLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type IssueSummaryLabelsIssueLabelConnection struct {
+	Nodes []IssueSummaryLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
 }
 
-// GetInput returns __createLabelInput.Input, and is useful for accessing the field via an interface.
-func (v *__createLabelInput) GetInput() IssueLabelCreateInput { return v.Input }
-
-// __createTeamInput is used internally by genqlient
-type __createTeamInput struct {
-	Input TeamCreateInput `json:"input"`
+// GetNodes returns IssueSummaryLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *IssueSummaryLabelsIssueLabelConnection) GetNodes() []IssueSummaryLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
 }
 
-// GetInput returns __createTeamInput.Input, and is useful for accessing the field via an interface.
-func (v *__createTeamInput) GetInput() TeamCreateInput { return v.Input }
-
-// __createWorkflowStateInput is used internally by genqlient
-type __createWorkflowStateInput struct {
-	Input WorkflowStateCreateInput `json:"input"`
+// IssueSummaryLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type IssueSummaryLabelsIssueLabelConnectionNodesIssueLabel struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetInput returns __createWorkflowStateInput.Input, and is useful for accessing the field via an interface.
-func (v *__createWorkflowStateInput) GetInput() WorkflowStateCreateInput { return v.Input }
+// GetId returns IssueSummaryLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *IssueSummaryLabelsIssueLabelConnectionNodesIssueLabel) GetId() string { return v.Id }
 
-// __deleteLabelInput is used internally by genqlient
-type __deleteLabelInput struct {
+// IssueSummaryStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type IssueSummaryStateWorkflowState struct {
+	// The unique identifier of the entity.
 	Id string `json:"id"`
+	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
+	Type string `json:"type"`
 }
 
-// GetId returns __deleteLabelInput.Id, and is useful for accessing the field via an interface.
-func (v *__deleteLabelInput) GetId() string { return v.Id }
-
-// __deleteTeamInput is used internally by genqlient
-type __deleteTeamInput struct {
-	Key string `json:"key"`
-}
+// GetId returns IssueSummaryStateWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *IssueSummaryStateWorkflowState) GetId() string { return v.Id }
 
-// GetKey returns __deleteTeamInput.Key, and is useful for accessing the field via an interface.
-func (v *__deleteTeamInput) GetKey() string { return v.Key }
+// GetType returns IssueSummaryStateWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *IssueSummaryStateWorkflowState) GetType() string { return v.Type }
 
-// __deleteWorkflowStateInput is used internally by genqlient
-type __deleteWorkflowStateInput struct {
+// IssueSummaryTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type IssueSummaryTeam struct {
+	// The unique identifier of the entity.
 	Id string `json:"id"`
 }
 
-// GetId returns __deleteWorkflowStateInput.Id, and is useful for accessing the field via an interface.
-func (v *__deleteWorkflowStateInput) GetId() string { return v.Id }
-
-// __findTeamLabelInput is used internally by genqlient
-type __findTeamLabelInput struct {
-	Name string `json:"name"`
-	Key  string `json:"key"`
-}
-
-// GetName returns __findTeamLabelInput.Name, and is useful for accessing the field via an interface.
-func (v *__findTeamLabelInput) GetName() string { return v.Name }
-
-// GetKey returns __findTeamLabelInput.Key, and is useful for accessing the field via an interface.
-func (v *__findTeamLabelInput) GetKey() string { return v.Key }
+// GetId returns IssueSummaryTeam.Id, and is useful for accessing the field via an interface.
+func (v *IssueSummaryTeam) GetId() string { return v.Id }
 
-// __findWorkflowStateInput is used internally by genqlient
-type __findWorkflowStateInput struct {
-	Name string `json:"name"`
-	Key  string `json:"key"`
+// IssueTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type IssueTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetName returns __findWorkflowStateInput.Name, and is useful for accessing the field via an interface.
-func (v *__findWorkflowStateInput) GetName() string { return v.Name }
+// GetId returns IssueTeam.Id, and is useful for accessing the field via an interface.
+func (v *IssueTeam) GetId() string { return v.Id }
 
-// GetKey returns __findWorkflowStateInput.Key, and is useful for accessing the field via an interface.
-func (v *__findWorkflowStateInput) GetKey() string { return v.Key }
+type IssueUpdateInput struct {
+	// The issue title.
+	Title string `json:"title,omitempty"`
+	// The issue description in markdown format.
+	Description *string `json:"description"`
+	// The issue description as a Prosemirror document.
+	DescriptionData json.RawMessage `json:"descriptionData,omitempty"`
+	// The identifier of the user to assign the issue to.
+	AssigneeId *string `json:"assigneeId,omitempty"`
+	// The identifier of the parent issue.
+	ParentId *string `json:"parentId,omitempty"`
+	// The priority of the issue. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+	Priority int `json:"priority"`
+	// The estimated complexity of the issue.
+	Estimate *int `json:"estimate,omitempty"`
+	// The identifiers of the users subscribing to this ticket.
+	SubscriberIds []string `json:"subscriberIds,omitempty"`
+	// The identifiers of the issue labels associated with this ticket.
+	LabelIds []string `json:"labelIds,omitempty"`
+	// The identifier of the team associated with the issue.
+	TeamId string `json:"teamId,omitempty"`
+	// The cycle associated with the issue.
+	CycleId *string `json:"cycleId,omitempty"`
+	// The project associated with the issue.
+	ProjectId *string `json:"projectId,omitempty"`
+	// The project milestone associated with the issue.
+	ProjectMilestoneId *string `json:"projectMilestoneId,omitempty"`
+	// The ID of the last template applied to the issue.
+	LastAppliedTemplateId string `json:"lastAppliedTemplateId,omitempty"`
+	// The team state of the issue.
+	StateId *string `json:"stateId,omitempty"`
+	// The position of the issue in its column on the board view.
+	BoardOrder *float64 `json:"boardOrder,omitempty"`
+	// The position of the issue related to other issues.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// [ALPHA] The position of the issue related to other issues, when ordered by priority.
+	PrioritySortOrder *float64 `json:"prioritySortOrder,omitempty"`
+	// The position of the issue in parent's sub-issue list.
+	SubIssueSortOrder *float64 `json:"subIssueSortOrder,omitempty"`
+	// The date at which the issue is due.
+	DueDate *string `json:"dueDate,omitempty"`
+	// Whether the issue has been trashed.
+	Trashed *bool `json:"trashed,omitempty"`
+	// [Internal] The timestamp at which an issue will be considered in breach of SLA.
+	SlaBreachesAt *time.Time `json:"slaBreachesAt,omitempty"`
+	// The time until an issue will be snoozed in Triage view.
+	SnoozedUntilAt *time.Time `json:"snoozedUntilAt,omitempty"`
+	// The identifier of the user who snoozed the issue.
+	SnoozedById *string `json:"snoozedById,omitempty"`
+}
+
+// GetTitle returns IssueUpdateInput.Title, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetTitle() string { return v.Title }
+
+// GetDescription returns IssueUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetDescription() *string { return v.Description }
+
+// GetDescriptionData returns IssueUpdateInput.DescriptionData, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetDescriptionData() json.RawMessage { return v.DescriptionData }
+
+// GetAssigneeId returns IssueUpdateInput.AssigneeId, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetAssigneeId() *string { return v.AssigneeId }
+
+// GetParentId returns IssueUpdateInput.ParentId, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetParentId() *string { return v.ParentId }
+
+// GetPriority returns IssueUpdateInput.Priority, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetPriority() int { return v.Priority }
+
+// GetEstimate returns IssueUpdateInput.Estimate, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetEstimate() *int { return v.Estimate }
+
+// GetSubscriberIds returns IssueUpdateInput.SubscriberIds, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetSubscriberIds() []string { return v.SubscriberIds }
+
+// GetLabelIds returns IssueUpdateInput.LabelIds, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetLabelIds() []string { return v.LabelIds }
+
+// GetTeamId returns IssueUpdateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetTeamId() string { return v.TeamId }
+
+// GetCycleId returns IssueUpdateInput.CycleId, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetCycleId() *string { return v.CycleId }
+
+// GetProjectId returns IssueUpdateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetProjectId() *string { return v.ProjectId }
+
+// GetProjectMilestoneId returns IssueUpdateInput.ProjectMilestoneId, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetProjectMilestoneId() *string { return v.ProjectMilestoneId }
+
+// GetLastAppliedTemplateId returns IssueUpdateInput.LastAppliedTemplateId, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetLastAppliedTemplateId() string { return v.LastAppliedTemplateId }
+
+// GetStateId returns IssueUpdateInput.StateId, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetStateId() *string { return v.StateId }
+
+// GetBoardOrder returns IssueUpdateInput.BoardOrder, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetBoardOrder() *float64 { return v.BoardOrder }
+
+// GetSortOrder returns IssueUpdateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// GetPrioritySortOrder returns IssueUpdateInput.PrioritySortOrder, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetPrioritySortOrder() *float64 { return v.PrioritySortOrder }
+
+// GetSubIssueSortOrder returns IssueUpdateInput.SubIssueSortOrder, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetSubIssueSortOrder() *float64 { return v.SubIssueSortOrder }
+
+// GetDueDate returns IssueUpdateInput.DueDate, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetDueDate() *string { return v.DueDate }
+
+// GetTrashed returns IssueUpdateInput.Trashed, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetTrashed() *bool { return v.Trashed }
+
+// GetSlaBreachesAt returns IssueUpdateInput.SlaBreachesAt, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetSlaBreachesAt() *time.Time { return v.SlaBreachesAt }
 
-// __findWorkspaceLabelInput is used internally by genqlient
-type __findWorkspaceLabelInput struct {
-	Name string `json:"name"`
-}
+// GetSnoozedUntilAt returns IssueUpdateInput.SnoozedUntilAt, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetSnoozedUntilAt() *time.Time { return v.SnoozedUntilAt }
 
-// GetName returns __findWorkspaceLabelInput.Name, and is useful for accessing the field via an interface.
-func (v *__findWorkspaceLabelInput) GetName() string { return v.Name }
+// GetSnoozedById returns IssueUpdateInput.SnoozedById, and is useful for accessing the field via an interface.
+func (v *IssueUpdateInput) GetSnoozedById() *string { return v.SnoozedById }
 
-// __getLabelInput is used internally by genqlient
-type __getLabelInput struct {
+// Organization includes the GraphQL fields of Organization requested by the fragment Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization. Organizations are root-level objects that contain user accounts and teams.
+type Organization struct {
+	// The unique identifier of the entity.
 	Id string `json:"id"`
+	// The organization's name.
+	Name string `json:"name"`
+	// The organization's unique URL key.
+	UrlKey string `json:"urlKey"`
+	// The organization's logo URL.
+	LogoUrl *string `json:"logoUrl"`
+	// How git branches are formatted. If null, default formatting will be used.
+	GitBranchFormat *string `json:"gitBranchFormat"`
+	// Whether member users are allowed to send invites.
+	AllowMembersToInvite bool `json:"allowMembersToInvite"`
+	// Whether the organization is using a roadmap.
+	RoadmapEnabled bool `json:"roadmapEnabled"`
+	// Whether the Git integration linkback messages should be sent to private repositories.
+	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
+	// Whether the Git integration linkback messages should be sent to public repositories.
+	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
 }
 
-// GetId returns __getLabelInput.Id, and is useful for accessing the field via an interface.
-func (v *__getLabelInput) GetId() string { return v.Id }
-
-// __getTeamInput is used internally by genqlient
-type __getTeamInput struct {
-	Key string `json:"key"`
-}
+// GetId returns Organization.Id, and is useful for accessing the field via an interface.
+func (v *Organization) GetId() string { return v.Id }
 
-// GetKey returns __getTeamInput.Key, and is useful for accessing the field via an interface.
-func (v *__getTeamInput) GetKey() string { return v.Key }
+// GetName returns Organization.Name, and is useful for accessing the field via an interface.
+func (v *Organization) GetName() string { return v.Name }
 
-// __getTeamWorkflowInput is used internally by genqlient
-type __getTeamWorkflowInput struct {
-	Key string `json:"key"`
-}
+// GetUrlKey returns Organization.UrlKey, and is useful for accessing the field via an interface.
+func (v *Organization) GetUrlKey() string { return v.UrlKey }
 
-// GetKey returns __getTeamWorkflowInput.Key, and is useful for accessing the field via an interface.
-func (v *__getTeamWorkflowInput) GetKey() string { return v.Key }
+// GetLogoUrl returns Organization.LogoUrl, and is useful for accessing the field via an interface.
+func (v *Organization) GetLogoUrl() *string { return v.LogoUrl }
 
-// __getTeamWorkflowStatesInput is used internally by genqlient
-type __getTeamWorkflowStatesInput struct {
-	Key string `json:"key"`
-}
+// GetGitBranchFormat returns Organization.GitBranchFormat, and is useful for accessing the field via an interface.
+func (v *Organization) GetGitBranchFormat() *string { return v.GitBranchFormat }
 
-// GetKey returns __getTeamWorkflowStatesInput.Key, and is useful for accessing the field via an interface.
-func (v *__getTeamWorkflowStatesInput) GetKey() string { return v.Key }
+// GetAllowMembersToInvite returns Organization.AllowMembersToInvite, and is useful for accessing the field via an interface.
+func (v *Organization) GetAllowMembersToInvite() bool { return v.AllowMembersToInvite }
 
-// __getWorkflowStateInput is used internally by genqlient
-type __getWorkflowStateInput struct {
-	Id string `json:"id"`
-}
+// GetRoadmapEnabled returns Organization.RoadmapEnabled, and is useful for accessing the field via an interface.
+func (v *Organization) GetRoadmapEnabled() bool { return v.RoadmapEnabled }
 
-// GetId returns __getWorkflowStateInput.Id, and is useful for accessing the field via an interface.
-func (v *__getWorkflowStateInput) GetId() string { return v.Id }
+// GetGitLinkbackMessagesEnabled returns Organization.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *Organization) GetGitLinkbackMessagesEnabled() bool { return v.GitLinkbackMessagesEnabled }
 
-// __updateLabelInput is used internally by genqlient
-type __updateLabelInput struct {
-	Input IssueLabelUpdateInput `json:"input"`
-	Id    string                `json:"id"`
+// GetGitPublicLinkbackMessagesEnabled returns Organization.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *Organization) GetGitPublicLinkbackMessagesEnabled() bool {
+	return v.GitPublicLinkbackMessagesEnabled
 }
 
-// GetInput returns __updateLabelInput.Input, and is useful for accessing the field via an interface.
-func (v *__updateLabelInput) GetInput() IssueLabelUpdateInput { return v.Input }
-
-// GetId returns __updateLabelInput.Id, and is useful for accessing the field via an interface.
-func (v *__updateLabelInput) GetId() string { return v.Id }
-
-// __updateTeamInput is used internally by genqlient
-type __updateTeamInput struct {
-	Input TeamUpdateInput `json:"input"`
-	Id    string          `json:"id"`
+// [INTERNAL] Organization IP restriction configuration.
+type OrganizationIpRestrictionInput struct {
+	// IP range in CIDR format.
+	Range string `json:"range"`
+	// Restriction type.
+	Type string `json:"type"`
+	// Optional restriction description.
+	Description string `json:"description"`
+	// Whether the restriction is enabled.
+	Enabled bool `json:"enabled"`
 }
 
-// GetInput returns __updateTeamInput.Input, and is useful for accessing the field via an interface.
-func (v *__updateTeamInput) GetInput() TeamUpdateInput { return v.Input }
-
-// GetId returns __updateTeamInput.Id, and is useful for accessing the field via an interface.
-func (v *__updateTeamInput) GetId() string { return v.Id }
+// GetRange returns OrganizationIpRestrictionInput.Range, and is useful for accessing the field via an interface.
+func (v *OrganizationIpRestrictionInput) GetRange() string { return v.Range }
 
-// __updateTeamWorkflowInput is used internally by genqlient
-type __updateTeamWorkflowInput struct {
-	Id     string  `json:"id"`
-	Draft  *string `json:"draft"`
-	Start  *string `json:"start"`
-	Review *string `json:"review"`
-	Merge  *string `json:"merge"`
-}
+// GetType returns OrganizationIpRestrictionInput.Type, and is useful for accessing the field via an interface.
+func (v *OrganizationIpRestrictionInput) GetType() string { return v.Type }
 
-// GetId returns __updateTeamWorkflowInput.Id, and is useful for accessing the field via an interface.
-func (v *__updateTeamWorkflowInput) GetId() string { return v.Id }
+// GetDescription returns OrganizationIpRestrictionInput.Description, and is useful for accessing the field via an interface.
+func (v *OrganizationIpRestrictionInput) GetDescription() string { return v.Description }
 
-// GetDraft returns __updateTeamWorkflowInput.Draft, and is useful for accessing the field via an interface.
-func (v *__updateTeamWorkflowInput) GetDraft() *string { return v.Draft }
+// GetEnabled returns OrganizationIpRestrictionInput.Enabled, and is useful for accessing the field via an interface.
+func (v *OrganizationIpRestrictionInput) GetEnabled() bool { return v.Enabled }
 
-// GetStart returns __updateTeamWorkflowInput.Start, and is useful for accessing the field via an interface.
-func (v *__updateTeamWorkflowInput) GetStart() *string { return v.Start }
-
-// GetReview returns __updateTeamWorkflowInput.Review, and is useful for accessing the field via an interface.
-func (v *__updateTeamWorkflowInput) GetReview() *string { return v.Review }
-
-// GetMerge returns __updateTeamWorkflowInput.Merge, and is useful for accessing the field via an interface.
-func (v *__updateTeamWorkflowInput) GetMerge() *string { return v.Merge }
-
-// __updateWorkflowStateInput is used internally by genqlient
-type __updateWorkflowStateInput struct {
-	Input WorkflowStateUpdateInput `json:"input"`
-	Id    string                   `json:"id"`
+type OrganizationUpdateInput struct {
+	// The name of the organization.
+	Name string `json:"name,omitempty"`
+	// The logo of the organization.
+	LogoUrl string `json:"logoUrl,omitempty"`
+	// The URL key of the organization.
+	UrlKey string `json:"urlKey,omitempty"`
+	// How git branches are formatted. If null, default formatting will be used.
+	GitBranchFormat string `json:"gitBranchFormat,omitempty"`
+	// Whether the Git integration linkback messages should be sent for private repositories.
+	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
+	// Whether the Git integration linkback messages should be sent for public repositories.
+	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
+	// Whether the organization is using roadmap.
+	RoadmapEnabled bool `json:"roadmapEnabled"`
+	// The n-weekly frequency at which to prompt for project updates.
+	ProjectUpdateReminderFrequencyInWeeks float64 `json:"projectUpdateReminderFrequencyInWeeks,omitempty"`
+	// The day at which project updates are sent.
+	ProjectUpdateRemindersDay Day `json:"projectUpdateRemindersDay,omitempty"`
+	// The hour at which project updates are sent.
+	ProjectUpdateRemindersHour float64 `json:"projectUpdateRemindersHour,omitempty"`
+	// The month at which the fiscal year starts.
+	FiscalYearStartMonth float64 `json:"fiscalYearStartMonth"`
+	// Whether the organization has opted for reduced customer support attachment information.
+	ReducedPersonalInformation bool `json:"reducedPersonalInformation,omitempty"`
+	// Whether the organization has opted for having to approve all OAuth applications for install.
+	OauthAppReview bool `json:"oauthAppReview,omitempty"`
+	// List of services that are allowed to be used for login.
+	AllowedAuthServices []string `json:"allowedAuthServices,omitempty"`
+	// Internal. Whether SLAs have been enabled for the organization.
+	SlaEnabled bool `json:"slaEnabled,omitempty"`
+	// Which day count to use for SLA calculation.
+	SlaDayCount SLADayCountType `json:"slaDayCount,omitempty"`
+	// Whether member users are allowed to send invites.
+	AllowMembersToInvite bool `json:"allowMembersToInvite"`
+	// IP restriction configurations controlling allowed access the workspace.
+	IpRestrictions []OrganizationIpRestrictionInput `json:"ipRestrictions"`
+	// [ALPHA] Theme settings for the organization.
+	ThemeSettings map[string]interface{} `json:"themeSettings"`
 }
 
-// GetInput returns __updateWorkflowStateInput.Input, and is useful for accessing the field via an interface.
-func (v *__updateWorkflowStateInput) GetInput() WorkflowStateUpdateInput { return v.Input }
+// GetName returns OrganizationUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetName() string { return v.Name }
 
-// GetId returns __updateWorkflowStateInput.Id, and is useful for accessing the field via an interface.
-func (v *__updateWorkflowStateInput) GetId() string { return v.Id }
+// GetLogoUrl returns OrganizationUpdateInput.LogoUrl, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetLogoUrl() string { return v.LogoUrl }
 
-// __updateWorkspaceSettingsInput is used internally by genqlient
-type __updateWorkspaceSettingsInput struct {
-	Input OrganizationUpdateInput `json:"input"`
-}
+// GetUrlKey returns OrganizationUpdateInput.UrlKey, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetUrlKey() string { return v.UrlKey }
 
-// GetInput returns __updateWorkspaceSettingsInput.Input, and is useful for accessing the field via an interface.
-func (v *__updateWorkspaceSettingsInput) GetInput() OrganizationUpdateInput { return v.Input }
+// GetGitBranchFormat returns OrganizationUpdateInput.GitBranchFormat, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetGitBranchFormat() string { return v.GitBranchFormat }
 
-// createLabelIssueLabelCreateIssueLabelPayload includes the requested fields of the GraphQL type IssueLabelPayload.
-type createLabelIssueLabelCreateIssueLabelPayload struct {
-	// The label that was created or updated.
-	IssueLabel createLabelIssueLabelCreateIssueLabelPayloadIssueLabel `json:"issueLabel"`
+// GetGitLinkbackMessagesEnabled returns OrganizationUpdateInput.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetGitLinkbackMessagesEnabled() bool {
+	return v.GitLinkbackMessagesEnabled
 }
 
-// GetIssueLabel returns createLabelIssueLabelCreateIssueLabelPayload.IssueLabel, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayload) GetIssueLabel() createLabelIssueLabelCreateIssueLabelPayloadIssueLabel {
-	return v.IssueLabel
+// GetGitPublicLinkbackMessagesEnabled returns OrganizationUpdateInput.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetGitPublicLinkbackMessagesEnabled() bool {
+	return v.GitPublicLinkbackMessagesEnabled
 }
 
-// createLabelIssueLabelCreateIssueLabelPayloadIssueLabel includes the requested fields of the GraphQL type IssueLabel.
-// The GraphQL type's documentation follows.
-//
-// Labels that can be associated with issues.
-type createLabelIssueLabelCreateIssueLabelPayloadIssueLabel struct {
-	IssueLabel `json:"-"`
-}
+// GetRoadmapEnabled returns OrganizationUpdateInput.RoadmapEnabled, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetRoadmapEnabled() bool { return v.RoadmapEnabled }
 
-// GetId returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetId() string {
-	return v.IssueLabel.Id
+// GetProjectUpdateReminderFrequencyInWeeks returns OrganizationUpdateInput.ProjectUpdateReminderFrequencyInWeeks, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetProjectUpdateReminderFrequencyInWeeks() float64 {
+	return v.ProjectUpdateReminderFrequencyInWeeks
 }
 
-// GetName returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Name, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetName() string {
-	return v.IssueLabel.Name
+// GetProjectUpdateRemindersDay returns OrganizationUpdateInput.ProjectUpdateRemindersDay, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetProjectUpdateRemindersDay() Day {
+	return v.ProjectUpdateRemindersDay
 }
 
-// GetDescription returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Description, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetDescription() *string {
-	return v.IssueLabel.Description
+// GetProjectUpdateRemindersHour returns OrganizationUpdateInput.ProjectUpdateRemindersHour, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetProjectUpdateRemindersHour() float64 {
+	return v.ProjectUpdateRemindersHour
 }
 
-// GetColor returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Color, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetColor() *string {
-	return v.IssueLabel.Color
-}
+// GetFiscalYearStartMonth returns OrganizationUpdateInput.FiscalYearStartMonth, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetFiscalYearStartMonth() float64 { return v.FiscalYearStartMonth }
 
-// GetParent returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Parent, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetParent() *IssueLabelParentIssueLabel {
-	return v.IssueLabel.Parent
+// GetReducedPersonalInformation returns OrganizationUpdateInput.ReducedPersonalInformation, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetReducedPersonalInformation() bool {
+	return v.ReducedPersonalInformation
 }
 
-// GetTeam returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Team, and is useful for accessing the field via an interface.
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetTeam() *IssueLabelTeam {
-	return v.IssueLabel.Team
-}
+// GetOauthAppReview returns OrganizationUpdateInput.OauthAppReview, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetOauthAppReview() bool { return v.OauthAppReview }
 
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) UnmarshalJSON(b []byte) error {
+// GetAllowedAuthServices returns OrganizationUpdateInput.AllowedAuthServices, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetAllowedAuthServices() []string { return v.AllowedAuthServices }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetSlaEnabled returns OrganizationUpdateInput.SlaEnabled, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetSlaEnabled() bool { return v.SlaEnabled }
 
-	var firstPass struct {
-		*createLabelIssueLabelCreateIssueLabelPayloadIssueLabel
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.createLabelIssueLabelCreateIssueLabelPayloadIssueLabel = v
+// GetSlaDayCount returns OrganizationUpdateInput.SlaDayCount, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetSlaDayCount() SLADayCountType { return v.SlaDayCount }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetAllowMembersToInvite returns OrganizationUpdateInput.AllowMembersToInvite, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetAllowMembersToInvite() bool { return v.AllowMembersToInvite }
 
-	err = json.Unmarshal(
-		b, &v.IssueLabel)
-	if err != nil {
-		return err
-	}
-	return nil
+// GetIpRestrictions returns OrganizationUpdateInput.IpRestrictions, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetIpRestrictions() []OrganizationIpRestrictionInput {
+	return v.IpRestrictions
 }
 
-type __premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel struct {
-	Id string `json:"id"`
+// GetThemeSettings returns OrganizationUpdateInput.ThemeSettings, and is useful for accessing the field via an interface.
+func (v *OrganizationUpdateInput) GetThemeSettings() map[string]interface{} { return v.ThemeSettings }
 
+// Project includes the GraphQL fields of Project requested by the fragment Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type Project struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The project's name.
 	Name string `json:"name"`
+	// The project's description.
+	Description string `json:"description"`
+	// The icon of the project.
+	Icon *string `json:"icon"`
+	// The project's color.
+	Color string `json:"color"`
+	// The priority of the project. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+	Priority int `json:"priority"`
+	// The estimated start date of the project.
+	StartDate *string `json:"startDate"`
+	// The estimated completion date of the project.
+	TargetDate *string `json:"targetDate"`
+	// The status that the project is associated with.
+	Status ProjectStatus `json:"status"`
+	// The project lead.
+	Lead *ProjectLeadUser `json:"lead"`
+	// Teams associated with this project.
+	Teams ProjectTeamsTeamConnection `json:"teams"`
+}
 
-	Description *string `json:"description"`
+// GetId returns Project.Id, and is useful for accessing the field via an interface.
+func (v *Project) GetId() string { return v.Id }
 
-	Color *string `json:"color"`
+// GetName returns Project.Name, and is useful for accessing the field via an interface.
+func (v *Project) GetName() string { return v.Name }
 
-	Parent *IssueLabelParentIssueLabel `json:"parent"`
+// GetDescription returns Project.Description, and is useful for accessing the field via an interface.
+func (v *Project) GetDescription() string { return v.Description }
 
-	Team *IssueLabelTeam `json:"team"`
-}
+// GetIcon returns Project.Icon, and is useful for accessing the field via an interface.
+func (v *Project) GetIcon() *string { return v.Icon }
 
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+// GetColor returns Project.Color, and is useful for accessing the field via an interface.
+func (v *Project) GetColor() string { return v.Color }
 
-func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) __premarshalJSON() (*__premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel, error) {
-	var retval __premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel
+// GetPriority returns Project.Priority, and is useful for accessing the field via an interface.
+func (v *Project) GetPriority() int { return v.Priority }
 
-	retval.Id = v.IssueLabel.Id
-	retval.Name = v.IssueLabel.Name
-	retval.Description = v.IssueLabel.Description
-	retval.Color = v.IssueLabel.Color
-	retval.Parent = v.IssueLabel.Parent
-	retval.Team = v.IssueLabel.Team
-	return &retval, nil
-}
+// GetStartDate returns Project.StartDate, and is useful for accessing the field via an interface.
+func (v *Project) GetStartDate() *string { return v.StartDate }
 
-// createLabelResponse is returned by createLabel on success.
-type createLabelResponse struct {
-	// Creates a new label.
-	IssueLabelCreate createLabelIssueLabelCreateIssueLabelPayload `json:"issueLabelCreate"`
-}
+// GetTargetDate returns Project.TargetDate, and is useful for accessing the field via an interface.
+func (v *Project) GetTargetDate() *string { return v.TargetDate }
 
-// GetIssueLabelCreate returns createLabelResponse.IssueLabelCreate, and is useful for accessing the field via an interface.
-func (v *createLabelResponse) GetIssueLabelCreate() createLabelIssueLabelCreateIssueLabelPayload {
-	return v.IssueLabelCreate
-}
+// GetStatus returns Project.Status, and is useful for accessing the field via an interface.
+func (v *Project) GetStatus() ProjectStatus { return v.Status }
 
-// createTeamResponse is returned by createTeam on success.
-type createTeamResponse struct {
-	// Creates a new team. The user who creates the team will automatically be added as a member to the newly created team.
-	TeamCreate createTeamTeamCreateTeamPayload `json:"teamCreate"`
-}
+// GetLead returns Project.Lead, and is useful for accessing the field via an interface.
+func (v *Project) GetLead() *ProjectLeadUser { return v.Lead }
 
-// GetTeamCreate returns createTeamResponse.TeamCreate, and is useful for accessing the field via an interface.
-func (v *createTeamResponse) GetTeamCreate() createTeamTeamCreateTeamPayload { return v.TeamCreate }
+// GetTeams returns Project.Teams, and is useful for accessing the field via an interface.
+func (v *Project) GetTeams() ProjectTeamsTeamConnection { return v.Teams }
 
-// createTeamTeamCreateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
-type createTeamTeamCreateTeamPayload struct {
-	// The team that was created or updated.
-	Team createTeamTeamCreateTeamPayloadTeam `json:"team"`
+type ProjectCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the project.
+	Name string `json:"name"`
+	// The icon of the project.
+	Icon *string `json:"icon,omitempty"`
+	// The color of the project.
+	Color *string `json:"color,omitempty"`
+	// [DEPRECATED] The state of the project.
+	State string `json:"state,omitempty"`
+	// The ID of the project status.
+	StatusId *string `json:"statusId,omitempty"`
+	// The description for the project.
+	Description *string `json:"description"`
+	// The identifiers of the teams this project is associated with.
+	TeamIds []string `json:"teamIds"`
+	// The ID of the issue from which that project is created.
+	ConvertedFromIssueId string `json:"convertedFromIssueId,omitempty"`
+	// The ID of the last template applied to the project.
+	LastAppliedTemplateId string `json:"lastAppliedTemplateId,omitempty"`
+	// The identifier of the project lead.
+	LeadId *string `json:"leadId"`
+	// The identifiers of the members of this project.
+	MemberIds []string `json:"memberIds,omitempty"`
+	// The planned start date of the project.
+	StartDate *string `json:"startDate"`
+	// [INTERNAL] The resolution of the project's start date.
+	StartDateResolution *DateResolutionType `json:"startDateResolution,omitempty"`
+	// The planned target date of the project.
+	TargetDate *string `json:"targetDate"`
+	// [INTERNAL] The resolution of the project's estimated completion date.
+	TargetDateResolution *DateResolutionType `json:"targetDateResolution,omitempty"`
+	// The sort order for the project within shared views.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// [ALPHA] The sort order for the project within shared views, when ordered by priority.
+	PrioritySortOrder *float64 `json:"prioritySortOrder,omitempty"`
+	// The priority of the project. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+	Priority int `json:"priority"`
 }
 
-// GetTeam returns createTeamTeamCreateTeamPayload.Team, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayload) GetTeam() createTeamTeamCreateTeamPayloadTeam {
-	return v.Team
-}
+// GetId returns ProjectCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetId() string { return v.Id }
 
-// createTeamTeamCreateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
-// The GraphQL type's documentation follows.
-//
-// An organizational unit that contains issues.
-type createTeamTeamCreateTeamPayloadTeam struct {
-	Team `json:"-"`
-}
+// GetName returns ProjectCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetName() string { return v.Name }
 
-// GetId returns createTeamTeamCreateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetId() string { return v.Team.Id }
+// GetIcon returns ProjectCreateInput.Icon, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetIcon() *string { return v.Icon }
 
-// GetName returns createTeamTeamCreateTeamPayloadTeam.Name, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetName() string { return v.Team.Name }
+// GetColor returns ProjectCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetColor() *string { return v.Color }
 
-// GetKey returns createTeamTeamCreateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetKey() string { return v.Team.Key }
+// GetState returns ProjectCreateInput.State, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetState() string { return v.State }
 
-// GetPrivate returns createTeamTeamCreateTeamPayloadTeam.Private, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetPrivate() bool { return v.Team.Private }
+// GetStatusId returns ProjectCreateInput.StatusId, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetStatusId() *string { return v.StatusId }
 
-// GetDescription returns createTeamTeamCreateTeamPayloadTeam.Description, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetDescription() *string { return v.Team.Description }
+// GetDescription returns ProjectCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetDescription() *string { return v.Description }
 
-// GetIcon returns createTeamTeamCreateTeamPayloadTeam.Icon, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetIcon() *string { return v.Team.Icon }
+// GetTeamIds returns ProjectCreateInput.TeamIds, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetTeamIds() []string { return v.TeamIds }
 
-// GetColor returns createTeamTeamCreateTeamPayloadTeam.Color, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetColor() *string { return v.Team.Color }
+// GetConvertedFromIssueId returns ProjectCreateInput.ConvertedFromIssueId, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetConvertedFromIssueId() string { return v.ConvertedFromIssueId }
 
-// GetTimezone returns createTeamTeamCreateTeamPayloadTeam.Timezone, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetTimezone() string { return v.Team.Timezone }
+// GetLastAppliedTemplateId returns ProjectCreateInput.LastAppliedTemplateId, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetLastAppliedTemplateId() string { return v.LastAppliedTemplateId }
 
-// GetIssueOrderingNoPriorityFirst returns createTeamTeamCreateTeamPayloadTeam.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueOrderingNoPriorityFirst() bool {
-	return v.Team.IssueOrderingNoPriorityFirst
+// GetLeadId returns ProjectCreateInput.LeadId, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetLeadId() *string { return v.LeadId }
+
+// GetMemberIds returns ProjectCreateInput.MemberIds, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetMemberIds() []string { return v.MemberIds }
+
+// GetStartDate returns ProjectCreateInput.StartDate, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetStartDate() *string { return v.StartDate }
+
+// GetStartDateResolution returns ProjectCreateInput.StartDateResolution, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetStartDateResolution() *DateResolutionType {
+	return v.StartDateResolution
 }
 
-// GetGroupIssueHistory returns createTeamTeamCreateTeamPayloadTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetGroupIssueHistory() bool {
-	return v.Team.GroupIssueHistory
+// GetTargetDate returns ProjectCreateInput.TargetDate, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetTargetDate() *string { return v.TargetDate }
+
+// GetTargetDateResolution returns ProjectCreateInput.TargetDateResolution, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetTargetDateResolution() *DateResolutionType {
+	return v.TargetDateResolution
 }
 
-// GetSetIssueSortOrderOnStateChange returns createTeamTeamCreateTeamPayloadTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetSetIssueSortOrderOnStateChange() string {
-	return v.Team.SetIssueSortOrderOnStateChange
-}
+// GetSortOrder returns ProjectCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetSortOrder() *float64 { return v.SortOrder }
 
-// GetAutoArchivePeriod returns createTeamTeamCreateTeamPayloadTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoArchivePeriod() float64 {
-	return v.Team.AutoArchivePeriod
-}
+// GetPrioritySortOrder returns ProjectCreateInput.PrioritySortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetPrioritySortOrder() *float64 { return v.PrioritySortOrder }
 
-// GetAutoClosePeriod returns createTeamTeamCreateTeamPayloadTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoClosePeriod() *float64 {
-	return v.Team.AutoClosePeriod
+// GetPriority returns ProjectCreateInput.Priority, and is useful for accessing the field via an interface.
+func (v *ProjectCreateInput) GetPriority() int { return v.Priority }
+
+// ProjectDetails includes the GraphQL fields of Project requested by the fragment ProjectDetails.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type ProjectDetails struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The project's unique URL slug.
+	SlugId string `json:"slugId"`
+	// The project's name.
+	Name string `json:"name"`
+	// The project's description.
+	Description string `json:"description"`
+	// The icon of the project.
+	Icon *string `json:"icon"`
+	// The project's color.
+	Color string `json:"color"`
+	// The priority of the project. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+	Priority int `json:"priority"`
+	// The overall progress of the project. This is the (completed estimate points +
+	// 0.25 * in progress estimate points) / total estimate points.
+	Progress float64 `json:"progress"`
+	// The estimated start date of the project.
+	StartDate *string `json:"startDate"`
+	// The estimated completion date of the project.
+	TargetDate *string `json:"targetDate"`
+	// Project URL.
+	Url string `json:"url"`
+	// The status that the project is associated with.
+	Status ProjectDetailsStatusProjectStatus `json:"status"`
+	// The project lead.
+	Lead *ProjectDetailsLeadUser `json:"lead"`
+	// Teams associated with this project.
+	Teams ProjectDetailsTeamsTeamConnection `json:"teams"`
 }
 
-// GetTriageEnabled returns createTeamTeamCreateTeamPayloadTeam.TriageEnabled, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
+// GetId returns ProjectDetails.Id, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetId() string { return v.Id }
 
-// GetCyclesEnabled returns createTeamTeamCreateTeamPayloadTeam.CyclesEnabled, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
+// GetSlugId returns ProjectDetails.SlugId, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetSlugId() string { return v.SlugId }
 
-// GetCycleStartDay returns createTeamTeamCreateTeamPayloadTeam.CycleStartDay, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
+// GetName returns ProjectDetails.Name, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetName() string { return v.Name }
 
-// GetCycleDuration returns createTeamTeamCreateTeamPayloadTeam.CycleDuration, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
+// GetDescription returns ProjectDetails.Description, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetDescription() string { return v.Description }
 
-// GetCycleCooldownTime returns createTeamTeamCreateTeamPayloadTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleCooldownTime() float64 {
-	return v.Team.CycleCooldownTime
-}
+// GetIcon returns ProjectDetails.Icon, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetIcon() *string { return v.Icon }
 
-// GetUpcomingCycleCount returns createTeamTeamCreateTeamPayloadTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetUpcomingCycleCount() float64 {
-	return v.Team.UpcomingCycleCount
-}
+// GetColor returns ProjectDetails.Color, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetColor() string { return v.Color }
 
-// GetCycleIssueAutoAssignStarted returns createTeamTeamCreateTeamPayloadTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleIssueAutoAssignStarted() bool {
-	return v.Team.CycleIssueAutoAssignStarted
-}
+// GetPriority returns ProjectDetails.Priority, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetPriority() int { return v.Priority }
 
-// GetCycleIssueAutoAssignCompleted returns createTeamTeamCreateTeamPayloadTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleIssueAutoAssignCompleted() bool {
-	return v.Team.CycleIssueAutoAssignCompleted
-}
+// GetProgress returns ProjectDetails.Progress, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetProgress() float64 { return v.Progress }
 
-// GetCycleLockToActive returns createTeamTeamCreateTeamPayloadTeam.CycleLockToActive, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleLockToActive() bool {
-	return v.Team.CycleLockToActive
-}
+// GetStartDate returns ProjectDetails.StartDate, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetStartDate() *string { return v.StartDate }
 
-// GetIssueEstimationType returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationType, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationType() string {
-	return v.Team.IssueEstimationType
-}
+// GetTargetDate returns ProjectDetails.TargetDate, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetTargetDate() *string { return v.TargetDate }
 
-// GetIssueEstimationAllowZero returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationAllowZero() bool {
-	return v.Team.IssueEstimationAllowZero
-}
+// GetUrl returns ProjectDetails.Url, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetUrl() string { return v.Url }
 
-// GetIssueEstimationExtended returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationExtended() bool {
-	return v.Team.IssueEstimationExtended
+// GetStatus returns ProjectDetails.Status, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetStatus() ProjectDetailsStatusProjectStatus { return v.Status }
+
+// GetLead returns ProjectDetails.Lead, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetLead() *ProjectDetailsLeadUser { return v.Lead }
+
+// GetTeams returns ProjectDetails.Teams, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetTeams() ProjectDetailsTeamsTeamConnection { return v.Teams }
+
+// ProjectDetailsLeadUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type ProjectDetailsLeadUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetDefaultIssueEstimate returns createTeamTeamCreateTeamPayloadTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
-func (v *createTeamTeamCreateTeamPayloadTeam) GetDefaultIssueEstimate() float64 {
-	return v.Team.DefaultIssueEstimate
+// GetId returns ProjectDetailsLeadUser.Id, and is useful for accessing the field via an interface.
+func (v *ProjectDetailsLeadUser) GetId() string { return v.Id }
+
+// ProjectDetailsStatusProjectStatus includes the requested fields of the GraphQL type ProjectStatus.
+// The GraphQL type's documentation follows.
+//
+// A project status.
+type ProjectDetailsStatusProjectStatus struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The type of the project status.
+	Type ProjectStatusType `json:"type"`
 }
 
-func (v *createTeamTeamCreateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+// GetId returns ProjectDetailsStatusProjectStatus.Id, and is useful for accessing the field via an interface.
+func (v *ProjectDetailsStatusProjectStatus) GetId() string { return v.Id }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetType returns ProjectDetailsStatusProjectStatus.Type, and is useful for accessing the field via an interface.
+func (v *ProjectDetailsStatusProjectStatus) GetType() ProjectStatusType { return v.Type }
 
-	var firstPass struct {
-		*createTeamTeamCreateTeamPayloadTeam
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.createTeamTeamCreateTeamPayloadTeam = v
+// ProjectDetailsTeamsTeamConnection includes the requested fields of the GraphQL type TeamConnection.
+type ProjectDetailsTeamsTeamConnection struct {
+	Nodes []ProjectDetailsTeamsTeamConnectionNodesTeam `json:"nodes"`
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetNodes returns ProjectDetailsTeamsTeamConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectDetailsTeamsTeamConnection) GetNodes() []ProjectDetailsTeamsTeamConnectionNodesTeam {
+	return v.Nodes
+}
 
-	err = json.Unmarshal(
-		b, &v.Team)
-	if err != nil {
-		return err
-	}
-	return nil
+// ProjectDetailsTeamsTeamConnectionNodesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type ProjectDetailsTeamsTeamConnectionNodesTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-type __premarshalcreateTeamTeamCreateTeamPayloadTeam struct {
+// GetId returns ProjectDetailsTeamsTeamConnectionNodesTeam.Id, and is useful for accessing the field via an interface.
+func (v *ProjectDetailsTeamsTeamConnectionNodesTeam) GetId() string { return v.Id }
+
+// ProjectLeadUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type ProjectLeadUser struct {
+	// The unique identifier of the entity.
 	Id string `json:"id"`
+}
 
+// GetId returns ProjectLeadUser.Id, and is useful for accessing the field via an interface.
+func (v *ProjectLeadUser) GetId() string { return v.Id }
+
+// ProjectMilestone includes the GraphQL fields of ProjectMilestone requested by the fragment ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type ProjectMilestone struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The name of the project milestone.
 	Name string `json:"name"`
+	// The project milestone's description in markdown format.
+	Description *string `json:"description"`
+	// The planned completion date of the milestone.
+	TargetDate *string `json:"targetDate"`
+	// The project of the milestone.
+	Project ProjectMilestoneProject `json:"project"`
+}
 
-	Key string `json:"key"`
+// GetId returns ProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *ProjectMilestone) GetId() string { return v.Id }
 
-	Private bool `json:"private"`
+// GetName returns ProjectMilestone.Name, and is useful for accessing the field via an interface.
+func (v *ProjectMilestone) GetName() string { return v.Name }
+
+// GetDescription returns ProjectMilestone.Description, and is useful for accessing the field via an interface.
+func (v *ProjectMilestone) GetDescription() *string { return v.Description }
 
+// GetTargetDate returns ProjectMilestone.TargetDate, and is useful for accessing the field via an interface.
+func (v *ProjectMilestone) GetTargetDate() *string { return v.TargetDate }
+
+// GetProject returns ProjectMilestone.Project, and is useful for accessing the field via an interface.
+func (v *ProjectMilestone) GetProject() ProjectMilestoneProject { return v.Project }
+
+type ProjectMilestoneCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the project milestone.
+	Name string `json:"name"`
+	// The description of the project milestone in markdown format.
 	Description *string `json:"description"`
+	// [Internal] The description of the project milestone as a Prosemirror document.
+	DescriptionData map[string]interface{} `json:"descriptionData,omitempty"`
+	// The planned target date of the project milestone.
+	TargetDate *string `json:"targetDate"`
+	// Related project for the project milestone.
+	ProjectId string `json:"projectId"`
+	// The sort order for the project milestone within a project.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+}
 
-	Icon *string `json:"icon"`
+// GetId returns ProjectMilestoneCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetId() string { return v.Id }
 
-	Color *string `json:"color"`
+// GetName returns ProjectMilestoneCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetName() string { return v.Name }
 
-	Timezone string `json:"timezone"`
+// GetDescription returns ProjectMilestoneCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetDescription() *string { return v.Description }
 
-	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
+// GetDescriptionData returns ProjectMilestoneCreateInput.DescriptionData, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetDescriptionData() map[string]interface{} {
+	return v.DescriptionData
+}
 
-	GroupIssueHistory bool `json:"groupIssueHistory"`
+// GetTargetDate returns ProjectMilestoneCreateInput.TargetDate, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetTargetDate() *string { return v.TargetDate }
 
-	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+// GetProjectId returns ProjectMilestoneCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetProjectId() string { return v.ProjectId }
 
-	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+// GetSortOrder returns ProjectMilestoneCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneCreateInput) GetSortOrder() *float64 { return v.SortOrder }
 
-	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+// ProjectMilestoneProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type ProjectMilestoneProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
 
-	TriageEnabled bool `json:"triageEnabled"`
+// GetId returns ProjectMilestoneProject.Id, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneProject) GetId() string { return v.Id }
 
-	CyclesEnabled bool `json:"cyclesEnabled"`
+type ProjectMilestoneUpdateInput struct {
+	// The name of the project milestone.
+	Name string `json:"name,omitempty"`
+	// The description of the project milestone in markdown format.
+	Description *string `json:"description"`
+	// [Internal] The description of the project milestone as a Prosemirror document.
+	DescriptionData map[string]interface{} `json:"descriptionData,omitempty"`
+	// The planned target date of the project milestone.
+	TargetDate *string `json:"targetDate"`
+	// The sort order for the project milestone within a project.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+}
 
-	CycleStartDay float64 `json:"cycleStartDay"`
+// GetName returns ProjectMilestoneUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneUpdateInput) GetName() string { return v.Name }
 
-	CycleDuration float64 `json:"cycleDuration"`
+// GetDescription returns ProjectMilestoneUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneUpdateInput) GetDescription() *string { return v.Description }
 
-	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+// GetDescriptionData returns ProjectMilestoneUpdateInput.DescriptionData, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneUpdateInput) GetDescriptionData() map[string]interface{} {
+	return v.DescriptionData
+}
 
-	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+// GetTargetDate returns ProjectMilestoneUpdateInput.TargetDate, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneUpdateInput) GetTargetDate() *string { return v.TargetDate }
 
-	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+// GetSortOrder returns ProjectMilestoneUpdateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectMilestoneUpdateInput) GetSortOrder() *float64 { return v.SortOrder }
 
-	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+// ProjectRelation includes the GraphQL fields of ProjectRelation requested by the fragment ProjectRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation between two projects.
+type ProjectRelation struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The relationship of the project with the related project.
+	Type string `json:"type"`
+	// The type of anchor on the project end of the relation.
+	AnchorType string `json:"anchorType"`
+	// The type of anchor on the relatedProject end of the relation.
+	RelatedAnchorType string `json:"relatedAnchorType"`
+	// The project whose relationship is being described.
+	Project ProjectRelationProject `json:"project"`
+	// The milestone within the project whose relationship is being described.
+	ProjectMilestone *ProjectRelationProjectMilestone `json:"projectMilestone"`
+	// The related project.
+	RelatedProject ProjectRelationRelatedProject `json:"relatedProject"`
+	// The milestone within the related project whose relationship is being described.
+	RelatedProjectMilestone *ProjectRelationRelatedProjectMilestone `json:"relatedProjectMilestone"`
+}
 
-	CycleLockToActive bool `json:"cycleLockToActive"`
+// GetId returns ProjectRelation.Id, and is useful for accessing the field via an interface.
+func (v *ProjectRelation) GetId() string { return v.Id }
 
-	IssueEstimationType string `json:"issueEstimationType"`
+// GetType returns ProjectRelation.Type, and is useful for accessing the field via an interface.
+func (v *ProjectRelation) GetType() string { return v.Type }
 
-	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+// GetAnchorType returns ProjectRelation.AnchorType, and is useful for accessing the field via an interface.
+func (v *ProjectRelation) GetAnchorType() string { return v.AnchorType }
 
-	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+// GetRelatedAnchorType returns ProjectRelation.RelatedAnchorType, and is useful for accessing the field via an interface.
+func (v *ProjectRelation) GetRelatedAnchorType() string { return v.RelatedAnchorType }
 
-	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
-}
+// GetProject returns ProjectRelation.Project, and is useful for accessing the field via an interface.
+func (v *ProjectRelation) GetProject() ProjectRelationProject { return v.Project }
 
-func (v *createTeamTeamCreateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetProjectMilestone returns ProjectRelation.ProjectMilestone, and is useful for accessing the field via an interface.
+func (v *ProjectRelation) GetProjectMilestone() *ProjectRelationProjectMilestone {
+	return v.ProjectMilestone
 }
 
-func (v *createTeamTeamCreateTeamPayloadTeam) __premarshalJSON() (*__premarshalcreateTeamTeamCreateTeamPayloadTeam, error) {
-	var retval __premarshalcreateTeamTeamCreateTeamPayloadTeam
+// GetRelatedProject returns ProjectRelation.RelatedProject, and is useful for accessing the field via an interface.
+func (v *ProjectRelation) GetRelatedProject() ProjectRelationRelatedProject { return v.RelatedProject }
 
-	retval.Id = v.Team.Id
-	retval.Name = v.Team.Name
-	retval.Key = v.Team.Key
-	retval.Private = v.Team.Private
-	retval.Description = v.Team.Description
-	retval.Icon = v.Team.Icon
-	retval.Color = v.Team.Color
-	retval.Timezone = v.Team.Timezone
-	retval.IssueOrderingNoPriorityFirst = v.Team.IssueOrderingNoPriorityFirst
-	retval.GroupIssueHistory = v.Team.GroupIssueHistory
-	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
-	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
-	retval.AutoClosePeriod = v.Team.AutoClosePeriod
-	retval.TriageEnabled = v.Team.TriageEnabled
-	retval.CyclesEnabled = v.Team.CyclesEnabled
-	retval.CycleStartDay = v.Team.CycleStartDay
-	retval.CycleDuration = v.Team.CycleDuration
-	retval.CycleCooldownTime = v.Team.CycleCooldownTime
-	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
-	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
-	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
-	retval.CycleLockToActive = v.Team.CycleLockToActive
-	retval.IssueEstimationType = v.Team.IssueEstimationType
-	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
-	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
-	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
-	return &retval, nil
+// GetRelatedProjectMilestone returns ProjectRelation.RelatedProjectMilestone, and is useful for accessing the field via an interface.
+func (v *ProjectRelation) GetRelatedProjectMilestone() *ProjectRelationRelatedProjectMilestone {
+	return v.RelatedProjectMilestone
 }
 
-// createWorkflowStateResponse is returned by createWorkflowState on success.
-type createWorkflowStateResponse struct {
-	// Creates a new state, adding it to the workflow of a team.
-	WorkflowStateCreate createWorkflowStateWorkflowStateCreateWorkflowStatePayload `json:"workflowStateCreate"`
+type ProjectRelationCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The type of relation of the project to the related project.
+	Type string `json:"type"`
+	// The identifier of the project that is related to another project.
+	ProjectId string `json:"projectId"`
+	// The identifier of the project milestone.
+	ProjectMilestoneId *string `json:"projectMilestoneId"`
+	// The type of the anchor for the project.
+	AnchorType string `json:"anchorType"`
+	// The identifier of the related project.
+	RelatedProjectId string `json:"relatedProjectId"`
+	// The identifier of the related project milestone.
+	RelatedProjectMilestoneId *string `json:"relatedProjectMilestoneId"`
+	// The type of the anchor for the related project.
+	RelatedAnchorType string `json:"relatedAnchorType"`
 }
 
-// GetWorkflowStateCreate returns createWorkflowStateResponse.WorkflowStateCreate, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateResponse) GetWorkflowStateCreate() createWorkflowStateWorkflowStateCreateWorkflowStatePayload {
-	return v.WorkflowStateCreate
-}
+// GetId returns ProjectRelationCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *ProjectRelationCreateInput) GetId() string { return v.Id }
 
-// createWorkflowStateWorkflowStateCreateWorkflowStatePayload includes the requested fields of the GraphQL type WorkflowStatePayload.
-type createWorkflowStateWorkflowStateCreateWorkflowStatePayload struct {
-	// The state that was created or updated.
-	WorkflowState createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState `json:"workflowState"`
-}
+// GetType returns ProjectRelationCreateInput.Type, and is useful for accessing the field via an interface.
+func (v *ProjectRelationCreateInput) GetType() string { return v.Type }
 
-// GetWorkflowState returns createWorkflowStateWorkflowStateCreateWorkflowStatePayload.WorkflowState, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayload) GetWorkflowState() createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState {
-	return v.WorkflowState
+// GetProjectId returns ProjectRelationCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *ProjectRelationCreateInput) GetProjectId() string { return v.ProjectId }
+
+// GetProjectMilestoneId returns ProjectRelationCreateInput.ProjectMilestoneId, and is useful for accessing the field via an interface.
+func (v *ProjectRelationCreateInput) GetProjectMilestoneId() *string { return v.ProjectMilestoneId }
+
+// GetAnchorType returns ProjectRelationCreateInput.AnchorType, and is useful for accessing the field via an interface.
+func (v *ProjectRelationCreateInput) GetAnchorType() string { return v.AnchorType }
+
+// GetRelatedProjectId returns ProjectRelationCreateInput.RelatedProjectId, and is useful for accessing the field via an interface.
+func (v *ProjectRelationCreateInput) GetRelatedProjectId() string { return v.RelatedProjectId }
+
+// GetRelatedProjectMilestoneId returns ProjectRelationCreateInput.RelatedProjectMilestoneId, and is useful for accessing the field via an interface.
+func (v *ProjectRelationCreateInput) GetRelatedProjectMilestoneId() *string {
+	return v.RelatedProjectMilestoneId
 }
 
-// createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// GetRelatedAnchorType returns ProjectRelationCreateInput.RelatedAnchorType, and is useful for accessing the field via an interface.
+func (v *ProjectRelationCreateInput) GetRelatedAnchorType() string { return v.RelatedAnchorType }
+
+// ProjectRelationProject includes the requested fields of the GraphQL type Project.
 // The GraphQL type's documentation follows.
 //
-// A state in a team workflow.
-type createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState struct {
-	WorkflowState `json:"-"`
+// A project.
+type ProjectRelationProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetId returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetId() string {
-	return v.WorkflowState.Id
-}
+// GetId returns ProjectRelationProject.Id, and is useful for accessing the field via an interface.
+func (v *ProjectRelationProject) GetId() string { return v.Id }
 
-// GetName returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Name, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetName() string {
-	return v.WorkflowState.Name
+// ProjectRelationProjectMilestone includes the requested fields of the GraphQL type ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type ProjectRelationProjectMilestone struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetColor returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Color, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetColor() string {
-	return v.WorkflowState.Color
-}
+// GetId returns ProjectRelationProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *ProjectRelationProjectMilestone) GetId() string { return v.Id }
 
-// GetDescription returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Description, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetDescription() *string {
-	return v.WorkflowState.Description
+// ProjectRelationRelatedProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type ProjectRelationRelatedProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetType returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Type, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetType() string {
-	return v.WorkflowState.Type
+// GetId returns ProjectRelationRelatedProject.Id, and is useful for accessing the field via an interface.
+func (v *ProjectRelationRelatedProject) GetId() string { return v.Id }
+
+// ProjectRelationRelatedProjectMilestone includes the requested fields of the GraphQL type ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type ProjectRelationRelatedProjectMilestone struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetPosition returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Position, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetPosition() float64 {
-	return v.WorkflowState.Position
-}
+// GetId returns ProjectRelationRelatedProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *ProjectRelationRelatedProjectMilestone) GetId() string { return v.Id }
 
-// GetTeam returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Team, and is useful for accessing the field via an interface.
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetTeam() WorkflowStateTeam {
-	return v.WorkflowState.Team
+type ProjectRelationUpdateInput struct {
+	// The type of relation of the project to the related project.
+	Type string `json:"type"`
+	// The identifier of the project that is related to another project.
+	ProjectId string `json:"projectId"`
+	// The identifier of the project milestone.
+	ProjectMilestoneId *string `json:"projectMilestoneId"`
+	// The type of the anchor for the project.
+	AnchorType string `json:"anchorType"`
+	// The identifier of the related project.
+	RelatedProjectId string `json:"relatedProjectId"`
+	// The identifier of the related project milestone.
+	RelatedProjectMilestoneId *string `json:"relatedProjectMilestoneId"`
+	// The type of the anchor for the related project.
+	RelatedAnchorType string `json:"relatedAnchorType"`
 }
 
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) UnmarshalJSON(b []byte) error {
+// GetType returns ProjectRelationUpdateInput.Type, and is useful for accessing the field via an interface.
+func (v *ProjectRelationUpdateInput) GetType() string { return v.Type }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetProjectId returns ProjectRelationUpdateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *ProjectRelationUpdateInput) GetProjectId() string { return v.ProjectId }
 
-	var firstPass struct {
-		*createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState = v
+// GetProjectMilestoneId returns ProjectRelationUpdateInput.ProjectMilestoneId, and is useful for accessing the field via an interface.
+func (v *ProjectRelationUpdateInput) GetProjectMilestoneId() *string { return v.ProjectMilestoneId }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetAnchorType returns ProjectRelationUpdateInput.AnchorType, and is useful for accessing the field via an interface.
+func (v *ProjectRelationUpdateInput) GetAnchorType() string { return v.AnchorType }
 
-	err = json.Unmarshal(
-		b, &v.WorkflowState)
-	if err != nil {
-		return err
-	}
-	return nil
+// GetRelatedProjectId returns ProjectRelationUpdateInput.RelatedProjectId, and is useful for accessing the field via an interface.
+func (v *ProjectRelationUpdateInput) GetRelatedProjectId() string { return v.RelatedProjectId }
+
+// GetRelatedProjectMilestoneId returns ProjectRelationUpdateInput.RelatedProjectMilestoneId, and is useful for accessing the field via an interface.
+func (v *ProjectRelationUpdateInput) GetRelatedProjectMilestoneId() *string {
+	return v.RelatedProjectMilestoneId
 }
 
-type __premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState struct {
+// GetRelatedAnchorType returns ProjectRelationUpdateInput.RelatedAnchorType, and is useful for accessing the field via an interface.
+func (v *ProjectRelationUpdateInput) GetRelatedAnchorType() string { return v.RelatedAnchorType }
+
+// ProjectStatus includes the requested fields of the GraphQL type ProjectStatus.
+// The GraphQL type's documentation follows.
+//
+// A project status.
+type ProjectStatus struct {
+	// The unique identifier of the entity.
 	Id string `json:"id"`
+}
 
-	Name string `json:"name"`
+// GetId returns ProjectStatus.Id, and is useful for accessing the field via an interface.
+func (v *ProjectStatus) GetId() string { return v.Id }
 
-	Color string `json:"color"`
+// A type of project status.
+type ProjectStatusType string
 
-	Description *string `json:"description"`
+const (
+	ProjectStatusTypeBacklog   ProjectStatusType = "backlog"
+	ProjectStatusTypePlanned   ProjectStatusType = "planned"
+	ProjectStatusTypeStarted   ProjectStatusType = "started"
+	ProjectStatusTypePaused    ProjectStatusType = "paused"
+	ProjectStatusTypeCompleted ProjectStatusType = "completed"
+	ProjectStatusTypeCanceled  ProjectStatusType = "canceled"
+)
 
-	Type string `json:"type"`
+// ProjectTeamsTeamConnection includes the requested fields of the GraphQL type TeamConnection.
+type ProjectTeamsTeamConnection struct {
+	Nodes []ProjectTeamsTeamConnectionNodesTeam `json:"nodes"`
+}
 
-	Position float64 `json:"position"`
+// GetNodes returns ProjectTeamsTeamConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ProjectTeamsTeamConnection) GetNodes() []ProjectTeamsTeamConnectionNodesTeam { return v.Nodes }
 
-	Team WorkflowStateTeam `json:"team"`
+// ProjectTeamsTeamConnectionNodesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type ProjectTeamsTeamConnectionNodesTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetId returns ProjectTeamsTeamConnectionNodesTeam.Id, and is useful for accessing the field via an interface.
+func (v *ProjectTeamsTeamConnectionNodesTeam) GetId() string { return v.Id }
+
+type ProjectUpdateInput struct {
+	// [DEPRECATED] The state of the project.
+	State string `json:"state,omitempty"`
+	// The ID of the project status.
+	StatusId *string `json:"statusId,omitempty"`
+	// The name of the project.
+	Name string `json:"name,omitempty"`
+	// The description for the project.
+	Description *string `json:"description"`
+	// The ID of the issue from which that project is created.
+	ConvertedFromIssueId string `json:"convertedFromIssueId,omitempty"`
+	// The ID of the last template applied to the project.
+	LastAppliedTemplateId string `json:"lastAppliedTemplateId,omitempty"`
+	// The icon of the project.
+	Icon *string `json:"icon"`
+	// The color of the project.
+	Color *string `json:"color,omitempty"`
+	// The identifiers of the teams this project is associated with.
+	TeamIds []string `json:"teamIds"`
+	// The time until which project update reminders are paused.
+	ProjectUpdateRemindersPausedUntilAt *time.Time `json:"projectUpdateRemindersPausedUntilAt,omitempty"`
+	// The identifier of the project lead.
+	LeadId *string `json:"leadId"`
+	// The identifiers of the members of this project.
+	MemberIds []string `json:"memberIds,omitempty"`
+	// The planned start date of the project.
+	StartDate *string `json:"startDate"`
+	// [INTERNAL] The resolution of the project's start date.
+	StartDateResolution *DateResolutionType `json:"startDateResolution,omitempty"`
+	// The planned target date of the project.
+	TargetDate *string `json:"targetDate"`
+	// [INTERNAL] The resolution of the project's estimated completion date.
+	TargetDateResolution *DateResolutionType `json:"targetDateResolution,omitempty"`
+	// The date when the project was completed.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// The date when the project was canceled.
+	CanceledAt *time.Time `json:"canceledAt,omitempty"`
+	// Whether to send new issue notifications to Slack.
+	SlackNewIssue *bool `json:"slackNewIssue,omitempty"`
+	// Whether to send new issue comment notifications to Slack.
+	SlackIssueComments *bool `json:"slackIssueComments,omitempty"`
+	// Whether to send issue status update notifications to Slack.
+	SlackIssueStatuses *bool `json:"slackIssueStatuses,omitempty"`
+	// The sort order for the project in shared views.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// [ALPHA] The sort order for the project within shared views, when ordered by priority.
+	PrioritySortOrder *float64 `json:"prioritySortOrder,omitempty"`
+	// Whether the project has been trashed.
+	Trashed *bool `json:"trashed,omitempty"`
+	// The priority of the project. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+	Priority int `json:"priority"`
 }
 
-func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) __premarshalJSON() (*__premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState, error) {
-	var retval __premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState
+// GetState returns ProjectUpdateInput.State, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetState() string { return v.State }
 
-	retval.Id = v.WorkflowState.Id
-	retval.Name = v.WorkflowState.Name
-	retval.Color = v.WorkflowState.Color
-	retval.Description = v.WorkflowState.Description
-	retval.Type = v.WorkflowState.Type
-	retval.Position = v.WorkflowState.Position
-	retval.Team = v.WorkflowState.Team
-	return &retval, nil
-}
+// GetStatusId returns ProjectUpdateInput.StatusId, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetStatusId() *string { return v.StatusId }
 
-// deleteLabelIssueLabelDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
-// The GraphQL type's documentation follows.
-//
-// A generic payload return from entity deletion mutations.
-type deleteLabelIssueLabelDeleteDeletePayload struct {
-	// Whether the operation was successful.
-	Success bool `json:"success"`
-}
+// GetName returns ProjectUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetName() string { return v.Name }
 
-// GetSuccess returns deleteLabelIssueLabelDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
-func (v *deleteLabelIssueLabelDeleteDeletePayload) GetSuccess() bool { return v.Success }
+// GetDescription returns ProjectUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetDescription() *string { return v.Description }
 
-// deleteLabelResponse is returned by deleteLabel on success.
-type deleteLabelResponse struct {
-	// Deletes an issue label.
-	IssueLabelDelete deleteLabelIssueLabelDeleteDeletePayload `json:"issueLabelDelete"`
-}
+// GetConvertedFromIssueId returns ProjectUpdateInput.ConvertedFromIssueId, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetConvertedFromIssueId() string { return v.ConvertedFromIssueId }
 
-// GetIssueLabelDelete returns deleteLabelResponse.IssueLabelDelete, and is useful for accessing the field via an interface.
-func (v *deleteLabelResponse) GetIssueLabelDelete() deleteLabelIssueLabelDeleteDeletePayload {
-	return v.IssueLabelDelete
-}
+// GetLastAppliedTemplateId returns ProjectUpdateInput.LastAppliedTemplateId, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetLastAppliedTemplateId() string { return v.LastAppliedTemplateId }
 
-// deleteTeamResponse is returned by deleteTeam on success.
-type deleteTeamResponse struct {
-	// Deletes a team.
-	TeamDelete deleteTeamTeamDeleteDeletePayload `json:"teamDelete"`
-}
+// GetIcon returns ProjectUpdateInput.Icon, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetIcon() *string { return v.Icon }
 
-// GetTeamDelete returns deleteTeamResponse.TeamDelete, and is useful for accessing the field via an interface.
-func (v *deleteTeamResponse) GetTeamDelete() deleteTeamTeamDeleteDeletePayload { return v.TeamDelete }
+// GetColor returns ProjectUpdateInput.Color, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetColor() *string { return v.Color }
 
-// deleteTeamTeamDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
-// The GraphQL type's documentation follows.
-//
-// A generic payload return from entity deletion mutations.
-type deleteTeamTeamDeleteDeletePayload struct {
-	// Whether the operation was successful.
-	Success bool `json:"success"`
+// GetTeamIds returns ProjectUpdateInput.TeamIds, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetTeamIds() []string { return v.TeamIds }
+
+// GetProjectUpdateRemindersPausedUntilAt returns ProjectUpdateInput.ProjectUpdateRemindersPausedUntilAt, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetProjectUpdateRemindersPausedUntilAt() *time.Time {
+	return v.ProjectUpdateRemindersPausedUntilAt
 }
 
-// GetSuccess returns deleteTeamTeamDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
-func (v *deleteTeamTeamDeleteDeletePayload) GetSuccess() bool { return v.Success }
+// GetLeadId returns ProjectUpdateInput.LeadId, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetLeadId() *string { return v.LeadId }
 
-// deleteWorkflowStateResponse is returned by deleteWorkflowState on success.
-type deleteWorkflowStateResponse struct {
-	// Archives a state. Only states with issues that have all been archived can be archived.
-	WorkflowStateArchive deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload `json:"workflowStateArchive"`
-}
+// GetMemberIds returns ProjectUpdateInput.MemberIds, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetMemberIds() []string { return v.MemberIds }
 
-// GetWorkflowStateArchive returns deleteWorkflowStateResponse.WorkflowStateArchive, and is useful for accessing the field via an interface.
-func (v *deleteWorkflowStateResponse) GetWorkflowStateArchive() deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload {
-	return v.WorkflowStateArchive
-}
+// GetStartDate returns ProjectUpdateInput.StartDate, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetStartDate() *string { return v.StartDate }
 
-// deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload includes the requested fields of the GraphQL type WorkflowStateArchivePayload.
-// The GraphQL type's documentation follows.
-//
-// A generic payload return from entity archive mutations.
-type deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload struct {
-	// Whether the operation was successful.
-	Success bool `json:"success"`
+// GetStartDateResolution returns ProjectUpdateInput.StartDateResolution, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetStartDateResolution() *DateResolutionType {
+	return v.StartDateResolution
 }
 
-// GetSuccess returns deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload.Success, and is useful for accessing the field via an interface.
-func (v *deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload) GetSuccess() bool {
-	return v.Success
-}
+// GetTargetDate returns ProjectUpdateInput.TargetDate, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetTargetDate() *string { return v.TargetDate }
 
-// findTeamLabelIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
-type findTeamLabelIssueLabelsIssueLabelConnection struct {
-	Nodes []findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+// GetTargetDateResolution returns ProjectUpdateInput.TargetDateResolution, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetTargetDateResolution() *DateResolutionType {
+	return v.TargetDateResolution
 }
 
-// GetNodes returns findTeamLabelIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *findTeamLabelIssueLabelsIssueLabelConnection) GetNodes() []findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel {
-	return v.Nodes
-}
+// GetCompletedAt returns ProjectUpdateInput.CompletedAt, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetCompletedAt() *time.Time { return v.CompletedAt }
 
-// findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
-// The GraphQL type's documentation follows.
-//
-// Labels that can be associated with issues.
-type findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
-	// The unique identifier of the entity.
-	Id string `json:"id"`
-}
+// GetCanceledAt returns ProjectUpdateInput.CanceledAt, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetCanceledAt() *time.Time { return v.CanceledAt }
 
-// GetId returns findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string { return v.Id }
+// GetSlackNewIssue returns ProjectUpdateInput.SlackNewIssue, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetSlackNewIssue() *bool { return v.SlackNewIssue }
 
-// findTeamLabelResponse is returned by findTeamLabel on success.
-type findTeamLabelResponse struct {
-	// All issue labels.
-	IssueLabels findTeamLabelIssueLabelsIssueLabelConnection `json:"issueLabels"`
-}
+// GetSlackIssueComments returns ProjectUpdateInput.SlackIssueComments, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetSlackIssueComments() *bool { return v.SlackIssueComments }
 
-// GetIssueLabels returns findTeamLabelResponse.IssueLabels, and is useful for accessing the field via an interface.
-func (v *findTeamLabelResponse) GetIssueLabels() findTeamLabelIssueLabelsIssueLabelConnection {
-	return v.IssueLabels
-}
+// GetSlackIssueStatuses returns ProjectUpdateInput.SlackIssueStatuses, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetSlackIssueStatuses() *bool { return v.SlackIssueStatuses }
 
-// findWorkflowStateResponse is returned by findWorkflowState on success.
-type findWorkflowStateResponse struct {
-	// All issue workflow states.
-	WorkflowStates findWorkflowStateWorkflowStatesWorkflowStateConnection `json:"workflowStates"`
-}
+// GetSortOrder returns ProjectUpdateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetSortOrder() *float64 { return v.SortOrder }
 
-// GetWorkflowStates returns findWorkflowStateResponse.WorkflowStates, and is useful for accessing the field via an interface.
-func (v *findWorkflowStateResponse) GetWorkflowStates() findWorkflowStateWorkflowStatesWorkflowStateConnection {
-	return v.WorkflowStates
-}
+// GetPrioritySortOrder returns ProjectUpdateInput.PrioritySortOrder, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetPrioritySortOrder() *float64 { return v.PrioritySortOrder }
 
-// findWorkflowStateWorkflowStatesWorkflowStateConnection includes the requested fields of the GraphQL type WorkflowStateConnection.
-type findWorkflowStateWorkflowStatesWorkflowStateConnection struct {
-	Nodes []findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState `json:"nodes"`
-}
+// GetTrashed returns ProjectUpdateInput.Trashed, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetTrashed() *bool { return v.Trashed }
 
-// GetNodes returns findWorkflowStateWorkflowStatesWorkflowStateConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *findWorkflowStateWorkflowStatesWorkflowStateConnection) GetNodes() []findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
-	return v.Nodes
-}
+// GetPriority returns ProjectUpdateInput.Priority, and is useful for accessing the field via an interface.
+func (v *ProjectUpdateInput) GetPriority() int { return v.Priority }
 
-// findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// Roadmap includes the GraphQL fields of Roadmap requested by the fragment Roadmap.
 // The GraphQL type's documentation follows.
 //
-// A state in a team workflow.
-type findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
+// A roadmap for projects.
+type Roadmap struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
+	// The name of the roadmap.
+	Name string `json:"name"`
+	// The description of the roadmap.
+	Description *string `json:"description"`
+	// The roadmap's color.
+	Color *string `json:"color"`
+	// The user who owns the roadmap.
+	Owner RoadmapOwnerUser `json:"owner"`
 }
 
-// GetId returns findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetId() string {
-	return v.Id
-}
+// GetId returns Roadmap.Id, and is useful for accessing the field via an interface.
+func (v *Roadmap) GetId() string { return v.Id }
 
-// findWorkspaceLabelIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
-type findWorkspaceLabelIssueLabelsIssueLabelConnection struct {
-	Nodes []findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
-}
+// GetName returns Roadmap.Name, and is useful for accessing the field via an interface.
+func (v *Roadmap) GetName() string { return v.Name }
 
-// GetNodes returns findWorkspaceLabelIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *findWorkspaceLabelIssueLabelsIssueLabelConnection) GetNodes() []findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel {
-	return v.Nodes
-}
+// GetDescription returns Roadmap.Description, and is useful for accessing the field via an interface.
+func (v *Roadmap) GetDescription() *string { return v.Description }
 
-// findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
-// The GraphQL type's documentation follows.
-//
-// Labels that can be associated with issues.
-type findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
-	// The unique identifier of the entity.
-	Id string `json:"id"`
-	// The team that the label is associated with. If null, the label is associated with the global workspace.
-	Team findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam `json:"team"`
-}
+// GetColor returns Roadmap.Color, and is useful for accessing the field via an interface.
+func (v *Roadmap) GetColor() *string { return v.Color }
 
-// GetId returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string {
-	return v.Id
-}
+// GetOwner returns Roadmap.Owner, and is useful for accessing the field via an interface.
+func (v *Roadmap) GetOwner() RoadmapOwnerUser { return v.Owner }
 
-// GetTeam returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Team, and is useful for accessing the field via an interface.
-func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetTeam() findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam {
-	return v.Team
+type RoadmapCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the roadmap.
+	Name string `json:"name"`
+	// The description of the roadmap.
+	Description *string `json:"description"`
+	// The owner of the roadmap.
+	OwnerId *string `json:"ownerId,omitempty"`
+	// The sort order of the roadmap within the organization.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// The roadmap's color.
+	Color *string `json:"color"`
 }
 
-// findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam includes the requested fields of the GraphQL type Team.
+// GetId returns RoadmapCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *RoadmapCreateInput) GetId() string { return v.Id }
+
+// GetName returns RoadmapCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *RoadmapCreateInput) GetName() string { return v.Name }
+
+// GetDescription returns RoadmapCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *RoadmapCreateInput) GetDescription() *string { return v.Description }
+
+// GetOwnerId returns RoadmapCreateInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *RoadmapCreateInput) GetOwnerId() *string { return v.OwnerId }
+
+// GetSortOrder returns RoadmapCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *RoadmapCreateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// GetColor returns RoadmapCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *RoadmapCreateInput) GetColor() *string { return v.Color }
+
+// RoadmapOwnerUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
-// An organizational unit that contains issues.
-type findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam struct {
+// A user that has access to the the resources of an organization.
+type RoadmapOwnerUser struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
 }
 
-// GetId returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam.Id, and is useful for accessing the field via an interface.
-func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam) GetId() string {
-	return v.Id
-}
+// GetId returns RoadmapOwnerUser.Id, and is useful for accessing the field via an interface.
+func (v *RoadmapOwnerUser) GetId() string { return v.Id }
 
-// findWorkspaceLabelResponse is returned by findWorkspaceLabel on success.
-type findWorkspaceLabelResponse struct {
-	// All issue labels.
-	IssueLabels findWorkspaceLabelIssueLabelsIssueLabelConnection `json:"issueLabels"`
+type RoadmapToProjectCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The identifier of the project.
+	ProjectId string `json:"projectId"`
+	// The identifier of the roadmap.
+	RoadmapId string `json:"roadmapId"`
+	// The sort order for the project within its organization.
+	SortOrder float64 `json:"sortOrder"`
 }
 
-// GetIssueLabels returns findWorkspaceLabelResponse.IssueLabels, and is useful for accessing the field via an interface.
-func (v *findWorkspaceLabelResponse) GetIssueLabels() findWorkspaceLabelIssueLabelsIssueLabelConnection {
-	return v.IssueLabels
+// GetId returns RoadmapToProjectCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *RoadmapToProjectCreateInput) GetId() string { return v.Id }
+
+// GetProjectId returns RoadmapToProjectCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *RoadmapToProjectCreateInput) GetProjectId() string { return v.ProjectId }
+
+// GetRoadmapId returns RoadmapToProjectCreateInput.RoadmapId, and is useful for accessing the field via an interface.
+func (v *RoadmapToProjectCreateInput) GetRoadmapId() string { return v.RoadmapId }
+
+// GetSortOrder returns RoadmapToProjectCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *RoadmapToProjectCreateInput) GetSortOrder() float64 { return v.SortOrder }
+
+type RoadmapToProjectUpdateInput struct {
+	// The sort order for the project within its organization.
+	SortOrder float64 `json:"sortOrder"`
 }
 
-// getLabelIssueLabel includes the requested fields of the GraphQL type IssueLabel.
-// The GraphQL type's documentation follows.
-//
-// Labels that can be associated with issues.
-type getLabelIssueLabel struct {
-	IssueLabel `json:"-"`
+// GetSortOrder returns RoadmapToProjectUpdateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *RoadmapToProjectUpdateInput) GetSortOrder() float64 { return v.SortOrder }
+
+type RoadmapUpdateInput struct {
+	// The name of the roadmap.
+	Name string `json:"name,omitempty"`
+	// The description of the roadmap.
+	Description *string `json:"description"`
+	// The owner of the roadmap.
+	OwnerId *string `json:"ownerId,omitempty"`
+	// The sort order of the roadmap within the organization.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// The roadmap's color.
+	Color *string `json:"color"`
 }
 
-// GetId returns getLabelIssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetId() string { return v.IssueLabel.Id }
+// GetName returns RoadmapUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *RoadmapUpdateInput) GetName() string { return v.Name }
 
-// GetName returns getLabelIssueLabel.Name, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetName() string { return v.IssueLabel.Name }
+// GetDescription returns RoadmapUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *RoadmapUpdateInput) GetDescription() *string { return v.Description }
 
-// GetDescription returns getLabelIssueLabel.Description, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetDescription() *string { return v.IssueLabel.Description }
+// GetOwnerId returns RoadmapUpdateInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *RoadmapUpdateInput) GetOwnerId() *string { return v.OwnerId }
 
-// GetColor returns getLabelIssueLabel.Color, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetColor() *string { return v.IssueLabel.Color }
+// GetSortOrder returns RoadmapUpdateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *RoadmapUpdateInput) GetSortOrder() *float64 { return v.SortOrder }
 
-// GetParent returns getLabelIssueLabel.Parent, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetParent() *IssueLabelParentIssueLabel { return v.IssueLabel.Parent }
-
-// GetTeam returns getLabelIssueLabel.Team, and is useful for accessing the field via an interface.
-func (v *getLabelIssueLabel) GetTeam() *IssueLabelTeam { return v.IssueLabel.Team }
-
-func (v *getLabelIssueLabel) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*getLabelIssueLabel
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.getLabelIssueLabel = v
+// GetColor returns RoadmapUpdateInput.Color, and is useful for accessing the field via an interface.
+func (v *RoadmapUpdateInput) GetColor() *string { return v.Color }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// Which day count to use for SLA calculations.
+type SLADayCountType string
 
-	err = json.Unmarshal(
-		b, &v.IssueLabel)
-	if err != nil {
-		return err
-	}
-	return nil
-}
+const (
+	SLADayCountTypeAll              SLADayCountType = "all"
+	SLADayCountTypeOnlybusinessdays SLADayCountType = "onlyBusinessDays"
+)
 
-type __premarshalgetLabelIssueLabel struct {
+// Team includes the GraphQL fields of Team requested by the fragment Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type Team struct {
+	// The unique identifier of the entity.
 	Id string `json:"id"`
-
+	// The team's name.
 	Name string `json:"name"`
-
+	// The team's unique key. The key is used in URLs.
+	Key string `json:"key"`
+	// Whether the team is private or not.
+	Private bool `json:"private"`
+	// [INTERNAL] Whether new users should join this team by default.
+	JoinByDefault *bool `json:"joinByDefault"`
+	// The team's description.
 	Description *string `json:"description"`
-
+	// The icon of the team.
+	Icon *string `json:"icon"`
+	// The team's color.
 	Color *string `json:"color"`
-
-	Parent *IssueLabelParentIssueLabel `json:"parent"`
-
-	Team *IssueLabelTeam `json:"team"`
+	// The timezone of the team. Defaults to "America/Los_Angeles"
+	Timezone string `json:"timezone"`
+	// Whether issues without priority should be sorted first.
+	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
+	// Whether to group recent issue history entries.
+	GroupIssueHistory bool `json:"groupIssueHistory"`
+	// Where to move issues when changing state.
+	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+	// Period after which automatically closed and completed issues are automatically archived in months.
+	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+	// Period after which issues are automatically closed in months. Null/undefined means disabled.
+	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+	// Whether triage mode is enabled for the team or not.
+	TriageEnabled bool `json:"triageEnabled"`
+	// Whether an issue needs to have a priority set before leaving triage.
+	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
+	// Whether the team uses cycles.
+	CyclesEnabled bool `json:"cyclesEnabled"`
+	// The day of the week that a new cycle starts.
+	CycleStartDay float64 `json:"cycleStartDay"`
+	// The duration of a cycle in weeks.
+	CycleDuration float64 `json:"cycleDuration"`
+	// The cooldown time after each cycle in weeks.
+	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+	// How many upcoming cycles to create.
+	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+	// Auto assign started issues to current cycle.
+	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+	// Auto assign completed issues to current cycle.
+	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+	// Auto assign issues to current cycle if in active status.
+	CycleLockToActive bool `json:"cycleLockToActive"`
+	// The issue estimation type to use. Must be one of "notUsed", "exponential", "fibonacci", "linear", "tShirt".
+	IssueEstimationType string `json:"issueEstimationType"`
+	// Whether to allow zeros in issues estimates.
+	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+	// Whether to add additional points to the estimate scale.
+	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+	// What to use as an default estimate for unestimated issues.
+	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+	// The default template to use for new issues created by members of the team.
+	DefaultTemplateForMembers *TeamDefaultTemplateForMembersTemplate `json:"defaultTemplateForMembers"`
+	// The default template to use for new issues created by non-members of the team.
+	DefaultTemplateForNonMembers *TeamDefaultTemplateForNonMembersTemplate `json:"defaultTemplateForNonMembers"`
+	// The default template to use for new projects created for the team.
+	DefaultProjectTemplate *TeamDefaultProjectTemplate `json:"defaultProjectTemplate"`
 }
 
-func (v *getLabelIssueLabel) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+// GetId returns Team.Id, and is useful for accessing the field via an interface.
+func (v *Team) GetId() string { return v.Id }
 
-func (v *getLabelIssueLabel) __premarshalJSON() (*__premarshalgetLabelIssueLabel, error) {
-	var retval __premarshalgetLabelIssueLabel
+// GetName returns Team.Name, and is useful for accessing the field via an interface.
+func (v *Team) GetName() string { return v.Name }
 
-	retval.Id = v.IssueLabel.Id
-	retval.Name = v.IssueLabel.Name
-	retval.Description = v.IssueLabel.Description
-	retval.Color = v.IssueLabel.Color
-	retval.Parent = v.IssueLabel.Parent
-	retval.Team = v.IssueLabel.Team
-	return &retval, nil
-}
+// GetKey returns Team.Key, and is useful for accessing the field via an interface.
+func (v *Team) GetKey() string { return v.Key }
 
-// getLabelResponse is returned by getLabel on success.
-type getLabelResponse struct {
-	// One specific label.
-	IssueLabel getLabelIssueLabel `json:"issueLabel"`
-}
+// GetPrivate returns Team.Private, and is useful for accessing the field via an interface.
+func (v *Team) GetPrivate() bool { return v.Private }
 
-// GetIssueLabel returns getLabelResponse.IssueLabel, and is useful for accessing the field via an interface.
-func (v *getLabelResponse) GetIssueLabel() getLabelIssueLabel { return v.IssueLabel }
+// GetJoinByDefault returns Team.JoinByDefault, and is useful for accessing the field via an interface.
+func (v *Team) GetJoinByDefault() *bool { return v.JoinByDefault }
 
-// getTeamResponse is returned by getTeam on success.
-type getTeamResponse struct {
-	// One specific team.
-	Team getTeamTeam `json:"team"`
-}
+// GetDescription returns Team.Description, and is useful for accessing the field via an interface.
+func (v *Team) GetDescription() *string { return v.Description }
 
-// GetTeam returns getTeamResponse.Team, and is useful for accessing the field via an interface.
-func (v *getTeamResponse) GetTeam() getTeamTeam { return v.Team }
+// GetIcon returns Team.Icon, and is useful for accessing the field via an interface.
+func (v *Team) GetIcon() *string { return v.Icon }
 
-// getTeamTeam includes the requested fields of the GraphQL type Team.
-// The GraphQL type's documentation follows.
-//
-// An organizational unit that contains issues.
-type getTeamTeam struct {
-	Team `json:"-"`
-}
+// GetColor returns Team.Color, and is useful for accessing the field via an interface.
+func (v *Team) GetColor() *string { return v.Color }
 
-// GetId returns getTeamTeam.Id, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetId() string { return v.Team.Id }
+// GetTimezone returns Team.Timezone, and is useful for accessing the field via an interface.
+func (v *Team) GetTimezone() string { return v.Timezone }
 
-// GetName returns getTeamTeam.Name, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetName() string { return v.Team.Name }
+// GetIssueOrderingNoPriorityFirst returns Team.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
+func (v *Team) GetIssueOrderingNoPriorityFirst() bool { return v.IssueOrderingNoPriorityFirst }
 
-// GetKey returns getTeamTeam.Key, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetKey() string { return v.Team.Key }
+// GetGroupIssueHistory returns Team.GroupIssueHistory, and is useful for accessing the field via an interface.
+func (v *Team) GetGroupIssueHistory() bool { return v.GroupIssueHistory }
 
-// GetPrivate returns getTeamTeam.Private, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetPrivate() bool { return v.Team.Private }
+// GetSetIssueSortOrderOnStateChange returns Team.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
+func (v *Team) GetSetIssueSortOrderOnStateChange() string { return v.SetIssueSortOrderOnStateChange }
 
-// GetDescription returns getTeamTeam.Description, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetDescription() *string { return v.Team.Description }
+// GetAutoArchivePeriod returns Team.AutoArchivePeriod, and is useful for accessing the field via an interface.
+func (v *Team) GetAutoArchivePeriod() float64 { return v.AutoArchivePeriod }
 
-// GetIcon returns getTeamTeam.Icon, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetIcon() *string { return v.Team.Icon }
+// GetAutoClosePeriod returns Team.AutoClosePeriod, and is useful for accessing the field via an interface.
+func (v *Team) GetAutoClosePeriod() *float64 { return v.AutoClosePeriod }
 
-// GetColor returns getTeamTeam.Color, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetColor() *string { return v.Team.Color }
+// GetTriageEnabled returns Team.TriageEnabled, and is useful for accessing the field via an interface.
+func (v *Team) GetTriageEnabled() bool { return v.TriageEnabled }
 
-// GetTimezone returns getTeamTeam.Timezone, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetTimezone() string { return v.Team.Timezone }
+// GetRequirePriorityToLeaveTriage returns Team.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
+func (v *Team) GetRequirePriorityToLeaveTriage() bool { return v.RequirePriorityToLeaveTriage }
 
-// GetIssueOrderingNoPriorityFirst returns getTeamTeam.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetIssueOrderingNoPriorityFirst() bool {
-	return v.Team.IssueOrderingNoPriorityFirst
-}
+// GetCyclesEnabled returns Team.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *Team) GetCyclesEnabled() bool { return v.CyclesEnabled }
 
-// GetGroupIssueHistory returns getTeamTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetGroupIssueHistory() bool { return v.Team.GroupIssueHistory }
+// GetCycleStartDay returns Team.CycleStartDay, and is useful for accessing the field via an interface.
+func (v *Team) GetCycleStartDay() float64 { return v.CycleStartDay }
 
-// GetSetIssueSortOrderOnStateChange returns getTeamTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetSetIssueSortOrderOnStateChange() string {
-	return v.Team.SetIssueSortOrderOnStateChange
-}
+// GetCycleDuration returns Team.CycleDuration, and is useful for accessing the field via an interface.
+func (v *Team) GetCycleDuration() float64 { return v.CycleDuration }
 
-// GetAutoArchivePeriod returns getTeamTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetAutoArchivePeriod() float64 { return v.Team.AutoArchivePeriod }
+// GetCycleCooldownTime returns Team.CycleCooldownTime, and is useful for accessing the field via an interface.
+func (v *Team) GetCycleCooldownTime() float64 { return v.CycleCooldownTime }
 
-// GetAutoClosePeriod returns getTeamTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetAutoClosePeriod() *float64 { return v.Team.AutoClosePeriod }
+// GetUpcomingCycleCount returns Team.UpcomingCycleCount, and is useful for accessing the field via an interface.
+func (v *Team) GetUpcomingCycleCount() float64 { return v.UpcomingCycleCount }
 
-// GetTriageEnabled returns getTeamTeam.TriageEnabled, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
+// GetCycleIssueAutoAssignStarted returns Team.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
+func (v *Team) GetCycleIssueAutoAssignStarted() bool { return v.CycleIssueAutoAssignStarted }
 
-// GetCyclesEnabled returns getTeamTeam.CyclesEnabled, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
+// GetCycleIssueAutoAssignCompleted returns Team.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
+func (v *Team) GetCycleIssueAutoAssignCompleted() bool { return v.CycleIssueAutoAssignCompleted }
 
-// GetCycleStartDay returns getTeamTeam.CycleStartDay, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
+// GetCycleLockToActive returns Team.CycleLockToActive, and is useful for accessing the field via an interface.
+func (v *Team) GetCycleLockToActive() bool { return v.CycleLockToActive }
 
-// GetCycleDuration returns getTeamTeam.CycleDuration, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
+// GetIssueEstimationType returns Team.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *Team) GetIssueEstimationType() string { return v.IssueEstimationType }
 
-// GetCycleCooldownTime returns getTeamTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleCooldownTime() float64 { return v.Team.CycleCooldownTime }
+// GetIssueEstimationAllowZero returns Team.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
+func (v *Team) GetIssueEstimationAllowZero() bool { return v.IssueEstimationAllowZero }
 
-// GetUpcomingCycleCount returns getTeamTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetUpcomingCycleCount() float64 { return v.Team.UpcomingCycleCount }
+// GetIssueEstimationExtended returns Team.IssueEstimationExtended, and is useful for accessing the field via an interface.
+func (v *Team) GetIssueEstimationExtended() bool { return v.IssueEstimationExtended }
 
-// GetCycleIssueAutoAssignStarted returns getTeamTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleIssueAutoAssignStarted() bool {
-	return v.Team.CycleIssueAutoAssignStarted
-}
+// GetDefaultIssueEstimate returns Team.DefaultIssueEstimate, and is useful for accessing the field via an interface.
+func (v *Team) GetDefaultIssueEstimate() float64 { return v.DefaultIssueEstimate }
 
-// GetCycleIssueAutoAssignCompleted returns getTeamTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleIssueAutoAssignCompleted() bool {
-	return v.Team.CycleIssueAutoAssignCompleted
+// GetDefaultTemplateForMembers returns Team.DefaultTemplateForMembers, and is useful for accessing the field via an interface.
+func (v *Team) GetDefaultTemplateForMembers() *TeamDefaultTemplateForMembersTemplate {
+	return v.DefaultTemplateForMembers
 }
 
-// GetCycleLockToActive returns getTeamTeam.CycleLockToActive, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetCycleLockToActive() bool { return v.Team.CycleLockToActive }
-
-// GetIssueEstimationType returns getTeamTeam.IssueEstimationType, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetIssueEstimationType() string { return v.Team.IssueEstimationType }
-
-// GetIssueEstimationAllowZero returns getTeamTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetIssueEstimationAllowZero() bool { return v.Team.IssueEstimationAllowZero }
-
-// GetIssueEstimationExtended returns getTeamTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetIssueEstimationExtended() bool { return v.Team.IssueEstimationExtended }
-
-// GetDefaultIssueEstimate returns getTeamTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
-func (v *getTeamTeam) GetDefaultIssueEstimate() float64 { return v.Team.DefaultIssueEstimate }
-
-func (v *getTeamTeam) UnmarshalJSON(b []byte) error {
+// GetDefaultTemplateForNonMembers returns Team.DefaultTemplateForNonMembers, and is useful for accessing the field via an interface.
+func (v *Team) GetDefaultTemplateForNonMembers() *TeamDefaultTemplateForNonMembersTemplate {
+	return v.DefaultTemplateForNonMembers
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetDefaultProjectTemplate returns Team.DefaultProjectTemplate, and is useful for accessing the field via an interface.
+func (v *Team) GetDefaultProjectTemplate() *TeamDefaultProjectTemplate {
+	return v.DefaultProjectTemplate
+}
 
-	var firstPass struct {
-		*getTeamTeam
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.getTeamTeam = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
-
-	err = json.Unmarshal(
-		b, &v.Team)
-	if err != nil {
-		return err
-	}
-	return nil
+type TeamCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The name of the team.
+	Name string `json:"name"`
+	// The description of the team.
+	Description *string `json:"description"`
+	// The key of the team. If not given, the key will be generated based on the name of the team.
+	Key string `json:"key"`
+	// The icon of the team.
+	Icon *string `json:"icon,omitempty"`
+	// The color of the team.
+	Color *string `json:"color,omitempty"`
+	// The organization associated with the team.
+	OrganizationId string `json:"organizationId,omitempty"`
+	// Whether the team uses cycles.
+	CyclesEnabled bool `json:"cyclesEnabled"`
+	// The day of the week that a new cycle starts.
+	CycleStartDay float64 `json:"cycleStartDay"`
+	// The duration of each cycle in weeks.
+	CycleDuration int `json:"cycleDuration"`
+	// The cooldown time after each cycle in weeks.
+	CycleCooldownTime int `json:"cycleCooldownTime"`
+	// Auto assign started issues to current active cycle setting.
+	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+	// Auto assign completed issues to current active cycle setting.
+	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+	// Only allow issues issues with cycles in Active Issues.
+	CycleLockToActive bool `json:"cycleLockToActive"`
+	// How many upcoming cycles to create.
+	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+	// Whether triage mode is enabled for the team.
+	TriageEnabled bool `json:"triageEnabled"`
+	// Whether an issue needs to have a priority set before leaving triage.
+	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
+	// The timezone of the team.
+	Timezone string `json:"timezone"`
+	// Whether issues without priority should be sorted first.
+	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
+	// The issue estimation type to use. Must be one of "notUsed", "exponential", "fibonacci", "linear", "tShirt".
+	IssueEstimationType string `json:"issueEstimationType"`
+	// Whether to allow zeros in issues estimates.
+	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+	// Whether to move issues to bottom of the column when changing state.
+	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+	// Whether to add additional points to the estimate scale.
+	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+	// What to use as an default estimate for unestimated issues.
+	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+	// Whether to group recent issue history entries.
+	GroupIssueHistory bool `json:"groupIssueHistory"`
+	// The identifier of the default template for members of this team.
+	DefaultTemplateForMembersId string `json:"defaultTemplateForMembersId,omitempty"`
+	// The identifier of the default template for non-members of this team.
+	DefaultTemplateForNonMembersId string `json:"defaultTemplateForNonMembersId,omitempty"`
+	// The identifier of the default project template of this team.
+	DefaultProjectTemplateId string `json:"defaultProjectTemplateId,omitempty"`
+	// Internal. Whether the team is private or not.
+	Private bool `json:"private"`
+	// Period after which issues are automatically closed, in months.
+	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+	// The canceled workflow state which auto closed issues will be set to.
+	AutoCloseStateId string `json:"autoCloseStateId,omitempty"`
+	// Period after which closed and completed issues are automatically archived, in months. 0 means disabled.
+	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+	// The workflow state into which issues are moved when they are marked as a duplicate of another issue.
+	MarkedAsDuplicateWorkflowStateId string `json:"markedAsDuplicateWorkflowStateId,omitempty"`
 }
 
-type __premarshalgetTeamTeam struct {
-	Id string `json:"id"`
+// GetId returns TeamCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetId() string { return v.Id }
 
-	Name string `json:"name"`
+// GetName returns TeamCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetName() string { return v.Name }
 
-	Key string `json:"key"`
+// GetDescription returns TeamCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetDescription() *string { return v.Description }
 
-	Private bool `json:"private"`
+// GetKey returns TeamCreateInput.Key, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetKey() string { return v.Key }
 
-	Description *string `json:"description"`
+// GetIcon returns TeamCreateInput.Icon, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetIcon() *string { return v.Icon }
 
-	Icon *string `json:"icon"`
+// GetColor returns TeamCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetColor() *string { return v.Color }
 
-	Color *string `json:"color"`
+// GetOrganizationId returns TeamCreateInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetOrganizationId() string { return v.OrganizationId }
 
-	Timezone string `json:"timezone"`
+// GetCyclesEnabled returns TeamCreateInput.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetCyclesEnabled() bool { return v.CyclesEnabled }
 
-	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
+// GetCycleStartDay returns TeamCreateInput.CycleStartDay, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetCycleStartDay() float64 { return v.CycleStartDay }
 
-	GroupIssueHistory bool `json:"groupIssueHistory"`
+// GetCycleDuration returns TeamCreateInput.CycleDuration, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetCycleDuration() int { return v.CycleDuration }
 
-	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+// GetCycleCooldownTime returns TeamCreateInput.CycleCooldownTime, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetCycleCooldownTime() int { return v.CycleCooldownTime }
 
-	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+// GetCycleIssueAutoAssignStarted returns TeamCreateInput.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetCycleIssueAutoAssignStarted() bool { return v.CycleIssueAutoAssignStarted }
 
-	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+// GetCycleIssueAutoAssignCompleted returns TeamCreateInput.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetCycleIssueAutoAssignCompleted() bool {
+	return v.CycleIssueAutoAssignCompleted
+}
 
-	TriageEnabled bool `json:"triageEnabled"`
+// GetCycleLockToActive returns TeamCreateInput.CycleLockToActive, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetCycleLockToActive() bool { return v.CycleLockToActive }
 
-	CyclesEnabled bool `json:"cyclesEnabled"`
+// GetUpcomingCycleCount returns TeamCreateInput.UpcomingCycleCount, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetUpcomingCycleCount() float64 { return v.UpcomingCycleCount }
 
-	CycleStartDay float64 `json:"cycleStartDay"`
+// GetTriageEnabled returns TeamCreateInput.TriageEnabled, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetTriageEnabled() bool { return v.TriageEnabled }
 
-	CycleDuration float64 `json:"cycleDuration"`
+// GetRequirePriorityToLeaveTriage returns TeamCreateInput.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetRequirePriorityToLeaveTriage() bool {
+	return v.RequirePriorityToLeaveTriage
+}
 
-	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+// GetTimezone returns TeamCreateInput.Timezone, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetTimezone() string { return v.Timezone }
 
-	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+// GetIssueOrderingNoPriorityFirst returns TeamCreateInput.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetIssueOrderingNoPriorityFirst() bool {
+	return v.IssueOrderingNoPriorityFirst
+}
 
-	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+// GetIssueEstimationType returns TeamCreateInput.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetIssueEstimationType() string { return v.IssueEstimationType }
 
-	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+// GetIssueEstimationAllowZero returns TeamCreateInput.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetIssueEstimationAllowZero() bool { return v.IssueEstimationAllowZero }
 
-	CycleLockToActive bool `json:"cycleLockToActive"`
+// GetSetIssueSortOrderOnStateChange returns TeamCreateInput.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetSetIssueSortOrderOnStateChange() string {
+	return v.SetIssueSortOrderOnStateChange
+}
 
-	IssueEstimationType string `json:"issueEstimationType"`
+// GetIssueEstimationExtended returns TeamCreateInput.IssueEstimationExtended, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetIssueEstimationExtended() bool { return v.IssueEstimationExtended }
 
-	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+// GetDefaultIssueEstimate returns TeamCreateInput.DefaultIssueEstimate, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetDefaultIssueEstimate() float64 { return v.DefaultIssueEstimate }
 
-	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+// GetGroupIssueHistory returns TeamCreateInput.GroupIssueHistory, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetGroupIssueHistory() bool { return v.GroupIssueHistory }
 
-	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+// GetDefaultTemplateForMembersId returns TeamCreateInput.DefaultTemplateForMembersId, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetDefaultTemplateForMembersId() string {
+	return v.DefaultTemplateForMembersId
 }
 
-func (v *getTeamTeam) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetDefaultTemplateForNonMembersId returns TeamCreateInput.DefaultTemplateForNonMembersId, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetDefaultTemplateForNonMembersId() string {
+	return v.DefaultTemplateForNonMembersId
 }
 
-func (v *getTeamTeam) __premarshalJSON() (*__premarshalgetTeamTeam, error) {
-	var retval __premarshalgetTeamTeam
+// GetDefaultProjectTemplateId returns TeamCreateInput.DefaultProjectTemplateId, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetDefaultProjectTemplateId() string { return v.DefaultProjectTemplateId }
 
-	retval.Id = v.Team.Id
-	retval.Name = v.Team.Name
-	retval.Key = v.Team.Key
-	retval.Private = v.Team.Private
-	retval.Description = v.Team.Description
-	retval.Icon = v.Team.Icon
-	retval.Color = v.Team.Color
-	retval.Timezone = v.Team.Timezone
-	retval.IssueOrderingNoPriorityFirst = v.Team.IssueOrderingNoPriorityFirst
-	retval.GroupIssueHistory = v.Team.GroupIssueHistory
-	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
-	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
-	retval.AutoClosePeriod = v.Team.AutoClosePeriod
-	retval.TriageEnabled = v.Team.TriageEnabled
-	retval.CyclesEnabled = v.Team.CyclesEnabled
-	retval.CycleStartDay = v.Team.CycleStartDay
-	retval.CycleDuration = v.Team.CycleDuration
-	retval.CycleCooldownTime = v.Team.CycleCooldownTime
-	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
-	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
-	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
-	retval.CycleLockToActive = v.Team.CycleLockToActive
-	retval.IssueEstimationType = v.Team.IssueEstimationType
-	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
-	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
-	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
-	return &retval, nil
-}
+// GetPrivate returns TeamCreateInput.Private, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetPrivate() bool { return v.Private }
 
-// getTeamWorkflowResponse is returned by getTeamWorkflow on success.
-type getTeamWorkflowResponse struct {
-	// One specific team.
-	Team getTeamWorkflowTeam `json:"team"`
-}
+// GetAutoClosePeriod returns TeamCreateInput.AutoClosePeriod, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetAutoClosePeriod() *float64 { return v.AutoClosePeriod }
 
-// GetTeam returns getTeamWorkflowResponse.Team, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowResponse) GetTeam() getTeamWorkflowTeam { return v.Team }
+// GetAutoCloseStateId returns TeamCreateInput.AutoCloseStateId, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetAutoCloseStateId() string { return v.AutoCloseStateId }
 
-// getTeamWorkflowStatesResponse is returned by getTeamWorkflowStates on success.
-type getTeamWorkflowStatesResponse struct {
-	// All issue workflow states.
-	WorkflowStates getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection `json:"workflowStates"`
-}
+// GetAutoArchivePeriod returns TeamCreateInput.AutoArchivePeriod, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetAutoArchivePeriod() float64 { return v.AutoArchivePeriod }
 
-// GetWorkflowStates returns getTeamWorkflowStatesResponse.WorkflowStates, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesResponse) GetWorkflowStates() getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection {
-	return v.WorkflowStates
+// GetMarkedAsDuplicateWorkflowStateId returns TeamCreateInput.MarkedAsDuplicateWorkflowStateId, and is useful for accessing the field via an interface.
+func (v *TeamCreateInput) GetMarkedAsDuplicateWorkflowStateId() string {
+	return v.MarkedAsDuplicateWorkflowStateId
 }
 
-// getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection includes the requested fields of the GraphQL type WorkflowStateConnection.
-type getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection struct {
-	Nodes []getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState `json:"nodes"`
+// TeamDefaultProjectTemplate includes the requested fields of the GraphQL type Template.
+// The GraphQL type's documentation follows.
+//
+// A template object used for creating entities faster.
+type TeamDefaultProjectTemplate struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetNodes returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection) GetNodes() []getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
-	return v.Nodes
-}
+// GetId returns TeamDefaultProjectTemplate.Id, and is useful for accessing the field via an interface.
+func (v *TeamDefaultProjectTemplate) GetId() string { return v.Id }
 
-// getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// TeamDefaultTemplateForMembersTemplate includes the requested fields of the GraphQL type Template.
 // The GraphQL type's documentation follows.
 //
-// A state in a team workflow.
-type getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
-	WorkflowState `json:"-"`
+// A template object used for creating entities faster.
+type TeamDefaultTemplateForMembersTemplate struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetId returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetId() string {
-	return v.WorkflowState.Id
-}
+// GetId returns TeamDefaultTemplateForMembersTemplate.Id, and is useful for accessing the field via an interface.
+func (v *TeamDefaultTemplateForMembersTemplate) GetId() string { return v.Id }
 
-// GetName returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Name, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetName() string {
-	return v.WorkflowState.Name
+// TeamDefaultTemplateForNonMembersTemplate includes the requested fields of the GraphQL type Template.
+// The GraphQL type's documentation follows.
+//
+// A template object used for creating entities faster.
+type TeamDefaultTemplateForNonMembersTemplate struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetColor returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Color, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetColor() string {
-	return v.WorkflowState.Color
-}
+// GetId returns TeamDefaultTemplateForNonMembersTemplate.Id, and is useful for accessing the field via an interface.
+func (v *TeamDefaultTemplateForNonMembersTemplate) GetId() string { return v.Id }
 
-// GetDescription returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Description, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetDescription() *string {
-	return v.WorkflowState.Description
+// TeamMembership includes the GraphQL fields of TeamMembership requested by the fragment TeamMembership.
+// The GraphQL type's documentation follows.
+//
+// Defines the membership of a user to a team.
+type TeamMembership struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Whether the user is the owner of the team.
+	Owner bool `json:"owner"`
+	// The team that the membership is associated with.
+	Team TeamMembershipTeam `json:"team"`
+	// The user that the membership is associated with.
+	User TeamMembershipUser `json:"user"`
 }
 
-// GetType returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Type, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetType() string {
-	return v.WorkflowState.Type
+// GetId returns TeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *TeamMembership) GetId() string { return v.Id }
+
+// GetOwner returns TeamMembership.Owner, and is useful for accessing the field via an interface.
+func (v *TeamMembership) GetOwner() bool { return v.Owner }
+
+// GetTeam returns TeamMembership.Team, and is useful for accessing the field via an interface.
+func (v *TeamMembership) GetTeam() TeamMembershipTeam { return v.Team }
+
+// GetUser returns TeamMembership.User, and is useful for accessing the field via an interface.
+func (v *TeamMembership) GetUser() TeamMembershipUser { return v.User }
+
+// TeamMembershipTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type TeamMembershipTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetPosition returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Position, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetPosition() float64 {
-	return v.WorkflowState.Position
+// GetId returns TeamMembershipTeam.Id, and is useful for accessing the field via an interface.
+func (v *TeamMembershipTeam) GetId() string { return v.Id }
+
+// TeamMembershipUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type TeamMembershipUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetTeam returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Team, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetTeam() WorkflowStateTeam {
-	return v.WorkflowState.Team
+// GetId returns TeamMembershipUser.Id, and is useful for accessing the field via an interface.
+func (v *TeamMembershipUser) GetId() string { return v.Id }
+
+// TeamNotificationSubscription includes the GraphQL fields of Team requested by the fragment TeamNotificationSubscription.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type TeamNotificationSubscription struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Whether to send new issue notifications to Slack.
+	SlackNewIssue bool `json:"slackNewIssue"`
+	// Whether to send new issue comment notifications to Slack.
+	SlackIssueComments bool `json:"slackIssueComments"`
+	// Whether to send new issue status updates to Slack.
+	SlackIssueStatuses bool `json:"slackIssueStatuses"`
 }
 
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) UnmarshalJSON(b []byte) error {
+// GetId returns TeamNotificationSubscription.Id, and is useful for accessing the field via an interface.
+func (v *TeamNotificationSubscription) GetId() string { return v.Id }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetSlackNewIssue returns TeamNotificationSubscription.SlackNewIssue, and is useful for accessing the field via an interface.
+func (v *TeamNotificationSubscription) GetSlackNewIssue() bool { return v.SlackNewIssue }
 
-	var firstPass struct {
-		*getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState = v
+// GetSlackIssueComments returns TeamNotificationSubscription.SlackIssueComments, and is useful for accessing the field via an interface.
+func (v *TeamNotificationSubscription) GetSlackIssueComments() bool { return v.SlackIssueComments }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetSlackIssueStatuses returns TeamNotificationSubscription.SlackIssueStatuses, and is useful for accessing the field via an interface.
+func (v *TeamNotificationSubscription) GetSlackIssueStatuses() bool { return v.SlackIssueStatuses }
 
-	err = json.Unmarshal(
-		b, &v.WorkflowState)
-	if err != nil {
-		return err
-	}
-	return nil
+type TeamUpdateInput struct {
+	// The name of the team.
+	Name string `json:"name,omitempty"`
+	// The description of the team.
+	Description *string `json:"description"`
+	// The key of the team.
+	Key string `json:"key,omitempty"`
+	// The icon of the team.
+	Icon *string `json:"icon,omitempty"`
+	// The color of the team.
+	Color *string `json:"color,omitempty"`
+	// Whether the team uses cycles.
+	CyclesEnabled bool `json:"cyclesEnabled"`
+	// The day of the week that a new cycle starts.
+	CycleStartDay float64 `json:"cycleStartDay"`
+	// The duration of each cycle in weeks.
+	CycleDuration int `json:"cycleDuration"`
+	// The cooldown time after each cycle in weeks.
+	CycleCooldownTime int `json:"cycleCooldownTime"`
+	// Auto assign started issues to current active cycle setting.
+	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+	// Auto assign completed issues to current active cycle setting.
+	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+	// Only allow issues with cycles in Active Issues.
+	CycleLockToActive bool `json:"cycleLockToActive"`
+	// [DEPRECATED] Whether the first cycle should start in the current or the next week.
+	CycleEnabledStartWeek string `json:"cycleEnabledStartWeek,omitempty"`
+	// The date to begin cycles on.
+	CycleEnabledStartDate time.Time `json:"cycleEnabledStartDate"`
+	// How many upcoming cycles to create.
+	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+	// The timezone of the team.
+	Timezone string `json:"timezone"`
+	// Whether issues without priority should be sorted first.
+	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
+	// The issue estimation type to use. Must be one of "notUsed", "exponential", "fibonacci", "linear", "tShirt".
+	IssueEstimationType string `json:"issueEstimationType"`
+	// Whether to allow zeros in issues estimates.
+	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+	// Whether to move issues to bottom of the column when changing state.
+	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+	// Whether to add additional points to the estimate scale.
+	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+	// What to use as an default estimate for unestimated issues.
+	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+	// The workflow state into which issues are moved when a draft PR has been opened.
+	DraftWorkflowStateId string `json:"draftWorkflowStateId,omitempty"`
+	// The workflow state into which issues are moved when a PR has been opened.
+	StartWorkflowStateId string `json:"startWorkflowStateId,omitempty"`
+	// The workflow state into which issues are moved when a review has been requested for the PR.
+	ReviewWorkflowStateId string `json:"reviewWorkflowStateId,omitempty"`
+	// The workflow state into which issues are moved when a PR is ready to be merged.
+	MergeableWorkflowStateId string `json:"mergeableWorkflowStateId,omitempty"`
+	// The workflow state into which issues are moved when a PR has been merged.
+	MergeWorkflowStateId string `json:"mergeWorkflowStateId,omitempty"`
+	// Whether to send new issue notifications to Slack.
+	SlackNewIssue *bool `json:"slackNewIssue,omitempty"`
+	// Whether to send new issue comment notifications to Slack.
+	SlackIssueComments *bool `json:"slackIssueComments,omitempty"`
+	// Whether to send issue status update notifications to Slack.
+	SlackIssueStatuses *bool `json:"slackIssueStatuses,omitempty"`
+	// Whether to group recent issue history entries.
+	GroupIssueHistory bool `json:"groupIssueHistory"`
+	// The identifier of the default template for members of this team.
+	DefaultTemplateForMembersId string `json:"defaultTemplateForMembersId,omitempty"`
+	// The identifier of the default template for non-members of this team.
+	DefaultTemplateForNonMembersId string `json:"defaultTemplateForNonMembersId,omitempty"`
+	// The identifier of the default project template of this team.
+	DefaultProjectTemplateId string `json:"defaultProjectTemplateId,omitempty"`
+	// Whether the team is private or not.
+	Private bool `json:"private"`
+	// Whether triage mode is enabled for the team.
+	TriageEnabled bool `json:"triageEnabled"`
+	// Whether an issue needs to have a priority set before leaving triage.
+	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
+	// Default status for newly created issues.
+	DefaultIssueStateId string `json:"defaultIssueStateId,omitempty"`
+	// Period after which issues are automatically closed, in months.
+	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+	// The canceled workflow state which auto closed issues will be set to.
+	AutoCloseStateId string `json:"autoCloseStateId,omitempty"`
+	// Period after which closed and completed issues are automatically archived, in months.
+	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+	// The workflow state into which issues are moved when they are marked as a duplicate of another issue.
+	MarkedAsDuplicateWorkflowStateId string `json:"markedAsDuplicateWorkflowStateId,omitempty"`
+	// Whether new users should join this team by default. Mutation restricted to workspace admins!
+	JoinByDefault *bool `json:"joinByDefault,omitempty"`
+	// Whether the team is managed by SCIM integration. Mutation restricted to workspace admins and only unsetting is allowed!
+	ScimManaged bool `json:"scimManaged,omitempty"`
 }
 
-type __premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
-	Id string `json:"id"`
+// GetName returns TeamUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetName() string { return v.Name }
 
-	Name string `json:"name"`
+// GetDescription returns TeamUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetDescription() *string { return v.Description }
 
-	Color string `json:"color"`
+// GetKey returns TeamUpdateInput.Key, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetKey() string { return v.Key }
 
-	Description *string `json:"description"`
+// GetIcon returns TeamUpdateInput.Icon, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetIcon() *string { return v.Icon }
 
-	Type string `json:"type"`
+// GetColor returns TeamUpdateInput.Color, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetColor() *string { return v.Color }
 
-	Position float64 `json:"position"`
+// GetCyclesEnabled returns TeamUpdateInput.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetCyclesEnabled() bool { return v.CyclesEnabled }
 
-	Team WorkflowStateTeam `json:"team"`
-}
+// GetCycleStartDay returns TeamUpdateInput.CycleStartDay, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetCycleStartDay() float64 { return v.CycleStartDay }
 
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+// GetCycleDuration returns TeamUpdateInput.CycleDuration, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetCycleDuration() int { return v.CycleDuration }
 
-func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) __premarshalJSON() (*__premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, error) {
-	var retval __premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+// GetCycleCooldownTime returns TeamUpdateInput.CycleCooldownTime, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetCycleCooldownTime() int { return v.CycleCooldownTime }
 
-	retval.Id = v.WorkflowState.Id
-	retval.Name = v.WorkflowState.Name
-	retval.Color = v.WorkflowState.Color
-	retval.Description = v.WorkflowState.Description
-	retval.Type = v.WorkflowState.Type
-	retval.Position = v.WorkflowState.Position
-	retval.Team = v.WorkflowState.Team
-	return &retval, nil
-}
+// GetCycleIssueAutoAssignStarted returns TeamUpdateInput.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetCycleIssueAutoAssignStarted() bool { return v.CycleIssueAutoAssignStarted }
 
-// getTeamWorkflowTeam includes the requested fields of the GraphQL type Team.
-// The GraphQL type's documentation follows.
-//
-// An organizational unit that contains issues.
-type getTeamWorkflowTeam struct {
-	TeamWorkflow `json:"-"`
+// GetCycleIssueAutoAssignCompleted returns TeamUpdateInput.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetCycleIssueAutoAssignCompleted() bool {
+	return v.CycleIssueAutoAssignCompleted
 }
 
-// GetId returns getTeamWorkflowTeam.Id, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowTeam) GetId() string { return v.TeamWorkflow.Id }
+// GetCycleLockToActive returns TeamUpdateInput.CycleLockToActive, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetCycleLockToActive() bool { return v.CycleLockToActive }
 
-// GetKey returns getTeamWorkflowTeam.Key, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowTeam) GetKey() string { return v.TeamWorkflow.Key }
+// GetCycleEnabledStartWeek returns TeamUpdateInput.CycleEnabledStartWeek, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetCycleEnabledStartWeek() string { return v.CycleEnabledStartWeek }
 
-// GetDraftWorkflowState returns getTeamWorkflowTeam.DraftWorkflowState, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowTeam) GetDraftWorkflowState() *TeamWorkflowDraftWorkflowState {
-	return v.TeamWorkflow.DraftWorkflowState
-}
+// GetCycleEnabledStartDate returns TeamUpdateInput.CycleEnabledStartDate, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetCycleEnabledStartDate() time.Time { return v.CycleEnabledStartDate }
 
-// GetStartWorkflowState returns getTeamWorkflowTeam.StartWorkflowState, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowTeam) GetStartWorkflowState() *TeamWorkflowStartWorkflowState {
-	return v.TeamWorkflow.StartWorkflowState
-}
+// GetUpcomingCycleCount returns TeamUpdateInput.UpcomingCycleCount, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetUpcomingCycleCount() float64 { return v.UpcomingCycleCount }
 
-// GetReviewWorkflowState returns getTeamWorkflowTeam.ReviewWorkflowState, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowTeam) GetReviewWorkflowState() *TeamWorkflowReviewWorkflowState {
-	return v.TeamWorkflow.ReviewWorkflowState
-}
+// GetTimezone returns TeamUpdateInput.Timezone, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetTimezone() string { return v.Timezone }
 
-// GetMergeWorkflowState returns getTeamWorkflowTeam.MergeWorkflowState, and is useful for accessing the field via an interface.
-func (v *getTeamWorkflowTeam) GetMergeWorkflowState() *TeamWorkflowMergeWorkflowState {
-	return v.TeamWorkflow.MergeWorkflowState
+// GetIssueOrderingNoPriorityFirst returns TeamUpdateInput.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetIssueOrderingNoPriorityFirst() bool {
+	return v.IssueOrderingNoPriorityFirst
 }
 
-func (v *getTeamWorkflowTeam) UnmarshalJSON(b []byte) error {
+// GetIssueEstimationType returns TeamUpdateInput.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetIssueEstimationType() string { return v.IssueEstimationType }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetIssueEstimationAllowZero returns TeamUpdateInput.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetIssueEstimationAllowZero() bool { return v.IssueEstimationAllowZero }
 
-	var firstPass struct {
-		*getTeamWorkflowTeam
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.getTeamWorkflowTeam = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
-
-	err = json.Unmarshal(
-		b, &v.TeamWorkflow)
-	if err != nil {
-		return err
-	}
-	return nil
+// GetSetIssueSortOrderOnStateChange returns TeamUpdateInput.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetSetIssueSortOrderOnStateChange() string {
+	return v.SetIssueSortOrderOnStateChange
 }
 
-type __premarshalgetTeamWorkflowTeam struct {
-	Id string `json:"id"`
+// GetIssueEstimationExtended returns TeamUpdateInput.IssueEstimationExtended, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetIssueEstimationExtended() bool { return v.IssueEstimationExtended }
 
-	Key string `json:"key"`
+// GetDefaultIssueEstimate returns TeamUpdateInput.DefaultIssueEstimate, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetDefaultIssueEstimate() float64 { return v.DefaultIssueEstimate }
 
-	DraftWorkflowState *TeamWorkflowDraftWorkflowState `json:"draftWorkflowState"`
+// GetDraftWorkflowStateId returns TeamUpdateInput.DraftWorkflowStateId, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetDraftWorkflowStateId() string { return v.DraftWorkflowStateId }
 
-	StartWorkflowState *TeamWorkflowStartWorkflowState `json:"startWorkflowState"`
+// GetStartWorkflowStateId returns TeamUpdateInput.StartWorkflowStateId, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetStartWorkflowStateId() string { return v.StartWorkflowStateId }
 
-	ReviewWorkflowState *TeamWorkflowReviewWorkflowState `json:"reviewWorkflowState"`
+// GetReviewWorkflowStateId returns TeamUpdateInput.ReviewWorkflowStateId, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetReviewWorkflowStateId() string { return v.ReviewWorkflowStateId }
 
-	MergeWorkflowState *TeamWorkflowMergeWorkflowState `json:"mergeWorkflowState"`
-}
+// GetMergeableWorkflowStateId returns TeamUpdateInput.MergeableWorkflowStateId, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetMergeableWorkflowStateId() string { return v.MergeableWorkflowStateId }
 
-func (v *getTeamWorkflowTeam) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+// GetMergeWorkflowStateId returns TeamUpdateInput.MergeWorkflowStateId, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetMergeWorkflowStateId() string { return v.MergeWorkflowStateId }
 
-func (v *getTeamWorkflowTeam) __premarshalJSON() (*__premarshalgetTeamWorkflowTeam, error) {
-	var retval __premarshalgetTeamWorkflowTeam
+// GetSlackNewIssue returns TeamUpdateInput.SlackNewIssue, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetSlackNewIssue() *bool { return v.SlackNewIssue }
 
-	retval.Id = v.TeamWorkflow.Id
-	retval.Key = v.TeamWorkflow.Key
-	retval.DraftWorkflowState = v.TeamWorkflow.DraftWorkflowState
-	retval.StartWorkflowState = v.TeamWorkflow.StartWorkflowState
-	retval.ReviewWorkflowState = v.TeamWorkflow.ReviewWorkflowState
-	retval.MergeWorkflowState = v.TeamWorkflow.MergeWorkflowState
-	return &retval, nil
-}
+// GetSlackIssueComments returns TeamUpdateInput.SlackIssueComments, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetSlackIssueComments() *bool { return v.SlackIssueComments }
 
-// getWorkflowStateResponse is returned by getWorkflowState on success.
-type getWorkflowStateResponse struct {
-	// One specific state.
-	WorkflowState getWorkflowStateWorkflowState `json:"workflowState"`
-}
+// GetSlackIssueStatuses returns TeamUpdateInput.SlackIssueStatuses, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetSlackIssueStatuses() *bool { return v.SlackIssueStatuses }
 
-// GetWorkflowState returns getWorkflowStateResponse.WorkflowState, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateResponse) GetWorkflowState() getWorkflowStateWorkflowState {
-	return v.WorkflowState
-}
+// GetGroupIssueHistory returns TeamUpdateInput.GroupIssueHistory, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetGroupIssueHistory() bool { return v.GroupIssueHistory }
 
-// getWorkflowStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
-// The GraphQL type's documentation follows.
-//
-// A state in a team workflow.
-type getWorkflowStateWorkflowState struct {
-	WorkflowState `json:"-"`
+// GetDefaultTemplateForMembersId returns TeamUpdateInput.DefaultTemplateForMembersId, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetDefaultTemplateForMembersId() string {
+	return v.DefaultTemplateForMembersId
 }
 
-// GetId returns getWorkflowStateWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetId() string { return v.WorkflowState.Id }
-
-// GetName returns getWorkflowStateWorkflowState.Name, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetName() string { return v.WorkflowState.Name }
-
-// GetColor returns getWorkflowStateWorkflowState.Color, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetColor() string { return v.WorkflowState.Color }
+// GetDefaultTemplateForNonMembersId returns TeamUpdateInput.DefaultTemplateForNonMembersId, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetDefaultTemplateForNonMembersId() string {
+	return v.DefaultTemplateForNonMembersId
+}
 
-// GetDescription returns getWorkflowStateWorkflowState.Description, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetDescription() *string { return v.WorkflowState.Description }
+// GetDefaultProjectTemplateId returns TeamUpdateInput.DefaultProjectTemplateId, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetDefaultProjectTemplateId() string { return v.DefaultProjectTemplateId }
 
-// GetType returns getWorkflowStateWorkflowState.Type, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetType() string { return v.WorkflowState.Type }
+// GetPrivate returns TeamUpdateInput.Private, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetPrivate() bool { return v.Private }
 
-// GetPosition returns getWorkflowStateWorkflowState.Position, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetPosition() float64 { return v.WorkflowState.Position }
+// GetTriageEnabled returns TeamUpdateInput.TriageEnabled, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetTriageEnabled() bool { return v.TriageEnabled }
 
-// GetTeam returns getWorkflowStateWorkflowState.Team, and is useful for accessing the field via an interface.
-func (v *getWorkflowStateWorkflowState) GetTeam() WorkflowStateTeam { return v.WorkflowState.Team }
+// GetRequirePriorityToLeaveTriage returns TeamUpdateInput.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetRequirePriorityToLeaveTriage() bool {
+	return v.RequirePriorityToLeaveTriage
+}
 
-func (v *getWorkflowStateWorkflowState) UnmarshalJSON(b []byte) error {
+// GetDefaultIssueStateId returns TeamUpdateInput.DefaultIssueStateId, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetDefaultIssueStateId() string { return v.DefaultIssueStateId }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetAutoClosePeriod returns TeamUpdateInput.AutoClosePeriod, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetAutoClosePeriod() *float64 { return v.AutoClosePeriod }
 
-	var firstPass struct {
-		*getWorkflowStateWorkflowState
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.getWorkflowStateWorkflowState = v
+// GetAutoCloseStateId returns TeamUpdateInput.AutoCloseStateId, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetAutoCloseStateId() string { return v.AutoCloseStateId }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetAutoArchivePeriod returns TeamUpdateInput.AutoArchivePeriod, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetAutoArchivePeriod() float64 { return v.AutoArchivePeriod }
 
-	err = json.Unmarshal(
-		b, &v.WorkflowState)
-	if err != nil {
-		return err
-	}
-	return nil
+// GetMarkedAsDuplicateWorkflowStateId returns TeamUpdateInput.MarkedAsDuplicateWorkflowStateId, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetMarkedAsDuplicateWorkflowStateId() string {
+	return v.MarkedAsDuplicateWorkflowStateId
 }
 
-type __premarshalgetWorkflowStateWorkflowState struct {
-	Id string `json:"id"`
-
-	Name string `json:"name"`
+// GetJoinByDefault returns TeamUpdateInput.JoinByDefault, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetJoinByDefault() *bool { return v.JoinByDefault }
 
-	Color string `json:"color"`
+// GetScimManaged returns TeamUpdateInput.ScimManaged, and is useful for accessing the field via an interface.
+func (v *TeamUpdateInput) GetScimManaged() bool { return v.ScimManaged }
 
-	Description *string `json:"description"`
+// TeamWorkflow includes the GraphQL fields of Team requested by the fragment TeamWorkflow.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type TeamWorkflow struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team's unique key. The key is used in URLs.
+	Key string `json:"key"`
+	// The workflow state into which issues are moved when a PR has been opened as draft.
+	DraftWorkflowState *TeamWorkflowDraftWorkflowState `json:"draftWorkflowState"`
+	// The workflow state into which issues are moved when a PR has been opened.
+	StartWorkflowState *TeamWorkflowStartWorkflowState `json:"startWorkflowState"`
+	// The workflow state into which issues are moved when a review has been requested for the PR.
+	ReviewWorkflowState *TeamWorkflowReviewWorkflowState `json:"reviewWorkflowState"`
+	// The workflow state into which issues are moved when a PR has been merged.
+	MergeWorkflowState *TeamWorkflowMergeWorkflowState `json:"mergeWorkflowState"`
+}
 
-	Type string `json:"type"`
+// GetId returns TeamWorkflow.Id, and is useful for accessing the field via an interface.
+func (v *TeamWorkflow) GetId() string { return v.Id }
 
-	Position float64 `json:"position"`
+// GetKey returns TeamWorkflow.Key, and is useful for accessing the field via an interface.
+func (v *TeamWorkflow) GetKey() string { return v.Key }
 
-	Team WorkflowStateTeam `json:"team"`
+// GetDraftWorkflowState returns TeamWorkflow.DraftWorkflowState, and is useful for accessing the field via an interface.
+func (v *TeamWorkflow) GetDraftWorkflowState() *TeamWorkflowDraftWorkflowState {
+	return v.DraftWorkflowState
 }
 
-func (v *getWorkflowStateWorkflowState) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetStartWorkflowState returns TeamWorkflow.StartWorkflowState, and is useful for accessing the field via an interface.
+func (v *TeamWorkflow) GetStartWorkflowState() *TeamWorkflowStartWorkflowState {
+	return v.StartWorkflowState
 }
 
-func (v *getWorkflowStateWorkflowState) __premarshalJSON() (*__premarshalgetWorkflowStateWorkflowState, error) {
-	var retval __premarshalgetWorkflowStateWorkflowState
+// GetReviewWorkflowState returns TeamWorkflow.ReviewWorkflowState, and is useful for accessing the field via an interface.
+func (v *TeamWorkflow) GetReviewWorkflowState() *TeamWorkflowReviewWorkflowState {
+	return v.ReviewWorkflowState
+}
 
-	retval.Id = v.WorkflowState.Id
-	retval.Name = v.WorkflowState.Name
-	retval.Color = v.WorkflowState.Color
-	retval.Description = v.WorkflowState.Description
-	retval.Type = v.WorkflowState.Type
-	retval.Position = v.WorkflowState.Position
-	retval.Team = v.WorkflowState.Team
-	return &retval, nil
+// GetMergeWorkflowState returns TeamWorkflow.MergeWorkflowState, and is useful for accessing the field via an interface.
+func (v *TeamWorkflow) GetMergeWorkflowState() *TeamWorkflowMergeWorkflowState {
+	return v.MergeWorkflowState
 }
 
-// getWorkspaceOrganization includes the requested fields of the GraphQL type Organization.
+// TeamWorkflowDraftWorkflowState includes the requested fields of the GraphQL type WorkflowState.
 // The GraphQL type's documentation follows.
 //
-// An organization. Organizations are root-level objects that contain user accounts and teams.
-type getWorkspaceOrganization struct {
+// A state in a team workflow.
+type TeamWorkflowDraftWorkflowState struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
-	// The organization's unique URL key.
-	UrlKey string `json:"urlKey"`
-	// The organization's name.
-	Name string `json:"name"`
 }
 
-// GetId returns getWorkspaceOrganization.Id, and is useful for accessing the field via an interface.
-func (v *getWorkspaceOrganization) GetId() string { return v.Id }
+// GetId returns TeamWorkflowDraftWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *TeamWorkflowDraftWorkflowState) GetId() string { return v.Id }
 
-// GetUrlKey returns getWorkspaceOrganization.UrlKey, and is useful for accessing the field via an interface.
-func (v *getWorkspaceOrganization) GetUrlKey() string { return v.UrlKey }
+// TeamWorkflowMergeWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type TeamWorkflowMergeWorkflowState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
 
-// GetName returns getWorkspaceOrganization.Name, and is useful for accessing the field via an interface.
-func (v *getWorkspaceOrganization) GetName() string { return v.Name }
+// GetId returns TeamWorkflowMergeWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *TeamWorkflowMergeWorkflowState) GetId() string { return v.Id }
 
-// getWorkspaceResponse is returned by getWorkspace on success.
-type getWorkspaceResponse struct {
-	// The user's organization.
-	Organization getWorkspaceOrganization `json:"organization"`
+// TeamWorkflowReviewWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type TeamWorkflowReviewWorkflowState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetOrganization returns getWorkspaceResponse.Organization, and is useful for accessing the field via an interface.
-func (v *getWorkspaceResponse) GetOrganization() getWorkspaceOrganization { return v.Organization }
+// GetId returns TeamWorkflowReviewWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *TeamWorkflowReviewWorkflowState) GetId() string { return v.Id }
 
-// getWorkspaceSettingsOrganization includes the requested fields of the GraphQL type Organization.
+// TeamWorkflowStartWorkflowState includes the requested fields of the GraphQL type WorkflowState.
 // The GraphQL type's documentation follows.
 //
-// An organization. Organizations are root-level objects that contain user accounts and teams.
-type getWorkspaceSettingsOrganization struct {
-	Organization `json:"-"`
+// A state in a team workflow.
+type TeamWorkflowStartWorkflowState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// GetId returns getWorkspaceSettingsOrganization.Id, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetId() string { return v.Organization.Id }
-
-// GetAllowMembersToInvite returns getWorkspaceSettingsOrganization.AllowMembersToInvite, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetAllowMembersToInvite() bool {
-	return v.Organization.AllowMembersToInvite
-}
+// GetId returns TeamWorkflowStartWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *TeamWorkflowStartWorkflowState) GetId() string { return v.Id }
 
-// GetRoadmapEnabled returns getWorkspaceSettingsOrganization.RoadmapEnabled, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetRoadmapEnabled() bool {
-	return v.Organization.RoadmapEnabled
+// Template includes the GraphQL fields of Template requested by the fragment Template.
+// The GraphQL type's documentation follows.
+//
+// A template object used for creating entities faster.
+type Template struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The entity type this template is for.
+	Type string `json:"type"`
+	// The name of the template.
+	Name string `json:"name"`
+	// Template description.
+	Description *string `json:"description"`
+	// Template data.
+	TemplateData json.RawMessage `json:"templateData"`
+	// The team that the template is associated with. If null, the template is global to the workspace.
+	Team *TemplateTeam `json:"team"`
 }
 
-// GetGitLinkbackMessagesEnabled returns getWorkspaceSettingsOrganization.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetGitLinkbackMessagesEnabled() bool {
-	return v.Organization.GitLinkbackMessagesEnabled
-}
+// GetId returns Template.Id, and is useful for accessing the field via an interface.
+func (v *Template) GetId() string { return v.Id }
 
-// GetGitPublicLinkbackMessagesEnabled returns getWorkspaceSettingsOrganization.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsOrganization) GetGitPublicLinkbackMessagesEnabled() bool {
-	return v.Organization.GitPublicLinkbackMessagesEnabled
-}
+// GetType returns Template.Type, and is useful for accessing the field via an interface.
+func (v *Template) GetType() string { return v.Type }
 
-func (v *getWorkspaceSettingsOrganization) UnmarshalJSON(b []byte) error {
+// GetName returns Template.Name, and is useful for accessing the field via an interface.
+func (v *Template) GetName() string { return v.Name }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetDescription returns Template.Description, and is useful for accessing the field via an interface.
+func (v *Template) GetDescription() *string { return v.Description }
 
-	var firstPass struct {
-		*getWorkspaceSettingsOrganization
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.getWorkspaceSettingsOrganization = v
+// GetTemplateData returns Template.TemplateData, and is useful for accessing the field via an interface.
+func (v *Template) GetTemplateData() json.RawMessage { return v.TemplateData }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetTeam returns Template.Team, and is useful for accessing the field via an interface.
+func (v *Template) GetTeam() *TemplateTeam { return v.Team }
 
-	err = json.Unmarshal(
-		b, &v.Organization)
-	if err != nil {
-		return err
-	}
-	return nil
+type TemplateCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The template type, e.g. 'issue'.
+	Type string `json:"type"`
+	// The identifier or key of the team associated with the template. If not given,
+	// the template will be shared across all teams.
+	TeamId *string `json:"teamId"`
+	// The template name.
+	Name string `json:"name"`
+	// The template description.
+	Description *string `json:"description"`
+	// The template data as JSON encoded attributes of the type of entity, such as an issue.
+	TemplateData json.RawMessage `json:"templateData"`
+	// The position of the template in the templates list.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
 }
 
-type __premarshalgetWorkspaceSettingsOrganization struct {
-	Id string `json:"id"`
+// GetId returns TemplateCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *TemplateCreateInput) GetId() string { return v.Id }
 
-	AllowMembersToInvite bool `json:"allowMembersToInvite"`
+// GetType returns TemplateCreateInput.Type, and is useful for accessing the field via an interface.
+func (v *TemplateCreateInput) GetType() string { return v.Type }
 
-	RoadmapEnabled bool `json:"roadmapEnabled"`
+// GetTeamId returns TemplateCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *TemplateCreateInput) GetTeamId() *string { return v.TeamId }
 
-	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
+// GetName returns TemplateCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *TemplateCreateInput) GetName() string { return v.Name }
 
-	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
-}
+// GetDescription returns TemplateCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *TemplateCreateInput) GetDescription() *string { return v.Description }
 
-func (v *getWorkspaceSettingsOrganization) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+// GetTemplateData returns TemplateCreateInput.TemplateData, and is useful for accessing the field via an interface.
+func (v *TemplateCreateInput) GetTemplateData() json.RawMessage { return v.TemplateData }
 
-func (v *getWorkspaceSettingsOrganization) __premarshalJSON() (*__premarshalgetWorkspaceSettingsOrganization, error) {
-	var retval __premarshalgetWorkspaceSettingsOrganization
+// GetSortOrder returns TemplateCreateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *TemplateCreateInput) GetSortOrder() *float64 { return v.SortOrder }
 
-	retval.Id = v.Organization.Id
-	retval.AllowMembersToInvite = v.Organization.AllowMembersToInvite
-	retval.RoadmapEnabled = v.Organization.RoadmapEnabled
-	retval.GitLinkbackMessagesEnabled = v.Organization.GitLinkbackMessagesEnabled
-	retval.GitPublicLinkbackMessagesEnabled = v.Organization.GitPublicLinkbackMessagesEnabled
-	return &retval, nil
+// TemplateTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type TemplateTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
 }
 
-// getWorkspaceSettingsResponse is returned by getWorkspaceSettings on success.
-type getWorkspaceSettingsResponse struct {
-	// The user's organization.
-	Organization getWorkspaceSettingsOrganization `json:"organization"`
-}
+// GetId returns TemplateTeam.Id, and is useful for accessing the field via an interface.
+func (v *TemplateTeam) GetId() string { return v.Id }
 
-// GetOrganization returns getWorkspaceSettingsResponse.Organization, and is useful for accessing the field via an interface.
-func (v *getWorkspaceSettingsResponse) GetOrganization() getWorkspaceSettingsOrganization {
-	return v.Organization
+type TemplateUpdateInput struct {
+	// The template name.
+	Name string `json:"name,omitempty"`
+	// The template description.
+	Description *string `json:"description"`
+	// The identifier or key of the team associated with the template. If set to
+	// null, the template will be shared across all teams.
+	TeamId *string `json:"teamId,omitempty"`
+	// The template data as JSON encoded attributes of the type of entity, such as an issue.
+	TemplateData json.RawMessage `json:"templateData,omitempty"`
+	// The position of the template in the templates list.
+	SortOrder *float64 `json:"sortOrder,omitempty"`
 }
 
-// updateLabelIssueLabelUpdateIssueLabelPayload includes the requested fields of the GraphQL type IssueLabelPayload.
-type updateLabelIssueLabelUpdateIssueLabelPayload struct {
-	// The label that was created or updated.
-	IssueLabel updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel `json:"issueLabel"`
-}
+// GetName returns TemplateUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *TemplateUpdateInput) GetName() string { return v.Name }
 
-// GetIssueLabel returns updateLabelIssueLabelUpdateIssueLabelPayload.IssueLabel, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayload) GetIssueLabel() updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel {
-	return v.IssueLabel
-}
+// GetDescription returns TemplateUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *TemplateUpdateInput) GetDescription() *string { return v.Description }
 
-// updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// GetTeamId returns TemplateUpdateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *TemplateUpdateInput) GetTeamId() *string { return v.TeamId }
+
+// GetTemplateData returns TemplateUpdateInput.TemplateData, and is useful for accessing the field via an interface.
+func (v *TemplateUpdateInput) GetTemplateData() json.RawMessage { return v.TemplateData }
+
+// GetSortOrder returns TemplateUpdateInput.SortOrder, and is useful for accessing the field via an interface.
+func (v *TemplateUpdateInput) GetSortOrder() *float64 { return v.SortOrder }
+
+// User includes the GraphQL fields of User requested by the fragment User.
 // The GraphQL type's documentation follows.
 //
-// Labels that can be associated with issues.
-type updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel struct {
-	IssueLabel `json:"-"`
+// A user that has access to the the resources of an organization.
+type User struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The user's full name.
+	Name string `json:"name"`
+	// The user's display (nick) name. Unique within each organization.
+	DisplayName string `json:"displayName"`
+	// The user's email address.
+	Email string `json:"email"`
+	// Whether the user is an organization administrator.
+	Admin bool `json:"admin"`
+	// Whether the user is a guest in the workspace and limited to accessing a subset of teams.
+	Guest bool `json:"guest"`
+	// Whether the user account is active or disabled (suspended).
+	Active bool `json:"active"`
 }
 
-// GetId returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Id, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetId() string {
-	return v.IssueLabel.Id
-}
+// GetId returns User.Id, and is useful for accessing the field via an interface.
+func (v *User) GetId() string { return v.Id }
 
-// GetName returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Name, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetName() string {
-	return v.IssueLabel.Name
-}
+// GetName returns User.Name, and is useful for accessing the field via an interface.
+func (v *User) GetName() string { return v.Name }
 
-// GetDescription returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Description, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetDescription() *string {
-	return v.IssueLabel.Description
-}
+// GetDisplayName returns User.DisplayName, and is useful for accessing the field via an interface.
+func (v *User) GetDisplayName() string { return v.DisplayName }
 
-// GetColor returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Color, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetColor() *string {
-	return v.IssueLabel.Color
-}
+// GetEmail returns User.Email, and is useful for accessing the field via an interface.
+func (v *User) GetEmail() string { return v.Email }
 
-// GetParent returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Parent, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetParent() *IssueLabelParentIssueLabel {
-	return v.IssueLabel.Parent
-}
+// GetAdmin returns User.Admin, and is useful for accessing the field via an interface.
+func (v *User) GetAdmin() bool { return v.Admin }
 
-// GetTeam returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Team, and is useful for accessing the field via an interface.
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetTeam() *IssueLabelTeam {
-	return v.IssueLabel.Team
+// GetGuest returns User.Guest, and is useful for accessing the field via an interface.
+func (v *User) GetGuest() bool { return v.Guest }
+
+// GetActive returns User.Active, and is useful for accessing the field via an interface.
+func (v *User) GetActive() bool { return v.Active }
+
+// WorkflowState includes the GraphQL fields of WorkflowState requested by the fragment WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type WorkflowState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The state's name.
+	Name string `json:"name"`
+	// The state's UI color as a HEX string.
+	Color string `json:"color"`
+	// Description of the state.
+	Description *string `json:"description"`
+	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
+	Type string `json:"type"`
+	// The position of the state in the team flow.
+	Position float64 `json:"position"`
+	// The time at which the entity was archived. Null if the entity has not been archived.
+	ArchivedAt *time.Time `json:"archivedAt"`
+	// The time at which the entity was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// The last time at which the entity was meaningfully updated, i.e. for all changes of syncable properties except those
+	// for which updates should not produce an update to updatedAt (see
+	// skipUpdatedAtKeys). This is the same as the creation time if the entity hasn't
+	// been updated after creation.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The team to which this state belongs to.
+	Team WorkflowStateTeam `json:"team"`
 }
 
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) UnmarshalJSON(b []byte) error {
+// GetId returns WorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *WorkflowState) GetId() string { return v.Id }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetName returns WorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *WorkflowState) GetName() string { return v.Name }
 
-	var firstPass struct {
-		*updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel = v
+// GetColor returns WorkflowState.Color, and is useful for accessing the field via an interface.
+func (v *WorkflowState) GetColor() string { return v.Color }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetDescription returns WorkflowState.Description, and is useful for accessing the field via an interface.
+func (v *WorkflowState) GetDescription() *string { return v.Description }
 
-	err = json.Unmarshal(
-		b, &v.IssueLabel)
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// GetType returns WorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *WorkflowState) GetType() string { return v.Type }
 
-type __premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel struct {
-	Id string `json:"id"`
+// GetPosition returns WorkflowState.Position, and is useful for accessing the field via an interface.
+func (v *WorkflowState) GetPosition() float64 { return v.Position }
 
-	Name string `json:"name"`
+// GetArchivedAt returns WorkflowState.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *WorkflowState) GetArchivedAt() *time.Time { return v.ArchivedAt }
 
-	Description *string `json:"description"`
+// GetCreatedAt returns WorkflowState.CreatedAt, and is useful for accessing the field via an interface.
+func (v *WorkflowState) GetCreatedAt() time.Time { return v.CreatedAt }
 
-	Color *string `json:"color"`
+// GetUpdatedAt returns WorkflowState.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *WorkflowState) GetUpdatedAt() time.Time { return v.UpdatedAt }
 
-	Parent *IssueLabelParentIssueLabel `json:"parent"`
+// GetTeam returns WorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *WorkflowState) GetTeam() WorkflowStateTeam { return v.Team }
 
-	Team *IssueLabelTeam `json:"team"`
+type WorkflowStateCreateInput struct {
+	// The identifier in UUID v4 format. If none is provided, the backend will generate one.
+	Id string `json:"id,omitempty"`
+	// The workflow type.
+	Type string `json:"type"`
+	// The name of the state.
+	Name string `json:"name"`
+	// The color of the state.
+	Color string `json:"color"`
+	// The description of the state.
+	Description *string `json:"description"`
+	// The position of the state.
+	Position float64 `json:"position"`
+	// The team associated with the state.
+	TeamId string `json:"teamId"`
 }
 
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+// GetId returns WorkflowStateCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *WorkflowStateCreateInput) GetId() string { return v.Id }
 
-func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) __premarshalJSON() (*__premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel, error) {
-	var retval __premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel
+// GetType returns WorkflowStateCreateInput.Type, and is useful for accessing the field via an interface.
+func (v *WorkflowStateCreateInput) GetType() string { return v.Type }
 
-	retval.Id = v.IssueLabel.Id
-	retval.Name = v.IssueLabel.Name
-	retval.Description = v.IssueLabel.Description
-	retval.Color = v.IssueLabel.Color
-	retval.Parent = v.IssueLabel.Parent
-	retval.Team = v.IssueLabel.Team
-	return &retval, nil
-}
+// GetName returns WorkflowStateCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *WorkflowStateCreateInput) GetName() string { return v.Name }
 
-// updateLabelResponse is returned by updateLabel on success.
-type updateLabelResponse struct {
-	// Updates an label.
-	IssueLabelUpdate updateLabelIssueLabelUpdateIssueLabelPayload `json:"issueLabelUpdate"`
-}
+// GetColor returns WorkflowStateCreateInput.Color, and is useful for accessing the field via an interface.
+func (v *WorkflowStateCreateInput) GetColor() string { return v.Color }
 
-// GetIssueLabelUpdate returns updateLabelResponse.IssueLabelUpdate, and is useful for accessing the field via an interface.
-func (v *updateLabelResponse) GetIssueLabelUpdate() updateLabelIssueLabelUpdateIssueLabelPayload {
-	return v.IssueLabelUpdate
-}
+// GetDescription returns WorkflowStateCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *WorkflowStateCreateInput) GetDescription() *string { return v.Description }
 
-// updateTeamResponse is returned by updateTeam on success.
-type updateTeamResponse struct {
-	// Updates a team.
-	TeamUpdate updateTeamTeamUpdateTeamPayload `json:"teamUpdate"`
-}
+// GetPosition returns WorkflowStateCreateInput.Position, and is useful for accessing the field via an interface.
+func (v *WorkflowStateCreateInput) GetPosition() float64 { return v.Position }
 
-// GetTeamUpdate returns updateTeamResponse.TeamUpdate, and is useful for accessing the field via an interface.
-func (v *updateTeamResponse) GetTeamUpdate() updateTeamTeamUpdateTeamPayload { return v.TeamUpdate }
+// GetTeamId returns WorkflowStateCreateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *WorkflowStateCreateInput) GetTeamId() string { return v.TeamId }
 
-// updateTeamTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
-type updateTeamTeamUpdateTeamPayload struct {
-	// The team that was created or updated.
-	Team updateTeamTeamUpdateTeamPayloadTeam `json:"team"`
+// WorkflowStateTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type WorkflowStateTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team's unique key. The key is used in URLs.
+	Key string `json:"key"`
+	// The organization that the team is associated with.
+	Organization WorkflowStateTeamOrganization `json:"organization"`
 }
 
-// GetTeam returns updateTeamTeamUpdateTeamPayload.Team, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayload) GetTeam() updateTeamTeamUpdateTeamPayloadTeam {
-	return v.Team
-}
+// GetId returns WorkflowStateTeam.Id, and is useful for accessing the field via an interface.
+func (v *WorkflowStateTeam) GetId() string { return v.Id }
 
-// updateTeamTeamUpdateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
+// GetKey returns WorkflowStateTeam.Key, and is useful for accessing the field via an interface.
+func (v *WorkflowStateTeam) GetKey() string { return v.Key }
+
+// GetOrganization returns WorkflowStateTeam.Organization, and is useful for accessing the field via an interface.
+func (v *WorkflowStateTeam) GetOrganization() WorkflowStateTeamOrganization { return v.Organization }
+
+// WorkflowStateTeamOrganization includes the requested fields of the GraphQL type Organization.
 // The GraphQL type's documentation follows.
 //
-// An organizational unit that contains issues.
-type updateTeamTeamUpdateTeamPayloadTeam struct {
-	Team `json:"-"`
+// An organization. Organizations are root-level objects that contain user accounts and teams.
+type WorkflowStateTeamOrganization struct {
+	// The organization's unique URL key.
+	UrlKey string `json:"urlKey"`
 }
 
-// GetId returns updateTeamTeamUpdateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetId() string { return v.Team.Id }
+// GetUrlKey returns WorkflowStateTeamOrganization.UrlKey, and is useful for accessing the field via an interface.
+func (v *WorkflowStateTeamOrganization) GetUrlKey() string { return v.UrlKey }
 
-// GetName returns updateTeamTeamUpdateTeamPayloadTeam.Name, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetName() string { return v.Team.Name }
+type WorkflowStateUpdateInput struct {
+	// The name of the state.
+	Name string `json:"name,omitempty"`
+	// The color of the state.
+	Color string `json:"color,omitempty"`
+	// The description of the state.
+	Description *string `json:"description"`
+	// The position of the state.
+	Position float64 `json:"position"`
+}
 
-// GetKey returns updateTeamTeamUpdateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetKey() string { return v.Team.Key }
+// GetName returns WorkflowStateUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *WorkflowStateUpdateInput) GetName() string { return v.Name }
 
-// GetPrivate returns updateTeamTeamUpdateTeamPayloadTeam.Private, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetPrivate() bool { return v.Team.Private }
+// GetColor returns WorkflowStateUpdateInput.Color, and is useful for accessing the field via an interface.
+func (v *WorkflowStateUpdateInput) GetColor() string { return v.Color }
 
-// GetDescription returns updateTeamTeamUpdateTeamPayloadTeam.Description, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetDescription() *string { return v.Team.Description }
+// GetDescription returns WorkflowStateUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *WorkflowStateUpdateInput) GetDescription() *string { return v.Description }
 
-// GetIcon returns updateTeamTeamUpdateTeamPayloadTeam.Icon, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIcon() *string { return v.Team.Icon }
+// GetPosition returns WorkflowStateUpdateInput.Position, and is useful for accessing the field via an interface.
+func (v *WorkflowStateUpdateInput) GetPosition() float64 { return v.Position }
 
-// GetColor returns updateTeamTeamUpdateTeamPayloadTeam.Color, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetColor() *string { return v.Team.Color }
+// __createCustomViewInput is used internally by genqlient
+type __createCustomViewInput struct {
+	Input CustomViewCreateInput `json:"input"`
+}
 
-// GetTimezone returns updateTeamTeamUpdateTeamPayloadTeam.Timezone, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetTimezone() string { return v.Team.Timezone }
+// GetInput returns __createCustomViewInput.Input, and is useful for accessing the field via an interface.
+func (v *__createCustomViewInput) GetInput() CustomViewCreateInput { return v.Input }
 
-// GetIssueOrderingNoPriorityFirst returns updateTeamTeamUpdateTeamPayloadTeam.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueOrderingNoPriorityFirst() bool {
-	return v.Team.IssueOrderingNoPriorityFirst
+// __createCustomerInput is used internally by genqlient
+type __createCustomerInput struct {
+	Input CustomerCreateInput `json:"input"`
 }
 
-// GetGroupIssueHistory returns updateTeamTeamUpdateTeamPayloadTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetGroupIssueHistory() bool {
-	return v.Team.GroupIssueHistory
-}
+// GetInput returns __createCustomerInput.Input, and is useful for accessing the field via an interface.
+func (v *__createCustomerInput) GetInput() CustomerCreateInput { return v.Input }
 
-// GetSetIssueSortOrderOnStateChange returns updateTeamTeamUpdateTeamPayloadTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetSetIssueSortOrderOnStateChange() string {
-	return v.Team.SetIssueSortOrderOnStateChange
+// __createCustomerNeedInput is used internally by genqlient
+type __createCustomerNeedInput struct {
+	Input CustomerNeedCreateInput `json:"input"`
 }
 
-// GetAutoArchivePeriod returns updateTeamTeamUpdateTeamPayloadTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoArchivePeriod() float64 {
-	return v.Team.AutoArchivePeriod
+// GetInput returns __createCustomerNeedInput.Input, and is useful for accessing the field via an interface.
+func (v *__createCustomerNeedInput) GetInput() CustomerNeedCreateInput { return v.Input }
+
+// __createCustomerStatusInput is used internally by genqlient
+type __createCustomerStatusInput struct {
+	Input CustomerStatusCreateInput `json:"input"`
 }
 
-// GetAutoClosePeriod returns updateTeamTeamUpdateTeamPayloadTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoClosePeriod() *float64 {
-	return v.Team.AutoClosePeriod
+// GetInput returns __createCustomerStatusInput.Input, and is useful for accessing the field via an interface.
+func (v *__createCustomerStatusInput) GetInput() CustomerStatusCreateInput { return v.Input }
+
+// __createEmojiInput is used internally by genqlient
+type __createEmojiInput struct {
+	Input EmojiCreateInput `json:"input"`
 }
 
-// GetTriageEnabled returns updateTeamTeamUpdateTeamPayloadTeam.TriageEnabled, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
+// GetInput returns __createEmojiInput.Input, and is useful for accessing the field via an interface.
+func (v *__createEmojiInput) GetInput() EmojiCreateInput { return v.Input }
 
-// GetCyclesEnabled returns updateTeamTeamUpdateTeamPayloadTeam.CyclesEnabled, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
+// __createGitAutomationStateInput is used internally by genqlient
+type __createGitAutomationStateInput struct {
+	Input GitAutomationStateCreateInput `json:"input"`
+}
 
-// GetCycleStartDay returns updateTeamTeamUpdateTeamPayloadTeam.CycleStartDay, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
+// GetInput returns __createGitAutomationStateInput.Input, and is useful for accessing the field via an interface.
+func (v *__createGitAutomationStateInput) GetInput() GitAutomationStateCreateInput { return v.Input }
 
-// GetCycleDuration returns updateTeamTeamUpdateTeamPayloadTeam.CycleDuration, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
+// __createInitiativeToProjectInput is used internally by genqlient
+type __createInitiativeToProjectInput struct {
+	Input InitiativeToProjectCreateInput `json:"input"`
+}
 
-// GetCycleCooldownTime returns updateTeamTeamUpdateTeamPayloadTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleCooldownTime() float64 {
-	return v.Team.CycleCooldownTime
+// GetInput returns __createInitiativeToProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__createInitiativeToProjectInput) GetInput() InitiativeToProjectCreateInput { return v.Input }
+
+// __createIssueInput is used internally by genqlient
+type __createIssueInput struct {
+	Input IssueCreateInput `json:"input"`
 }
 
-// GetUpcomingCycleCount returns updateTeamTeamUpdateTeamPayloadTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetUpcomingCycleCount() float64 {
-	return v.Team.UpcomingCycleCount
+// GetInput returns __createIssueInput.Input, and is useful for accessing the field via an interface.
+func (v *__createIssueInput) GetInput() IssueCreateInput { return v.Input }
+
+// __createIssueRelationInput is used internally by genqlient
+type __createIssueRelationInput struct {
+	Input IssueRelationCreateInput `json:"input"`
 }
 
-// GetCycleIssueAutoAssignStarted returns updateTeamTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignStarted() bool {
-	return v.Team.CycleIssueAutoAssignStarted
+// GetInput returns __createIssueRelationInput.Input, and is useful for accessing the field via an interface.
+func (v *__createIssueRelationInput) GetInput() IssueRelationCreateInput { return v.Input }
+
+// __createLabelInput is used internally by genqlient
+type __createLabelInput struct {
+	Input IssueLabelCreateInput `json:"input"`
 }
 
-// GetCycleIssueAutoAssignCompleted returns updateTeamTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignCompleted() bool {
-	return v.Team.CycleIssueAutoAssignCompleted
+// GetInput returns __createLabelInput.Input, and is useful for accessing the field via an interface.
+func (v *__createLabelInput) GetInput() IssueLabelCreateInput { return v.Input }
+
+// __createProjectInput is used internally by genqlient
+type __createProjectInput struct {
+	Input ProjectCreateInput `json:"input"`
 }
 
-// GetCycleLockToActive returns updateTeamTeamUpdateTeamPayloadTeam.CycleLockToActive, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleLockToActive() bool {
-	return v.Team.CycleLockToActive
+// GetInput returns __createProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__createProjectInput) GetInput() ProjectCreateInput { return v.Input }
+
+// __createProjectMilestoneInput is used internally by genqlient
+type __createProjectMilestoneInput struct {
+	Input ProjectMilestoneCreateInput `json:"input"`
 }
 
-// GetIssueEstimationType returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationType, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationType() string {
-	return v.Team.IssueEstimationType
+// GetInput returns __createProjectMilestoneInput.Input, and is useful for accessing the field via an interface.
+func (v *__createProjectMilestoneInput) GetInput() ProjectMilestoneCreateInput { return v.Input }
+
+// __createProjectRelationInput is used internally by genqlient
+type __createProjectRelationInput struct {
+	Input ProjectRelationCreateInput `json:"input"`
 }
 
-// GetIssueEstimationAllowZero returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationAllowZero() bool {
-	return v.Team.IssueEstimationAllowZero
+// GetInput returns __createProjectRelationInput.Input, and is useful for accessing the field via an interface.
+func (v *__createProjectRelationInput) GetInput() ProjectRelationCreateInput { return v.Input }
+
+// __createRoadmapInput is used internally by genqlient
+type __createRoadmapInput struct {
+	Input RoadmapCreateInput `json:"input"`
 }
 
-// GetIssueEstimationExtended returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationExtended() bool {
-	return v.Team.IssueEstimationExtended
+// GetInput returns __createRoadmapInput.Input, and is useful for accessing the field via an interface.
+func (v *__createRoadmapInput) GetInput() RoadmapCreateInput { return v.Input }
+
+// __createRoadmapToProjectInput is used internally by genqlient
+type __createRoadmapToProjectInput struct {
+	Input RoadmapToProjectCreateInput `json:"input"`
 }
 
-// GetDefaultIssueEstimate returns updateTeamTeamUpdateTeamPayloadTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
-func (v *updateTeamTeamUpdateTeamPayloadTeam) GetDefaultIssueEstimate() float64 {
-	return v.Team.DefaultIssueEstimate
+// GetInput returns __createRoadmapToProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__createRoadmapToProjectInput) GetInput() RoadmapToProjectCreateInput { return v.Input }
+
+// __createTeamInput is used internally by genqlient
+type __createTeamInput struct {
+	Input TeamCreateInput `json:"input"`
 }
 
-func (v *updateTeamTeamUpdateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+// GetInput returns __createTeamInput.Input, and is useful for accessing the field via an interface.
+func (v *__createTeamInput) GetInput() TeamCreateInput { return v.Input }
 
-	if string(b) == "null" {
-		return nil
-	}
+// __createTeamMembershipInput is used internally by genqlient
+type __createTeamMembershipInput struct {
+	TeamId string `json:"teamId"`
+	UserId string `json:"userId"`
+	Owner  bool   `json:"owner"`
+}
 
-	var firstPass struct {
-		*updateTeamTeamUpdateTeamPayloadTeam
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.updateTeamTeamUpdateTeamPayloadTeam = v
+// GetTeamId returns __createTeamMembershipInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__createTeamMembershipInput) GetTeamId() string { return v.TeamId }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetUserId returns __createTeamMembershipInput.UserId, and is useful for accessing the field via an interface.
+func (v *__createTeamMembershipInput) GetUserId() string { return v.UserId }
 
-	err = json.Unmarshal(
-		b, &v.Team)
-	if err != nil {
-		return err
-	}
-	return nil
+// GetOwner returns __createTeamMembershipInput.Owner, and is useful for accessing the field via an interface.
+func (v *__createTeamMembershipInput) GetOwner() bool { return v.Owner }
+
+// __createTemplateInput is used internally by genqlient
+type __createTemplateInput struct {
+	Input TemplateCreateInput `json:"input"`
 }
 
-type __premarshalupdateTeamTeamUpdateTeamPayloadTeam struct {
-	Id string `json:"id"`
+// GetInput returns __createTemplateInput.Input, and is useful for accessing the field via an interface.
+func (v *__createTemplateInput) GetInput() TemplateCreateInput { return v.Input }
 
-	Name string `json:"name"`
+// __createWorkflowStateInput is used internally by genqlient
+type __createWorkflowStateInput struct {
+	Input WorkflowStateCreateInput `json:"input"`
+}
 
-	Key string `json:"key"`
+// GetInput returns __createWorkflowStateInput.Input, and is useful for accessing the field via an interface.
+func (v *__createWorkflowStateInput) GetInput() WorkflowStateCreateInput { return v.Input }
 
-	Private bool `json:"private"`
+// __deleteCustomViewInput is used internally by genqlient
+type __deleteCustomViewInput struct {
+	Id string `json:"id"`
+}
 
-	Description *string `json:"description"`
+// GetId returns __deleteCustomViewInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteCustomViewInput) GetId() string { return v.Id }
 
-	Icon *string `json:"icon"`
+// __deleteCustomerInput is used internally by genqlient
+type __deleteCustomerInput struct {
+	Id string `json:"id"`
+}
 
-	Color *string `json:"color"`
+// GetId returns __deleteCustomerInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteCustomerInput) GetId() string { return v.Id }
 
-	Timezone string `json:"timezone"`
+// __deleteCustomerNeedInput is used internally by genqlient
+type __deleteCustomerNeedInput struct {
+	Id string `json:"id"`
+}
 
-	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
+// GetId returns __deleteCustomerNeedInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteCustomerNeedInput) GetId() string { return v.Id }
 
-	GroupIssueHistory bool `json:"groupIssueHistory"`
+// __deleteCustomerStatusInput is used internally by genqlient
+type __deleteCustomerStatusInput struct {
+	Id string `json:"id"`
+}
 
-	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+// GetId returns __deleteCustomerStatusInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteCustomerStatusInput) GetId() string { return v.Id }
 
-	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+// __deleteEmojiInput is used internally by genqlient
+type __deleteEmojiInput struct {
+	Id string `json:"id"`
+}
 
-	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+// GetId returns __deleteEmojiInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteEmojiInput) GetId() string { return v.Id }
 
-	TriageEnabled bool `json:"triageEnabled"`
+// __deleteGitAutomationStateInput is used internally by genqlient
+type __deleteGitAutomationStateInput struct {
+	Id string `json:"id"`
+}
 
-	CyclesEnabled bool `json:"cyclesEnabled"`
+// GetId returns __deleteGitAutomationStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteGitAutomationStateInput) GetId() string { return v.Id }
 
-	CycleStartDay float64 `json:"cycleStartDay"`
+// __deleteInitiativeToProjectInput is used internally by genqlient
+type __deleteInitiativeToProjectInput struct {
+	Id string `json:"id"`
+}
 
-	CycleDuration float64 `json:"cycleDuration"`
+// GetId returns __deleteInitiativeToProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteInitiativeToProjectInput) GetId() string { return v.Id }
 
-	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+// __deleteIssueInput is used internally by genqlient
+type __deleteIssueInput struct {
+	Id string `json:"id"`
+}
 
-	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+// GetId returns __deleteIssueInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteIssueInput) GetId() string { return v.Id }
 
-	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+// __deleteIssueRelationInput is used internally by genqlient
+type __deleteIssueRelationInput struct {
+	Id string `json:"id"`
+}
 
-	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+// GetId returns __deleteIssueRelationInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteIssueRelationInput) GetId() string { return v.Id }
 
-	CycleLockToActive bool `json:"cycleLockToActive"`
+// __deleteLabelInput is used internally by genqlient
+type __deleteLabelInput struct {
+	Id string `json:"id"`
+}
 
-	IssueEstimationType string `json:"issueEstimationType"`
+// GetId returns __deleteLabelInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteLabelInput) GetId() string { return v.Id }
 
-	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+// __deleteProjectInput is used internally by genqlient
+type __deleteProjectInput struct {
+	Id string `json:"id"`
+}
 
-	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+// GetId returns __deleteProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteProjectInput) GetId() string { return v.Id }
 
-	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+// __deleteProjectMilestoneInput is used internally by genqlient
+type __deleteProjectMilestoneInput struct {
+	Id string `json:"id"`
 }
 
-func (v *updateTeamTeamUpdateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetId returns __deleteProjectMilestoneInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteProjectMilestoneInput) GetId() string { return v.Id }
+
+// __deleteProjectRelationInput is used internally by genqlient
+type __deleteProjectRelationInput struct {
+	Id string `json:"id"`
 }
 
-func (v *updateTeamTeamUpdateTeamPayloadTeam) __premarshalJSON() (*__premarshalupdateTeamTeamUpdateTeamPayloadTeam, error) {
-	var retval __premarshalupdateTeamTeamUpdateTeamPayloadTeam
+// GetId returns __deleteProjectRelationInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteProjectRelationInput) GetId() string { return v.Id }
 
-	retval.Id = v.Team.Id
-	retval.Name = v.Team.Name
-	retval.Key = v.Team.Key
-	retval.Private = v.Team.Private
-	retval.Description = v.Team.Description
-	retval.Icon = v.Team.Icon
-	retval.Color = v.Team.Color
-	retval.Timezone = v.Team.Timezone
-	retval.IssueOrderingNoPriorityFirst = v.Team.IssueOrderingNoPriorityFirst
-	retval.GroupIssueHistory = v.Team.GroupIssueHistory
-	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
-	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
-	retval.AutoClosePeriod = v.Team.AutoClosePeriod
-	retval.TriageEnabled = v.Team.TriageEnabled
-	retval.CyclesEnabled = v.Team.CyclesEnabled
-	retval.CycleStartDay = v.Team.CycleStartDay
-	retval.CycleDuration = v.Team.CycleDuration
-	retval.CycleCooldownTime = v.Team.CycleCooldownTime
-	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
-	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
-	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
-	retval.CycleLockToActive = v.Team.CycleLockToActive
-	retval.IssueEstimationType = v.Team.IssueEstimationType
-	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
-	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
-	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
-	return &retval, nil
+// __deleteRoadmapInput is used internally by genqlient
+type __deleteRoadmapInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteRoadmapInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteRoadmapInput) GetId() string { return v.Id }
+
+// __deleteRoadmapToProjectInput is used internally by genqlient
+type __deleteRoadmapToProjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteRoadmapToProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteRoadmapToProjectInput) GetId() string { return v.Id }
+
+// __deleteTeamInput is used internally by genqlient
+type __deleteTeamInput struct {
+	Key string `json:"key"`
+}
+
+// GetKey returns __deleteTeamInput.Key, and is useful for accessing the field via an interface.
+func (v *__deleteTeamInput) GetKey() string { return v.Key }
+
+// __deleteTeamMembershipInput is used internally by genqlient
+type __deleteTeamMembershipInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteTeamMembershipInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteTeamMembershipInput) GetId() string { return v.Id }
+
+// __deleteTemplateInput is used internally by genqlient
+type __deleteTemplateInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteTemplateInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteTemplateInput) GetId() string { return v.Id }
+
+// __deleteWorkflowStateInput is used internally by genqlient
+type __deleteWorkflowStateInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteWorkflowStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteWorkflowStateInput) GetId() string { return v.Id }
+
+// __findCycleInput is used internally by genqlient
+type __findCycleInput struct {
+	TeamId string                 `json:"teamId"`
+	Filter map[string]interface{} `json:"filter"`
+}
+
+// GetTeamId returns __findCycleInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__findCycleInput) GetTeamId() string { return v.TeamId }
+
+// GetFilter returns __findCycleInput.Filter, and is useful for accessing the field via an interface.
+func (v *__findCycleInput) GetFilter() map[string]interface{} { return v.Filter }
+
+// __findProjectByNameInput is used internally by genqlient
+type __findProjectByNameInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __findProjectByNameInput.Name, and is useful for accessing the field via an interface.
+func (v *__findProjectByNameInput) GetName() string { return v.Name }
+
+// __findProjectBySlugInput is used internally by genqlient
+type __findProjectBySlugInput struct {
+	SlugId string `json:"slugId"`
+}
+
+// GetSlugId returns __findProjectBySlugInput.SlugId, and is useful for accessing the field via an interface.
+func (v *__findProjectBySlugInput) GetSlugId() string { return v.SlugId }
+
+// __findProjectMembershipInput is used internally by genqlient
+type __findProjectMembershipInput struct {
+	ProjectSlug string `json:"projectSlug"`
+	UserEmail   string `json:"userEmail"`
+}
+
+// GetProjectSlug returns __findProjectMembershipInput.ProjectSlug, and is useful for accessing the field via an interface.
+func (v *__findProjectMembershipInput) GetProjectSlug() string { return v.ProjectSlug }
+
+// GetUserEmail returns __findProjectMembershipInput.UserEmail, and is useful for accessing the field via an interface.
+func (v *__findProjectMembershipInput) GetUserEmail() string { return v.UserEmail }
+
+// __findProjectMilestoneInput is used internally by genqlient
+type __findProjectMilestoneInput struct {
+	Name      string `json:"name"`
+	ProjectId string `json:"projectId"`
+}
+
+// GetName returns __findProjectMilestoneInput.Name, and is useful for accessing the field via an interface.
+func (v *__findProjectMilestoneInput) GetName() string { return v.Name }
+
+// GetProjectId returns __findProjectMilestoneInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__findProjectMilestoneInput) GetProjectId() string { return v.ProjectId }
+
+// __findTeamByNameInput is used internally by genqlient
+type __findTeamByNameInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __findTeamByNameInput.Name, and is useful for accessing the field via an interface.
+func (v *__findTeamByNameInput) GetName() string { return v.Name }
+
+// __findTeamInput is used internally by genqlient
+type __findTeamInput struct {
+	Key string `json:"key"`
+}
+
+// GetKey returns __findTeamInput.Key, and is useful for accessing the field via an interface.
+func (v *__findTeamInput) GetKey() string { return v.Key }
+
+// __findTeamLabelByTeamIdInput is used internally by genqlient
+type __findTeamLabelByTeamIdInput struct {
+	Name   string `json:"name"`
+	TeamId string `json:"teamId"`
+}
+
+// GetName returns __findTeamLabelByTeamIdInput.Name, and is useful for accessing the field via an interface.
+func (v *__findTeamLabelByTeamIdInput) GetName() string { return v.Name }
+
+// GetTeamId returns __findTeamLabelByTeamIdInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__findTeamLabelByTeamIdInput) GetTeamId() string { return v.TeamId }
+
+// __findTeamLabelInput is used internally by genqlient
+type __findTeamLabelInput struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// GetName returns __findTeamLabelInput.Name, and is useful for accessing the field via an interface.
+func (v *__findTeamLabelInput) GetName() string { return v.Name }
+
+// GetKey returns __findTeamLabelInput.Key, and is useful for accessing the field via an interface.
+func (v *__findTeamLabelInput) GetKey() string { return v.Key }
+
+// __findTeamMembershipInput is used internally by genqlient
+type __findTeamMembershipInput struct {
+	TeamKey   string `json:"teamKey"`
+	UserEmail string `json:"userEmail"`
+}
+
+// GetTeamKey returns __findTeamMembershipInput.TeamKey, and is useful for accessing the field via an interface.
+func (v *__findTeamMembershipInput) GetTeamKey() string { return v.TeamKey }
+
+// GetUserEmail returns __findTeamMembershipInput.UserEmail, and is useful for accessing the field via an interface.
+func (v *__findTeamMembershipInput) GetUserEmail() string { return v.UserEmail }
+
+// __findUserByDisplayNameInput is used internally by genqlient
+type __findUserByDisplayNameInput struct {
+	DisplayName string `json:"displayName"`
+}
+
+// GetDisplayName returns __findUserByDisplayNameInput.DisplayName, and is useful for accessing the field via an interface.
+func (v *__findUserByDisplayNameInput) GetDisplayName() string { return v.DisplayName }
+
+// __findUserByEmailInput is used internally by genqlient
+type __findUserByEmailInput struct {
+	Email string `json:"email"`
+}
+
+// GetEmail returns __findUserByEmailInput.Email, and is useful for accessing the field via an interface.
+func (v *__findUserByEmailInput) GetEmail() string { return v.Email }
+
+// __findWorkflowStateByTeamIdInput is used internally by genqlient
+type __findWorkflowStateByTeamIdInput struct {
+	Name   string `json:"name"`
+	TeamId string `json:"teamId"`
+}
+
+// GetName returns __findWorkflowStateByTeamIdInput.Name, and is useful for accessing the field via an interface.
+func (v *__findWorkflowStateByTeamIdInput) GetName() string { return v.Name }
+
+// GetTeamId returns __findWorkflowStateByTeamIdInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__findWorkflowStateByTeamIdInput) GetTeamId() string { return v.TeamId }
+
+// __findWorkflowStateInput is used internally by genqlient
+type __findWorkflowStateInput struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// GetName returns __findWorkflowStateInput.Name, and is useful for accessing the field via an interface.
+func (v *__findWorkflowStateInput) GetName() string { return v.Name }
+
+// GetKey returns __findWorkflowStateInput.Key, and is useful for accessing the field via an interface.
+func (v *__findWorkflowStateInput) GetKey() string { return v.Key }
+
+// __findWorkspaceLabelInput is used internally by genqlient
+type __findWorkspaceLabelInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __findWorkspaceLabelInput.Name, and is useful for accessing the field via an interface.
+func (v *__findWorkspaceLabelInput) GetName() string { return v.Name }
+
+// __getCustomViewInput is used internally by genqlient
+type __getCustomViewInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getCustomViewInput.Id, and is useful for accessing the field via an interface.
+func (v *__getCustomViewInput) GetId() string { return v.Id }
+
+// __getCustomerInput is used internally by genqlient
+type __getCustomerInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getCustomerInput.Id, and is useful for accessing the field via an interface.
+func (v *__getCustomerInput) GetId() string { return v.Id }
+
+// __getCustomerNeedInput is used internally by genqlient
+type __getCustomerNeedInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getCustomerNeedInput.Id, and is useful for accessing the field via an interface.
+func (v *__getCustomerNeedInput) GetId() string { return v.Id }
+
+// __getCustomerStatusInput is used internally by genqlient
+type __getCustomerStatusInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getCustomerStatusInput.Id, and is useful for accessing the field via an interface.
+func (v *__getCustomerStatusInput) GetId() string { return v.Id }
+
+// __getEmojiInput is used internally by genqlient
+type __getEmojiInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getEmojiInput.Id, and is useful for accessing the field via an interface.
+func (v *__getEmojiInput) GetId() string { return v.Id }
+
+// __getInitiativeToProjectInput is used internally by genqlient
+type __getInitiativeToProjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getInitiativeToProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__getInitiativeToProjectInput) GetId() string { return v.Id }
+
+// __getIssueInput is used internally by genqlient
+type __getIssueInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getIssueInput.Id, and is useful for accessing the field via an interface.
+func (v *__getIssueInput) GetId() string { return v.Id }
+
+// __getIssueRelationInput is used internally by genqlient
+type __getIssueRelationInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getIssueRelationInput.Id, and is useful for accessing the field via an interface.
+func (v *__getIssueRelationInput) GetId() string { return v.Id }
+
+// __getIssueWorkflowStateInput is used internally by genqlient
+type __getIssueWorkflowStateInput struct {
+	StateId string `json:"stateId"`
+	TeamId  string `json:"teamId"`
+}
+
+// GetStateId returns __getIssueWorkflowStateInput.StateId, and is useful for accessing the field via an interface.
+func (v *__getIssueWorkflowStateInput) GetStateId() string { return v.StateId }
+
+// GetTeamId returns __getIssueWorkflowStateInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__getIssueWorkflowStateInput) GetTeamId() string { return v.TeamId }
+
+// __getLabelInput is used internally by genqlient
+type __getLabelInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getLabelInput.Id, and is useful for accessing the field via an interface.
+func (v *__getLabelInput) GetId() string { return v.Id }
+
+// __getProjectInput is used internally by genqlient
+type __getProjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__getProjectInput) GetId() string { return v.Id }
+
+// __getProjectMembershipInput is used internally by genqlient
+type __getProjectMembershipInput struct {
+	ProjectId string `json:"projectId"`
+	UserId    string `json:"userId"`
+}
+
+// GetProjectId returns __getProjectMembershipInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__getProjectMembershipInput) GetProjectId() string { return v.ProjectId }
+
+// GetUserId returns __getProjectMembershipInput.UserId, and is useful for accessing the field via an interface.
+func (v *__getProjectMembershipInput) GetUserId() string { return v.UserId }
+
+// __getProjectMilestoneInput is used internally by genqlient
+type __getProjectMilestoneInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getProjectMilestoneInput.Id, and is useful for accessing the field via an interface.
+func (v *__getProjectMilestoneInput) GetId() string { return v.Id }
+
+// __getProjectMilestoneIssuesInput is used internally by genqlient
+type __getProjectMilestoneIssuesInput struct {
+	Id    string  `json:"id"`
+	After *string `json:"after"`
+}
+
+// GetId returns __getProjectMilestoneIssuesInput.Id, and is useful for accessing the field via an interface.
+func (v *__getProjectMilestoneIssuesInput) GetId() string { return v.Id }
+
+// GetAfter returns __getProjectMilestoneIssuesInput.After, and is useful for accessing the field via an interface.
+func (v *__getProjectMilestoneIssuesInput) GetAfter() *string { return v.After }
+
+// __getProjectRelationInput is used internally by genqlient
+type __getProjectRelationInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getProjectRelationInput.Id, and is useful for accessing the field via an interface.
+func (v *__getProjectRelationInput) GetId() string { return v.Id }
+
+// __getRoadmapInput is used internally by genqlient
+type __getRoadmapInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getRoadmapInput.Id, and is useful for accessing the field via an interface.
+func (v *__getRoadmapInput) GetId() string { return v.Id }
+
+// __getTeamDefaultWorkflowStatesInput is used internally by genqlient
+type __getTeamDefaultWorkflowStatesInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getTeamDefaultWorkflowStatesInput.Id, and is useful for accessing the field via an interface.
+func (v *__getTeamDefaultWorkflowStatesInput) GetId() string { return v.Id }
+
+// __getTeamInput is used internally by genqlient
+type __getTeamInput struct {
+	Key string `json:"key"`
+}
+
+// GetKey returns __getTeamInput.Key, and is useful for accessing the field via an interface.
+func (v *__getTeamInput) GetKey() string { return v.Key }
+
+// __getTeamMembershipByIdInput is used internally by genqlient
+type __getTeamMembershipByIdInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getTeamMembershipByIdInput.Id, and is useful for accessing the field via an interface.
+func (v *__getTeamMembershipByIdInput) GetId() string { return v.Id }
+
+// __getTeamMembershipInput is used internally by genqlient
+type __getTeamMembershipInput struct {
+	TeamId string `json:"teamId"`
+	UserId string `json:"userId"`
+}
+
+// GetTeamId returns __getTeamMembershipInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__getTeamMembershipInput) GetTeamId() string { return v.TeamId }
+
+// GetUserId returns __getTeamMembershipInput.UserId, and is useful for accessing the field via an interface.
+func (v *__getTeamMembershipInput) GetUserId() string { return v.UserId }
+
+// __getTeamNotificationSubscriptionInput is used internally by genqlient
+type __getTeamNotificationSubscriptionInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getTeamNotificationSubscriptionInput.Id, and is useful for accessing the field via an interface.
+func (v *__getTeamNotificationSubscriptionInput) GetId() string { return v.Id }
+
+// __getTeamWorkflowInput is used internally by genqlient
+type __getTeamWorkflowInput struct {
+	Key string `json:"key"`
+}
+
+// GetKey returns __getTeamWorkflowInput.Key, and is useful for accessing the field via an interface.
+func (v *__getTeamWorkflowInput) GetKey() string { return v.Key }
+
+// __getTeamWorkflowStatesInput is used internally by genqlient
+type __getTeamWorkflowStatesInput struct {
+	Key string `json:"key"`
+}
+
+// GetKey returns __getTeamWorkflowStatesInput.Key, and is useful for accessing the field via an interface.
+func (v *__getTeamWorkflowStatesInput) GetKey() string { return v.Key }
+
+// __getTemplateInput is used internally by genqlient
+type __getTemplateInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getTemplateInput.Id, and is useful for accessing the field via an interface.
+func (v *__getTemplateInput) GetId() string { return v.Id }
+
+// __getWorkflowStateInput is used internally by genqlient
+type __getWorkflowStateInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getWorkflowStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__getWorkflowStateInput) GetId() string { return v.Id }
+
+// __getWorkflowStateIssuesInput is used internally by genqlient
+type __getWorkflowStateIssuesInput struct {
+	Id    string  `json:"id"`
+	After *string `json:"after"`
+}
+
+// GetId returns __getWorkflowStateIssuesInput.Id, and is useful for accessing the field via an interface.
+func (v *__getWorkflowStateIssuesInput) GetId() string { return v.Id }
+
+// GetAfter returns __getWorkflowStateIssuesInput.After, and is useful for accessing the field via an interface.
+func (v *__getWorkflowStateIssuesInput) GetAfter() *string { return v.After }
+
+// __getWorkflowSyncStatesInput is used internally by genqlient
+type __getWorkflowSyncStatesInput struct {
+	TeamId string `json:"teamId"`
+}
+
+// GetTeamId returns __getWorkflowSyncStatesInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__getWorkflowSyncStatesInput) GetTeamId() string { return v.TeamId }
+
+// __listCustomViewsInput is used internally by genqlient
+type __listCustomViewsInput struct {
+	After *string `json:"after"`
+}
+
+// GetAfter returns __listCustomViewsInput.After, and is useful for accessing the field via an interface.
+func (v *__listCustomViewsInput) GetAfter() *string { return v.After }
+
+// __listCyclesInput is used internally by genqlient
+type __listCyclesInput struct {
+	TeamId string                 `json:"teamId"`
+	Filter map[string]interface{} `json:"filter"`
+	After  *string                `json:"after"`
+}
+
+// GetTeamId returns __listCyclesInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__listCyclesInput) GetTeamId() string { return v.TeamId }
+
+// GetFilter returns __listCyclesInput.Filter, and is useful for accessing the field via an interface.
+func (v *__listCyclesInput) GetFilter() map[string]interface{} { return v.Filter }
+
+// GetAfter returns __listCyclesInput.After, and is useful for accessing the field via an interface.
+func (v *__listCyclesInput) GetAfter() *string { return v.After }
+
+// __listGitAutomationStatesInput is used internally by genqlient
+type __listGitAutomationStatesInput struct {
+	TeamId string  `json:"teamId"`
+	After  *string `json:"after"`
+}
+
+// GetTeamId returns __listGitAutomationStatesInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__listGitAutomationStatesInput) GetTeamId() string { return v.TeamId }
+
+// GetAfter returns __listGitAutomationStatesInput.After, and is useful for accessing the field via an interface.
+func (v *__listGitAutomationStatesInput) GetAfter() *string { return v.After }
+
+// __listIssuesInput is used internally by genqlient
+type __listIssuesInput struct {
+	Filter map[string]interface{} `json:"filter"`
+	First  int                    `json:"first"`
+	After  *string                `json:"after"`
+}
+
+// GetFilter returns __listIssuesInput.Filter, and is useful for accessing the field via an interface.
+func (v *__listIssuesInput) GetFilter() map[string]interface{} { return v.Filter }
+
+// GetFirst returns __listIssuesInput.First, and is useful for accessing the field via an interface.
+func (v *__listIssuesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __listIssuesInput.After, and is useful for accessing the field via an interface.
+func (v *__listIssuesInput) GetAfter() *string { return v.After }
+
+// __listLabelsOfTeamInput is used internally by genqlient
+type __listLabelsOfTeamInput struct {
+	TeamId string  `json:"teamId"`
+	After  *string `json:"after"`
+}
+
+// GetTeamId returns __listLabelsOfTeamInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__listLabelsOfTeamInput) GetTeamId() string { return v.TeamId }
+
+// GetAfter returns __listLabelsOfTeamInput.After, and is useful for accessing the field via an interface.
+func (v *__listLabelsOfTeamInput) GetAfter() *string { return v.After }
+
+// __listProjectMembersInput is used internally by genqlient
+type __listProjectMembersInput struct {
+	ProjectId string  `json:"projectId"`
+	After     *string `json:"after"`
+}
+
+// GetProjectId returns __listProjectMembersInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listProjectMembersInput) GetProjectId() string { return v.ProjectId }
+
+// GetAfter returns __listProjectMembersInput.After, and is useful for accessing the field via an interface.
+func (v *__listProjectMembersInput) GetAfter() *string { return v.After }
+
+// __listProjectsInput is used internally by genqlient
+type __listProjectsInput struct {
+	Filter map[string]interface{} `json:"filter"`
+	After  *string                `json:"after"`
+}
+
+// GetFilter returns __listProjectsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__listProjectsInput) GetFilter() map[string]interface{} { return v.Filter }
+
+// GetAfter returns __listProjectsInput.After, and is useful for accessing the field via an interface.
+func (v *__listProjectsInput) GetAfter() *string { return v.After }
+
+// __listRoadmapToProjectsInput is used internally by genqlient
+type __listRoadmapToProjectsInput struct {
+	After *string `json:"after"`
+}
+
+// GetAfter returns __listRoadmapToProjectsInput.After, and is useful for accessing the field via an interface.
+func (v *__listRoadmapToProjectsInput) GetAfter() *string { return v.After }
+
+// __listTeamLabelsInput is used internally by genqlient
+type __listTeamLabelsInput struct {
+	TeamId string `json:"teamId"`
+}
+
+// GetTeamId returns __listTeamLabelsInput.TeamId, and is useful for accessing the field via an interface.
+func (v *__listTeamLabelsInput) GetTeamId() string { return v.TeamId }
+
+// __listTeamsInput is used internally by genqlient
+type __listTeamsInput struct {
+	After *string `json:"after"`
+}
+
+// GetAfter returns __listTeamsInput.After, and is useful for accessing the field via an interface.
+func (v *__listTeamsInput) GetAfter() *string { return v.After }
+
+// __listWebhooksInput is used internally by genqlient
+type __listWebhooksInput struct {
+	After *string `json:"after"`
+}
+
+// GetAfter returns __listWebhooksInput.After, and is useful for accessing the field via an interface.
+func (v *__listWebhooksInput) GetAfter() *string { return v.After }
+
+// __listWorkspaceLabelsInput is used internally by genqlient
+type __listWorkspaceLabelsInput struct {
+	After *string `json:"after"`
+}
+
+// GetAfter returns __listWorkspaceLabelsInput.After, and is useful for accessing the field via an interface.
+func (v *__listWorkspaceLabelsInput) GetAfter() *string { return v.After }
+
+// __moveIssueToWorkflowStateInput is used internally by genqlient
+type __moveIssueToWorkflowStateInput struct {
+	Id      string `json:"id"`
+	StateId string `json:"stateId"`
+}
+
+// GetId returns __moveIssueToWorkflowStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__moveIssueToWorkflowStateInput) GetId() string { return v.Id }
+
+// GetStateId returns __moveIssueToWorkflowStateInput.StateId, and is useful for accessing the field via an interface.
+func (v *__moveIssueToWorkflowStateInput) GetStateId() string { return v.StateId }
+
+// __setTeamAutoCloseStateInput is used internally by genqlient
+type __setTeamAutoCloseStateInput struct {
+	Id      string `json:"id"`
+	StateId string `json:"stateId"`
+}
+
+// GetId returns __setTeamAutoCloseStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__setTeamAutoCloseStateInput) GetId() string { return v.Id }
+
+// GetStateId returns __setTeamAutoCloseStateInput.StateId, and is useful for accessing the field via an interface.
+func (v *__setTeamAutoCloseStateInput) GetStateId() string { return v.StateId }
+
+// __setTeamDefaultIssueStateInput is used internally by genqlient
+type __setTeamDefaultIssueStateInput struct {
+	Id      string `json:"id"`
+	StateId string `json:"stateId"`
+}
+
+// GetId returns __setTeamDefaultIssueStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__setTeamDefaultIssueStateInput) GetId() string { return v.Id }
+
+// GetStateId returns __setTeamDefaultIssueStateInput.StateId, and is useful for accessing the field via an interface.
+func (v *__setTeamDefaultIssueStateInput) GetStateId() string { return v.StateId }
+
+// __setTeamDefaultTemplatesInput is used internally by genqlient
+type __setTeamDefaultTemplatesInput struct {
+	Id                   string  `json:"id"`
+	MembersTemplateId    *string `json:"membersTemplateId"`
+	NonMembersTemplateId *string `json:"nonMembersTemplateId"`
+	ProjectTemplateId    *string `json:"projectTemplateId"`
+}
+
+// GetId returns __setTeamDefaultTemplatesInput.Id, and is useful for accessing the field via an interface.
+func (v *__setTeamDefaultTemplatesInput) GetId() string { return v.Id }
+
+// GetMembersTemplateId returns __setTeamDefaultTemplatesInput.MembersTemplateId, and is useful for accessing the field via an interface.
+func (v *__setTeamDefaultTemplatesInput) GetMembersTemplateId() *string { return v.MembersTemplateId }
+
+// GetNonMembersTemplateId returns __setTeamDefaultTemplatesInput.NonMembersTemplateId, and is useful for accessing the field via an interface.
+func (v *__setTeamDefaultTemplatesInput) GetNonMembersTemplateId() *string {
+	return v.NonMembersTemplateId
+}
+
+// GetProjectTemplateId returns __setTeamDefaultTemplatesInput.ProjectTemplateId, and is useful for accessing the field via an interface.
+func (v *__setTeamDefaultTemplatesInput) GetProjectTemplateId() *string { return v.ProjectTemplateId }
+
+// __setTeamMarkedAsDuplicateWorkflowStateInput is used internally by genqlient
+type __setTeamMarkedAsDuplicateWorkflowStateInput struct {
+	Id      string `json:"id"`
+	StateId string `json:"stateId"`
+}
+
+// GetId returns __setTeamMarkedAsDuplicateWorkflowStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__setTeamMarkedAsDuplicateWorkflowStateInput) GetId() string { return v.Id }
+
+// GetStateId returns __setTeamMarkedAsDuplicateWorkflowStateInput.StateId, and is useful for accessing the field via an interface.
+func (v *__setTeamMarkedAsDuplicateWorkflowStateInput) GetStateId() string { return v.StateId }
+
+// __updateCustomViewInput is used internally by genqlient
+type __updateCustomViewInput struct {
+	Input CustomViewUpdateInput `json:"input"`
+	Id    string                `json:"id"`
+}
+
+// GetInput returns __updateCustomViewInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateCustomViewInput) GetInput() CustomViewUpdateInput { return v.Input }
+
+// GetId returns __updateCustomViewInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateCustomViewInput) GetId() string { return v.Id }
+
+// __updateCustomerInput is used internally by genqlient
+type __updateCustomerInput struct {
+	Input CustomerUpdateInput `json:"input"`
+	Id    string              `json:"id"`
+}
+
+// GetInput returns __updateCustomerInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateCustomerInput) GetInput() CustomerUpdateInput { return v.Input }
+
+// GetId returns __updateCustomerInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateCustomerInput) GetId() string { return v.Id }
+
+// __updateCustomerNeedInput is used internally by genqlient
+type __updateCustomerNeedInput struct {
+	Input CustomerNeedUpdateInput `json:"input"`
+	Id    string                  `json:"id"`
+}
+
+// GetInput returns __updateCustomerNeedInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateCustomerNeedInput) GetInput() CustomerNeedUpdateInput { return v.Input }
+
+// GetId returns __updateCustomerNeedInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateCustomerNeedInput) GetId() string { return v.Id }
+
+// __updateCustomerStatusInput is used internally by genqlient
+type __updateCustomerStatusInput struct {
+	Input CustomerStatusUpdateInput `json:"input"`
+	Id    string                    `json:"id"`
+}
+
+// GetInput returns __updateCustomerStatusInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateCustomerStatusInput) GetInput() CustomerStatusUpdateInput { return v.Input }
+
+// GetId returns __updateCustomerStatusInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateCustomerStatusInput) GetId() string { return v.Id }
+
+// __updateGitAutomationStateInput is used internally by genqlient
+type __updateGitAutomationStateInput struct {
+	Input GitAutomationStateUpdateInput `json:"input"`
+	Id    string                        `json:"id"`
+}
+
+// GetInput returns __updateGitAutomationStateInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateGitAutomationStateInput) GetInput() GitAutomationStateUpdateInput { return v.Input }
+
+// GetId returns __updateGitAutomationStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateGitAutomationStateInput) GetId() string { return v.Id }
+
+// __updateInitiativeToProjectInput is used internally by genqlient
+type __updateInitiativeToProjectInput struct {
+	Input InitiativeToProjectUpdateInput `json:"input"`
+	Id    string                         `json:"id"`
+}
+
+// GetInput returns __updateInitiativeToProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateInitiativeToProjectInput) GetInput() InitiativeToProjectUpdateInput { return v.Input }
+
+// GetId returns __updateInitiativeToProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateInitiativeToProjectInput) GetId() string { return v.Id }
+
+// __updateIssueInput is used internally by genqlient
+type __updateIssueInput struct {
+	Input IssueUpdateInput `json:"input"`
+	Id    string           `json:"id"`
+}
+
+// GetInput returns __updateIssueInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateIssueInput) GetInput() IssueUpdateInput { return v.Input }
+
+// GetId returns __updateIssueInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateIssueInput) GetId() string { return v.Id }
+
+// __updateIssueRelationInput is used internally by genqlient
+type __updateIssueRelationInput struct {
+	Input IssueRelationUpdateInput `json:"input"`
+	Id    string                   `json:"id"`
+}
+
+// GetInput returns __updateIssueRelationInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateIssueRelationInput) GetInput() IssueRelationUpdateInput { return v.Input }
+
+// GetId returns __updateIssueRelationInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateIssueRelationInput) GetId() string { return v.Id }
+
+// __updateLabelInput is used internally by genqlient
+type __updateLabelInput struct {
+	Input IssueLabelUpdateInput `json:"input"`
+	Id    string                `json:"id"`
+}
+
+// GetInput returns __updateLabelInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateLabelInput) GetInput() IssueLabelUpdateInput { return v.Input }
+
+// GetId returns __updateLabelInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateLabelInput) GetId() string { return v.Id }
+
+// __updateProjectInput is used internally by genqlient
+type __updateProjectInput struct {
+	Input ProjectUpdateInput `json:"input"`
+	Id    string             `json:"id"`
+}
+
+// GetInput returns __updateProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateProjectInput) GetInput() ProjectUpdateInput { return v.Input }
+
+// GetId returns __updateProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateProjectInput) GetId() string { return v.Id }
+
+// __updateProjectMembersInput is used internally by genqlient
+type __updateProjectMembersInput struct {
+	ProjectId string   `json:"projectId"`
+	MemberIds []string `json:"memberIds"`
+}
+
+// GetProjectId returns __updateProjectMembersInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__updateProjectMembersInput) GetProjectId() string { return v.ProjectId }
+
+// GetMemberIds returns __updateProjectMembersInput.MemberIds, and is useful for accessing the field via an interface.
+func (v *__updateProjectMembersInput) GetMemberIds() []string { return v.MemberIds }
+
+// __updateProjectMilestoneInput is used internally by genqlient
+type __updateProjectMilestoneInput struct {
+	Input ProjectMilestoneUpdateInput `json:"input"`
+	Id    string                      `json:"id"`
+}
+
+// GetInput returns __updateProjectMilestoneInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateProjectMilestoneInput) GetInput() ProjectMilestoneUpdateInput { return v.Input }
+
+// GetId returns __updateProjectMilestoneInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateProjectMilestoneInput) GetId() string { return v.Id }
+
+// __updateProjectRelationInput is used internally by genqlient
+type __updateProjectRelationInput struct {
+	Input ProjectRelationUpdateInput `json:"input"`
+	Id    string                     `json:"id"`
+}
+
+// GetInput returns __updateProjectRelationInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateProjectRelationInput) GetInput() ProjectRelationUpdateInput { return v.Input }
+
+// GetId returns __updateProjectRelationInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateProjectRelationInput) GetId() string { return v.Id }
+
+// __updateRoadmapInput is used internally by genqlient
+type __updateRoadmapInput struct {
+	Input RoadmapUpdateInput `json:"input"`
+	Id    string             `json:"id"`
+}
+
+// GetInput returns __updateRoadmapInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateRoadmapInput) GetInput() RoadmapUpdateInput { return v.Input }
+
+// GetId returns __updateRoadmapInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateRoadmapInput) GetId() string { return v.Id }
+
+// __updateRoadmapToProjectInput is used internally by genqlient
+type __updateRoadmapToProjectInput struct {
+	Input RoadmapToProjectUpdateInput `json:"input"`
+	Id    string                      `json:"id"`
+}
+
+// GetInput returns __updateRoadmapToProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateRoadmapToProjectInput) GetInput() RoadmapToProjectUpdateInput { return v.Input }
+
+// GetId returns __updateRoadmapToProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateRoadmapToProjectInput) GetId() string { return v.Id }
+
+// __updateTeamInput is used internally by genqlient
+type __updateTeamInput struct {
+	Input TeamUpdateInput `json:"input"`
+	Id    string          `json:"id"`
+}
+
+// GetInput returns __updateTeamInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateTeamInput) GetInput() TeamUpdateInput { return v.Input }
+
+// GetId returns __updateTeamInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateTeamInput) GetId() string { return v.Id }
+
+// __updateTeamMembershipInput is used internally by genqlient
+type __updateTeamMembershipInput struct {
+	Id    string `json:"id"`
+	Owner bool   `json:"owner"`
+}
+
+// GetId returns __updateTeamMembershipInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateTeamMembershipInput) GetId() string { return v.Id }
+
+// GetOwner returns __updateTeamMembershipInput.Owner, and is useful for accessing the field via an interface.
+func (v *__updateTeamMembershipInput) GetOwner() bool { return v.Owner }
+
+// __updateTeamNotificationSubscriptionInput is used internally by genqlient
+type __updateTeamNotificationSubscriptionInput struct {
+	Id                 string `json:"id"`
+	SlackNewIssue      bool   `json:"slackNewIssue"`
+	SlackIssueComments bool   `json:"slackIssueComments"`
+	SlackIssueStatuses bool   `json:"slackIssueStatuses"`
+}
+
+// GetId returns __updateTeamNotificationSubscriptionInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateTeamNotificationSubscriptionInput) GetId() string { return v.Id }
+
+// GetSlackNewIssue returns __updateTeamNotificationSubscriptionInput.SlackNewIssue, and is useful for accessing the field via an interface.
+func (v *__updateTeamNotificationSubscriptionInput) GetSlackNewIssue() bool { return v.SlackNewIssue }
+
+// GetSlackIssueComments returns __updateTeamNotificationSubscriptionInput.SlackIssueComments, and is useful for accessing the field via an interface.
+func (v *__updateTeamNotificationSubscriptionInput) GetSlackIssueComments() bool {
+	return v.SlackIssueComments
+}
+
+// GetSlackIssueStatuses returns __updateTeamNotificationSubscriptionInput.SlackIssueStatuses, and is useful for accessing the field via an interface.
+func (v *__updateTeamNotificationSubscriptionInput) GetSlackIssueStatuses() bool {
+	return v.SlackIssueStatuses
+}
+
+// __updateTeamWorkflowInput is used internally by genqlient
+type __updateTeamWorkflowInput struct {
+	Id     string  `json:"id"`
+	Draft  *string `json:"draft"`
+	Start  *string `json:"start"`
+	Review *string `json:"review"`
+	Merge  *string `json:"merge"`
+}
+
+// GetId returns __updateTeamWorkflowInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateTeamWorkflowInput) GetId() string { return v.Id }
+
+// GetDraft returns __updateTeamWorkflowInput.Draft, and is useful for accessing the field via an interface.
+func (v *__updateTeamWorkflowInput) GetDraft() *string { return v.Draft }
+
+// GetStart returns __updateTeamWorkflowInput.Start, and is useful for accessing the field via an interface.
+func (v *__updateTeamWorkflowInput) GetStart() *string { return v.Start }
+
+// GetReview returns __updateTeamWorkflowInput.Review, and is useful for accessing the field via an interface.
+func (v *__updateTeamWorkflowInput) GetReview() *string { return v.Review }
+
+// GetMerge returns __updateTeamWorkflowInput.Merge, and is useful for accessing the field via an interface.
+func (v *__updateTeamWorkflowInput) GetMerge() *string { return v.Merge }
+
+// __updateTemplateInput is used internally by genqlient
+type __updateTemplateInput struct {
+	Input TemplateUpdateInput `json:"input"`
+	Id    string              `json:"id"`
+}
+
+// GetInput returns __updateTemplateInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateTemplateInput) GetInput() TemplateUpdateInput { return v.Input }
+
+// GetId returns __updateTemplateInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateTemplateInput) GetId() string { return v.Id }
+
+// __updateWorkflowStateInput is used internally by genqlient
+type __updateWorkflowStateInput struct {
+	Input WorkflowStateUpdateInput `json:"input"`
+	Id    string                   `json:"id"`
+}
+
+// GetInput returns __updateWorkflowStateInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateWorkflowStateInput) GetInput() WorkflowStateUpdateInput { return v.Input }
+
+// GetId returns __updateWorkflowStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateWorkflowStateInput) GetId() string { return v.Id }
+
+// __updateWorkspaceSettingsInput is used internally by genqlient
+type __updateWorkspaceSettingsInput struct {
+	Input OrganizationUpdateInput `json:"input"`
+}
+
+// GetInput returns __updateWorkspaceSettingsInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateWorkspaceSettingsInput) GetInput() OrganizationUpdateInput { return v.Input }
+
+// createCustomViewCustomViewCreateCustomViewPayload includes the requested fields of the GraphQL type CustomViewPayload.
+type createCustomViewCustomViewCreateCustomViewPayload struct {
+	// The custom view that was created or updated.
+	CustomView createCustomViewCustomViewCreateCustomViewPayloadCustomView `json:"customView"`
+}
+
+// GetCustomView returns createCustomViewCustomViewCreateCustomViewPayload.CustomView, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayload) GetCustomView() createCustomViewCustomViewCreateCustomViewPayloadCustomView {
+	return v.CustomView
+}
+
+// createCustomViewCustomViewCreateCustomViewPayloadCustomView includes the requested fields of the GraphQL type CustomView.
+// The GraphQL type's documentation follows.
+//
+// A custom view that has been saved by a user.
+type createCustomViewCustomViewCreateCustomViewPayloadCustomView struct {
+	CustomView `json:"-"`
+}
+
+// GetId returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Id, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetId() string {
+	return v.CustomView.Id
+}
+
+// GetName returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Name, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetName() string {
+	return v.CustomView.Name
+}
+
+// GetDescription returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Description, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetDescription() *string {
+	return v.CustomView.Description
+}
+
+// GetIcon returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Icon, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetIcon() *string {
+	return v.CustomView.Icon
+}
+
+// GetColor returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Color, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetColor() *string {
+	return v.CustomView.Color
+}
+
+// GetShared returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Shared, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetShared() bool {
+	return v.CustomView.Shared
+}
+
+// GetFilterData returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.FilterData, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetFilterData() map[string]interface{} {
+	return v.CustomView.FilterData
+}
+
+// GetTeam returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Team, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetTeam() *CustomViewTeam {
+	return v.CustomView.Team
+}
+
+// GetOwner returns createCustomViewCustomViewCreateCustomViewPayloadCustomView.Owner, and is useful for accessing the field via an interface.
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) GetOwner() CustomViewOwnerUser {
+	return v.CustomView.Owner
+}
+
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createCustomViewCustomViewCreateCustomViewPayloadCustomView
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createCustomViewCustomViewCreateCustomViewPayloadCustomView = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomView)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateCustomViewCustomViewCreateCustomViewPayloadCustomView struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Shared bool `json:"shared"`
+
+	FilterData map[string]interface{} `json:"filterData"`
+
+	Team *CustomViewTeam `json:"team"`
+
+	Owner CustomViewOwnerUser `json:"owner"`
+}
+
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createCustomViewCustomViewCreateCustomViewPayloadCustomView) __premarshalJSON() (*__premarshalcreateCustomViewCustomViewCreateCustomViewPayloadCustomView, error) {
+	var retval __premarshalcreateCustomViewCustomViewCreateCustomViewPayloadCustomView
+
+	retval.Id = v.CustomView.Id
+	retval.Name = v.CustomView.Name
+	retval.Description = v.CustomView.Description
+	retval.Icon = v.CustomView.Icon
+	retval.Color = v.CustomView.Color
+	retval.Shared = v.CustomView.Shared
+	retval.FilterData = v.CustomView.FilterData
+	retval.Team = v.CustomView.Team
+	retval.Owner = v.CustomView.Owner
+	return &retval, nil
+}
+
+// createCustomViewResponse is returned by createCustomView on success.
+type createCustomViewResponse struct {
+	// Creates a new custom view.
+	CustomViewCreate createCustomViewCustomViewCreateCustomViewPayload `json:"customViewCreate"`
+}
+
+// GetCustomViewCreate returns createCustomViewResponse.CustomViewCreate, and is useful for accessing the field via an interface.
+func (v *createCustomViewResponse) GetCustomViewCreate() createCustomViewCustomViewCreateCustomViewPayload {
+	return v.CustomViewCreate
+}
+
+// createCustomerCustomerCreateCustomerPayload includes the requested fields of the GraphQL type CustomerPayload.
+type createCustomerCustomerCreateCustomerPayload struct {
+	// The customer that was created or updated.
+	Customer createCustomerCustomerCreateCustomerPayloadCustomer `json:"customer"`
+}
+
+// GetCustomer returns createCustomerCustomerCreateCustomerPayload.Customer, and is useful for accessing the field via an interface.
+func (v *createCustomerCustomerCreateCustomerPayload) GetCustomer() createCustomerCustomerCreateCustomerPayloadCustomer {
+	return v.Customer
+}
+
+// createCustomerCustomerCreateCustomerPayloadCustomer includes the requested fields of the GraphQL type Customer.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer whose needs will be tied to issues or projects.
+type createCustomerCustomerCreateCustomerPayloadCustomer struct {
+	Customer `json:"-"`
+}
+
+// GetId returns createCustomerCustomerCreateCustomerPayloadCustomer.Id, and is useful for accessing the field via an interface.
+func (v *createCustomerCustomerCreateCustomerPayloadCustomer) GetId() string { return v.Customer.Id }
+
+// GetName returns createCustomerCustomerCreateCustomerPayloadCustomer.Name, and is useful for accessing the field via an interface.
+func (v *createCustomerCustomerCreateCustomerPayloadCustomer) GetName() string {
+	return v.Customer.Name
+}
+
+// GetDomains returns createCustomerCustomerCreateCustomerPayloadCustomer.Domains, and is useful for accessing the field via an interface.
+func (v *createCustomerCustomerCreateCustomerPayloadCustomer) GetDomains() []string {
+	return v.Customer.Domains
+}
+
+// GetExternalIds returns createCustomerCustomerCreateCustomerPayloadCustomer.ExternalIds, and is useful for accessing the field via an interface.
+func (v *createCustomerCustomerCreateCustomerPayloadCustomer) GetExternalIds() []string {
+	return v.Customer.ExternalIds
+}
+
+// GetSlackChannelId returns createCustomerCustomerCreateCustomerPayloadCustomer.SlackChannelId, and is useful for accessing the field via an interface.
+func (v *createCustomerCustomerCreateCustomerPayloadCustomer) GetSlackChannelId() *string {
+	return v.Customer.SlackChannelId
+}
+
+// GetOwner returns createCustomerCustomerCreateCustomerPayloadCustomer.Owner, and is useful for accessing the field via an interface.
+func (v *createCustomerCustomerCreateCustomerPayloadCustomer) GetOwner() CustomerOwnerUser {
+	return v.Customer.Owner
+}
+
+// GetStatus returns createCustomerCustomerCreateCustomerPayloadCustomer.Status, and is useful for accessing the field via an interface.
+func (v *createCustomerCustomerCreateCustomerPayloadCustomer) GetStatus() CustomerStatusRef {
+	return v.Customer.Status
+}
+
+func (v *createCustomerCustomerCreateCustomerPayloadCustomer) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createCustomerCustomerCreateCustomerPayloadCustomer
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createCustomerCustomerCreateCustomerPayloadCustomer = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Customer)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateCustomerCustomerCreateCustomerPayloadCustomer struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Domains []string `json:"domains"`
+
+	ExternalIds []string `json:"externalIds"`
+
+	SlackChannelId *string `json:"slackChannelId"`
+
+	Owner CustomerOwnerUser `json:"owner"`
+
+	Status CustomerStatusRef `json:"status"`
+}
+
+func (v *createCustomerCustomerCreateCustomerPayloadCustomer) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createCustomerCustomerCreateCustomerPayloadCustomer) __premarshalJSON() (*__premarshalcreateCustomerCustomerCreateCustomerPayloadCustomer, error) {
+	var retval __premarshalcreateCustomerCustomerCreateCustomerPayloadCustomer
+
+	retval.Id = v.Customer.Id
+	retval.Name = v.Customer.Name
+	retval.Domains = v.Customer.Domains
+	retval.ExternalIds = v.Customer.ExternalIds
+	retval.SlackChannelId = v.Customer.SlackChannelId
+	retval.Owner = v.Customer.Owner
+	retval.Status = v.Customer.Status
+	return &retval, nil
+}
+
+// createCustomerNeedCustomerNeedCreateCustomerNeedPayload includes the requested fields of the GraphQL type CustomerNeedPayload.
+type createCustomerNeedCustomerNeedCreateCustomerNeedPayload struct {
+	// The customer need that was created or updated.
+	Need createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed `json:"need"`
+}
+
+// GetNeed returns createCustomerNeedCustomerNeedCreateCustomerNeedPayload.Need, and is useful for accessing the field via an interface.
+func (v *createCustomerNeedCustomerNeedCreateCustomerNeedPayload) GetNeed() createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed {
+	return v.Need
+}
+
+// createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed includes the requested fields of the GraphQL type CustomerNeed.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer need, expressed through a reference to an issue, project, or comment.
+type createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed struct {
+	CustomerNeed `json:"-"`
+}
+
+// GetId returns createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed.Id, and is useful for accessing the field via an interface.
+func (v *createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed) GetId() string {
+	return v.CustomerNeed.Id
+}
+
+// GetPriority returns createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed.Priority, and is useful for accessing the field via an interface.
+func (v *createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed) GetPriority() float64 {
+	return v.CustomerNeed.Priority
+}
+
+// GetCustomer returns createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed.Customer, and is useful for accessing the field via an interface.
+func (v *createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed) GetCustomer() CustomerNeedCustomer {
+	return v.CustomerNeed.Customer
+}
+
+// GetIssue returns createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed.Issue, and is useful for accessing the field via an interface.
+func (v *createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed) GetIssue() *CustomerNeedIssue {
+	return v.CustomerNeed.Issue
+}
+
+// GetProject returns createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed.Project, and is useful for accessing the field via an interface.
+func (v *createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed) GetProject() *CustomerNeedProject {
+	return v.CustomerNeed.Project
+}
+
+// GetComment returns createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed.Comment, and is useful for accessing the field via an interface.
+func (v *createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed) GetComment() *CustomerNeedComment {
+	return v.CustomerNeed.Comment
+}
+
+func (v *createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomerNeed)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed struct {
+	Id string `json:"id"`
+
+	Priority float64 `json:"priority"`
+
+	Customer CustomerNeedCustomer `json:"customer"`
+
+	Issue *CustomerNeedIssue `json:"issue"`
+
+	Project *CustomerNeedProject `json:"project"`
+
+	Comment *CustomerNeedComment `json:"comment"`
+}
+
+func (v *createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed) __premarshalJSON() (*__premarshalcreateCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed, error) {
+	var retval __premarshalcreateCustomerNeedCustomerNeedCreateCustomerNeedPayloadNeedCustomerNeed
+
+	retval.Id = v.CustomerNeed.Id
+	retval.Priority = v.CustomerNeed.Priority
+	retval.Customer = v.CustomerNeed.Customer
+	retval.Issue = v.CustomerNeed.Issue
+	retval.Project = v.CustomerNeed.Project
+	retval.Comment = v.CustomerNeed.Comment
+	return &retval, nil
+}
+
+// createCustomerNeedResponse is returned by createCustomerNeed on success.
+type createCustomerNeedResponse struct {
+	// [ALPHA] Creates a new customer need.
+	CustomerNeedCreate createCustomerNeedCustomerNeedCreateCustomerNeedPayload `json:"customerNeedCreate"`
+}
+
+// GetCustomerNeedCreate returns createCustomerNeedResponse.CustomerNeedCreate, and is useful for accessing the field via an interface.
+func (v *createCustomerNeedResponse) GetCustomerNeedCreate() createCustomerNeedCustomerNeedCreateCustomerNeedPayload {
+	return v.CustomerNeedCreate
+}
+
+// createCustomerResponse is returned by createCustomer on success.
+type createCustomerResponse struct {
+	// [ALPHA] Creates a new customer.
+	CustomerCreate createCustomerCustomerCreateCustomerPayload `json:"customerCreate"`
+}
+
+// GetCustomerCreate returns createCustomerResponse.CustomerCreate, and is useful for accessing the field via an interface.
+func (v *createCustomerResponse) GetCustomerCreate() createCustomerCustomerCreateCustomerPayload {
+	return v.CustomerCreate
+}
+
+// createCustomerStatusCustomerStatusCreateCustomerStatusPayload includes the requested fields of the GraphQL type CustomerStatusPayload.
+type createCustomerStatusCustomerStatusCreateCustomerStatusPayload struct {
+	// The customer status that was created or updated.
+	Status createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus `json:"status"`
+}
+
+// GetStatus returns createCustomerStatusCustomerStatusCreateCustomerStatusPayload.Status, and is useful for accessing the field via an interface.
+func (v *createCustomerStatusCustomerStatusCreateCustomerStatusPayload) GetStatus() createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus {
+	return v.Status
+}
+
+// createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus includes the requested fields of the GraphQL type CustomerStatus.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer status.
+type createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus struct {
+	CustomerStatus `json:"-"`
+}
+
+// GetId returns createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus.Id, and is useful for accessing the field via an interface.
+func (v *createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus) GetId() string {
+	return v.CustomerStatus.Id
+}
+
+// GetName returns createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus.Name, and is useful for accessing the field via an interface.
+func (v *createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus) GetName() string {
+	return v.CustomerStatus.Name
+}
+
+// GetColor returns createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus.Color, and is useful for accessing the field via an interface.
+func (v *createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus) GetColor() string {
+	return v.CustomerStatus.Color
+}
+
+// GetDescription returns createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus.Description, and is useful for accessing the field via an interface.
+func (v *createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus) GetDescription() *string {
+	return v.CustomerStatus.Description
+}
+
+// GetType returns createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus.Type, and is useful for accessing the field via an interface.
+func (v *createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus) GetType() CustomerStatusType {
+	return v.CustomerStatus.Type
+}
+
+// GetPosition returns createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus.Position, and is useful for accessing the field via an interface.
+func (v *createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus) GetPosition() float64 {
+	return v.CustomerStatus.Position
+}
+
+func (v *createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomerStatus)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type CustomerStatusType `json:"type"`
+
+	Position float64 `json:"position"`
+}
+
+func (v *createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus) __premarshalJSON() (*__premarshalcreateCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus, error) {
+	var retval __premarshalcreateCustomerStatusCustomerStatusCreateCustomerStatusPayloadStatusCustomerStatus
+
+	retval.Id = v.CustomerStatus.Id
+	retval.Name = v.CustomerStatus.Name
+	retval.Color = v.CustomerStatus.Color
+	retval.Description = v.CustomerStatus.Description
+	retval.Type = v.CustomerStatus.Type
+	retval.Position = v.CustomerStatus.Position
+	return &retval, nil
+}
+
+// createCustomerStatusResponse is returned by createCustomerStatus on success.
+type createCustomerStatusResponse struct {
+	// [ALPHA] Creates a new customer status.
+	CustomerStatusCreate createCustomerStatusCustomerStatusCreateCustomerStatusPayload `json:"customerStatusCreate"`
+}
+
+// GetCustomerStatusCreate returns createCustomerStatusResponse.CustomerStatusCreate, and is useful for accessing the field via an interface.
+func (v *createCustomerStatusResponse) GetCustomerStatusCreate() createCustomerStatusCustomerStatusCreateCustomerStatusPayload {
+	return v.CustomerStatusCreate
+}
+
+// createEmojiEmojiCreateEmojiPayload includes the requested fields of the GraphQL type EmojiPayload.
+type createEmojiEmojiCreateEmojiPayload struct {
+	// The emoji that was created.
+	Emoji createEmojiEmojiCreateEmojiPayloadEmoji `json:"emoji"`
+}
+
+// GetEmoji returns createEmojiEmojiCreateEmojiPayload.Emoji, and is useful for accessing the field via an interface.
+func (v *createEmojiEmojiCreateEmojiPayload) GetEmoji() createEmojiEmojiCreateEmojiPayloadEmoji {
+	return v.Emoji
+}
+
+// createEmojiEmojiCreateEmojiPayloadEmoji includes the requested fields of the GraphQL type Emoji.
+// The GraphQL type's documentation follows.
+//
+// A custom emoji.
+type createEmojiEmojiCreateEmojiPayloadEmoji struct {
+	Emoji `json:"-"`
+}
+
+// GetId returns createEmojiEmojiCreateEmojiPayloadEmoji.Id, and is useful for accessing the field via an interface.
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) GetId() string { return v.Emoji.Id }
+
+// GetName returns createEmojiEmojiCreateEmojiPayloadEmoji.Name, and is useful for accessing the field via an interface.
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) GetName() string { return v.Emoji.Name }
+
+// GetUrl returns createEmojiEmojiCreateEmojiPayloadEmoji.Url, and is useful for accessing the field via an interface.
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) GetUrl() string { return v.Emoji.Url }
+
+// GetSource returns createEmojiEmojiCreateEmojiPayloadEmoji.Source, and is useful for accessing the field via an interface.
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) GetSource() string { return v.Emoji.Source }
+
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createEmojiEmojiCreateEmojiPayloadEmoji
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createEmojiEmojiCreateEmojiPayloadEmoji = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Emoji)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateEmojiEmojiCreateEmojiPayloadEmoji struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Url string `json:"url"`
+
+	Source string `json:"source"`
+}
+
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createEmojiEmojiCreateEmojiPayloadEmoji) __premarshalJSON() (*__premarshalcreateEmojiEmojiCreateEmojiPayloadEmoji, error) {
+	var retval __premarshalcreateEmojiEmojiCreateEmojiPayloadEmoji
+
+	retval.Id = v.Emoji.Id
+	retval.Name = v.Emoji.Name
+	retval.Url = v.Emoji.Url
+	retval.Source = v.Emoji.Source
+	return &retval, nil
+}
+
+// createEmojiResponse is returned by createEmoji on success.
+type createEmojiResponse struct {
+	// Creates a custom emoji.
+	EmojiCreate createEmojiEmojiCreateEmojiPayload `json:"emojiCreate"`
+}
+
+// GetEmojiCreate returns createEmojiResponse.EmojiCreate, and is useful for accessing the field via an interface.
+func (v *createEmojiResponse) GetEmojiCreate() createEmojiEmojiCreateEmojiPayload {
+	return v.EmojiCreate
+}
+
+// createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload includes the requested fields of the GraphQL type GitAutomationStatePayload.
+type createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload struct {
+	// The automation state that was created or updated.
+	GitAutomationState createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState `json:"gitAutomationState"`
+}
+
+// GetGitAutomationState returns createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload.GitAutomationState, and is useful for accessing the field via an interface.
+func (v *createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload) GetGitAutomationState() createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState {
+	return v.GitAutomationState
+}
+
+// createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState includes the requested fields of the GraphQL type GitAutomationState.
+// The GraphQL type's documentation follows.
+//
+// A trigger that updates the issue status according to Git automations.
+type createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState struct {
+	GitAutomationState `json:"-"`
+}
+
+// GetId returns createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState.Id, and is useful for accessing the field via an interface.
+func (v *createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState) GetId() string {
+	return v.GitAutomationState.Id
+}
+
+// GetEvent returns createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState.Event, and is useful for accessing the field via an interface.
+func (v *createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState) GetEvent() GitAutomationStates {
+	return v.GitAutomationState.Event
+}
+
+// GetState returns createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState.State, and is useful for accessing the field via an interface.
+func (v *createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState) GetState() *GitAutomationStateStateWorkflowState {
+	return v.GitAutomationState.State
+}
+
+// GetTeam returns createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState.Team, and is useful for accessing the field via an interface.
+func (v *createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState) GetTeam() GitAutomationStateTeam {
+	return v.GitAutomationState.Team
+}
+
+// GetTargetBranch returns createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState.TargetBranch, and is useful for accessing the field via an interface.
+func (v *createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState) GetTargetBranch() *GitAutomationStateTargetBranchGitAutomationTargetBranch {
+	return v.GitAutomationState.TargetBranch
+}
+
+func (v *createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.GitAutomationState)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState struct {
+	Id string `json:"id"`
+
+	Event GitAutomationStates `json:"event"`
+
+	State *GitAutomationStateStateWorkflowState `json:"state"`
+
+	Team GitAutomationStateTeam `json:"team"`
+
+	TargetBranch *GitAutomationStateTargetBranchGitAutomationTargetBranch `json:"targetBranch"`
+}
+
+func (v *createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState) __premarshalJSON() (*__premarshalcreateGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState, error) {
+	var retval __premarshalcreateGitAutomationStateGitAutomationStateCreateGitAutomationStatePayloadGitAutomationState
+
+	retval.Id = v.GitAutomationState.Id
+	retval.Event = v.GitAutomationState.Event
+	retval.State = v.GitAutomationState.State
+	retval.Team = v.GitAutomationState.Team
+	retval.TargetBranch = v.GitAutomationState.TargetBranch
+	return &retval, nil
+}
+
+// createGitAutomationStateResponse is returned by createGitAutomationState on success.
+type createGitAutomationStateResponse struct {
+	// Creates a new automation state.
+	GitAutomationStateCreate createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload `json:"gitAutomationStateCreate"`
+}
+
+// GetGitAutomationStateCreate returns createGitAutomationStateResponse.GitAutomationStateCreate, and is useful for accessing the field via an interface.
+func (v *createGitAutomationStateResponse) GetGitAutomationStateCreate() createGitAutomationStateGitAutomationStateCreateGitAutomationStatePayload {
+	return v.GitAutomationStateCreate
+}
+
+// createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload includes the requested fields of the GraphQL type InitiativeToProjectPayload.
+// The GraphQL type's documentation follows.
+//
+// The result of a initiativeToProject mutation.
+type createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload struct {
+	// The initiativeToProject that was created or updated.
+	InitiativeToProject createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject `json:"initiativeToProject"`
+}
+
+// GetInitiativeToProject returns createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload.InitiativeToProject, and is useful for accessing the field via an interface.
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload) GetInitiativeToProject() createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject {
+	return v.InitiativeToProject
+}
+
+// createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject includes the requested fields of the GraphQL type InitiativeToProject.
+// The GraphQL type's documentation follows.
+//
+// Join table between projects and initiatives.
+type createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject struct {
+	InitiativeToProject `json:"-"`
+}
+
+// GetId returns createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject.Id, and is useful for accessing the field via an interface.
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) GetId() string {
+	return v.InitiativeToProject.Id
+}
+
+// GetSortOrder returns createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject.SortOrder, and is useful for accessing the field via an interface.
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) GetSortOrder() string {
+	return v.InitiativeToProject.SortOrder
+}
+
+// GetInitiative returns createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject.Initiative, and is useful for accessing the field via an interface.
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) GetInitiative() InitiativeToProjectInitiative {
+	return v.InitiativeToProject.Initiative
+}
+
+// GetProject returns createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject.Project, and is useful for accessing the field via an interface.
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) GetProject() InitiativeToProjectProject {
+	return v.InitiativeToProject.Project
+}
+
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.InitiativeToProject)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject struct {
+	Id string `json:"id"`
+
+	SortOrder string `json:"sortOrder"`
+
+	Initiative InitiativeToProjectInitiative `json:"initiative"`
+
+	Project InitiativeToProjectProject `json:"project"`
+}
+
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject) __premarshalJSON() (*__premarshalcreateInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject, error) {
+	var retval __premarshalcreateInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayloadInitiativeToProject
+
+	retval.Id = v.InitiativeToProject.Id
+	retval.SortOrder = v.InitiativeToProject.SortOrder
+	retval.Initiative = v.InitiativeToProject.Initiative
+	retval.Project = v.InitiativeToProject.Project
+	return &retval, nil
+}
+
+// createInitiativeToProjectResponse is returned by createInitiativeToProject on success.
+type createInitiativeToProjectResponse struct {
+	// Creates a new initiativeToProject join.
+	InitiativeToProjectCreate createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload `json:"initiativeToProjectCreate"`
+}
+
+// GetInitiativeToProjectCreate returns createInitiativeToProjectResponse.InitiativeToProjectCreate, and is useful for accessing the field via an interface.
+func (v *createInitiativeToProjectResponse) GetInitiativeToProjectCreate() createInitiativeToProjectInitiativeToProjectCreateInitiativeToProjectPayload {
+	return v.InitiativeToProjectCreate
+}
+
+// createIssueIssueCreateIssuePayload includes the requested fields of the GraphQL type IssuePayload.
+type createIssueIssueCreateIssuePayload struct {
+	// The issue that was created or updated.
+	Issue createIssueIssueCreateIssuePayloadIssue `json:"issue"`
+}
+
+// GetIssue returns createIssueIssueCreateIssuePayload.Issue, and is useful for accessing the field via an interface.
+func (v *createIssueIssueCreateIssuePayload) GetIssue() createIssueIssueCreateIssuePayloadIssue {
+	return v.Issue
+}
+
+// createIssueIssueCreateIssuePayloadIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type createIssueIssueCreateIssuePayloadIssue struct {
+	Issue `json:"-"`
+}
+
+// GetId returns createIssueIssueCreateIssuePayloadIssue.Id, and is useful for accessing the field via an interface.
+func (v *createIssueIssueCreateIssuePayloadIssue) GetId() string { return v.Issue.Id }
+
+// GetIdentifier returns createIssueIssueCreateIssuePayloadIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *createIssueIssueCreateIssuePayloadIssue) GetIdentifier() string { return v.Issue.Identifier }
+
+// GetTitle returns createIssueIssueCreateIssuePayloadIssue.Title, and is useful for accessing the field via an interface.
+func (v *createIssueIssueCreateIssuePayloadIssue) GetTitle() string { return v.Issue.Title }
+
+// GetDescription returns createIssueIssueCreateIssuePayloadIssue.Description, and is useful for accessing the field via an interface.
+func (v *createIssueIssueCreateIssuePayloadIssue) GetDescription() *string {
+	return v.Issue.Description
+}
+
+// GetPriority returns createIssueIssueCreateIssuePayloadIssue.Priority, and is useful for accessing the field via an interface.
+func (v *createIssueIssueCreateIssuePayloadIssue) GetPriority() float64 { return v.Issue.Priority }
+
+// GetTeam returns createIssueIssueCreateIssuePayloadIssue.Team, and is useful for accessing the field via an interface.
+func (v *createIssueIssueCreateIssuePayloadIssue) GetTeam() IssueTeam { return v.Issue.Team }
+
+// GetState returns createIssueIssueCreateIssuePayloadIssue.State, and is useful for accessing the field via an interface.
+func (v *createIssueIssueCreateIssuePayloadIssue) GetState() IssueStateWorkflowState {
+	return v.Issue.State
+}
+
+func (v *createIssueIssueCreateIssuePayloadIssue) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createIssueIssueCreateIssuePayloadIssue
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createIssueIssueCreateIssuePayloadIssue = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Issue)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateIssueIssueCreateIssuePayloadIssue struct {
+	Id string `json:"id"`
+
+	Identifier string `json:"identifier"`
+
+	Title string `json:"title"`
+
+	Description *string `json:"description"`
+
+	Priority float64 `json:"priority"`
+
+	Team IssueTeam `json:"team"`
+
+	State IssueStateWorkflowState `json:"state"`
+}
+
+func (v *createIssueIssueCreateIssuePayloadIssue) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createIssueIssueCreateIssuePayloadIssue) __premarshalJSON() (*__premarshalcreateIssueIssueCreateIssuePayloadIssue, error) {
+	var retval __premarshalcreateIssueIssueCreateIssuePayloadIssue
+
+	retval.Id = v.Issue.Id
+	retval.Identifier = v.Issue.Identifier
+	retval.Title = v.Issue.Title
+	retval.Description = v.Issue.Description
+	retval.Priority = v.Issue.Priority
+	retval.Team = v.Issue.Team
+	retval.State = v.Issue.State
+	return &retval, nil
+}
+
+// createIssueRelationIssueRelationCreateIssueRelationPayload includes the requested fields of the GraphQL type IssueRelationPayload.
+type createIssueRelationIssueRelationCreateIssueRelationPayload struct {
+	// The issue relation that was created or updated.
+	IssueRelation createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation `json:"issueRelation"`
+}
+
+// GetIssueRelation returns createIssueRelationIssueRelationCreateIssueRelationPayload.IssueRelation, and is useful for accessing the field via an interface.
+func (v *createIssueRelationIssueRelationCreateIssueRelationPayload) GetIssueRelation() createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation {
+	return v.IssueRelation
+}
+
+// createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation includes the requested fields of the GraphQL type IssueRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation between two issues.
+type createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation struct {
+	IssueRelation `json:"-"`
+}
+
+// GetId returns createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation.Id, and is useful for accessing the field via an interface.
+func (v *createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation) GetId() string {
+	return v.IssueRelation.Id
+}
+
+// GetType returns createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation.Type, and is useful for accessing the field via an interface.
+func (v *createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation) GetType() string {
+	return v.IssueRelation.Type
+}
+
+// GetIssue returns createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation.Issue, and is useful for accessing the field via an interface.
+func (v *createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation) GetIssue() IssueRelationIssue {
+	return v.IssueRelation.Issue
+}
+
+// GetRelatedIssue returns createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation.RelatedIssue, and is useful for accessing the field via an interface.
+func (v *createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation) GetRelatedIssue() IssueRelationRelatedIssue {
+	return v.IssueRelation.RelatedIssue
+}
+
+func (v *createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueRelation)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation struct {
+	Id string `json:"id"`
+
+	Type string `json:"type"`
+
+	Issue IssueRelationIssue `json:"issue"`
+
+	RelatedIssue IssueRelationRelatedIssue `json:"relatedIssue"`
+}
+
+func (v *createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation) __premarshalJSON() (*__premarshalcreateIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation, error) {
+	var retval __premarshalcreateIssueRelationIssueRelationCreateIssueRelationPayloadIssueRelation
+
+	retval.Id = v.IssueRelation.Id
+	retval.Type = v.IssueRelation.Type
+	retval.Issue = v.IssueRelation.Issue
+	retval.RelatedIssue = v.IssueRelation.RelatedIssue
+	return &retval, nil
+}
+
+// createIssueRelationResponse is returned by createIssueRelation on success.
+type createIssueRelationResponse struct {
+	// Creates a new issue relation.
+	IssueRelationCreate createIssueRelationIssueRelationCreateIssueRelationPayload `json:"issueRelationCreate"`
+}
+
+// GetIssueRelationCreate returns createIssueRelationResponse.IssueRelationCreate, and is useful for accessing the field via an interface.
+func (v *createIssueRelationResponse) GetIssueRelationCreate() createIssueRelationIssueRelationCreateIssueRelationPayload {
+	return v.IssueRelationCreate
+}
+
+// createIssueResponse is returned by createIssue on success.
+type createIssueResponse struct {
+	// Creates a new issue.
+	IssueCreate createIssueIssueCreateIssuePayload `json:"issueCreate"`
+}
+
+// GetIssueCreate returns createIssueResponse.IssueCreate, and is useful for accessing the field via an interface.
+func (v *createIssueResponse) GetIssueCreate() createIssueIssueCreateIssuePayload {
+	return v.IssueCreate
+}
+
+// createLabelIssueLabelCreateIssueLabelPayload includes the requested fields of the GraphQL type IssueLabelPayload.
+type createLabelIssueLabelCreateIssueLabelPayload struct {
+	// The label that was created or updated.
+	IssueLabel createLabelIssueLabelCreateIssueLabelPayloadIssueLabel `json:"issueLabel"`
+}
+
+// GetIssueLabel returns createLabelIssueLabelCreateIssueLabelPayload.IssueLabel, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayload) GetIssueLabel() createLabelIssueLabelCreateIssueLabelPayloadIssueLabel {
+	return v.IssueLabel
+}
+
+// createLabelIssueLabelCreateIssueLabelPayloadIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type createLabelIssueLabelCreateIssueLabelPayloadIssueLabel struct {
+	IssueLabel `json:"-"`
+}
+
+// GetId returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetId() string {
+	return v.IssueLabel.Id
+}
+
+// GetName returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetName() string {
+	return v.IssueLabel.Name
+}
+
+// GetDescription returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetDescription() *string {
+	return v.IssueLabel.Description
+}
+
+// GetColor returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetColor() *string {
+	return v.IssueLabel.Color
+}
+
+// GetParent returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetParent() *IssueLabelParentIssueLabel {
+	return v.IssueLabel.Parent
+}
+
+// GetTeam returns createLabelIssueLabelCreateIssueLabelPayloadIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) GetTeam() *IssueLabelTeam {
+	return v.IssueLabel.Team
+}
+
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createLabelIssueLabelCreateIssueLabelPayloadIssueLabel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createLabelIssueLabelCreateIssueLabelPayloadIssueLabel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueLabel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+
+	Team *IssueLabelTeam `json:"team"`
+}
+
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createLabelIssueLabelCreateIssueLabelPayloadIssueLabel) __premarshalJSON() (*__premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel, error) {
+	var retval __premarshalcreateLabelIssueLabelCreateIssueLabelPayloadIssueLabel
+
+	retval.Id = v.IssueLabel.Id
+	retval.Name = v.IssueLabel.Name
+	retval.Description = v.IssueLabel.Description
+	retval.Color = v.IssueLabel.Color
+	retval.Parent = v.IssueLabel.Parent
+	retval.Team = v.IssueLabel.Team
+	return &retval, nil
+}
+
+// createLabelResponse is returned by createLabel on success.
+type createLabelResponse struct {
+	// Creates a new label.
+	IssueLabelCreate createLabelIssueLabelCreateIssueLabelPayload `json:"issueLabelCreate"`
+}
+
+// GetIssueLabelCreate returns createLabelResponse.IssueLabelCreate, and is useful for accessing the field via an interface.
+func (v *createLabelResponse) GetIssueLabelCreate() createLabelIssueLabelCreateIssueLabelPayload {
+	return v.IssueLabelCreate
+}
+
+// createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload includes the requested fields of the GraphQL type ProjectMilestonePayload.
+type createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload struct {
+	// The project milestone that was created or updated.
+	ProjectMilestone createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone `json:"projectMilestone"`
+}
+
+// GetProjectMilestone returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload.ProjectMilestone, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload) GetProjectMilestone() createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone {
+	return v.ProjectMilestone
+}
+
+// createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone includes the requested fields of the GraphQL type ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone struct {
+	ProjectMilestone `json:"-"`
+}
+
+// GetId returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) GetId() string {
+	return v.ProjectMilestone.Id
+}
+
+// GetName returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone.Name, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) GetName() string {
+	return v.ProjectMilestone.Name
+}
+
+// GetDescription returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone.Description, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) GetDescription() *string {
+	return v.ProjectMilestone.Description
+}
+
+// GetTargetDate returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone.TargetDate, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) GetTargetDate() *string {
+	return v.ProjectMilestone.TargetDate
+}
+
+// GetProject returns createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone.Project, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) GetProject() ProjectMilestoneProject {
+	return v.ProjectMilestone.Project
+}
+
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectMilestone)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Project ProjectMilestoneProject `json:"project"`
+}
+
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone) __premarshalJSON() (*__premarshalcreateProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone, error) {
+	var retval __premarshalcreateProjectMilestoneProjectMilestoneCreateProjectMilestonePayloadProjectMilestone
+
+	retval.Id = v.ProjectMilestone.Id
+	retval.Name = v.ProjectMilestone.Name
+	retval.Description = v.ProjectMilestone.Description
+	retval.TargetDate = v.ProjectMilestone.TargetDate
+	retval.Project = v.ProjectMilestone.Project
+	return &retval, nil
+}
+
+// createProjectMilestoneResponse is returned by createProjectMilestone on success.
+type createProjectMilestoneResponse struct {
+	// Creates a new project milestone.
+	ProjectMilestoneCreate createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload `json:"projectMilestoneCreate"`
+}
+
+// GetProjectMilestoneCreate returns createProjectMilestoneResponse.ProjectMilestoneCreate, and is useful for accessing the field via an interface.
+func (v *createProjectMilestoneResponse) GetProjectMilestoneCreate() createProjectMilestoneProjectMilestoneCreateProjectMilestonePayload {
+	return v.ProjectMilestoneCreate
+}
+
+// createProjectProjectCreateProjectPayload includes the requested fields of the GraphQL type ProjectPayload.
+type createProjectProjectCreateProjectPayload struct {
+	// The project that was created or updated.
+	Project createProjectProjectCreateProjectPayloadProject `json:"project"`
+}
+
+// GetProject returns createProjectProjectCreateProjectPayload.Project, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayload) GetProject() createProjectProjectCreateProjectPayloadProject {
+	return v.Project
+}
+
+// createProjectProjectCreateProjectPayloadProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type createProjectProjectCreateProjectPayloadProject struct {
+	Project `json:"-"`
+}
+
+// GetId returns createProjectProjectCreateProjectPayloadProject.Id, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetId() string { return v.Project.Id }
+
+// GetName returns createProjectProjectCreateProjectPayloadProject.Name, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetName() string { return v.Project.Name }
+
+// GetDescription returns createProjectProjectCreateProjectPayloadProject.Description, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetDescription() string {
+	return v.Project.Description
+}
+
+// GetIcon returns createProjectProjectCreateProjectPayloadProject.Icon, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetIcon() *string { return v.Project.Icon }
+
+// GetColor returns createProjectProjectCreateProjectPayloadProject.Color, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetColor() string { return v.Project.Color }
+
+// GetPriority returns createProjectProjectCreateProjectPayloadProject.Priority, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetPriority() int {
+	return v.Project.Priority
+}
+
+// GetStartDate returns createProjectProjectCreateProjectPayloadProject.StartDate, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetStartDate() *string {
+	return v.Project.StartDate
+}
+
+// GetTargetDate returns createProjectProjectCreateProjectPayloadProject.TargetDate, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetTargetDate() *string {
+	return v.Project.TargetDate
+}
+
+// GetStatus returns createProjectProjectCreateProjectPayloadProject.Status, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetStatus() ProjectStatus {
+	return v.Project.Status
+}
+
+// GetLead returns createProjectProjectCreateProjectPayloadProject.Lead, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetLead() *ProjectLeadUser {
+	return v.Project.Lead
+}
+
+// GetTeams returns createProjectProjectCreateProjectPayloadProject.Teams, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProjectPayloadProject) GetTeams() ProjectTeamsTeamConnection {
+	return v.Project.Teams
+}
+
+func (v *createProjectProjectCreateProjectPayloadProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createProjectProjectCreateProjectPayloadProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createProjectProjectCreateProjectPayloadProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Project)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateProjectProjectCreateProjectPayloadProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color string `json:"color"`
+
+	Priority int `json:"priority"`
+
+	StartDate *string `json:"startDate"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Status ProjectStatus `json:"status"`
+
+	Lead *ProjectLeadUser `json:"lead"`
+
+	Teams ProjectTeamsTeamConnection `json:"teams"`
+}
+
+func (v *createProjectProjectCreateProjectPayloadProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createProjectProjectCreateProjectPayloadProject) __premarshalJSON() (*__premarshalcreateProjectProjectCreateProjectPayloadProject, error) {
+	var retval __premarshalcreateProjectProjectCreateProjectPayloadProject
+
+	retval.Id = v.Project.Id
+	retval.Name = v.Project.Name
+	retval.Description = v.Project.Description
+	retval.Icon = v.Project.Icon
+	retval.Color = v.Project.Color
+	retval.Priority = v.Project.Priority
+	retval.StartDate = v.Project.StartDate
+	retval.TargetDate = v.Project.TargetDate
+	retval.Status = v.Project.Status
+	retval.Lead = v.Project.Lead
+	retval.Teams = v.Project.Teams
+	return &retval, nil
+}
+
+// createProjectRelationProjectRelationCreateProjectRelationPayload includes the requested fields of the GraphQL type ProjectRelationPayload.
+type createProjectRelationProjectRelationCreateProjectRelationPayload struct {
+	// The project relation that was created or updated.
+	ProjectRelation createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation `json:"projectRelation"`
+}
+
+// GetProjectRelation returns createProjectRelationProjectRelationCreateProjectRelationPayload.ProjectRelation, and is useful for accessing the field via an interface.
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayload) GetProjectRelation() createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation {
+	return v.ProjectRelation
+}
+
+// createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation includes the requested fields of the GraphQL type ProjectRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation between two projects.
+type createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation struct {
+	ProjectRelation `json:"-"`
+}
+
+// GetId returns createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation.Id, and is useful for accessing the field via an interface.
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation) GetId() string {
+	return v.ProjectRelation.Id
+}
+
+// GetType returns createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation.Type, and is useful for accessing the field via an interface.
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation) GetType() string {
+	return v.ProjectRelation.Type
+}
+
+// GetAnchorType returns createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation.AnchorType, and is useful for accessing the field via an interface.
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation) GetAnchorType() string {
+	return v.ProjectRelation.AnchorType
+}
+
+// GetRelatedAnchorType returns createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation.RelatedAnchorType, and is useful for accessing the field via an interface.
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation) GetRelatedAnchorType() string {
+	return v.ProjectRelation.RelatedAnchorType
+}
+
+// GetProject returns createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation.Project, and is useful for accessing the field via an interface.
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation) GetProject() ProjectRelationProject {
+	return v.ProjectRelation.Project
+}
+
+// GetProjectMilestone returns createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation.ProjectMilestone, and is useful for accessing the field via an interface.
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation) GetProjectMilestone() *ProjectRelationProjectMilestone {
+	return v.ProjectRelation.ProjectMilestone
+}
+
+// GetRelatedProject returns createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation.RelatedProject, and is useful for accessing the field via an interface.
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation) GetRelatedProject() ProjectRelationRelatedProject {
+	return v.ProjectRelation.RelatedProject
+}
+
+// GetRelatedProjectMilestone returns createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation.RelatedProjectMilestone, and is useful for accessing the field via an interface.
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation) GetRelatedProjectMilestone() *ProjectRelationRelatedProjectMilestone {
+	return v.ProjectRelation.RelatedProjectMilestone
+}
+
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectRelation)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation struct {
+	Id string `json:"id"`
+
+	Type string `json:"type"`
+
+	AnchorType string `json:"anchorType"`
+
+	RelatedAnchorType string `json:"relatedAnchorType"`
+
+	Project ProjectRelationProject `json:"project"`
+
+	ProjectMilestone *ProjectRelationProjectMilestone `json:"projectMilestone"`
+
+	RelatedProject ProjectRelationRelatedProject `json:"relatedProject"`
+
+	RelatedProjectMilestone *ProjectRelationRelatedProjectMilestone `json:"relatedProjectMilestone"`
+}
+
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation) __premarshalJSON() (*__premarshalcreateProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation, error) {
+	var retval __premarshalcreateProjectRelationProjectRelationCreateProjectRelationPayloadProjectRelation
+
+	retval.Id = v.ProjectRelation.Id
+	retval.Type = v.ProjectRelation.Type
+	retval.AnchorType = v.ProjectRelation.AnchorType
+	retval.RelatedAnchorType = v.ProjectRelation.RelatedAnchorType
+	retval.Project = v.ProjectRelation.Project
+	retval.ProjectMilestone = v.ProjectRelation.ProjectMilestone
+	retval.RelatedProject = v.ProjectRelation.RelatedProject
+	retval.RelatedProjectMilestone = v.ProjectRelation.RelatedProjectMilestone
+	return &retval, nil
+}
+
+// createProjectRelationResponse is returned by createProjectRelation on success.
+type createProjectRelationResponse struct {
+	// [ALPHA] Creates a new project relation.
+	ProjectRelationCreate createProjectRelationProjectRelationCreateProjectRelationPayload `json:"projectRelationCreate"`
+}
+
+// GetProjectRelationCreate returns createProjectRelationResponse.ProjectRelationCreate, and is useful for accessing the field via an interface.
+func (v *createProjectRelationResponse) GetProjectRelationCreate() createProjectRelationProjectRelationCreateProjectRelationPayload {
+	return v.ProjectRelationCreate
+}
+
+// createProjectResponse is returned by createProject on success.
+type createProjectResponse struct {
+	// Creates a new project.
+	ProjectCreate createProjectProjectCreateProjectPayload `json:"projectCreate"`
+}
+
+// GetProjectCreate returns createProjectResponse.ProjectCreate, and is useful for accessing the field via an interface.
+func (v *createProjectResponse) GetProjectCreate() createProjectProjectCreateProjectPayload {
+	return v.ProjectCreate
+}
+
+// createRoadmapResponse is returned by createRoadmap on success.
+type createRoadmapResponse struct {
+	// Creates a new roadmap.
+	RoadmapCreate createRoadmapRoadmapCreateRoadmapPayload `json:"roadmapCreate"`
+}
+
+// GetRoadmapCreate returns createRoadmapResponse.RoadmapCreate, and is useful for accessing the field via an interface.
+func (v *createRoadmapResponse) GetRoadmapCreate() createRoadmapRoadmapCreateRoadmapPayload {
+	return v.RoadmapCreate
+}
+
+// createRoadmapRoadmapCreateRoadmapPayload includes the requested fields of the GraphQL type RoadmapPayload.
+type createRoadmapRoadmapCreateRoadmapPayload struct {
+	// The roadmap that was created or updated.
+	Roadmap createRoadmapRoadmapCreateRoadmapPayloadRoadmap `json:"roadmap"`
+}
+
+// GetRoadmap returns createRoadmapRoadmapCreateRoadmapPayload.Roadmap, and is useful for accessing the field via an interface.
+func (v *createRoadmapRoadmapCreateRoadmapPayload) GetRoadmap() createRoadmapRoadmapCreateRoadmapPayloadRoadmap {
+	return v.Roadmap
+}
+
+// createRoadmapRoadmapCreateRoadmapPayloadRoadmap includes the requested fields of the GraphQL type Roadmap.
+// The GraphQL type's documentation follows.
+//
+// A roadmap for projects.
+type createRoadmapRoadmapCreateRoadmapPayloadRoadmap struct {
+	Roadmap `json:"-"`
+}
+
+// GetId returns createRoadmapRoadmapCreateRoadmapPayloadRoadmap.Id, and is useful for accessing the field via an interface.
+func (v *createRoadmapRoadmapCreateRoadmapPayloadRoadmap) GetId() string { return v.Roadmap.Id }
+
+// GetName returns createRoadmapRoadmapCreateRoadmapPayloadRoadmap.Name, and is useful for accessing the field via an interface.
+func (v *createRoadmapRoadmapCreateRoadmapPayloadRoadmap) GetName() string { return v.Roadmap.Name }
+
+// GetDescription returns createRoadmapRoadmapCreateRoadmapPayloadRoadmap.Description, and is useful for accessing the field via an interface.
+func (v *createRoadmapRoadmapCreateRoadmapPayloadRoadmap) GetDescription() *string {
+	return v.Roadmap.Description
+}
+
+// GetColor returns createRoadmapRoadmapCreateRoadmapPayloadRoadmap.Color, and is useful for accessing the field via an interface.
+func (v *createRoadmapRoadmapCreateRoadmapPayloadRoadmap) GetColor() *string { return v.Roadmap.Color }
+
+// GetOwner returns createRoadmapRoadmapCreateRoadmapPayloadRoadmap.Owner, and is useful for accessing the field via an interface.
+func (v *createRoadmapRoadmapCreateRoadmapPayloadRoadmap) GetOwner() RoadmapOwnerUser {
+	return v.Roadmap.Owner
+}
+
+func (v *createRoadmapRoadmapCreateRoadmapPayloadRoadmap) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createRoadmapRoadmapCreateRoadmapPayloadRoadmap
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createRoadmapRoadmapCreateRoadmapPayloadRoadmap = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Roadmap)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateRoadmapRoadmapCreateRoadmapPayloadRoadmap struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Owner RoadmapOwnerUser `json:"owner"`
+}
+
+func (v *createRoadmapRoadmapCreateRoadmapPayloadRoadmap) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createRoadmapRoadmapCreateRoadmapPayloadRoadmap) __premarshalJSON() (*__premarshalcreateRoadmapRoadmapCreateRoadmapPayloadRoadmap, error) {
+	var retval __premarshalcreateRoadmapRoadmapCreateRoadmapPayloadRoadmap
+
+	retval.Id = v.Roadmap.Id
+	retval.Name = v.Roadmap.Name
+	retval.Description = v.Roadmap.Description
+	retval.Color = v.Roadmap.Color
+	retval.Owner = v.Roadmap.Owner
+	return &retval, nil
+}
+
+// createRoadmapToProjectResponse is returned by createRoadmapToProject on success.
+type createRoadmapToProjectResponse struct {
+	// Creates a new roadmapToProject join.
+	RoadmapToProjectCreate createRoadmapToProjectRoadmapToProjectCreateRoadmapToProjectPayload `json:"roadmapToProjectCreate"`
+}
+
+// GetRoadmapToProjectCreate returns createRoadmapToProjectResponse.RoadmapToProjectCreate, and is useful for accessing the field via an interface.
+func (v *createRoadmapToProjectResponse) GetRoadmapToProjectCreate() createRoadmapToProjectRoadmapToProjectCreateRoadmapToProjectPayload {
+	return v.RoadmapToProjectCreate
+}
+
+// createRoadmapToProjectRoadmapToProjectCreateRoadmapToProjectPayload includes the requested fields of the GraphQL type RoadmapToProjectPayload.
+type createRoadmapToProjectRoadmapToProjectCreateRoadmapToProjectPayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns createRoadmapToProjectRoadmapToProjectCreateRoadmapToProjectPayload.Success, and is useful for accessing the field via an interface.
+func (v *createRoadmapToProjectRoadmapToProjectCreateRoadmapToProjectPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// createTeamMembershipResponse is returned by createTeamMembership on success.
+type createTeamMembershipResponse struct {
+	// Creates a new team membership.
+	TeamMembershipCreate createTeamMembershipTeamMembershipCreateTeamMembershipPayload `json:"teamMembershipCreate"`
+}
+
+// GetTeamMembershipCreate returns createTeamMembershipResponse.TeamMembershipCreate, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipResponse) GetTeamMembershipCreate() createTeamMembershipTeamMembershipCreateTeamMembershipPayload {
+	return v.TeamMembershipCreate
+}
+
+// createTeamMembershipTeamMembershipCreateTeamMembershipPayload includes the requested fields of the GraphQL type TeamMembershipPayload.
+type createTeamMembershipTeamMembershipCreateTeamMembershipPayload struct {
+	// The team membership that was created or updated.
+	TeamMembership createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership `json:"teamMembership"`
+}
+
+// GetTeamMembership returns createTeamMembershipTeamMembershipCreateTeamMembershipPayload.TeamMembership, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayload) GetTeamMembership() createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership {
+	return v.TeamMembership
+}
+
+// createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+// The GraphQL type's documentation follows.
+//
+// Defines the membership of a user to a team.
+type createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership struct {
+	TeamMembership `json:"-"`
+}
+
+// GetId returns createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) GetId() string {
+	return v.TeamMembership.Id
+}
+
+// GetOwner returns createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership.Owner, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) GetOwner() bool {
+	return v.TeamMembership.Owner
+}
+
+// GetTeam returns createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership.Team, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) GetTeam() TeamMembershipTeam {
+	return v.TeamMembership.Team
+}
+
+// GetUser returns createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership.User, and is useful for accessing the field via an interface.
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) GetUser() TeamMembershipUser {
+	return v.TeamMembership.User
+}
+
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamMembership)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership struct {
+	Id string `json:"id"`
+
+	Owner bool `json:"owner"`
+
+	Team TeamMembershipTeam `json:"team"`
+
+	User TeamMembershipUser `json:"user"`
+}
+
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership) __premarshalJSON() (*__premarshalcreateTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership, error) {
+	var retval __premarshalcreateTeamMembershipTeamMembershipCreateTeamMembershipPayloadTeamMembership
+
+	retval.Id = v.TeamMembership.Id
+	retval.Owner = v.TeamMembership.Owner
+	retval.Team = v.TeamMembership.Team
+	retval.User = v.TeamMembership.User
+	return &retval, nil
+}
+
+// createTeamResponse is returned by createTeam on success.
+type createTeamResponse struct {
+	// Creates a new team. The user who creates the team will automatically be added as a member to the newly created team.
+	TeamCreate createTeamTeamCreateTeamPayload `json:"teamCreate"`
+}
+
+// GetTeamCreate returns createTeamResponse.TeamCreate, and is useful for accessing the field via an interface.
+func (v *createTeamResponse) GetTeamCreate() createTeamTeamCreateTeamPayload { return v.TeamCreate }
+
+// createTeamTeamCreateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
+type createTeamTeamCreateTeamPayload struct {
+	// The team that was created or updated.
+	Team createTeamTeamCreateTeamPayloadTeam `json:"team"`
+}
+
+// GetTeam returns createTeamTeamCreateTeamPayload.Team, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayload) GetTeam() createTeamTeamCreateTeamPayloadTeam {
+	return v.Team
+}
+
+// createTeamTeamCreateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type createTeamTeamCreateTeamPayloadTeam struct {
+	Team `json:"-"`
+}
+
+// GetId returns createTeamTeamCreateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetId() string { return v.Team.Id }
+
+// GetName returns createTeamTeamCreateTeamPayloadTeam.Name, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetName() string { return v.Team.Name }
+
+// GetKey returns createTeamTeamCreateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetKey() string { return v.Team.Key }
+
+// GetPrivate returns createTeamTeamCreateTeamPayloadTeam.Private, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetPrivate() bool { return v.Team.Private }
+
+// GetJoinByDefault returns createTeamTeamCreateTeamPayloadTeam.JoinByDefault, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetJoinByDefault() *bool { return v.Team.JoinByDefault }
+
+// GetDescription returns createTeamTeamCreateTeamPayloadTeam.Description, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetDescription() *string { return v.Team.Description }
+
+// GetIcon returns createTeamTeamCreateTeamPayloadTeam.Icon, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetIcon() *string { return v.Team.Icon }
+
+// GetColor returns createTeamTeamCreateTeamPayloadTeam.Color, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetColor() *string { return v.Team.Color }
+
+// GetTimezone returns createTeamTeamCreateTeamPayloadTeam.Timezone, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetTimezone() string { return v.Team.Timezone }
+
+// GetIssueOrderingNoPriorityFirst returns createTeamTeamCreateTeamPayloadTeam.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueOrderingNoPriorityFirst() bool {
+	return v.Team.IssueOrderingNoPriorityFirst
+}
+
+// GetGroupIssueHistory returns createTeamTeamCreateTeamPayloadTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetGroupIssueHistory() bool {
+	return v.Team.GroupIssueHistory
+}
+
+// GetSetIssueSortOrderOnStateChange returns createTeamTeamCreateTeamPayloadTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetSetIssueSortOrderOnStateChange() string {
+	return v.Team.SetIssueSortOrderOnStateChange
+}
+
+// GetAutoArchivePeriod returns createTeamTeamCreateTeamPayloadTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoArchivePeriod() float64 {
+	return v.Team.AutoArchivePeriod
+}
+
+// GetAutoClosePeriod returns createTeamTeamCreateTeamPayloadTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetAutoClosePeriod() *float64 {
+	return v.Team.AutoClosePeriod
+}
+
+// GetTriageEnabled returns createTeamTeamCreateTeamPayloadTeam.TriageEnabled, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
+
+// GetRequirePriorityToLeaveTriage returns createTeamTeamCreateTeamPayloadTeam.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetRequirePriorityToLeaveTriage() bool {
+	return v.Team.RequirePriorityToLeaveTriage
+}
+
+// GetCyclesEnabled returns createTeamTeamCreateTeamPayloadTeam.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
+
+// GetCycleStartDay returns createTeamTeamCreateTeamPayloadTeam.CycleStartDay, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
+
+// GetCycleDuration returns createTeamTeamCreateTeamPayloadTeam.CycleDuration, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
+
+// GetCycleCooldownTime returns createTeamTeamCreateTeamPayloadTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleCooldownTime() float64 {
+	return v.Team.CycleCooldownTime
+}
+
+// GetUpcomingCycleCount returns createTeamTeamCreateTeamPayloadTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetUpcomingCycleCount() float64 {
+	return v.Team.UpcomingCycleCount
+}
+
+// GetCycleIssueAutoAssignStarted returns createTeamTeamCreateTeamPayloadTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleIssueAutoAssignStarted() bool {
+	return v.Team.CycleIssueAutoAssignStarted
+}
+
+// GetCycleIssueAutoAssignCompleted returns createTeamTeamCreateTeamPayloadTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleIssueAutoAssignCompleted() bool {
+	return v.Team.CycleIssueAutoAssignCompleted
+}
+
+// GetCycleLockToActive returns createTeamTeamCreateTeamPayloadTeam.CycleLockToActive, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetCycleLockToActive() bool {
+	return v.Team.CycleLockToActive
+}
+
+// GetIssueEstimationType returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationType() string {
+	return v.Team.IssueEstimationType
+}
+
+// GetIssueEstimationAllowZero returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationAllowZero() bool {
+	return v.Team.IssueEstimationAllowZero
+}
+
+// GetIssueEstimationExtended returns createTeamTeamCreateTeamPayloadTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetIssueEstimationExtended() bool {
+	return v.Team.IssueEstimationExtended
+}
+
+// GetDefaultIssueEstimate returns createTeamTeamCreateTeamPayloadTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetDefaultIssueEstimate() float64 {
+	return v.Team.DefaultIssueEstimate
+}
+
+// GetDefaultTemplateForMembers returns createTeamTeamCreateTeamPayloadTeam.DefaultTemplateForMembers, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetDefaultTemplateForMembers() *TeamDefaultTemplateForMembersTemplate {
+	return v.Team.DefaultTemplateForMembers
+}
+
+// GetDefaultTemplateForNonMembers returns createTeamTeamCreateTeamPayloadTeam.DefaultTemplateForNonMembers, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetDefaultTemplateForNonMembers() *TeamDefaultTemplateForNonMembersTemplate {
+	return v.Team.DefaultTemplateForNonMembers
+}
+
+// GetDefaultProjectTemplate returns createTeamTeamCreateTeamPayloadTeam.DefaultProjectTemplate, and is useful for accessing the field via an interface.
+func (v *createTeamTeamCreateTeamPayloadTeam) GetDefaultProjectTemplate() *TeamDefaultProjectTemplate {
+	return v.Team.DefaultProjectTemplate
+}
+
+func (v *createTeamTeamCreateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createTeamTeamCreateTeamPayloadTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createTeamTeamCreateTeamPayloadTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Team)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateTeamTeamCreateTeamPayloadTeam struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Key string `json:"key"`
+
+	Private bool `json:"private"`
+
+	JoinByDefault *bool `json:"joinByDefault"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Timezone string `json:"timezone"`
+
+	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
+
+	GroupIssueHistory bool `json:"groupIssueHistory"`
+
+	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+
+	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+
+	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+
+	TriageEnabled bool `json:"triageEnabled"`
+
+	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
+
+	CyclesEnabled bool `json:"cyclesEnabled"`
+
+	CycleStartDay float64 `json:"cycleStartDay"`
+
+	CycleDuration float64 `json:"cycleDuration"`
+
+	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+
+	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+
+	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+
+	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+
+	CycleLockToActive bool `json:"cycleLockToActive"`
+
+	IssueEstimationType string `json:"issueEstimationType"`
+
+	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+
+	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+
+	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+
+	DefaultTemplateForMembers *TeamDefaultTemplateForMembersTemplate `json:"defaultTemplateForMembers"`
+
+	DefaultTemplateForNonMembers *TeamDefaultTemplateForNonMembersTemplate `json:"defaultTemplateForNonMembers"`
+
+	DefaultProjectTemplate *TeamDefaultProjectTemplate `json:"defaultProjectTemplate"`
+}
+
+func (v *createTeamTeamCreateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createTeamTeamCreateTeamPayloadTeam) __premarshalJSON() (*__premarshalcreateTeamTeamCreateTeamPayloadTeam, error) {
+	var retval __premarshalcreateTeamTeamCreateTeamPayloadTeam
+
+	retval.Id = v.Team.Id
+	retval.Name = v.Team.Name
+	retval.Key = v.Team.Key
+	retval.Private = v.Team.Private
+	retval.JoinByDefault = v.Team.JoinByDefault
+	retval.Description = v.Team.Description
+	retval.Icon = v.Team.Icon
+	retval.Color = v.Team.Color
+	retval.Timezone = v.Team.Timezone
+	retval.IssueOrderingNoPriorityFirst = v.Team.IssueOrderingNoPriorityFirst
+	retval.GroupIssueHistory = v.Team.GroupIssueHistory
+	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
+	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
+	retval.AutoClosePeriod = v.Team.AutoClosePeriod
+	retval.TriageEnabled = v.Team.TriageEnabled
+	retval.RequirePriorityToLeaveTriage = v.Team.RequirePriorityToLeaveTriage
+	retval.CyclesEnabled = v.Team.CyclesEnabled
+	retval.CycleStartDay = v.Team.CycleStartDay
+	retval.CycleDuration = v.Team.CycleDuration
+	retval.CycleCooldownTime = v.Team.CycleCooldownTime
+	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
+	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
+	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
+	retval.CycleLockToActive = v.Team.CycleLockToActive
+	retval.IssueEstimationType = v.Team.IssueEstimationType
+	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
+	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
+	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
+	retval.DefaultTemplateForMembers = v.Team.DefaultTemplateForMembers
+	retval.DefaultTemplateForNonMembers = v.Team.DefaultTemplateForNonMembers
+	retval.DefaultProjectTemplate = v.Team.DefaultProjectTemplate
+	return &retval, nil
+}
+
+// createTemplateResponse is returned by createTemplate on success.
+type createTemplateResponse struct {
+	// Creates a new template.
+	TemplateCreate createTemplateTemplateCreateTemplatePayload `json:"templateCreate"`
+}
+
+// GetTemplateCreate returns createTemplateResponse.TemplateCreate, and is useful for accessing the field via an interface.
+func (v *createTemplateResponse) GetTemplateCreate() createTemplateTemplateCreateTemplatePayload {
+	return v.TemplateCreate
+}
+
+// createTemplateTemplateCreateTemplatePayload includes the requested fields of the GraphQL type TemplatePayload.
+type createTemplateTemplateCreateTemplatePayload struct {
+	// The template that was created or updated.
+	Template createTemplateTemplateCreateTemplatePayloadTemplate `json:"template"`
+}
+
+// GetTemplate returns createTemplateTemplateCreateTemplatePayload.Template, and is useful for accessing the field via an interface.
+func (v *createTemplateTemplateCreateTemplatePayload) GetTemplate() createTemplateTemplateCreateTemplatePayloadTemplate {
+	return v.Template
+}
+
+// createTemplateTemplateCreateTemplatePayloadTemplate includes the requested fields of the GraphQL type Template.
+// The GraphQL type's documentation follows.
+//
+// A template object used for creating entities faster.
+type createTemplateTemplateCreateTemplatePayloadTemplate struct {
+	Template `json:"-"`
+}
+
+// GetId returns createTemplateTemplateCreateTemplatePayloadTemplate.Id, and is useful for accessing the field via an interface.
+func (v *createTemplateTemplateCreateTemplatePayloadTemplate) GetId() string { return v.Template.Id }
+
+// GetType returns createTemplateTemplateCreateTemplatePayloadTemplate.Type, and is useful for accessing the field via an interface.
+func (v *createTemplateTemplateCreateTemplatePayloadTemplate) GetType() string {
+	return v.Template.Type
+}
+
+// GetName returns createTemplateTemplateCreateTemplatePayloadTemplate.Name, and is useful for accessing the field via an interface.
+func (v *createTemplateTemplateCreateTemplatePayloadTemplate) GetName() string {
+	return v.Template.Name
+}
+
+// GetDescription returns createTemplateTemplateCreateTemplatePayloadTemplate.Description, and is useful for accessing the field via an interface.
+func (v *createTemplateTemplateCreateTemplatePayloadTemplate) GetDescription() *string {
+	return v.Template.Description
+}
+
+// GetTemplateData returns createTemplateTemplateCreateTemplatePayloadTemplate.TemplateData, and is useful for accessing the field via an interface.
+func (v *createTemplateTemplateCreateTemplatePayloadTemplate) GetTemplateData() json.RawMessage {
+	return v.Template.TemplateData
+}
+
+// GetTeam returns createTemplateTemplateCreateTemplatePayloadTemplate.Team, and is useful for accessing the field via an interface.
+func (v *createTemplateTemplateCreateTemplatePayloadTemplate) GetTeam() *TemplateTeam {
+	return v.Template.Team
+}
+
+func (v *createTemplateTemplateCreateTemplatePayloadTemplate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createTemplateTemplateCreateTemplatePayloadTemplate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createTemplateTemplateCreateTemplatePayloadTemplate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Template)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateTemplateTemplateCreateTemplatePayloadTemplate struct {
+	Id string `json:"id"`
+
+	Type string `json:"type"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	TemplateData json.RawMessage `json:"templateData"`
+
+	Team *TemplateTeam `json:"team"`
+}
+
+func (v *createTemplateTemplateCreateTemplatePayloadTemplate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createTemplateTemplateCreateTemplatePayloadTemplate) __premarshalJSON() (*__premarshalcreateTemplateTemplateCreateTemplatePayloadTemplate, error) {
+	var retval __premarshalcreateTemplateTemplateCreateTemplatePayloadTemplate
+
+	retval.Id = v.Template.Id
+	retval.Type = v.Template.Type
+	retval.Name = v.Template.Name
+	retval.Description = v.Template.Description
+	retval.TemplateData = v.Template.TemplateData
+	retval.Team = v.Template.Team
+	return &retval, nil
+}
+
+// createWorkflowStateResponse is returned by createWorkflowState on success.
+type createWorkflowStateResponse struct {
+	// Creates a new state, adding it to the workflow of a team.
+	WorkflowStateCreate createWorkflowStateWorkflowStateCreateWorkflowStatePayload `json:"workflowStateCreate"`
+}
+
+// GetWorkflowStateCreate returns createWorkflowStateResponse.WorkflowStateCreate, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateResponse) GetWorkflowStateCreate() createWorkflowStateWorkflowStateCreateWorkflowStatePayload {
+	return v.WorkflowStateCreate
+}
+
+// createWorkflowStateWorkflowStateCreateWorkflowStatePayload includes the requested fields of the GraphQL type WorkflowStatePayload.
+type createWorkflowStateWorkflowStateCreateWorkflowStatePayload struct {
+	// The state that was created or updated.
+	WorkflowState createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState `json:"workflowState"`
+}
+
+// GetWorkflowState returns createWorkflowStateWorkflowStateCreateWorkflowStatePayload.WorkflowState, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayload) GetWorkflowState() createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState {
+	return v.WorkflowState
+}
+
+// createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState struct {
+	WorkflowState `json:"-"`
+}
+
+// GetId returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetId() string {
+	return v.WorkflowState.Id
+}
+
+// GetName returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetName() string {
+	return v.WorkflowState.Name
+}
+
+// GetColor returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Color, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetColor() string {
+	return v.WorkflowState.Color
+}
+
+// GetDescription returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Description, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetDescription() *string {
+	return v.WorkflowState.Description
+}
+
+// GetType returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetType() string {
+	return v.WorkflowState.Type
+}
+
+// GetPosition returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Position, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetPosition() float64 {
+	return v.WorkflowState.Position
+}
+
+// GetArchivedAt returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetArchivedAt() *time.Time {
+	return v.WorkflowState.ArchivedAt
+}
+
+// GetCreatedAt returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.CreatedAt, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetCreatedAt() time.Time {
+	return v.WorkflowState.CreatedAt
+}
+
+// GetUpdatedAt returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetUpdatedAt() time.Time {
+	return v.WorkflowState.UpdatedAt
+}
+
+// GetTeam returns createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) GetTeam() WorkflowStateTeam {
+	return v.WorkflowState.Team
+}
+
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WorkflowState)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type string `json:"type"`
+
+	Position float64 `json:"position"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Team WorkflowStateTeam `json:"team"`
+}
+
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState) __premarshalJSON() (*__premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState, error) {
+	var retval __premarshalcreateWorkflowStateWorkflowStateCreateWorkflowStatePayloadWorkflowState
+
+	retval.Id = v.WorkflowState.Id
+	retval.Name = v.WorkflowState.Name
+	retval.Color = v.WorkflowState.Color
+	retval.Description = v.WorkflowState.Description
+	retval.Type = v.WorkflowState.Type
+	retval.Position = v.WorkflowState.Position
+	retval.ArchivedAt = v.WorkflowState.ArchivedAt
+	retval.CreatedAt = v.WorkflowState.CreatedAt
+	retval.UpdatedAt = v.WorkflowState.UpdatedAt
+	retval.Team = v.WorkflowState.Team
+	return &retval, nil
+}
+
+// deleteCustomViewCustomViewDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteCustomViewCustomViewDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteCustomViewCustomViewDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteCustomViewCustomViewDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteCustomViewResponse is returned by deleteCustomView on success.
+type deleteCustomViewResponse struct {
+	// Deletes a custom view.
+	CustomViewDelete deleteCustomViewCustomViewDeleteDeletePayload `json:"customViewDelete"`
+}
+
+// GetCustomViewDelete returns deleteCustomViewResponse.CustomViewDelete, and is useful for accessing the field via an interface.
+func (v *deleteCustomViewResponse) GetCustomViewDelete() deleteCustomViewCustomViewDeleteDeletePayload {
+	return v.CustomViewDelete
+}
+
+// deleteCustomerCustomerDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteCustomerCustomerDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteCustomerCustomerDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteCustomerCustomerDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteCustomerNeedCustomerNeedDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteCustomerNeedCustomerNeedDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteCustomerNeedCustomerNeedDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteCustomerNeedCustomerNeedDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteCustomerNeedResponse is returned by deleteCustomerNeed on success.
+type deleteCustomerNeedResponse struct {
+	// [ALPHA] Deletes a customer need.
+	CustomerNeedDelete deleteCustomerNeedCustomerNeedDeleteDeletePayload `json:"customerNeedDelete"`
+}
+
+// GetCustomerNeedDelete returns deleteCustomerNeedResponse.CustomerNeedDelete, and is useful for accessing the field via an interface.
+func (v *deleteCustomerNeedResponse) GetCustomerNeedDelete() deleteCustomerNeedCustomerNeedDeleteDeletePayload {
+	return v.CustomerNeedDelete
+}
+
+// deleteCustomerResponse is returned by deleteCustomer on success.
+type deleteCustomerResponse struct {
+	// [ALPHA] Deletes a customer.
+	CustomerDelete deleteCustomerCustomerDeleteDeletePayload `json:"customerDelete"`
+}
+
+// GetCustomerDelete returns deleteCustomerResponse.CustomerDelete, and is useful for accessing the field via an interface.
+func (v *deleteCustomerResponse) GetCustomerDelete() deleteCustomerCustomerDeleteDeletePayload {
+	return v.CustomerDelete
+}
+
+// deleteCustomerStatusCustomerStatusDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteCustomerStatusCustomerStatusDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteCustomerStatusCustomerStatusDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteCustomerStatusCustomerStatusDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteCustomerStatusResponse is returned by deleteCustomerStatus on success.
+type deleteCustomerStatusResponse struct {
+	// [ALPHA] Deletes a customer status.
+	CustomerStatusDelete deleteCustomerStatusCustomerStatusDeleteDeletePayload `json:"customerStatusDelete"`
+}
+
+// GetCustomerStatusDelete returns deleteCustomerStatusResponse.CustomerStatusDelete, and is useful for accessing the field via an interface.
+func (v *deleteCustomerStatusResponse) GetCustomerStatusDelete() deleteCustomerStatusCustomerStatusDeleteDeletePayload {
+	return v.CustomerStatusDelete
+}
+
+// deleteEmojiEmojiDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteEmojiEmojiDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteEmojiEmojiDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteEmojiEmojiDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteEmojiResponse is returned by deleteEmoji on success.
+type deleteEmojiResponse struct {
+	// Deletes an emoji.
+	EmojiDelete deleteEmojiEmojiDeleteDeletePayload `json:"emojiDelete"`
+}
+
+// GetEmojiDelete returns deleteEmojiResponse.EmojiDelete, and is useful for accessing the field via an interface.
+func (v *deleteEmojiResponse) GetEmojiDelete() deleteEmojiEmojiDeleteDeletePayload {
+	return v.EmojiDelete
+}
+
+// deleteGitAutomationStateGitAutomationStateDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteGitAutomationStateGitAutomationStateDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteGitAutomationStateGitAutomationStateDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteGitAutomationStateGitAutomationStateDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// deleteGitAutomationStateResponse is returned by deleteGitAutomationState on success.
+type deleteGitAutomationStateResponse struct {
+	// Archives an automation state.
+	GitAutomationStateDelete deleteGitAutomationStateGitAutomationStateDeleteDeletePayload `json:"gitAutomationStateDelete"`
+}
+
+// GetGitAutomationStateDelete returns deleteGitAutomationStateResponse.GitAutomationStateDelete, and is useful for accessing the field via an interface.
+func (v *deleteGitAutomationStateResponse) GetGitAutomationStateDelete() deleteGitAutomationStateGitAutomationStateDeleteDeletePayload {
+	return v.GitAutomationStateDelete
+}
+
+// deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// deleteInitiativeToProjectResponse is returned by deleteInitiativeToProject on success.
+type deleteInitiativeToProjectResponse struct {
+	// Deletes a initiativeToProject.
+	InitiativeToProjectDelete deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload `json:"initiativeToProjectDelete"`
+}
+
+// GetInitiativeToProjectDelete returns deleteInitiativeToProjectResponse.InitiativeToProjectDelete, and is useful for accessing the field via an interface.
+func (v *deleteInitiativeToProjectResponse) GetInitiativeToProjectDelete() deleteInitiativeToProjectInitiativeToProjectDeleteDeletePayload {
+	return v.InitiativeToProjectDelete
+}
+
+// deleteIssueIssueDeleteIssueArchivePayload includes the requested fields of the GraphQL type IssueArchivePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity archive mutations.
+type deleteIssueIssueDeleteIssueArchivePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteIssueIssueDeleteIssueArchivePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteIssueIssueDeleteIssueArchivePayload) GetSuccess() bool { return v.Success }
+
+// deleteIssueRelationIssueRelationDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteIssueRelationIssueRelationDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteIssueRelationIssueRelationDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteIssueRelationIssueRelationDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteIssueRelationResponse is returned by deleteIssueRelation on success.
+type deleteIssueRelationResponse struct {
+	// Deletes an issue relation.
+	IssueRelationDelete deleteIssueRelationIssueRelationDeleteDeletePayload `json:"issueRelationDelete"`
+}
+
+// GetIssueRelationDelete returns deleteIssueRelationResponse.IssueRelationDelete, and is useful for accessing the field via an interface.
+func (v *deleteIssueRelationResponse) GetIssueRelationDelete() deleteIssueRelationIssueRelationDeleteDeletePayload {
+	return v.IssueRelationDelete
+}
+
+// deleteIssueResponse is returned by deleteIssue on success.
+type deleteIssueResponse struct {
+	// Deletes (trashes) an issue.
+	IssueDelete deleteIssueIssueDeleteIssueArchivePayload `json:"issueDelete"`
+}
+
+// GetIssueDelete returns deleteIssueResponse.IssueDelete, and is useful for accessing the field via an interface.
+func (v *deleteIssueResponse) GetIssueDelete() deleteIssueIssueDeleteIssueArchivePayload {
+	return v.IssueDelete
+}
+
+// deleteLabelIssueLabelDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteLabelIssueLabelDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteLabelIssueLabelDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteLabelIssueLabelDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteLabelResponse is returned by deleteLabel on success.
+type deleteLabelResponse struct {
+	// Deletes an issue label.
+	IssueLabelDelete deleteLabelIssueLabelDeleteDeletePayload `json:"issueLabelDelete"`
+}
+
+// GetIssueLabelDelete returns deleteLabelResponse.IssueLabelDelete, and is useful for accessing the field via an interface.
+func (v *deleteLabelResponse) GetIssueLabelDelete() deleteLabelIssueLabelDeleteDeletePayload {
+	return v.IssueLabelDelete
+}
+
+// deleteProjectMilestoneProjectMilestoneDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteProjectMilestoneProjectMilestoneDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteProjectMilestoneProjectMilestoneDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteProjectMilestoneProjectMilestoneDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// deleteProjectMilestoneResponse is returned by deleteProjectMilestone on success.
+type deleteProjectMilestoneResponse struct {
+	// Deletes a project milestone.
+	ProjectMilestoneDelete deleteProjectMilestoneProjectMilestoneDeleteDeletePayload `json:"projectMilestoneDelete"`
+}
+
+// GetProjectMilestoneDelete returns deleteProjectMilestoneResponse.ProjectMilestoneDelete, and is useful for accessing the field via an interface.
+func (v *deleteProjectMilestoneResponse) GetProjectMilestoneDelete() deleteProjectMilestoneProjectMilestoneDeleteDeletePayload {
+	return v.ProjectMilestoneDelete
+}
+
+// deleteProjectProjectDeleteProjectArchivePayload includes the requested fields of the GraphQL type ProjectArchivePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity archive mutations.
+type deleteProjectProjectDeleteProjectArchivePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteProjectProjectDeleteProjectArchivePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteProjectProjectDeleteProjectArchivePayload) GetSuccess() bool { return v.Success }
+
+// deleteProjectRelationProjectRelationDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteProjectRelationProjectRelationDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteProjectRelationProjectRelationDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteProjectRelationProjectRelationDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteProjectRelationResponse is returned by deleteProjectRelation on success.
+type deleteProjectRelationResponse struct {
+	// [ALPHA] Deletes a project relation.
+	ProjectRelationDelete deleteProjectRelationProjectRelationDeleteDeletePayload `json:"projectRelationDelete"`
+}
+
+// GetProjectRelationDelete returns deleteProjectRelationResponse.ProjectRelationDelete, and is useful for accessing the field via an interface.
+func (v *deleteProjectRelationResponse) GetProjectRelationDelete() deleteProjectRelationProjectRelationDeleteDeletePayload {
+	return v.ProjectRelationDelete
+}
+
+// deleteProjectResponse is returned by deleteProject on success.
+type deleteProjectResponse struct {
+	// Deletes (trashes) a project.
+	ProjectDelete deleteProjectProjectDeleteProjectArchivePayload `json:"projectDelete"`
+}
+
+// GetProjectDelete returns deleteProjectResponse.ProjectDelete, and is useful for accessing the field via an interface.
+func (v *deleteProjectResponse) GetProjectDelete() deleteProjectProjectDeleteProjectArchivePayload {
+	return v.ProjectDelete
+}
+
+// deleteRoadmapResponse is returned by deleteRoadmap on success.
+type deleteRoadmapResponse struct {
+	// Deletes a roadmap.
+	RoadmapDelete deleteRoadmapRoadmapDeleteDeletePayload `json:"roadmapDelete"`
+}
+
+// GetRoadmapDelete returns deleteRoadmapResponse.RoadmapDelete, and is useful for accessing the field via an interface.
+func (v *deleteRoadmapResponse) GetRoadmapDelete() deleteRoadmapRoadmapDeleteDeletePayload {
+	return v.RoadmapDelete
+}
+
+// deleteRoadmapRoadmapDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteRoadmapRoadmapDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteRoadmapRoadmapDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteRoadmapRoadmapDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteRoadmapToProjectResponse is returned by deleteRoadmapToProject on success.
+type deleteRoadmapToProjectResponse struct {
+	// Deletes a roadmapToProject.
+	RoadmapToProjectDelete deleteRoadmapToProjectRoadmapToProjectDeleteDeletePayload `json:"roadmapToProjectDelete"`
+}
+
+// GetRoadmapToProjectDelete returns deleteRoadmapToProjectResponse.RoadmapToProjectDelete, and is useful for accessing the field via an interface.
+func (v *deleteRoadmapToProjectResponse) GetRoadmapToProjectDelete() deleteRoadmapToProjectRoadmapToProjectDeleteDeletePayload {
+	return v.RoadmapToProjectDelete
+}
+
+// deleteRoadmapToProjectRoadmapToProjectDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteRoadmapToProjectRoadmapToProjectDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteRoadmapToProjectRoadmapToProjectDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteRoadmapToProjectRoadmapToProjectDeleteDeletePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// deleteTeamMembershipResponse is returned by deleteTeamMembership on success.
+type deleteTeamMembershipResponse struct {
+	// Deletes a team membership.
+	TeamMembershipDelete deleteTeamMembershipTeamMembershipDeleteDeletePayload `json:"teamMembershipDelete"`
+}
+
+// GetTeamMembershipDelete returns deleteTeamMembershipResponse.TeamMembershipDelete, and is useful for accessing the field via an interface.
+func (v *deleteTeamMembershipResponse) GetTeamMembershipDelete() deleteTeamMembershipTeamMembershipDeleteDeletePayload {
+	return v.TeamMembershipDelete
+}
+
+// deleteTeamMembershipTeamMembershipDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteTeamMembershipTeamMembershipDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteTeamMembershipTeamMembershipDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteTeamMembershipTeamMembershipDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteTeamResponse is returned by deleteTeam on success.
+type deleteTeamResponse struct {
+	// Deletes a team.
+	TeamDelete deleteTeamTeamDeleteDeletePayload `json:"teamDelete"`
+}
+
+// GetTeamDelete returns deleteTeamResponse.TeamDelete, and is useful for accessing the field via an interface.
+func (v *deleteTeamResponse) GetTeamDelete() deleteTeamTeamDeleteDeletePayload { return v.TeamDelete }
+
+// deleteTeamTeamDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteTeamTeamDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteTeamTeamDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteTeamTeamDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteTemplateResponse is returned by deleteTemplate on success.
+type deleteTemplateResponse struct {
+	// Deletes a template.
+	TemplateDelete deleteTemplateTemplateDeleteDeletePayload `json:"templateDelete"`
+}
+
+// GetTemplateDelete returns deleteTemplateResponse.TemplateDelete, and is useful for accessing the field via an interface.
+func (v *deleteTemplateResponse) GetTemplateDelete() deleteTemplateTemplateDeleteDeletePayload {
+	return v.TemplateDelete
+}
+
+// deleteTemplateTemplateDeleteDeletePayload includes the requested fields of the GraphQL type DeletePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity deletion mutations.
+type deleteTemplateTemplateDeleteDeletePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteTemplateTemplateDeleteDeletePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteTemplateTemplateDeleteDeletePayload) GetSuccess() bool { return v.Success }
+
+// deleteWorkflowStateResponse is returned by deleteWorkflowState on success.
+type deleteWorkflowStateResponse struct {
+	// Archives a state. Only states with issues that have all been archived can be archived.
+	WorkflowStateArchive deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload `json:"workflowStateArchive"`
+}
+
+// GetWorkflowStateArchive returns deleteWorkflowStateResponse.WorkflowStateArchive, and is useful for accessing the field via an interface.
+func (v *deleteWorkflowStateResponse) GetWorkflowStateArchive() deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload {
+	return v.WorkflowStateArchive
+}
+
+// deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload includes the requested fields of the GraphQL type WorkflowStateArchivePayload.
+// The GraphQL type's documentation follows.
+//
+// A generic payload return from entity archive mutations.
+type deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload.Success, and is useful for accessing the field via an interface.
+func (v *deleteWorkflowStateWorkflowStateArchiveWorkflowStateArchivePayload) GetSuccess() bool {
+	return v.Success
+}
+
+// findCycleResponse is returned by findCycle on success.
+type findCycleResponse struct {
+	// One specific team.
+	Team findCycleTeam `json:"team"`
+}
+
+// GetTeam returns findCycleResponse.Team, and is useful for accessing the field via an interface.
+func (v *findCycleResponse) GetTeam() findCycleTeam { return v.Team }
+
+// findCycleTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type findCycleTeam struct {
+	// Cycles associated with the team.
+	Cycles findCycleTeamCyclesCycleConnection `json:"cycles"`
+}
+
+// GetCycles returns findCycleTeam.Cycles, and is useful for accessing the field via an interface.
+func (v *findCycleTeam) GetCycles() findCycleTeamCyclesCycleConnection { return v.Cycles }
+
+// findCycleTeamCyclesCycleConnection includes the requested fields of the GraphQL type CycleConnection.
+type findCycleTeamCyclesCycleConnection struct {
+	Nodes []findCycleTeamCyclesCycleConnectionNodesCycle `json:"nodes"`
+}
+
+// GetNodes returns findCycleTeamCyclesCycleConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findCycleTeamCyclesCycleConnection) GetNodes() []findCycleTeamCyclesCycleConnectionNodesCycle {
+	return v.Nodes
+}
+
+// findCycleTeamCyclesCycleConnectionNodesCycle includes the requested fields of the GraphQL type Cycle.
+// The GraphQL type's documentation follows.
+//
+// A set of issues to be resolved in a specified amount of time.
+type findCycleTeamCyclesCycleConnectionNodesCycle struct {
+	CycleDetails `json:"-"`
+}
+
+// GetId returns findCycleTeamCyclesCycleConnectionNodesCycle.Id, and is useful for accessing the field via an interface.
+func (v *findCycleTeamCyclesCycleConnectionNodesCycle) GetId() string { return v.CycleDetails.Id }
+
+// GetNumber returns findCycleTeamCyclesCycleConnectionNodesCycle.Number, and is useful for accessing the field via an interface.
+func (v *findCycleTeamCyclesCycleConnectionNodesCycle) GetNumber() float64 {
+	return v.CycleDetails.Number
+}
+
+// GetName returns findCycleTeamCyclesCycleConnectionNodesCycle.Name, and is useful for accessing the field via an interface.
+func (v *findCycleTeamCyclesCycleConnectionNodesCycle) GetName() *string { return v.CycleDetails.Name }
+
+// GetDescription returns findCycleTeamCyclesCycleConnectionNodesCycle.Description, and is useful for accessing the field via an interface.
+func (v *findCycleTeamCyclesCycleConnectionNodesCycle) GetDescription() *string {
+	return v.CycleDetails.Description
+}
+
+// GetStartsAt returns findCycleTeamCyclesCycleConnectionNodesCycle.StartsAt, and is useful for accessing the field via an interface.
+func (v *findCycleTeamCyclesCycleConnectionNodesCycle) GetStartsAt() time.Time {
+	return v.CycleDetails.StartsAt
+}
+
+// GetEndsAt returns findCycleTeamCyclesCycleConnectionNodesCycle.EndsAt, and is useful for accessing the field via an interface.
+func (v *findCycleTeamCyclesCycleConnectionNodesCycle) GetEndsAt() time.Time {
+	return v.CycleDetails.EndsAt
+}
+
+// GetCompletedAt returns findCycleTeamCyclesCycleConnectionNodesCycle.CompletedAt, and is useful for accessing the field via an interface.
+func (v *findCycleTeamCyclesCycleConnectionNodesCycle) GetCompletedAt() *time.Time {
+	return v.CycleDetails.CompletedAt
+}
+
+// GetProgress returns findCycleTeamCyclesCycleConnectionNodesCycle.Progress, and is useful for accessing the field via an interface.
+func (v *findCycleTeamCyclesCycleConnectionNodesCycle) GetProgress() float64 {
+	return v.CycleDetails.Progress
+}
+
+func (v *findCycleTeamCyclesCycleConnectionNodesCycle) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*findCycleTeamCyclesCycleConnectionNodesCycle
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.findCycleTeamCyclesCycleConnectionNodesCycle = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CycleDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalfindCycleTeamCyclesCycleConnectionNodesCycle struct {
+	Id string `json:"id"`
+
+	Number float64 `json:"number"`
+
+	Name *string `json:"name"`
+
+	Description *string `json:"description"`
+
+	StartsAt time.Time `json:"startsAt"`
+
+	EndsAt time.Time `json:"endsAt"`
+
+	CompletedAt *time.Time `json:"completedAt"`
+
+	Progress float64 `json:"progress"`
+}
+
+func (v *findCycleTeamCyclesCycleConnectionNodesCycle) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *findCycleTeamCyclesCycleConnectionNodesCycle) __premarshalJSON() (*__premarshalfindCycleTeamCyclesCycleConnectionNodesCycle, error) {
+	var retval __premarshalfindCycleTeamCyclesCycleConnectionNodesCycle
+
+	retval.Id = v.CycleDetails.Id
+	retval.Number = v.CycleDetails.Number
+	retval.Name = v.CycleDetails.Name
+	retval.Description = v.CycleDetails.Description
+	retval.StartsAt = v.CycleDetails.StartsAt
+	retval.EndsAt = v.CycleDetails.EndsAt
+	retval.CompletedAt = v.CycleDetails.CompletedAt
+	retval.Progress = v.CycleDetails.Progress
+	return &retval, nil
+}
+
+// findProjectByNameProjectsProjectConnection includes the requested fields of the GraphQL type ProjectConnection.
+type findProjectByNameProjectsProjectConnection struct {
+	Nodes []findProjectByNameProjectsProjectConnectionNodesProject `json:"nodes"`
+}
+
+// GetNodes returns findProjectByNameProjectsProjectConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnection) GetNodes() []findProjectByNameProjectsProjectConnectionNodesProject {
+	return v.Nodes
+}
+
+// findProjectByNameProjectsProjectConnectionNodesProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type findProjectByNameProjectsProjectConnectionNodesProject struct {
+	ProjectDetails `json:"-"`
+}
+
+// GetId returns findProjectByNameProjectsProjectConnectionNodesProject.Id, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetId() string {
+	return v.ProjectDetails.Id
+}
+
+// GetSlugId returns findProjectByNameProjectsProjectConnectionNodesProject.SlugId, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetSlugId() string {
+	return v.ProjectDetails.SlugId
+}
+
+// GetName returns findProjectByNameProjectsProjectConnectionNodesProject.Name, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetName() string {
+	return v.ProjectDetails.Name
+}
+
+// GetDescription returns findProjectByNameProjectsProjectConnectionNodesProject.Description, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetDescription() string {
+	return v.ProjectDetails.Description
+}
+
+// GetIcon returns findProjectByNameProjectsProjectConnectionNodesProject.Icon, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetIcon() *string {
+	return v.ProjectDetails.Icon
+}
+
+// GetColor returns findProjectByNameProjectsProjectConnectionNodesProject.Color, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetColor() string {
+	return v.ProjectDetails.Color
+}
+
+// GetPriority returns findProjectByNameProjectsProjectConnectionNodesProject.Priority, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetPriority() int {
+	return v.ProjectDetails.Priority
+}
+
+// GetProgress returns findProjectByNameProjectsProjectConnectionNodesProject.Progress, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetProgress() float64 {
+	return v.ProjectDetails.Progress
+}
+
+// GetStartDate returns findProjectByNameProjectsProjectConnectionNodesProject.StartDate, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetStartDate() *string {
+	return v.ProjectDetails.StartDate
+}
+
+// GetTargetDate returns findProjectByNameProjectsProjectConnectionNodesProject.TargetDate, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetTargetDate() *string {
+	return v.ProjectDetails.TargetDate
+}
+
+// GetUrl returns findProjectByNameProjectsProjectConnectionNodesProject.Url, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetUrl() string {
+	return v.ProjectDetails.Url
+}
+
+// GetStatus returns findProjectByNameProjectsProjectConnectionNodesProject.Status, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetStatus() ProjectDetailsStatusProjectStatus {
+	return v.ProjectDetails.Status
+}
+
+// GetLead returns findProjectByNameProjectsProjectConnectionNodesProject.Lead, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetLead() *ProjectDetailsLeadUser {
+	return v.ProjectDetails.Lead
+}
+
+// GetTeams returns findProjectByNameProjectsProjectConnectionNodesProject.Teams, and is useful for accessing the field via an interface.
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) GetTeams() ProjectDetailsTeamsTeamConnection {
+	return v.ProjectDetails.Teams
+}
+
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*findProjectByNameProjectsProjectConnectionNodesProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.findProjectByNameProjectsProjectConnectionNodesProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalfindProjectByNameProjectsProjectConnectionNodesProject struct {
+	Id string `json:"id"`
+
+	SlugId string `json:"slugId"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color string `json:"color"`
+
+	Priority int `json:"priority"`
+
+	Progress float64 `json:"progress"`
+
+	StartDate *string `json:"startDate"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Url string `json:"url"`
+
+	Status ProjectDetailsStatusProjectStatus `json:"status"`
+
+	Lead *ProjectDetailsLeadUser `json:"lead"`
+
+	Teams ProjectDetailsTeamsTeamConnection `json:"teams"`
+}
+
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *findProjectByNameProjectsProjectConnectionNodesProject) __premarshalJSON() (*__premarshalfindProjectByNameProjectsProjectConnectionNodesProject, error) {
+	var retval __premarshalfindProjectByNameProjectsProjectConnectionNodesProject
+
+	retval.Id = v.ProjectDetails.Id
+	retval.SlugId = v.ProjectDetails.SlugId
+	retval.Name = v.ProjectDetails.Name
+	retval.Description = v.ProjectDetails.Description
+	retval.Icon = v.ProjectDetails.Icon
+	retval.Color = v.ProjectDetails.Color
+	retval.Priority = v.ProjectDetails.Priority
+	retval.Progress = v.ProjectDetails.Progress
+	retval.StartDate = v.ProjectDetails.StartDate
+	retval.TargetDate = v.ProjectDetails.TargetDate
+	retval.Url = v.ProjectDetails.Url
+	retval.Status = v.ProjectDetails.Status
+	retval.Lead = v.ProjectDetails.Lead
+	retval.Teams = v.ProjectDetails.Teams
+	return &retval, nil
+}
+
+// findProjectByNameResponse is returned by findProjectByName on success.
+type findProjectByNameResponse struct {
+	// All projects.
+	Projects findProjectByNameProjectsProjectConnection `json:"projects"`
+}
+
+// GetProjects returns findProjectByNameResponse.Projects, and is useful for accessing the field via an interface.
+func (v *findProjectByNameResponse) GetProjects() findProjectByNameProjectsProjectConnection {
+	return v.Projects
+}
+
+// findProjectBySlugProjectsProjectConnection includes the requested fields of the GraphQL type ProjectConnection.
+type findProjectBySlugProjectsProjectConnection struct {
+	Nodes []findProjectBySlugProjectsProjectConnectionNodesProject `json:"nodes"`
+}
+
+// GetNodes returns findProjectBySlugProjectsProjectConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnection) GetNodes() []findProjectBySlugProjectsProjectConnectionNodesProject {
+	return v.Nodes
+}
+
+// findProjectBySlugProjectsProjectConnectionNodesProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type findProjectBySlugProjectsProjectConnectionNodesProject struct {
+	ProjectDetails `json:"-"`
+}
+
+// GetId returns findProjectBySlugProjectsProjectConnectionNodesProject.Id, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetId() string {
+	return v.ProjectDetails.Id
+}
+
+// GetSlugId returns findProjectBySlugProjectsProjectConnectionNodesProject.SlugId, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetSlugId() string {
+	return v.ProjectDetails.SlugId
+}
+
+// GetName returns findProjectBySlugProjectsProjectConnectionNodesProject.Name, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetName() string {
+	return v.ProjectDetails.Name
+}
+
+// GetDescription returns findProjectBySlugProjectsProjectConnectionNodesProject.Description, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetDescription() string {
+	return v.ProjectDetails.Description
+}
+
+// GetIcon returns findProjectBySlugProjectsProjectConnectionNodesProject.Icon, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetIcon() *string {
+	return v.ProjectDetails.Icon
+}
+
+// GetColor returns findProjectBySlugProjectsProjectConnectionNodesProject.Color, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetColor() string {
+	return v.ProjectDetails.Color
+}
+
+// GetPriority returns findProjectBySlugProjectsProjectConnectionNodesProject.Priority, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetPriority() int {
+	return v.ProjectDetails.Priority
+}
+
+// GetProgress returns findProjectBySlugProjectsProjectConnectionNodesProject.Progress, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetProgress() float64 {
+	return v.ProjectDetails.Progress
+}
+
+// GetStartDate returns findProjectBySlugProjectsProjectConnectionNodesProject.StartDate, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetStartDate() *string {
+	return v.ProjectDetails.StartDate
+}
+
+// GetTargetDate returns findProjectBySlugProjectsProjectConnectionNodesProject.TargetDate, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetTargetDate() *string {
+	return v.ProjectDetails.TargetDate
+}
+
+// GetUrl returns findProjectBySlugProjectsProjectConnectionNodesProject.Url, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetUrl() string {
+	return v.ProjectDetails.Url
+}
+
+// GetStatus returns findProjectBySlugProjectsProjectConnectionNodesProject.Status, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetStatus() ProjectDetailsStatusProjectStatus {
+	return v.ProjectDetails.Status
+}
+
+// GetLead returns findProjectBySlugProjectsProjectConnectionNodesProject.Lead, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetLead() *ProjectDetailsLeadUser {
+	return v.ProjectDetails.Lead
+}
+
+// GetTeams returns findProjectBySlugProjectsProjectConnectionNodesProject.Teams, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) GetTeams() ProjectDetailsTeamsTeamConnection {
+	return v.ProjectDetails.Teams
+}
+
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*findProjectBySlugProjectsProjectConnectionNodesProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.findProjectBySlugProjectsProjectConnectionNodesProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalfindProjectBySlugProjectsProjectConnectionNodesProject struct {
+	Id string `json:"id"`
+
+	SlugId string `json:"slugId"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color string `json:"color"`
+
+	Priority int `json:"priority"`
+
+	Progress float64 `json:"progress"`
+
+	StartDate *string `json:"startDate"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Url string `json:"url"`
+
+	Status ProjectDetailsStatusProjectStatus `json:"status"`
+
+	Lead *ProjectDetailsLeadUser `json:"lead"`
+
+	Teams ProjectDetailsTeamsTeamConnection `json:"teams"`
+}
+
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *findProjectBySlugProjectsProjectConnectionNodesProject) __premarshalJSON() (*__premarshalfindProjectBySlugProjectsProjectConnectionNodesProject, error) {
+	var retval __premarshalfindProjectBySlugProjectsProjectConnectionNodesProject
+
+	retval.Id = v.ProjectDetails.Id
+	retval.SlugId = v.ProjectDetails.SlugId
+	retval.Name = v.ProjectDetails.Name
+	retval.Description = v.ProjectDetails.Description
+	retval.Icon = v.ProjectDetails.Icon
+	retval.Color = v.ProjectDetails.Color
+	retval.Priority = v.ProjectDetails.Priority
+	retval.Progress = v.ProjectDetails.Progress
+	retval.StartDate = v.ProjectDetails.StartDate
+	retval.TargetDate = v.ProjectDetails.TargetDate
+	retval.Url = v.ProjectDetails.Url
+	retval.Status = v.ProjectDetails.Status
+	retval.Lead = v.ProjectDetails.Lead
+	retval.Teams = v.ProjectDetails.Teams
+	return &retval, nil
+}
+
+// findProjectBySlugResponse is returned by findProjectBySlug on success.
+type findProjectBySlugResponse struct {
+	// All projects.
+	Projects findProjectBySlugProjectsProjectConnection `json:"projects"`
+}
+
+// GetProjects returns findProjectBySlugResponse.Projects, and is useful for accessing the field via an interface.
+func (v *findProjectBySlugResponse) GetProjects() findProjectBySlugProjectsProjectConnection {
+	return v.Projects
+}
+
+// findProjectMembershipProjectsProjectConnection includes the requested fields of the GraphQL type ProjectConnection.
+type findProjectMembershipProjectsProjectConnection struct {
+	Nodes []findProjectMembershipProjectsProjectConnectionNodesProject `json:"nodes"`
+}
+
+// GetNodes returns findProjectMembershipProjectsProjectConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findProjectMembershipProjectsProjectConnection) GetNodes() []findProjectMembershipProjectsProjectConnectionNodesProject {
+	return v.Nodes
+}
+
+// findProjectMembershipProjectsProjectConnectionNodesProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type findProjectMembershipProjectsProjectConnectionNodesProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findProjectMembershipProjectsProjectConnectionNodesProject.Id, and is useful for accessing the field via an interface.
+func (v *findProjectMembershipProjectsProjectConnectionNodesProject) GetId() string { return v.Id }
+
+// findProjectMembershipResponse is returned by findProjectMembership on success.
+type findProjectMembershipResponse struct {
+	// All projects.
+	Projects findProjectMembershipProjectsProjectConnection `json:"projects"`
+	// All users for the organization.
+	Users findProjectMembershipUsersUserConnection `json:"users"`
+}
+
+// GetProjects returns findProjectMembershipResponse.Projects, and is useful for accessing the field via an interface.
+func (v *findProjectMembershipResponse) GetProjects() findProjectMembershipProjectsProjectConnection {
+	return v.Projects
+}
+
+// GetUsers returns findProjectMembershipResponse.Users, and is useful for accessing the field via an interface.
+func (v *findProjectMembershipResponse) GetUsers() findProjectMembershipUsersUserConnection {
+	return v.Users
+}
+
+// findProjectMembershipUsersUserConnection includes the requested fields of the GraphQL type UserConnection.
+type findProjectMembershipUsersUserConnection struct {
+	Nodes []findProjectMembershipUsersUserConnectionNodesUser `json:"nodes"`
+}
+
+// GetNodes returns findProjectMembershipUsersUserConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findProjectMembershipUsersUserConnection) GetNodes() []findProjectMembershipUsersUserConnectionNodesUser {
+	return v.Nodes
+}
+
+// findProjectMembershipUsersUserConnectionNodesUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type findProjectMembershipUsersUserConnectionNodesUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findProjectMembershipUsersUserConnectionNodesUser.Id, and is useful for accessing the field via an interface.
+func (v *findProjectMembershipUsersUserConnectionNodesUser) GetId() string { return v.Id }
+
+// findProjectMilestoneProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type findProjectMilestoneProject struct {
+	// Milestones associated with the project.
+	ProjectMilestones findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection `json:"projectMilestones"`
+}
+
+// GetProjectMilestones returns findProjectMilestoneProject.ProjectMilestones, and is useful for accessing the field via an interface.
+func (v *findProjectMilestoneProject) GetProjectMilestones() findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection {
+	return v.ProjectMilestones
+}
+
+// findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection includes the requested fields of the GraphQL type ProjectMilestoneConnection.
+type findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection struct {
+	Nodes []findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone `json:"nodes"`
+}
+
+// GetNodes returns findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnection) GetNodes() []findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone {
+	return v.Nodes
+}
+
+// findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone includes the requested fields of the GraphQL type ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *findProjectMilestoneProjectProjectMilestonesProjectMilestoneConnectionNodesProjectMilestone) GetId() string {
+	return v.Id
+}
+
+// findProjectMilestoneResponse is returned by findProjectMilestone on success.
+type findProjectMilestoneResponse struct {
+	// One specific project.
+	Project findProjectMilestoneProject `json:"project"`
+}
+
+// GetProject returns findProjectMilestoneResponse.Project, and is useful for accessing the field via an interface.
+func (v *findProjectMilestoneResponse) GetProject() findProjectMilestoneProject { return v.Project }
+
+// findTeamByNameResponse is returned by findTeamByName on success.
+type findTeamByNameResponse struct {
+	// All teams whose issues can be accessed by the user. This might be different
+	// from `administrableTeams`, which also includes teams whose settings can be
+	// changed by the user.
+	Teams findTeamByNameTeamsTeamConnection `json:"teams"`
+}
+
+// GetTeams returns findTeamByNameResponse.Teams, and is useful for accessing the field via an interface.
+func (v *findTeamByNameResponse) GetTeams() findTeamByNameTeamsTeamConnection { return v.Teams }
+
+// findTeamByNameTeamsTeamConnection includes the requested fields of the GraphQL type TeamConnection.
+type findTeamByNameTeamsTeamConnection struct {
+	Nodes []findTeamByNameTeamsTeamConnectionNodesTeam `json:"nodes"`
+}
+
+// GetNodes returns findTeamByNameTeamsTeamConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findTeamByNameTeamsTeamConnection) GetNodes() []findTeamByNameTeamsTeamConnectionNodesTeam {
+	return v.Nodes
+}
+
+// findTeamByNameTeamsTeamConnectionNodesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type findTeamByNameTeamsTeamConnectionNodesTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findTeamByNameTeamsTeamConnectionNodesTeam.Id, and is useful for accessing the field via an interface.
+func (v *findTeamByNameTeamsTeamConnectionNodesTeam) GetId() string { return v.Id }
+
+// findTeamLabelByTeamIdIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type findTeamLabelByTeamIdIssueLabelsIssueLabelConnection struct {
+	Nodes []findTeamLabelByTeamIdIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+}
+
+// GetNodes returns findTeamLabelByTeamIdIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findTeamLabelByTeamIdIssueLabelsIssueLabelConnection) GetNodes() []findTeamLabelByTeamIdIssueLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
+}
+
+// findTeamLabelByTeamIdIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type findTeamLabelByTeamIdIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findTeamLabelByTeamIdIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *findTeamLabelByTeamIdIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string {
+	return v.Id
+}
+
+// findTeamLabelByTeamIdResponse is returned by findTeamLabelByTeamId on success.
+type findTeamLabelByTeamIdResponse struct {
+	// All issue labels.
+	IssueLabels findTeamLabelByTeamIdIssueLabelsIssueLabelConnection `json:"issueLabels"`
+}
+
+// GetIssueLabels returns findTeamLabelByTeamIdResponse.IssueLabels, and is useful for accessing the field via an interface.
+func (v *findTeamLabelByTeamIdResponse) GetIssueLabels() findTeamLabelByTeamIdIssueLabelsIssueLabelConnection {
+	return v.IssueLabels
+}
+
+// findTeamLabelIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type findTeamLabelIssueLabelsIssueLabelConnection struct {
+	Nodes []findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+}
+
+// GetNodes returns findTeamLabelIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findTeamLabelIssueLabelsIssueLabelConnection) GetNodes() []findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
+}
+
+// findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *findTeamLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string { return v.Id }
+
+// findTeamLabelResponse is returned by findTeamLabel on success.
+type findTeamLabelResponse struct {
+	// All issue labels.
+	IssueLabels findTeamLabelIssueLabelsIssueLabelConnection `json:"issueLabels"`
+}
+
+// GetIssueLabels returns findTeamLabelResponse.IssueLabels, and is useful for accessing the field via an interface.
+func (v *findTeamLabelResponse) GetIssueLabels() findTeamLabelIssueLabelsIssueLabelConnection {
+	return v.IssueLabels
+}
+
+// findTeamMembershipResponse is returned by findTeamMembership on success.
+type findTeamMembershipResponse struct {
+	// All teams whose issues can be accessed by the user. This might be different
+	// from `administrableTeams`, which also includes teams whose settings can be
+	// changed by the user.
+	Teams findTeamMembershipTeamsTeamConnection `json:"teams"`
+	// All users for the organization.
+	Users findTeamMembershipUsersUserConnection `json:"users"`
+}
+
+// GetTeams returns findTeamMembershipResponse.Teams, and is useful for accessing the field via an interface.
+func (v *findTeamMembershipResponse) GetTeams() findTeamMembershipTeamsTeamConnection { return v.Teams }
+
+// GetUsers returns findTeamMembershipResponse.Users, and is useful for accessing the field via an interface.
+func (v *findTeamMembershipResponse) GetUsers() findTeamMembershipUsersUserConnection { return v.Users }
+
+// findTeamMembershipTeamsTeamConnection includes the requested fields of the GraphQL type TeamConnection.
+type findTeamMembershipTeamsTeamConnection struct {
+	Nodes []findTeamMembershipTeamsTeamConnectionNodesTeam `json:"nodes"`
+}
+
+// GetNodes returns findTeamMembershipTeamsTeamConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findTeamMembershipTeamsTeamConnection) GetNodes() []findTeamMembershipTeamsTeamConnectionNodesTeam {
+	return v.Nodes
+}
+
+// findTeamMembershipTeamsTeamConnectionNodesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type findTeamMembershipTeamsTeamConnectionNodesTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findTeamMembershipTeamsTeamConnectionNodesTeam.Id, and is useful for accessing the field via an interface.
+func (v *findTeamMembershipTeamsTeamConnectionNodesTeam) GetId() string { return v.Id }
+
+// findTeamMembershipUsersUserConnection includes the requested fields of the GraphQL type UserConnection.
+type findTeamMembershipUsersUserConnection struct {
+	Nodes []findTeamMembershipUsersUserConnectionNodesUser `json:"nodes"`
+}
+
+// GetNodes returns findTeamMembershipUsersUserConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findTeamMembershipUsersUserConnection) GetNodes() []findTeamMembershipUsersUserConnectionNodesUser {
+	return v.Nodes
+}
+
+// findTeamMembershipUsersUserConnectionNodesUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type findTeamMembershipUsersUserConnectionNodesUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findTeamMembershipUsersUserConnectionNodesUser.Id, and is useful for accessing the field via an interface.
+func (v *findTeamMembershipUsersUserConnectionNodesUser) GetId() string { return v.Id }
+
+// findTeamResponse is returned by findTeam on success.
+type findTeamResponse struct {
+	// All teams whose issues can be accessed by the user. This might be different
+	// from `administrableTeams`, which also includes teams whose settings can be
+	// changed by the user.
+	Teams findTeamTeamsTeamConnection `json:"teams"`
+}
+
+// GetTeams returns findTeamResponse.Teams, and is useful for accessing the field via an interface.
+func (v *findTeamResponse) GetTeams() findTeamTeamsTeamConnection { return v.Teams }
+
+// findTeamTeamsTeamConnection includes the requested fields of the GraphQL type TeamConnection.
+type findTeamTeamsTeamConnection struct {
+	Nodes []findTeamTeamsTeamConnectionNodesTeam `json:"nodes"`
+}
+
+// GetNodes returns findTeamTeamsTeamConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findTeamTeamsTeamConnection) GetNodes() []findTeamTeamsTeamConnectionNodesTeam {
+	return v.Nodes
+}
+
+// findTeamTeamsTeamConnectionNodesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type findTeamTeamsTeamConnectionNodesTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findTeamTeamsTeamConnectionNodesTeam.Id, and is useful for accessing the field via an interface.
+func (v *findTeamTeamsTeamConnectionNodesTeam) GetId() string { return v.Id }
+
+// findUserByDisplayNameResponse is returned by findUserByDisplayName on success.
+type findUserByDisplayNameResponse struct {
+	// All users for the organization.
+	Users findUserByDisplayNameUsersUserConnection `json:"users"`
+}
+
+// GetUsers returns findUserByDisplayNameResponse.Users, and is useful for accessing the field via an interface.
+func (v *findUserByDisplayNameResponse) GetUsers() findUserByDisplayNameUsersUserConnection {
+	return v.Users
+}
+
+// findUserByDisplayNameUsersUserConnection includes the requested fields of the GraphQL type UserConnection.
+type findUserByDisplayNameUsersUserConnection struct {
+	Nodes []findUserByDisplayNameUsersUserConnectionNodesUser `json:"nodes"`
+}
+
+// GetNodes returns findUserByDisplayNameUsersUserConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findUserByDisplayNameUsersUserConnection) GetNodes() []findUserByDisplayNameUsersUserConnectionNodesUser {
+	return v.Nodes
+}
+
+// findUserByDisplayNameUsersUserConnectionNodesUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type findUserByDisplayNameUsersUserConnectionNodesUser struct {
+	User `json:"-"`
+}
+
+// GetId returns findUserByDisplayNameUsersUserConnectionNodesUser.Id, and is useful for accessing the field via an interface.
+func (v *findUserByDisplayNameUsersUserConnectionNodesUser) GetId() string { return v.User.Id }
+
+// GetName returns findUserByDisplayNameUsersUserConnectionNodesUser.Name, and is useful for accessing the field via an interface.
+func (v *findUserByDisplayNameUsersUserConnectionNodesUser) GetName() string { return v.User.Name }
+
+// GetDisplayName returns findUserByDisplayNameUsersUserConnectionNodesUser.DisplayName, and is useful for accessing the field via an interface.
+func (v *findUserByDisplayNameUsersUserConnectionNodesUser) GetDisplayName() string {
+	return v.User.DisplayName
+}
+
+// GetEmail returns findUserByDisplayNameUsersUserConnectionNodesUser.Email, and is useful for accessing the field via an interface.
+func (v *findUserByDisplayNameUsersUserConnectionNodesUser) GetEmail() string { return v.User.Email }
+
+// GetAdmin returns findUserByDisplayNameUsersUserConnectionNodesUser.Admin, and is useful for accessing the field via an interface.
+func (v *findUserByDisplayNameUsersUserConnectionNodesUser) GetAdmin() bool { return v.User.Admin }
+
+// GetGuest returns findUserByDisplayNameUsersUserConnectionNodesUser.Guest, and is useful for accessing the field via an interface.
+func (v *findUserByDisplayNameUsersUserConnectionNodesUser) GetGuest() bool { return v.User.Guest }
+
+// GetActive returns findUserByDisplayNameUsersUserConnectionNodesUser.Active, and is useful for accessing the field via an interface.
+func (v *findUserByDisplayNameUsersUserConnectionNodesUser) GetActive() bool { return v.User.Active }
+
+func (v *findUserByDisplayNameUsersUserConnectionNodesUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*findUserByDisplayNameUsersUserConnectionNodesUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.findUserByDisplayNameUsersUserConnectionNodesUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.User)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalfindUserByDisplayNameUsersUserConnectionNodesUser struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	DisplayName string `json:"displayName"`
+
+	Email string `json:"email"`
+
+	Admin bool `json:"admin"`
+
+	Guest bool `json:"guest"`
+
+	Active bool `json:"active"`
+}
+
+func (v *findUserByDisplayNameUsersUserConnectionNodesUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *findUserByDisplayNameUsersUserConnectionNodesUser) __premarshalJSON() (*__premarshalfindUserByDisplayNameUsersUserConnectionNodesUser, error) {
+	var retval __premarshalfindUserByDisplayNameUsersUserConnectionNodesUser
+
+	retval.Id = v.User.Id
+	retval.Name = v.User.Name
+	retval.DisplayName = v.User.DisplayName
+	retval.Email = v.User.Email
+	retval.Admin = v.User.Admin
+	retval.Guest = v.User.Guest
+	retval.Active = v.User.Active
+	return &retval, nil
+}
+
+// findUserByEmailResponse is returned by findUserByEmail on success.
+type findUserByEmailResponse struct {
+	// All users for the organization.
+	Users findUserByEmailUsersUserConnection `json:"users"`
+}
+
+// GetUsers returns findUserByEmailResponse.Users, and is useful for accessing the field via an interface.
+func (v *findUserByEmailResponse) GetUsers() findUserByEmailUsersUserConnection { return v.Users }
+
+// findUserByEmailUsersUserConnection includes the requested fields of the GraphQL type UserConnection.
+type findUserByEmailUsersUserConnection struct {
+	Nodes []findUserByEmailUsersUserConnectionNodesUser `json:"nodes"`
+}
+
+// GetNodes returns findUserByEmailUsersUserConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findUserByEmailUsersUserConnection) GetNodes() []findUserByEmailUsersUserConnectionNodesUser {
+	return v.Nodes
+}
+
+// findUserByEmailUsersUserConnectionNodesUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type findUserByEmailUsersUserConnectionNodesUser struct {
+	User `json:"-"`
+}
+
+// GetId returns findUserByEmailUsersUserConnectionNodesUser.Id, and is useful for accessing the field via an interface.
+func (v *findUserByEmailUsersUserConnectionNodesUser) GetId() string { return v.User.Id }
+
+// GetName returns findUserByEmailUsersUserConnectionNodesUser.Name, and is useful for accessing the field via an interface.
+func (v *findUserByEmailUsersUserConnectionNodesUser) GetName() string { return v.User.Name }
+
+// GetDisplayName returns findUserByEmailUsersUserConnectionNodesUser.DisplayName, and is useful for accessing the field via an interface.
+func (v *findUserByEmailUsersUserConnectionNodesUser) GetDisplayName() string {
+	return v.User.DisplayName
+}
+
+// GetEmail returns findUserByEmailUsersUserConnectionNodesUser.Email, and is useful for accessing the field via an interface.
+func (v *findUserByEmailUsersUserConnectionNodesUser) GetEmail() string { return v.User.Email }
+
+// GetAdmin returns findUserByEmailUsersUserConnectionNodesUser.Admin, and is useful for accessing the field via an interface.
+func (v *findUserByEmailUsersUserConnectionNodesUser) GetAdmin() bool { return v.User.Admin }
+
+// GetGuest returns findUserByEmailUsersUserConnectionNodesUser.Guest, and is useful for accessing the field via an interface.
+func (v *findUserByEmailUsersUserConnectionNodesUser) GetGuest() bool { return v.User.Guest }
+
+// GetActive returns findUserByEmailUsersUserConnectionNodesUser.Active, and is useful for accessing the field via an interface.
+func (v *findUserByEmailUsersUserConnectionNodesUser) GetActive() bool { return v.User.Active }
+
+func (v *findUserByEmailUsersUserConnectionNodesUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*findUserByEmailUsersUserConnectionNodesUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.findUserByEmailUsersUserConnectionNodesUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.User)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalfindUserByEmailUsersUserConnectionNodesUser struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	DisplayName string `json:"displayName"`
+
+	Email string `json:"email"`
+
+	Admin bool `json:"admin"`
+
+	Guest bool `json:"guest"`
+
+	Active bool `json:"active"`
+}
+
+func (v *findUserByEmailUsersUserConnectionNodesUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *findUserByEmailUsersUserConnectionNodesUser) __premarshalJSON() (*__premarshalfindUserByEmailUsersUserConnectionNodesUser, error) {
+	var retval __premarshalfindUserByEmailUsersUserConnectionNodesUser
+
+	retval.Id = v.User.Id
+	retval.Name = v.User.Name
+	retval.DisplayName = v.User.DisplayName
+	retval.Email = v.User.Email
+	retval.Admin = v.User.Admin
+	retval.Guest = v.User.Guest
+	retval.Active = v.User.Active
+	return &retval, nil
+}
+
+// findWorkflowStateByTeamIdResponse is returned by findWorkflowStateByTeamId on success.
+type findWorkflowStateByTeamIdResponse struct {
+	// All issue workflow states.
+	WorkflowStates findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnection `json:"workflowStates"`
+}
+
+// GetWorkflowStates returns findWorkflowStateByTeamIdResponse.WorkflowStates, and is useful for accessing the field via an interface.
+func (v *findWorkflowStateByTeamIdResponse) GetWorkflowStates() findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnection {
+	return v.WorkflowStates
+}
+
+// findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnection includes the requested fields of the GraphQL type WorkflowStateConnection.
+type findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnection struct {
+	Nodes []findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnectionNodesWorkflowState `json:"nodes"`
+}
+
+// GetNodes returns findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnection) GetNodes() []findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
+	return v.Nodes
+}
+
+// findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnectionNodesWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
+	Type string `json:"type"`
+}
+
+// GetId returns findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetId() string {
+	return v.Id
+}
+
+// GetType returns findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *findWorkflowStateByTeamIdWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetType() string {
+	return v.Type
+}
+
+// findWorkflowStateResponse is returned by findWorkflowState on success.
+type findWorkflowStateResponse struct {
+	// All issue workflow states.
+	WorkflowStates findWorkflowStateWorkflowStatesWorkflowStateConnection `json:"workflowStates"`
+}
+
+// GetWorkflowStates returns findWorkflowStateResponse.WorkflowStates, and is useful for accessing the field via an interface.
+func (v *findWorkflowStateResponse) GetWorkflowStates() findWorkflowStateWorkflowStatesWorkflowStateConnection {
+	return v.WorkflowStates
+}
+
+// findWorkflowStateWorkflowStatesWorkflowStateConnection includes the requested fields of the GraphQL type WorkflowStateConnection.
+type findWorkflowStateWorkflowStatesWorkflowStateConnection struct {
+	Nodes []findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState `json:"nodes"`
+}
+
+// GetNodes returns findWorkflowStateWorkflowStatesWorkflowStateConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findWorkflowStateWorkflowStatesWorkflowStateConnection) GetNodes() []findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
+	return v.Nodes
+}
+
+// findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *findWorkflowStateWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetId() string {
+	return v.Id
+}
+
+// findWorkspaceLabelIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type findWorkspaceLabelIssueLabelsIssueLabelConnection struct {
+	Nodes []findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+}
+
+// GetNodes returns findWorkspaceLabelIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *findWorkspaceLabelIssueLabelsIssueLabelConnection) GetNodes() []findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
+}
+
+// findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team that the label is associated with. If null, the label is associated with the global workspace.
+	Team findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam `json:"team"`
+}
+
+// GetId returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string {
+	return v.Id
+}
+
+// GetTeam returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabel) GetTeam() findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam {
+	return v.Team
+}
+
+// findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam.Id, and is useful for accessing the field via an interface.
+func (v *findWorkspaceLabelIssueLabelsIssueLabelConnectionNodesIssueLabelTeam) GetId() string {
+	return v.Id
+}
+
+// findWorkspaceLabelResponse is returned by findWorkspaceLabel on success.
+type findWorkspaceLabelResponse struct {
+	// All issue labels.
+	IssueLabels findWorkspaceLabelIssueLabelsIssueLabelConnection `json:"issueLabels"`
+}
+
+// GetIssueLabels returns findWorkspaceLabelResponse.IssueLabels, and is useful for accessing the field via an interface.
+func (v *findWorkspaceLabelResponse) GetIssueLabels() findWorkspaceLabelIssueLabelsIssueLabelConnection {
+	return v.IssueLabels
+}
+
+// getCustomViewCustomView includes the requested fields of the GraphQL type CustomView.
+// The GraphQL type's documentation follows.
+//
+// A custom view that has been saved by a user.
+type getCustomViewCustomView struct {
+	CustomView `json:"-"`
+}
+
+// GetId returns getCustomViewCustomView.Id, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetId() string { return v.CustomView.Id }
+
+// GetName returns getCustomViewCustomView.Name, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetName() string { return v.CustomView.Name }
+
+// GetDescription returns getCustomViewCustomView.Description, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetDescription() *string { return v.CustomView.Description }
+
+// GetIcon returns getCustomViewCustomView.Icon, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetIcon() *string { return v.CustomView.Icon }
+
+// GetColor returns getCustomViewCustomView.Color, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetColor() *string { return v.CustomView.Color }
+
+// GetShared returns getCustomViewCustomView.Shared, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetShared() bool { return v.CustomView.Shared }
+
+// GetFilterData returns getCustomViewCustomView.FilterData, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetFilterData() map[string]interface{} {
+	return v.CustomView.FilterData
+}
+
+// GetTeam returns getCustomViewCustomView.Team, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetTeam() *CustomViewTeam { return v.CustomView.Team }
+
+// GetOwner returns getCustomViewCustomView.Owner, and is useful for accessing the field via an interface.
+func (v *getCustomViewCustomView) GetOwner() CustomViewOwnerUser { return v.CustomView.Owner }
+
+func (v *getCustomViewCustomView) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getCustomViewCustomView
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCustomViewCustomView = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomView)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetCustomViewCustomView struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Shared bool `json:"shared"`
+
+	FilterData map[string]interface{} `json:"filterData"`
+
+	Team *CustomViewTeam `json:"team"`
+
+	Owner CustomViewOwnerUser `json:"owner"`
+}
+
+func (v *getCustomViewCustomView) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getCustomViewCustomView) __premarshalJSON() (*__premarshalgetCustomViewCustomView, error) {
+	var retval __premarshalgetCustomViewCustomView
+
+	retval.Id = v.CustomView.Id
+	retval.Name = v.CustomView.Name
+	retval.Description = v.CustomView.Description
+	retval.Icon = v.CustomView.Icon
+	retval.Color = v.CustomView.Color
+	retval.Shared = v.CustomView.Shared
+	retval.FilterData = v.CustomView.FilterData
+	retval.Team = v.CustomView.Team
+	retval.Owner = v.CustomView.Owner
+	return &retval, nil
+}
+
+// getCustomViewResponse is returned by getCustomView on success.
+type getCustomViewResponse struct {
+	// One specific custom view.
+	CustomView getCustomViewCustomView `json:"customView"`
+}
+
+// GetCustomView returns getCustomViewResponse.CustomView, and is useful for accessing the field via an interface.
+func (v *getCustomViewResponse) GetCustomView() getCustomViewCustomView { return v.CustomView }
+
+// getCustomerCustomer includes the requested fields of the GraphQL type Customer.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer whose needs will be tied to issues or projects.
+type getCustomerCustomer struct {
+	Customer `json:"-"`
+}
+
+// GetId returns getCustomerCustomer.Id, and is useful for accessing the field via an interface.
+func (v *getCustomerCustomer) GetId() string { return v.Customer.Id }
+
+// GetName returns getCustomerCustomer.Name, and is useful for accessing the field via an interface.
+func (v *getCustomerCustomer) GetName() string { return v.Customer.Name }
+
+// GetDomains returns getCustomerCustomer.Domains, and is useful for accessing the field via an interface.
+func (v *getCustomerCustomer) GetDomains() []string { return v.Customer.Domains }
+
+// GetExternalIds returns getCustomerCustomer.ExternalIds, and is useful for accessing the field via an interface.
+func (v *getCustomerCustomer) GetExternalIds() []string { return v.Customer.ExternalIds }
+
+// GetSlackChannelId returns getCustomerCustomer.SlackChannelId, and is useful for accessing the field via an interface.
+func (v *getCustomerCustomer) GetSlackChannelId() *string { return v.Customer.SlackChannelId }
+
+// GetOwner returns getCustomerCustomer.Owner, and is useful for accessing the field via an interface.
+func (v *getCustomerCustomer) GetOwner() CustomerOwnerUser { return v.Customer.Owner }
+
+// GetStatus returns getCustomerCustomer.Status, and is useful for accessing the field via an interface.
+func (v *getCustomerCustomer) GetStatus() CustomerStatusRef { return v.Customer.Status }
+
+func (v *getCustomerCustomer) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getCustomerCustomer
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCustomerCustomer = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Customer)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetCustomerCustomer struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Domains []string `json:"domains"`
+
+	ExternalIds []string `json:"externalIds"`
+
+	SlackChannelId *string `json:"slackChannelId"`
+
+	Owner CustomerOwnerUser `json:"owner"`
+
+	Status CustomerStatusRef `json:"status"`
+}
+
+func (v *getCustomerCustomer) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getCustomerCustomer) __premarshalJSON() (*__premarshalgetCustomerCustomer, error) {
+	var retval __premarshalgetCustomerCustomer
+
+	retval.Id = v.Customer.Id
+	retval.Name = v.Customer.Name
+	retval.Domains = v.Customer.Domains
+	retval.ExternalIds = v.Customer.ExternalIds
+	retval.SlackChannelId = v.Customer.SlackChannelId
+	retval.Owner = v.Customer.Owner
+	retval.Status = v.Customer.Status
+	return &retval, nil
+}
+
+// getCustomerNeedCustomerNeed includes the requested fields of the GraphQL type CustomerNeed.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer need, expressed through a reference to an issue, project, or comment.
+type getCustomerNeedCustomerNeed struct {
+	CustomerNeed `json:"-"`
+}
+
+// GetId returns getCustomerNeedCustomerNeed.Id, and is useful for accessing the field via an interface.
+func (v *getCustomerNeedCustomerNeed) GetId() string { return v.CustomerNeed.Id }
+
+// GetPriority returns getCustomerNeedCustomerNeed.Priority, and is useful for accessing the field via an interface.
+func (v *getCustomerNeedCustomerNeed) GetPriority() float64 { return v.CustomerNeed.Priority }
+
+// GetCustomer returns getCustomerNeedCustomerNeed.Customer, and is useful for accessing the field via an interface.
+func (v *getCustomerNeedCustomerNeed) GetCustomer() CustomerNeedCustomer {
+	return v.CustomerNeed.Customer
+}
+
+// GetIssue returns getCustomerNeedCustomerNeed.Issue, and is useful for accessing the field via an interface.
+func (v *getCustomerNeedCustomerNeed) GetIssue() *CustomerNeedIssue { return v.CustomerNeed.Issue }
+
+// GetProject returns getCustomerNeedCustomerNeed.Project, and is useful for accessing the field via an interface.
+func (v *getCustomerNeedCustomerNeed) GetProject() *CustomerNeedProject {
+	return v.CustomerNeed.Project
+}
+
+// GetComment returns getCustomerNeedCustomerNeed.Comment, and is useful for accessing the field via an interface.
+func (v *getCustomerNeedCustomerNeed) GetComment() *CustomerNeedComment {
+	return v.CustomerNeed.Comment
+}
+
+func (v *getCustomerNeedCustomerNeed) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getCustomerNeedCustomerNeed
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCustomerNeedCustomerNeed = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomerNeed)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetCustomerNeedCustomerNeed struct {
+	Id string `json:"id"`
+
+	Priority float64 `json:"priority"`
+
+	Customer CustomerNeedCustomer `json:"customer"`
+
+	Issue *CustomerNeedIssue `json:"issue"`
+
+	Project *CustomerNeedProject `json:"project"`
+
+	Comment *CustomerNeedComment `json:"comment"`
+}
+
+func (v *getCustomerNeedCustomerNeed) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getCustomerNeedCustomerNeed) __premarshalJSON() (*__premarshalgetCustomerNeedCustomerNeed, error) {
+	var retval __premarshalgetCustomerNeedCustomerNeed
+
+	retval.Id = v.CustomerNeed.Id
+	retval.Priority = v.CustomerNeed.Priority
+	retval.Customer = v.CustomerNeed.Customer
+	retval.Issue = v.CustomerNeed.Issue
+	retval.Project = v.CustomerNeed.Project
+	retval.Comment = v.CustomerNeed.Comment
+	return &retval, nil
+}
+
+// getCustomerNeedResponse is returned by getCustomerNeed on success.
+type getCustomerNeedResponse struct {
+	// One specific customer need
+	CustomerNeed getCustomerNeedCustomerNeed `json:"customerNeed"`
+}
+
+// GetCustomerNeed returns getCustomerNeedResponse.CustomerNeed, and is useful for accessing the field via an interface.
+func (v *getCustomerNeedResponse) GetCustomerNeed() getCustomerNeedCustomerNeed {
+	return v.CustomerNeed
+}
+
+// getCustomerResponse is returned by getCustomer on success.
+type getCustomerResponse struct {
+	// One specific customer.
+	Customer getCustomerCustomer `json:"customer"`
+}
+
+// GetCustomer returns getCustomerResponse.Customer, and is useful for accessing the field via an interface.
+func (v *getCustomerResponse) GetCustomer() getCustomerCustomer { return v.Customer }
+
+// getCustomerStatusCustomerStatus includes the requested fields of the GraphQL type CustomerStatus.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer status.
+type getCustomerStatusCustomerStatus struct {
+	CustomerStatus `json:"-"`
+}
+
+// GetId returns getCustomerStatusCustomerStatus.Id, and is useful for accessing the field via an interface.
+func (v *getCustomerStatusCustomerStatus) GetId() string { return v.CustomerStatus.Id }
+
+// GetName returns getCustomerStatusCustomerStatus.Name, and is useful for accessing the field via an interface.
+func (v *getCustomerStatusCustomerStatus) GetName() string { return v.CustomerStatus.Name }
+
+// GetColor returns getCustomerStatusCustomerStatus.Color, and is useful for accessing the field via an interface.
+func (v *getCustomerStatusCustomerStatus) GetColor() string { return v.CustomerStatus.Color }
+
+// GetDescription returns getCustomerStatusCustomerStatus.Description, and is useful for accessing the field via an interface.
+func (v *getCustomerStatusCustomerStatus) GetDescription() *string {
+	return v.CustomerStatus.Description
+}
+
+// GetType returns getCustomerStatusCustomerStatus.Type, and is useful for accessing the field via an interface.
+func (v *getCustomerStatusCustomerStatus) GetType() CustomerStatusType { return v.CustomerStatus.Type }
+
+// GetPosition returns getCustomerStatusCustomerStatus.Position, and is useful for accessing the field via an interface.
+func (v *getCustomerStatusCustomerStatus) GetPosition() float64 { return v.CustomerStatus.Position }
+
+func (v *getCustomerStatusCustomerStatus) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getCustomerStatusCustomerStatus
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCustomerStatusCustomerStatus = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomerStatus)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetCustomerStatusCustomerStatus struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type CustomerStatusType `json:"type"`
+
+	Position float64 `json:"position"`
+}
+
+func (v *getCustomerStatusCustomerStatus) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getCustomerStatusCustomerStatus) __premarshalJSON() (*__premarshalgetCustomerStatusCustomerStatus, error) {
+	var retval __premarshalgetCustomerStatusCustomerStatus
+
+	retval.Id = v.CustomerStatus.Id
+	retval.Name = v.CustomerStatus.Name
+	retval.Color = v.CustomerStatus.Color
+	retval.Description = v.CustomerStatus.Description
+	retval.Type = v.CustomerStatus.Type
+	retval.Position = v.CustomerStatus.Position
+	return &retval, nil
+}
+
+// getCustomerStatusResponse is returned by getCustomerStatus on success.
+type getCustomerStatusResponse struct {
+	// One specific customer status.
+	CustomerStatus getCustomerStatusCustomerStatus `json:"customerStatus"`
+}
+
+// GetCustomerStatus returns getCustomerStatusResponse.CustomerStatus, and is useful for accessing the field via an interface.
+func (v *getCustomerStatusResponse) GetCustomerStatus() getCustomerStatusCustomerStatus {
+	return v.CustomerStatus
+}
+
+// getEmojiEmoji includes the requested fields of the GraphQL type Emoji.
+// The GraphQL type's documentation follows.
+//
+// A custom emoji.
+type getEmojiEmoji struct {
+	Emoji `json:"-"`
+}
+
+// GetId returns getEmojiEmoji.Id, and is useful for accessing the field via an interface.
+func (v *getEmojiEmoji) GetId() string { return v.Emoji.Id }
+
+// GetName returns getEmojiEmoji.Name, and is useful for accessing the field via an interface.
+func (v *getEmojiEmoji) GetName() string { return v.Emoji.Name }
+
+// GetUrl returns getEmojiEmoji.Url, and is useful for accessing the field via an interface.
+func (v *getEmojiEmoji) GetUrl() string { return v.Emoji.Url }
+
+// GetSource returns getEmojiEmoji.Source, and is useful for accessing the field via an interface.
+func (v *getEmojiEmoji) GetSource() string { return v.Emoji.Source }
+
+func (v *getEmojiEmoji) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getEmojiEmoji
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getEmojiEmoji = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Emoji)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetEmojiEmoji struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Url string `json:"url"`
+
+	Source string `json:"source"`
+}
+
+func (v *getEmojiEmoji) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getEmojiEmoji) __premarshalJSON() (*__premarshalgetEmojiEmoji, error) {
+	var retval __premarshalgetEmojiEmoji
+
+	retval.Id = v.Emoji.Id
+	retval.Name = v.Emoji.Name
+	retval.Url = v.Emoji.Url
+	retval.Source = v.Emoji.Source
+	return &retval, nil
+}
+
+// getEmojiResponse is returned by getEmoji on success.
+type getEmojiResponse struct {
+	// A specific emoji.
+	Emoji getEmojiEmoji `json:"emoji"`
+}
+
+// GetEmoji returns getEmojiResponse.Emoji, and is useful for accessing the field via an interface.
+func (v *getEmojiResponse) GetEmoji() getEmojiEmoji { return v.Emoji }
+
+// getInitiativeToProjectInitiativeToProject includes the requested fields of the GraphQL type InitiativeToProject.
+// The GraphQL type's documentation follows.
+//
+// Join table between projects and initiatives.
+type getInitiativeToProjectInitiativeToProject struct {
+	InitiativeToProject `json:"-"`
+}
+
+// GetId returns getInitiativeToProjectInitiativeToProject.Id, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectInitiativeToProject) GetId() string { return v.InitiativeToProject.Id }
+
+// GetSortOrder returns getInitiativeToProjectInitiativeToProject.SortOrder, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectInitiativeToProject) GetSortOrder() string {
+	return v.InitiativeToProject.SortOrder
+}
+
+// GetInitiative returns getInitiativeToProjectInitiativeToProject.Initiative, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectInitiativeToProject) GetInitiative() InitiativeToProjectInitiative {
+	return v.InitiativeToProject.Initiative
+}
+
+// GetProject returns getInitiativeToProjectInitiativeToProject.Project, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectInitiativeToProject) GetProject() InitiativeToProjectProject {
+	return v.InitiativeToProject.Project
+}
+
+func (v *getInitiativeToProjectInitiativeToProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInitiativeToProjectInitiativeToProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInitiativeToProjectInitiativeToProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.InitiativeToProject)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetInitiativeToProjectInitiativeToProject struct {
+	Id string `json:"id"`
+
+	SortOrder string `json:"sortOrder"`
+
+	Initiative InitiativeToProjectInitiative `json:"initiative"`
+
+	Project InitiativeToProjectProject `json:"project"`
+}
+
+func (v *getInitiativeToProjectInitiativeToProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInitiativeToProjectInitiativeToProject) __premarshalJSON() (*__premarshalgetInitiativeToProjectInitiativeToProject, error) {
+	var retval __premarshalgetInitiativeToProjectInitiativeToProject
+
+	retval.Id = v.InitiativeToProject.Id
+	retval.SortOrder = v.InitiativeToProject.SortOrder
+	retval.Initiative = v.InitiativeToProject.Initiative
+	retval.Project = v.InitiativeToProject.Project
+	return &retval, nil
+}
+
+// getInitiativeToProjectResponse is returned by getInitiativeToProject on success.
+type getInitiativeToProjectResponse struct {
+	// One specific initiativeToProject.
+	InitiativeToProject getInitiativeToProjectInitiativeToProject `json:"initiativeToProject"`
+}
+
+// GetInitiativeToProject returns getInitiativeToProjectResponse.InitiativeToProject, and is useful for accessing the field via an interface.
+func (v *getInitiativeToProjectResponse) GetInitiativeToProject() getInitiativeToProjectInitiativeToProject {
+	return v.InitiativeToProject
+}
+
+// getIssueIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type getIssueIssue struct {
+	Issue `json:"-"`
+}
+
+// GetId returns getIssueIssue.Id, and is useful for accessing the field via an interface.
+func (v *getIssueIssue) GetId() string { return v.Issue.Id }
+
+// GetIdentifier returns getIssueIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *getIssueIssue) GetIdentifier() string { return v.Issue.Identifier }
+
+// GetTitle returns getIssueIssue.Title, and is useful for accessing the field via an interface.
+func (v *getIssueIssue) GetTitle() string { return v.Issue.Title }
+
+// GetDescription returns getIssueIssue.Description, and is useful for accessing the field via an interface.
+func (v *getIssueIssue) GetDescription() *string { return v.Issue.Description }
+
+// GetPriority returns getIssueIssue.Priority, and is useful for accessing the field via an interface.
+func (v *getIssueIssue) GetPriority() float64 { return v.Issue.Priority }
+
+// GetTeam returns getIssueIssue.Team, and is useful for accessing the field via an interface.
+func (v *getIssueIssue) GetTeam() IssueTeam { return v.Issue.Team }
+
+// GetState returns getIssueIssue.State, and is useful for accessing the field via an interface.
+func (v *getIssueIssue) GetState() IssueStateWorkflowState { return v.Issue.State }
+
+func (v *getIssueIssue) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getIssueIssue
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getIssueIssue = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Issue)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetIssueIssue struct {
+	Id string `json:"id"`
+
+	Identifier string `json:"identifier"`
+
+	Title string `json:"title"`
+
+	Description *string `json:"description"`
+
+	Priority float64 `json:"priority"`
+
+	Team IssueTeam `json:"team"`
+
+	State IssueStateWorkflowState `json:"state"`
+}
+
+func (v *getIssueIssue) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getIssueIssue) __premarshalJSON() (*__premarshalgetIssueIssue, error) {
+	var retval __premarshalgetIssueIssue
+
+	retval.Id = v.Issue.Id
+	retval.Identifier = v.Issue.Identifier
+	retval.Title = v.Issue.Title
+	retval.Description = v.Issue.Description
+	retval.Priority = v.Issue.Priority
+	retval.Team = v.Issue.Team
+	retval.State = v.Issue.State
+	return &retval, nil
+}
+
+// getIssueRelationIssueRelation includes the requested fields of the GraphQL type IssueRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation between two issues.
+type getIssueRelationIssueRelation struct {
+	IssueRelation `json:"-"`
+}
+
+// GetId returns getIssueRelationIssueRelation.Id, and is useful for accessing the field via an interface.
+func (v *getIssueRelationIssueRelation) GetId() string { return v.IssueRelation.Id }
+
+// GetType returns getIssueRelationIssueRelation.Type, and is useful for accessing the field via an interface.
+func (v *getIssueRelationIssueRelation) GetType() string { return v.IssueRelation.Type }
+
+// GetIssue returns getIssueRelationIssueRelation.Issue, and is useful for accessing the field via an interface.
+func (v *getIssueRelationIssueRelation) GetIssue() IssueRelationIssue { return v.IssueRelation.Issue }
+
+// GetRelatedIssue returns getIssueRelationIssueRelation.RelatedIssue, and is useful for accessing the field via an interface.
+func (v *getIssueRelationIssueRelation) GetRelatedIssue() IssueRelationRelatedIssue {
+	return v.IssueRelation.RelatedIssue
+}
+
+func (v *getIssueRelationIssueRelation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getIssueRelationIssueRelation
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getIssueRelationIssueRelation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueRelation)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetIssueRelationIssueRelation struct {
+	Id string `json:"id"`
+
+	Type string `json:"type"`
+
+	Issue IssueRelationIssue `json:"issue"`
+
+	RelatedIssue IssueRelationRelatedIssue `json:"relatedIssue"`
+}
+
+func (v *getIssueRelationIssueRelation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getIssueRelationIssueRelation) __premarshalJSON() (*__premarshalgetIssueRelationIssueRelation, error) {
+	var retval __premarshalgetIssueRelationIssueRelation
+
+	retval.Id = v.IssueRelation.Id
+	retval.Type = v.IssueRelation.Type
+	retval.Issue = v.IssueRelation.Issue
+	retval.RelatedIssue = v.IssueRelation.RelatedIssue
+	return &retval, nil
+}
+
+// getIssueRelationResponse is returned by getIssueRelation on success.
+type getIssueRelationResponse struct {
+	// One specific issue relation.
+	IssueRelation getIssueRelationIssueRelation `json:"issueRelation"`
+}
+
+// GetIssueRelation returns getIssueRelationResponse.IssueRelation, and is useful for accessing the field via an interface.
+func (v *getIssueRelationResponse) GetIssueRelation() getIssueRelationIssueRelation {
+	return v.IssueRelation
+}
+
+// getIssueResponse is returned by getIssue on success.
+type getIssueResponse struct {
+	// One specific issue.
+	Issue getIssueIssue `json:"issue"`
+}
+
+// GetIssue returns getIssueResponse.Issue, and is useful for accessing the field via an interface.
+func (v *getIssueResponse) GetIssue() getIssueIssue { return v.Issue }
+
+// getIssueWorkflowStateResponse is returned by getIssueWorkflowState on success.
+type getIssueWorkflowStateResponse struct {
+	// One specific state.
+	WorkflowState getIssueWorkflowStateWorkflowState `json:"workflowState"`
+	// One specific team.
+	Team getIssueWorkflowStateTeam `json:"team"`
+}
+
+// GetWorkflowState returns getIssueWorkflowStateResponse.WorkflowState, and is useful for accessing the field via an interface.
+func (v *getIssueWorkflowStateResponse) GetWorkflowState() getIssueWorkflowStateWorkflowState {
+	return v.WorkflowState
+}
+
+// GetTeam returns getIssueWorkflowStateResponse.Team, and is useful for accessing the field via an interface.
+func (v *getIssueWorkflowStateResponse) GetTeam() getIssueWorkflowStateTeam { return v.Team }
+
+// getIssueWorkflowStateTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type getIssueWorkflowStateTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team's name.
+	Name string `json:"name"`
+}
+
+// GetId returns getIssueWorkflowStateTeam.Id, and is useful for accessing the field via an interface.
+func (v *getIssueWorkflowStateTeam) GetId() string { return v.Id }
+
+// GetName returns getIssueWorkflowStateTeam.Name, and is useful for accessing the field via an interface.
+func (v *getIssueWorkflowStateTeam) GetName() string { return v.Name }
+
+// getIssueWorkflowStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type getIssueWorkflowStateWorkflowState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The state's name.
+	Name string `json:"name"`
+	// The team to which this state belongs to.
+	Team getIssueWorkflowStateWorkflowStateTeam `json:"team"`
+}
+
+// GetId returns getIssueWorkflowStateWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *getIssueWorkflowStateWorkflowState) GetId() string { return v.Id }
+
+// GetName returns getIssueWorkflowStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *getIssueWorkflowStateWorkflowState) GetName() string { return v.Name }
+
+// GetTeam returns getIssueWorkflowStateWorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *getIssueWorkflowStateWorkflowState) GetTeam() getIssueWorkflowStateWorkflowStateTeam {
+	return v.Team
+}
+
+// getIssueWorkflowStateWorkflowStateTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type getIssueWorkflowStateWorkflowStateTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team's name.
+	Name string `json:"name"`
+}
+
+// GetId returns getIssueWorkflowStateWorkflowStateTeam.Id, and is useful for accessing the field via an interface.
+func (v *getIssueWorkflowStateWorkflowStateTeam) GetId() string { return v.Id }
+
+// GetName returns getIssueWorkflowStateWorkflowStateTeam.Name, and is useful for accessing the field via an interface.
+func (v *getIssueWorkflowStateWorkflowStateTeam) GetName() string { return v.Name }
+
+// getLabelIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type getLabelIssueLabel struct {
+	IssueLabel `json:"-"`
+}
+
+// GetId returns getLabelIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetId() string { return v.IssueLabel.Id }
+
+// GetName returns getLabelIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetName() string { return v.IssueLabel.Name }
+
+// GetDescription returns getLabelIssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetDescription() *string { return v.IssueLabel.Description }
+
+// GetColor returns getLabelIssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetColor() *string { return v.IssueLabel.Color }
+
+// GetParent returns getLabelIssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetParent() *IssueLabelParentIssueLabel { return v.IssueLabel.Parent }
+
+// GetTeam returns getLabelIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *getLabelIssueLabel) GetTeam() *IssueLabelTeam { return v.IssueLabel.Team }
+
+func (v *getLabelIssueLabel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getLabelIssueLabel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getLabelIssueLabel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueLabel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetLabelIssueLabel struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+
+	Team *IssueLabelTeam `json:"team"`
+}
+
+func (v *getLabelIssueLabel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getLabelIssueLabel) __premarshalJSON() (*__premarshalgetLabelIssueLabel, error) {
+	var retval __premarshalgetLabelIssueLabel
+
+	retval.Id = v.IssueLabel.Id
+	retval.Name = v.IssueLabel.Name
+	retval.Description = v.IssueLabel.Description
+	retval.Color = v.IssueLabel.Color
+	retval.Parent = v.IssueLabel.Parent
+	retval.Team = v.IssueLabel.Team
+	return &retval, nil
+}
+
+// getLabelResponse is returned by getLabel on success.
+type getLabelResponse struct {
+	// One specific label.
+	IssueLabel getLabelIssueLabel `json:"issueLabel"`
+}
+
+// GetIssueLabel returns getLabelResponse.IssueLabel, and is useful for accessing the field via an interface.
+func (v *getLabelResponse) GetIssueLabel() getLabelIssueLabel { return v.IssueLabel }
+
+// getProjectMembershipProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type getProjectMembershipProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Users that are members of the project.
+	Members getProjectMembershipProjectMembersUserConnection `json:"members"`
+}
+
+// GetId returns getProjectMembershipProject.Id, and is useful for accessing the field via an interface.
+func (v *getProjectMembershipProject) GetId() string { return v.Id }
+
+// GetMembers returns getProjectMembershipProject.Members, and is useful for accessing the field via an interface.
+func (v *getProjectMembershipProject) GetMembers() getProjectMembershipProjectMembersUserConnection {
+	return v.Members
+}
+
+// getProjectMembershipProjectMembersUserConnection includes the requested fields of the GraphQL type UserConnection.
+type getProjectMembershipProjectMembersUserConnection struct {
+	Nodes []getProjectMembershipProjectMembersUserConnectionNodesUser `json:"nodes"`
+}
+
+// GetNodes returns getProjectMembershipProjectMembersUserConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getProjectMembershipProjectMembersUserConnection) GetNodes() []getProjectMembershipProjectMembersUserConnectionNodesUser {
+	return v.Nodes
+}
+
+// getProjectMembershipProjectMembersUserConnectionNodesUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type getProjectMembershipProjectMembersUserConnectionNodesUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns getProjectMembershipProjectMembersUserConnectionNodesUser.Id, and is useful for accessing the field via an interface.
+func (v *getProjectMembershipProjectMembersUserConnectionNodesUser) GetId() string { return v.Id }
+
+// getProjectMembershipResponse is returned by getProjectMembership on success.
+type getProjectMembershipResponse struct {
+	// One specific project.
+	Project getProjectMembershipProject `json:"project"`
+}
+
+// GetProject returns getProjectMembershipResponse.Project, and is useful for accessing the field via an interface.
+func (v *getProjectMembershipResponse) GetProject() getProjectMembershipProject { return v.Project }
+
+// getProjectMilestoneIssuesProjectMilestone includes the requested fields of the GraphQL type ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type getProjectMilestoneIssuesProjectMilestone struct {
+	// Issues associated with the project milestone.
+	Issues getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnection `json:"issues"`
+}
+
+// GetIssues returns getProjectMilestoneIssuesProjectMilestone.Issues, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneIssuesProjectMilestone) GetIssues() getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnection {
+	return v.Issues
+}
+
+// getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnection includes the requested fields of the GraphQL type IssueConnection.
+type getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnection struct {
+	Nodes    []getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssue `json:"nodes"`
+	PageInfo getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionPageInfo     `json:"pageInfo"`
+}
+
+// GetNodes returns getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnection) GetNodes() []getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssue {
+	return v.Nodes
+}
+
+// GetPageInfo returns getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnection) GetPageInfo() getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssue struct {
+	// The workflow state that the issue is associated with.
+	State getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssueStateWorkflowState `json:"state"`
+}
+
+// GetState returns getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssue.State, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssue) GetState() getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssueStateWorkflowState {
+	return v.State
+}
+
+// getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssueStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssueStateWorkflowState struct {
+	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
+	Type string `json:"type"`
+}
+
+// GetType returns getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssueStateWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionNodesIssueStateWorkflowState) GetType() string {
+	return v.Type
+}
+
+// getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneIssuesProjectMilestoneIssuesIssueConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// getProjectMilestoneIssuesResponse is returned by getProjectMilestoneIssues on success.
+type getProjectMilestoneIssuesResponse struct {
+	// One specific project milestone.
+	ProjectMilestone getProjectMilestoneIssuesProjectMilestone `json:"projectMilestone"`
+}
+
+// GetProjectMilestone returns getProjectMilestoneIssuesResponse.ProjectMilestone, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneIssuesResponse) GetProjectMilestone() getProjectMilestoneIssuesProjectMilestone {
+	return v.ProjectMilestone
+}
+
+// getProjectMilestoneProjectMilestone includes the requested fields of the GraphQL type ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type getProjectMilestoneProjectMilestone struct {
+	ProjectMilestone `json:"-"`
+}
+
+// GetId returns getProjectMilestoneProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneProjectMilestone) GetId() string { return v.ProjectMilestone.Id }
+
+// GetName returns getProjectMilestoneProjectMilestone.Name, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneProjectMilestone) GetName() string { return v.ProjectMilestone.Name }
+
+// GetDescription returns getProjectMilestoneProjectMilestone.Description, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneProjectMilestone) GetDescription() *string {
+	return v.ProjectMilestone.Description
+}
+
+// GetTargetDate returns getProjectMilestoneProjectMilestone.TargetDate, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneProjectMilestone) GetTargetDate() *string {
+	return v.ProjectMilestone.TargetDate
+}
+
+// GetProject returns getProjectMilestoneProjectMilestone.Project, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneProjectMilestone) GetProject() ProjectMilestoneProject {
+	return v.ProjectMilestone.Project
+}
+
+func (v *getProjectMilestoneProjectMilestone) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectMilestoneProjectMilestone
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectMilestoneProjectMilestone = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectMilestone)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectMilestoneProjectMilestone struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Project ProjectMilestoneProject `json:"project"`
+}
+
+func (v *getProjectMilestoneProjectMilestone) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectMilestoneProjectMilestone) __premarshalJSON() (*__premarshalgetProjectMilestoneProjectMilestone, error) {
+	var retval __premarshalgetProjectMilestoneProjectMilestone
+
+	retval.Id = v.ProjectMilestone.Id
+	retval.Name = v.ProjectMilestone.Name
+	retval.Description = v.ProjectMilestone.Description
+	retval.TargetDate = v.ProjectMilestone.TargetDate
+	retval.Project = v.ProjectMilestone.Project
+	return &retval, nil
+}
+
+// getProjectMilestoneResponse is returned by getProjectMilestone on success.
+type getProjectMilestoneResponse struct {
+	// One specific project milestone.
+	ProjectMilestone getProjectMilestoneProjectMilestone `json:"projectMilestone"`
+}
+
+// GetProjectMilestone returns getProjectMilestoneResponse.ProjectMilestone, and is useful for accessing the field via an interface.
+func (v *getProjectMilestoneResponse) GetProjectMilestone() getProjectMilestoneProjectMilestone {
+	return v.ProjectMilestone
+}
+
+// getProjectProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type getProjectProject struct {
+	Project `json:"-"`
+}
+
+// GetId returns getProjectProject.Id, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetId() string { return v.Project.Id }
+
+// GetName returns getProjectProject.Name, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetName() string { return v.Project.Name }
+
+// GetDescription returns getProjectProject.Description, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetDescription() string { return v.Project.Description }
+
+// GetIcon returns getProjectProject.Icon, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetIcon() *string { return v.Project.Icon }
+
+// GetColor returns getProjectProject.Color, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetColor() string { return v.Project.Color }
+
+// GetPriority returns getProjectProject.Priority, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetPriority() int { return v.Project.Priority }
+
+// GetStartDate returns getProjectProject.StartDate, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetStartDate() *string { return v.Project.StartDate }
+
+// GetTargetDate returns getProjectProject.TargetDate, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetTargetDate() *string { return v.Project.TargetDate }
+
+// GetStatus returns getProjectProject.Status, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetStatus() ProjectStatus { return v.Project.Status }
+
+// GetLead returns getProjectProject.Lead, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetLead() *ProjectLeadUser { return v.Project.Lead }
+
+// GetTeams returns getProjectProject.Teams, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetTeams() ProjectTeamsTeamConnection { return v.Project.Teams }
+
+func (v *getProjectProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Project)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color string `json:"color"`
+
+	Priority int `json:"priority"`
+
+	StartDate *string `json:"startDate"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Status ProjectStatus `json:"status"`
+
+	Lead *ProjectLeadUser `json:"lead"`
+
+	Teams ProjectTeamsTeamConnection `json:"teams"`
+}
+
+func (v *getProjectProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectProject) __premarshalJSON() (*__premarshalgetProjectProject, error) {
+	var retval __premarshalgetProjectProject
+
+	retval.Id = v.Project.Id
+	retval.Name = v.Project.Name
+	retval.Description = v.Project.Description
+	retval.Icon = v.Project.Icon
+	retval.Color = v.Project.Color
+	retval.Priority = v.Project.Priority
+	retval.StartDate = v.Project.StartDate
+	retval.TargetDate = v.Project.TargetDate
+	retval.Status = v.Project.Status
+	retval.Lead = v.Project.Lead
+	retval.Teams = v.Project.Teams
+	return &retval, nil
+}
+
+// getProjectRelationProjectRelation includes the requested fields of the GraphQL type ProjectRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation between two projects.
+type getProjectRelationProjectRelation struct {
+	ProjectRelation `json:"-"`
+}
+
+// GetId returns getProjectRelationProjectRelation.Id, and is useful for accessing the field via an interface.
+func (v *getProjectRelationProjectRelation) GetId() string { return v.ProjectRelation.Id }
+
+// GetType returns getProjectRelationProjectRelation.Type, and is useful for accessing the field via an interface.
+func (v *getProjectRelationProjectRelation) GetType() string { return v.ProjectRelation.Type }
+
+// GetAnchorType returns getProjectRelationProjectRelation.AnchorType, and is useful for accessing the field via an interface.
+func (v *getProjectRelationProjectRelation) GetAnchorType() string {
+	return v.ProjectRelation.AnchorType
+}
+
+// GetRelatedAnchorType returns getProjectRelationProjectRelation.RelatedAnchorType, and is useful for accessing the field via an interface.
+func (v *getProjectRelationProjectRelation) GetRelatedAnchorType() string {
+	return v.ProjectRelation.RelatedAnchorType
+}
+
+// GetProject returns getProjectRelationProjectRelation.Project, and is useful for accessing the field via an interface.
+func (v *getProjectRelationProjectRelation) GetProject() ProjectRelationProject {
+	return v.ProjectRelation.Project
+}
+
+// GetProjectMilestone returns getProjectRelationProjectRelation.ProjectMilestone, and is useful for accessing the field via an interface.
+func (v *getProjectRelationProjectRelation) GetProjectMilestone() *ProjectRelationProjectMilestone {
+	return v.ProjectRelation.ProjectMilestone
+}
+
+// GetRelatedProject returns getProjectRelationProjectRelation.RelatedProject, and is useful for accessing the field via an interface.
+func (v *getProjectRelationProjectRelation) GetRelatedProject() ProjectRelationRelatedProject {
+	return v.ProjectRelation.RelatedProject
+}
+
+// GetRelatedProjectMilestone returns getProjectRelationProjectRelation.RelatedProjectMilestone, and is useful for accessing the field via an interface.
+func (v *getProjectRelationProjectRelation) GetRelatedProjectMilestone() *ProjectRelationRelatedProjectMilestone {
+	return v.ProjectRelation.RelatedProjectMilestone
+}
+
+func (v *getProjectRelationProjectRelation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectRelationProjectRelation
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectRelationProjectRelation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectRelation)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectRelationProjectRelation struct {
+	Id string `json:"id"`
+
+	Type string `json:"type"`
+
+	AnchorType string `json:"anchorType"`
+
+	RelatedAnchorType string `json:"relatedAnchorType"`
+
+	Project ProjectRelationProject `json:"project"`
+
+	ProjectMilestone *ProjectRelationProjectMilestone `json:"projectMilestone"`
+
+	RelatedProject ProjectRelationRelatedProject `json:"relatedProject"`
+
+	RelatedProjectMilestone *ProjectRelationRelatedProjectMilestone `json:"relatedProjectMilestone"`
+}
+
+func (v *getProjectRelationProjectRelation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectRelationProjectRelation) __premarshalJSON() (*__premarshalgetProjectRelationProjectRelation, error) {
+	var retval __premarshalgetProjectRelationProjectRelation
+
+	retval.Id = v.ProjectRelation.Id
+	retval.Type = v.ProjectRelation.Type
+	retval.AnchorType = v.ProjectRelation.AnchorType
+	retval.RelatedAnchorType = v.ProjectRelation.RelatedAnchorType
+	retval.Project = v.ProjectRelation.Project
+	retval.ProjectMilestone = v.ProjectRelation.ProjectMilestone
+	retval.RelatedProject = v.ProjectRelation.RelatedProject
+	retval.RelatedProjectMilestone = v.ProjectRelation.RelatedProjectMilestone
+	return &retval, nil
+}
+
+// getProjectRelationResponse is returned by getProjectRelation on success.
+type getProjectRelationResponse struct {
+	// One specific project relation.
+	ProjectRelation getProjectRelationProjectRelation `json:"projectRelation"`
+}
+
+// GetProjectRelation returns getProjectRelationResponse.ProjectRelation, and is useful for accessing the field via an interface.
+func (v *getProjectRelationResponse) GetProjectRelation() getProjectRelationProjectRelation {
+	return v.ProjectRelation
+}
+
+// getProjectResponse is returned by getProject on success.
+type getProjectResponse struct {
+	// One specific project.
+	Project getProjectProject `json:"project"`
+}
+
+// GetProject returns getProjectResponse.Project, and is useful for accessing the field via an interface.
+func (v *getProjectResponse) GetProject() getProjectProject { return v.Project }
+
+// getRoadmapResponse is returned by getRoadmap on success.
+type getRoadmapResponse struct {
+	// One specific roadmap.
+	Roadmap getRoadmapRoadmap `json:"roadmap"`
+}
+
+// GetRoadmap returns getRoadmapResponse.Roadmap, and is useful for accessing the field via an interface.
+func (v *getRoadmapResponse) GetRoadmap() getRoadmapRoadmap { return v.Roadmap }
+
+// getRoadmapRoadmap includes the requested fields of the GraphQL type Roadmap.
+// The GraphQL type's documentation follows.
+//
+// A roadmap for projects.
+type getRoadmapRoadmap struct {
+	Roadmap `json:"-"`
+}
+
+// GetId returns getRoadmapRoadmap.Id, and is useful for accessing the field via an interface.
+func (v *getRoadmapRoadmap) GetId() string { return v.Roadmap.Id }
+
+// GetName returns getRoadmapRoadmap.Name, and is useful for accessing the field via an interface.
+func (v *getRoadmapRoadmap) GetName() string { return v.Roadmap.Name }
+
+// GetDescription returns getRoadmapRoadmap.Description, and is useful for accessing the field via an interface.
+func (v *getRoadmapRoadmap) GetDescription() *string { return v.Roadmap.Description }
+
+// GetColor returns getRoadmapRoadmap.Color, and is useful for accessing the field via an interface.
+func (v *getRoadmapRoadmap) GetColor() *string { return v.Roadmap.Color }
+
+// GetOwner returns getRoadmapRoadmap.Owner, and is useful for accessing the field via an interface.
+func (v *getRoadmapRoadmap) GetOwner() RoadmapOwnerUser { return v.Roadmap.Owner }
+
+func (v *getRoadmapRoadmap) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRoadmapRoadmap
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRoadmapRoadmap = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Roadmap)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetRoadmapRoadmap struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Owner RoadmapOwnerUser `json:"owner"`
+}
+
+func (v *getRoadmapRoadmap) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRoadmapRoadmap) __premarshalJSON() (*__premarshalgetRoadmapRoadmap, error) {
+	var retval __premarshalgetRoadmapRoadmap
+
+	retval.Id = v.Roadmap.Id
+	retval.Name = v.Roadmap.Name
+	retval.Description = v.Roadmap.Description
+	retval.Color = v.Roadmap.Color
+	retval.Owner = v.Roadmap.Owner
+	return &retval, nil
+}
+
+// getTeamDefaultWorkflowStatesResponse is returned by getTeamDefaultWorkflowStates on success.
+type getTeamDefaultWorkflowStatesResponse struct {
+	// One specific team.
+	Team getTeamDefaultWorkflowStatesTeam `json:"team"`
+}
+
+// GetTeam returns getTeamDefaultWorkflowStatesResponse.Team, and is useful for accessing the field via an interface.
+func (v *getTeamDefaultWorkflowStatesResponse) GetTeam() getTeamDefaultWorkflowStatesTeam {
+	return v.Team
+}
+
+// getTeamDefaultWorkflowStatesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type getTeamDefaultWorkflowStatesTeam struct {
+	// The default workflow state into which issues are set when they are opened by team members.
+	DefaultIssueState *getTeamDefaultWorkflowStatesTeamDefaultIssueStateWorkflowState `json:"defaultIssueState"`
+	// The workflow state into which issues are moved when they are marked as a
+	// duplicate of another issue. Defaults to the first canceled state.
+	MarkedAsDuplicateWorkflowState *getTeamDefaultWorkflowStatesTeamMarkedAsDuplicateWorkflowState `json:"markedAsDuplicateWorkflowState"`
+	// The canceled workflow state which auto closed issues will be set to. Defaults to the first canceled state.
+	AutoCloseStateId *string `json:"autoCloseStateId"`
+}
+
+// GetDefaultIssueState returns getTeamDefaultWorkflowStatesTeam.DefaultIssueState, and is useful for accessing the field via an interface.
+func (v *getTeamDefaultWorkflowStatesTeam) GetDefaultIssueState() *getTeamDefaultWorkflowStatesTeamDefaultIssueStateWorkflowState {
+	return v.DefaultIssueState
+}
+
+// GetMarkedAsDuplicateWorkflowState returns getTeamDefaultWorkflowStatesTeam.MarkedAsDuplicateWorkflowState, and is useful for accessing the field via an interface.
+func (v *getTeamDefaultWorkflowStatesTeam) GetMarkedAsDuplicateWorkflowState() *getTeamDefaultWorkflowStatesTeamMarkedAsDuplicateWorkflowState {
+	return v.MarkedAsDuplicateWorkflowState
+}
+
+// GetAutoCloseStateId returns getTeamDefaultWorkflowStatesTeam.AutoCloseStateId, and is useful for accessing the field via an interface.
+func (v *getTeamDefaultWorkflowStatesTeam) GetAutoCloseStateId() *string { return v.AutoCloseStateId }
+
+// getTeamDefaultWorkflowStatesTeamDefaultIssueStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type getTeamDefaultWorkflowStatesTeamDefaultIssueStateWorkflowState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns getTeamDefaultWorkflowStatesTeamDefaultIssueStateWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *getTeamDefaultWorkflowStatesTeamDefaultIssueStateWorkflowState) GetId() string { return v.Id }
+
+// getTeamDefaultWorkflowStatesTeamMarkedAsDuplicateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type getTeamDefaultWorkflowStatesTeamMarkedAsDuplicateWorkflowState struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns getTeamDefaultWorkflowStatesTeamMarkedAsDuplicateWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *getTeamDefaultWorkflowStatesTeamMarkedAsDuplicateWorkflowState) GetId() string { return v.Id }
+
+// getTeamMembershipByIdResponse is returned by getTeamMembershipById on success.
+type getTeamMembershipByIdResponse struct {
+	// One specific team membership.
+	TeamMembership getTeamMembershipByIdTeamMembership `json:"teamMembership"`
+}
+
+// GetTeamMembership returns getTeamMembershipByIdResponse.TeamMembership, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipByIdResponse) GetTeamMembership() getTeamMembershipByIdTeamMembership {
+	return v.TeamMembership
+}
+
+// getTeamMembershipByIdTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+// The GraphQL type's documentation follows.
+//
+// Defines the membership of a user to a team.
+type getTeamMembershipByIdTeamMembership struct {
+	TeamMembership `json:"-"`
+}
+
+// GetId returns getTeamMembershipByIdTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipByIdTeamMembership) GetId() string { return v.TeamMembership.Id }
+
+// GetOwner returns getTeamMembershipByIdTeamMembership.Owner, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipByIdTeamMembership) GetOwner() bool { return v.TeamMembership.Owner }
+
+// GetTeam returns getTeamMembershipByIdTeamMembership.Team, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipByIdTeamMembership) GetTeam() TeamMembershipTeam {
+	return v.TeamMembership.Team
+}
+
+// GetUser returns getTeamMembershipByIdTeamMembership.User, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipByIdTeamMembership) GetUser() TeamMembershipUser {
+	return v.TeamMembership.User
+}
+
+func (v *getTeamMembershipByIdTeamMembership) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamMembershipByIdTeamMembership
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamMembershipByIdTeamMembership = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamMembership)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamMembershipByIdTeamMembership struct {
+	Id string `json:"id"`
+
+	Owner bool `json:"owner"`
+
+	Team TeamMembershipTeam `json:"team"`
+
+	User TeamMembershipUser `json:"user"`
+}
+
+func (v *getTeamMembershipByIdTeamMembership) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamMembershipByIdTeamMembership) __premarshalJSON() (*__premarshalgetTeamMembershipByIdTeamMembership, error) {
+	var retval __premarshalgetTeamMembershipByIdTeamMembership
+
+	retval.Id = v.TeamMembership.Id
+	retval.Owner = v.TeamMembership.Owner
+	retval.Team = v.TeamMembership.Team
+	retval.User = v.TeamMembership.User
+	return &retval, nil
+}
+
+// getTeamMembershipResponse is returned by getTeamMembership on success.
+type getTeamMembershipResponse struct {
+	// One specific team.
+	Team getTeamMembershipTeam `json:"team"`
+}
+
+// GetTeam returns getTeamMembershipResponse.Team, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipResponse) GetTeam() getTeamMembershipTeam { return v.Team }
+
+// getTeamMembershipTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type getTeamMembershipTeam struct {
+	// The membership of the given user in the team.
+	Membership *getTeamMembershipTeamMembership `json:"membership"`
+}
+
+// GetMembership returns getTeamMembershipTeam.Membership, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeam) GetMembership() *getTeamMembershipTeamMembership { return v.Membership }
+
+// getTeamMembershipTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+// The GraphQL type's documentation follows.
+//
+// Defines the membership of a user to a team.
+type getTeamMembershipTeamMembership struct {
+	TeamMembership `json:"-"`
+}
+
+// GetId returns getTeamMembershipTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetId() string { return v.TeamMembership.Id }
+
+// GetOwner returns getTeamMembershipTeamMembership.Owner, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetOwner() bool { return v.TeamMembership.Owner }
+
+// GetTeam returns getTeamMembershipTeamMembership.Team, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetTeam() TeamMembershipTeam { return v.TeamMembership.Team }
+
+// GetUser returns getTeamMembershipTeamMembership.User, and is useful for accessing the field via an interface.
+func (v *getTeamMembershipTeamMembership) GetUser() TeamMembershipUser { return v.TeamMembership.User }
+
+func (v *getTeamMembershipTeamMembership) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamMembershipTeamMembership
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamMembershipTeamMembership = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamMembership)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamMembershipTeamMembership struct {
+	Id string `json:"id"`
+
+	Owner bool `json:"owner"`
+
+	Team TeamMembershipTeam `json:"team"`
+
+	User TeamMembershipUser `json:"user"`
+}
+
+func (v *getTeamMembershipTeamMembership) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamMembershipTeamMembership) __premarshalJSON() (*__premarshalgetTeamMembershipTeamMembership, error) {
+	var retval __premarshalgetTeamMembershipTeamMembership
+
+	retval.Id = v.TeamMembership.Id
+	retval.Owner = v.TeamMembership.Owner
+	retval.Team = v.TeamMembership.Team
+	retval.User = v.TeamMembership.User
+	return &retval, nil
+}
+
+// getTeamNotificationSubscriptionResponse is returned by getTeamNotificationSubscription on success.
+type getTeamNotificationSubscriptionResponse struct {
+	// One specific team.
+	Team getTeamNotificationSubscriptionTeam `json:"team"`
+}
+
+// GetTeam returns getTeamNotificationSubscriptionResponse.Team, and is useful for accessing the field via an interface.
+func (v *getTeamNotificationSubscriptionResponse) GetTeam() getTeamNotificationSubscriptionTeam {
+	return v.Team
+}
+
+// getTeamNotificationSubscriptionTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type getTeamNotificationSubscriptionTeam struct {
+	TeamNotificationSubscription `json:"-"`
+}
+
+// GetId returns getTeamNotificationSubscriptionTeam.Id, and is useful for accessing the field via an interface.
+func (v *getTeamNotificationSubscriptionTeam) GetId() string {
+	return v.TeamNotificationSubscription.Id
+}
+
+// GetSlackNewIssue returns getTeamNotificationSubscriptionTeam.SlackNewIssue, and is useful for accessing the field via an interface.
+func (v *getTeamNotificationSubscriptionTeam) GetSlackNewIssue() bool {
+	return v.TeamNotificationSubscription.SlackNewIssue
+}
+
+// GetSlackIssueComments returns getTeamNotificationSubscriptionTeam.SlackIssueComments, and is useful for accessing the field via an interface.
+func (v *getTeamNotificationSubscriptionTeam) GetSlackIssueComments() bool {
+	return v.TeamNotificationSubscription.SlackIssueComments
+}
+
+// GetSlackIssueStatuses returns getTeamNotificationSubscriptionTeam.SlackIssueStatuses, and is useful for accessing the field via an interface.
+func (v *getTeamNotificationSubscriptionTeam) GetSlackIssueStatuses() bool {
+	return v.TeamNotificationSubscription.SlackIssueStatuses
+}
+
+func (v *getTeamNotificationSubscriptionTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamNotificationSubscriptionTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamNotificationSubscriptionTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamNotificationSubscription)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamNotificationSubscriptionTeam struct {
+	Id string `json:"id"`
+
+	SlackNewIssue bool `json:"slackNewIssue"`
+
+	SlackIssueComments bool `json:"slackIssueComments"`
+
+	SlackIssueStatuses bool `json:"slackIssueStatuses"`
+}
+
+func (v *getTeamNotificationSubscriptionTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamNotificationSubscriptionTeam) __premarshalJSON() (*__premarshalgetTeamNotificationSubscriptionTeam, error) {
+	var retval __premarshalgetTeamNotificationSubscriptionTeam
+
+	retval.Id = v.TeamNotificationSubscription.Id
+	retval.SlackNewIssue = v.TeamNotificationSubscription.SlackNewIssue
+	retval.SlackIssueComments = v.TeamNotificationSubscription.SlackIssueComments
+	retval.SlackIssueStatuses = v.TeamNotificationSubscription.SlackIssueStatuses
+	return &retval, nil
+}
+
+// getTeamResponse is returned by getTeam on success.
+type getTeamResponse struct {
+	// One specific team.
+	Team getTeamTeam `json:"team"`
+}
+
+// GetTeam returns getTeamResponse.Team, and is useful for accessing the field via an interface.
+func (v *getTeamResponse) GetTeam() getTeamTeam { return v.Team }
+
+// getTeamTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type getTeamTeam struct {
+	Team `json:"-"`
+}
+
+// GetId returns getTeamTeam.Id, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetId() string { return v.Team.Id }
+
+// GetName returns getTeamTeam.Name, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetName() string { return v.Team.Name }
+
+// GetKey returns getTeamTeam.Key, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetKey() string { return v.Team.Key }
+
+// GetPrivate returns getTeamTeam.Private, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetPrivate() bool { return v.Team.Private }
+
+// GetJoinByDefault returns getTeamTeam.JoinByDefault, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetJoinByDefault() *bool { return v.Team.JoinByDefault }
+
+// GetDescription returns getTeamTeam.Description, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetDescription() *string { return v.Team.Description }
+
+// GetIcon returns getTeamTeam.Icon, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetIcon() *string { return v.Team.Icon }
+
+// GetColor returns getTeamTeam.Color, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetColor() *string { return v.Team.Color }
+
+// GetTimezone returns getTeamTeam.Timezone, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetTimezone() string { return v.Team.Timezone }
+
+// GetIssueOrderingNoPriorityFirst returns getTeamTeam.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetIssueOrderingNoPriorityFirst() bool {
+	return v.Team.IssueOrderingNoPriorityFirst
+}
+
+// GetGroupIssueHistory returns getTeamTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetGroupIssueHistory() bool { return v.Team.GroupIssueHistory }
+
+// GetSetIssueSortOrderOnStateChange returns getTeamTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetSetIssueSortOrderOnStateChange() string {
+	return v.Team.SetIssueSortOrderOnStateChange
+}
+
+// GetAutoArchivePeriod returns getTeamTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetAutoArchivePeriod() float64 { return v.Team.AutoArchivePeriod }
+
+// GetAutoClosePeriod returns getTeamTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetAutoClosePeriod() *float64 { return v.Team.AutoClosePeriod }
+
+// GetTriageEnabled returns getTeamTeam.TriageEnabled, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
+
+// GetRequirePriorityToLeaveTriage returns getTeamTeam.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetRequirePriorityToLeaveTriage() bool {
+	return v.Team.RequirePriorityToLeaveTriage
+}
+
+// GetCyclesEnabled returns getTeamTeam.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
+
+// GetCycleStartDay returns getTeamTeam.CycleStartDay, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
+
+// GetCycleDuration returns getTeamTeam.CycleDuration, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
+
+// GetCycleCooldownTime returns getTeamTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleCooldownTime() float64 { return v.Team.CycleCooldownTime }
+
+// GetUpcomingCycleCount returns getTeamTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetUpcomingCycleCount() float64 { return v.Team.UpcomingCycleCount }
+
+// GetCycleIssueAutoAssignStarted returns getTeamTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleIssueAutoAssignStarted() bool {
+	return v.Team.CycleIssueAutoAssignStarted
+}
+
+// GetCycleIssueAutoAssignCompleted returns getTeamTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleIssueAutoAssignCompleted() bool {
+	return v.Team.CycleIssueAutoAssignCompleted
+}
+
+// GetCycleLockToActive returns getTeamTeam.CycleLockToActive, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetCycleLockToActive() bool { return v.Team.CycleLockToActive }
+
+// GetIssueEstimationType returns getTeamTeam.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetIssueEstimationType() string { return v.Team.IssueEstimationType }
+
+// GetIssueEstimationAllowZero returns getTeamTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetIssueEstimationAllowZero() bool { return v.Team.IssueEstimationAllowZero }
+
+// GetIssueEstimationExtended returns getTeamTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetIssueEstimationExtended() bool { return v.Team.IssueEstimationExtended }
+
+// GetDefaultIssueEstimate returns getTeamTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetDefaultIssueEstimate() float64 { return v.Team.DefaultIssueEstimate }
+
+// GetDefaultTemplateForMembers returns getTeamTeam.DefaultTemplateForMembers, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetDefaultTemplateForMembers() *TeamDefaultTemplateForMembersTemplate {
+	return v.Team.DefaultTemplateForMembers
+}
+
+// GetDefaultTemplateForNonMembers returns getTeamTeam.DefaultTemplateForNonMembers, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetDefaultTemplateForNonMembers() *TeamDefaultTemplateForNonMembersTemplate {
+	return v.Team.DefaultTemplateForNonMembers
+}
+
+// GetDefaultProjectTemplate returns getTeamTeam.DefaultProjectTemplate, and is useful for accessing the field via an interface.
+func (v *getTeamTeam) GetDefaultProjectTemplate() *TeamDefaultProjectTemplate {
+	return v.Team.DefaultProjectTemplate
+}
+
+func (v *getTeamTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Team)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamTeam struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Key string `json:"key"`
+
+	Private bool `json:"private"`
+
+	JoinByDefault *bool `json:"joinByDefault"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Timezone string `json:"timezone"`
+
+	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
+
+	GroupIssueHistory bool `json:"groupIssueHistory"`
+
+	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+
+	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+
+	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+
+	TriageEnabled bool `json:"triageEnabled"`
+
+	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
+
+	CyclesEnabled bool `json:"cyclesEnabled"`
+
+	CycleStartDay float64 `json:"cycleStartDay"`
+
+	CycleDuration float64 `json:"cycleDuration"`
+
+	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+
+	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+
+	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+
+	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+
+	CycleLockToActive bool `json:"cycleLockToActive"`
+
+	IssueEstimationType string `json:"issueEstimationType"`
+
+	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+
+	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+
+	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+
+	DefaultTemplateForMembers *TeamDefaultTemplateForMembersTemplate `json:"defaultTemplateForMembers"`
+
+	DefaultTemplateForNonMembers *TeamDefaultTemplateForNonMembersTemplate `json:"defaultTemplateForNonMembers"`
+
+	DefaultProjectTemplate *TeamDefaultProjectTemplate `json:"defaultProjectTemplate"`
+}
+
+func (v *getTeamTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamTeam) __premarshalJSON() (*__premarshalgetTeamTeam, error) {
+	var retval __premarshalgetTeamTeam
+
+	retval.Id = v.Team.Id
+	retval.Name = v.Team.Name
+	retval.Key = v.Team.Key
+	retval.Private = v.Team.Private
+	retval.JoinByDefault = v.Team.JoinByDefault
+	retval.Description = v.Team.Description
+	retval.Icon = v.Team.Icon
+	retval.Color = v.Team.Color
+	retval.Timezone = v.Team.Timezone
+	retval.IssueOrderingNoPriorityFirst = v.Team.IssueOrderingNoPriorityFirst
+	retval.GroupIssueHistory = v.Team.GroupIssueHistory
+	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
+	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
+	retval.AutoClosePeriod = v.Team.AutoClosePeriod
+	retval.TriageEnabled = v.Team.TriageEnabled
+	retval.RequirePriorityToLeaveTriage = v.Team.RequirePriorityToLeaveTriage
+	retval.CyclesEnabled = v.Team.CyclesEnabled
+	retval.CycleStartDay = v.Team.CycleStartDay
+	retval.CycleDuration = v.Team.CycleDuration
+	retval.CycleCooldownTime = v.Team.CycleCooldownTime
+	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
+	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
+	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
+	retval.CycleLockToActive = v.Team.CycleLockToActive
+	retval.IssueEstimationType = v.Team.IssueEstimationType
+	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
+	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
+	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
+	retval.DefaultTemplateForMembers = v.Team.DefaultTemplateForMembers
+	retval.DefaultTemplateForNonMembers = v.Team.DefaultTemplateForNonMembers
+	retval.DefaultProjectTemplate = v.Team.DefaultProjectTemplate
+	return &retval, nil
+}
+
+// getTeamWorkflowResponse is returned by getTeamWorkflow on success.
+type getTeamWorkflowResponse struct {
+	// One specific team.
+	Team getTeamWorkflowTeam `json:"team"`
+}
+
+// GetTeam returns getTeamWorkflowResponse.Team, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowResponse) GetTeam() getTeamWorkflowTeam { return v.Team }
+
+// getTeamWorkflowStatesResponse is returned by getTeamWorkflowStates on success.
+type getTeamWorkflowStatesResponse struct {
+	// All issue workflow states.
+	WorkflowStates getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection `json:"workflowStates"`
+}
+
+// GetWorkflowStates returns getTeamWorkflowStatesResponse.WorkflowStates, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesResponse) GetWorkflowStates() getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection {
+	return v.WorkflowStates
+}
+
+// getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection includes the requested fields of the GraphQL type WorkflowStateConnection.
+type getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection struct {
+	Nodes []getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState `json:"nodes"`
+}
+
+// GetNodes returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnection) GetNodes() []getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
+	return v.Nodes
+}
+
+// getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
+	WorkflowState `json:"-"`
+}
+
+// GetId returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetId() string {
+	return v.WorkflowState.Id
+}
+
+// GetName returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetName() string {
+	return v.WorkflowState.Name
+}
+
+// GetColor returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Color, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetColor() string {
+	return v.WorkflowState.Color
+}
+
+// GetDescription returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Description, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetDescription() *string {
+	return v.WorkflowState.Description
+}
+
+// GetType returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetType() string {
+	return v.WorkflowState.Type
+}
+
+// GetPosition returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Position, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetPosition() float64 {
+	return v.WorkflowState.Position
+}
+
+// GetArchivedAt returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetArchivedAt() *time.Time {
+	return v.WorkflowState.ArchivedAt
+}
+
+// GetCreatedAt returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetCreatedAt() time.Time {
+	return v.WorkflowState.CreatedAt
+}
+
+// GetUpdatedAt returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetUpdatedAt() time.Time {
+	return v.WorkflowState.UpdatedAt
+}
+
+// GetTeam returns getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetTeam() WorkflowStateTeam {
+	return v.WorkflowState.Team
+}
+
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WorkflowState)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type string `json:"type"`
+
+	Position float64 `json:"position"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Team WorkflowStateTeam `json:"team"`
+}
+
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) __premarshalJSON() (*__premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, error) {
+	var retval __premarshalgetTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+
+	retval.Id = v.WorkflowState.Id
+	retval.Name = v.WorkflowState.Name
+	retval.Color = v.WorkflowState.Color
+	retval.Description = v.WorkflowState.Description
+	retval.Type = v.WorkflowState.Type
+	retval.Position = v.WorkflowState.Position
+	retval.ArchivedAt = v.WorkflowState.ArchivedAt
+	retval.CreatedAt = v.WorkflowState.CreatedAt
+	retval.UpdatedAt = v.WorkflowState.UpdatedAt
+	retval.Team = v.WorkflowState.Team
+	return &retval, nil
+}
+
+// getTeamWorkflowTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type getTeamWorkflowTeam struct {
+	TeamWorkflow `json:"-"`
+}
+
+// GetId returns getTeamWorkflowTeam.Id, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowTeam) GetId() string { return v.TeamWorkflow.Id }
+
+// GetKey returns getTeamWorkflowTeam.Key, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowTeam) GetKey() string { return v.TeamWorkflow.Key }
+
+// GetDraftWorkflowState returns getTeamWorkflowTeam.DraftWorkflowState, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowTeam) GetDraftWorkflowState() *TeamWorkflowDraftWorkflowState {
+	return v.TeamWorkflow.DraftWorkflowState
+}
+
+// GetStartWorkflowState returns getTeamWorkflowTeam.StartWorkflowState, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowTeam) GetStartWorkflowState() *TeamWorkflowStartWorkflowState {
+	return v.TeamWorkflow.StartWorkflowState
+}
+
+// GetReviewWorkflowState returns getTeamWorkflowTeam.ReviewWorkflowState, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowTeam) GetReviewWorkflowState() *TeamWorkflowReviewWorkflowState {
+	return v.TeamWorkflow.ReviewWorkflowState
+}
+
+// GetMergeWorkflowState returns getTeamWorkflowTeam.MergeWorkflowState, and is useful for accessing the field via an interface.
+func (v *getTeamWorkflowTeam) GetMergeWorkflowState() *TeamWorkflowMergeWorkflowState {
+	return v.TeamWorkflow.MergeWorkflowState
+}
+
+func (v *getTeamWorkflowTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTeamWorkflowTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTeamWorkflowTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamWorkflow)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTeamWorkflowTeam struct {
+	Id string `json:"id"`
+
+	Key string `json:"key"`
+
+	DraftWorkflowState *TeamWorkflowDraftWorkflowState `json:"draftWorkflowState"`
+
+	StartWorkflowState *TeamWorkflowStartWorkflowState `json:"startWorkflowState"`
+
+	ReviewWorkflowState *TeamWorkflowReviewWorkflowState `json:"reviewWorkflowState"`
+
+	MergeWorkflowState *TeamWorkflowMergeWorkflowState `json:"mergeWorkflowState"`
+}
+
+func (v *getTeamWorkflowTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTeamWorkflowTeam) __premarshalJSON() (*__premarshalgetTeamWorkflowTeam, error) {
+	var retval __premarshalgetTeamWorkflowTeam
+
+	retval.Id = v.TeamWorkflow.Id
+	retval.Key = v.TeamWorkflow.Key
+	retval.DraftWorkflowState = v.TeamWorkflow.DraftWorkflowState
+	retval.StartWorkflowState = v.TeamWorkflow.StartWorkflowState
+	retval.ReviewWorkflowState = v.TeamWorkflow.ReviewWorkflowState
+	retval.MergeWorkflowState = v.TeamWorkflow.MergeWorkflowState
+	return &retval, nil
+}
+
+// getTemplateResponse is returned by getTemplate on success.
+type getTemplateResponse struct {
+	// A specific template.
+	Template getTemplateTemplate `json:"template"`
+}
+
+// GetTemplate returns getTemplateResponse.Template, and is useful for accessing the field via an interface.
+func (v *getTemplateResponse) GetTemplate() getTemplateTemplate { return v.Template }
+
+// getTemplateTemplate includes the requested fields of the GraphQL type Template.
+// The GraphQL type's documentation follows.
+//
+// A template object used for creating entities faster.
+type getTemplateTemplate struct {
+	Template `json:"-"`
+}
+
+// GetId returns getTemplateTemplate.Id, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetId() string { return v.Template.Id }
+
+// GetType returns getTemplateTemplate.Type, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetType() string { return v.Template.Type }
+
+// GetName returns getTemplateTemplate.Name, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetName() string { return v.Template.Name }
+
+// GetDescription returns getTemplateTemplate.Description, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetDescription() *string { return v.Template.Description }
+
+// GetTemplateData returns getTemplateTemplate.TemplateData, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetTemplateData() json.RawMessage { return v.Template.TemplateData }
+
+// GetTeam returns getTemplateTemplate.Team, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetTeam() *TemplateTeam { return v.Template.Team }
+
+func (v *getTemplateTemplate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTemplateTemplate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTemplateTemplate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Template)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTemplateTemplate struct {
+	Id string `json:"id"`
+
+	Type string `json:"type"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	TemplateData json.RawMessage `json:"templateData"`
+
+	Team *TemplateTeam `json:"team"`
+}
+
+func (v *getTemplateTemplate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTemplateTemplate) __premarshalJSON() (*__premarshalgetTemplateTemplate, error) {
+	var retval __premarshalgetTemplateTemplate
+
+	retval.Id = v.Template.Id
+	retval.Type = v.Template.Type
+	retval.Name = v.Template.Name
+	retval.Description = v.Template.Description
+	retval.TemplateData = v.Template.TemplateData
+	retval.Team = v.Template.Team
+	return &retval, nil
+}
+
+// getViewerResponse is returned by getViewer on success.
+type getViewerResponse struct {
+	// The currently authenticated user.
+	Viewer getViewerViewerUser `json:"viewer"`
+}
+
+// GetViewer returns getViewerResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *getViewerResponse) GetViewer() getViewerViewerUser { return v.Viewer }
+
+// getViewerViewerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type getViewerViewerUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The user's full name.
+	Name string `json:"name"`
+	// The user's email address.
+	Email string `json:"email"`
+	// Organization the user belongs to.
+	Organization getViewerViewerUserOrganization `json:"organization"`
+}
+
+// GetId returns getViewerViewerUser.Id, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUser) GetId() string { return v.Id }
+
+// GetName returns getViewerViewerUser.Name, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUser) GetName() string { return v.Name }
+
+// GetEmail returns getViewerViewerUser.Email, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUser) GetEmail() string { return v.Email }
+
+// GetOrganization returns getViewerViewerUser.Organization, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUser) GetOrganization() getViewerViewerUserOrganization {
+	return v.Organization
+}
+
+// getViewerViewerUserOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization. Organizations are root-level objects that contain user accounts and teams.
+type getViewerViewerUserOrganization struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The organization's name.
+	Name string `json:"name"`
+	// The organization's unique URL key.
+	UrlKey string `json:"urlKey"`
+}
+
+// GetId returns getViewerViewerUserOrganization.Id, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUserOrganization) GetId() string { return v.Id }
+
+// GetName returns getViewerViewerUserOrganization.Name, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUserOrganization) GetName() string { return v.Name }
+
+// GetUrlKey returns getViewerViewerUserOrganization.UrlKey, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUserOrganization) GetUrlKey() string { return v.UrlKey }
+
+// getWorkflowStateIssuesResponse is returned by getWorkflowStateIssues on success.
+type getWorkflowStateIssuesResponse struct {
+	// One specific state.
+	WorkflowState getWorkflowStateIssuesWorkflowState `json:"workflowState"`
+}
+
+// GetWorkflowState returns getWorkflowStateIssuesResponse.WorkflowState, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateIssuesResponse) GetWorkflowState() getWorkflowStateIssuesWorkflowState {
+	return v.WorkflowState
+}
+
+// getWorkflowStateIssuesWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type getWorkflowStateIssuesWorkflowState struct {
+	// Issues belonging in this state.
+	Issues getWorkflowStateIssuesWorkflowStateIssuesIssueConnection `json:"issues"`
+	// The team to which this state belongs to.
+	Team getWorkflowStateIssuesWorkflowStateTeam `json:"team"`
+}
+
+// GetIssues returns getWorkflowStateIssuesWorkflowState.Issues, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateIssuesWorkflowState) GetIssues() getWorkflowStateIssuesWorkflowStateIssuesIssueConnection {
+	return v.Issues
+}
+
+// GetTeam returns getWorkflowStateIssuesWorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateIssuesWorkflowState) GetTeam() getWorkflowStateIssuesWorkflowStateTeam {
+	return v.Team
+}
+
+// getWorkflowStateIssuesWorkflowStateIssuesIssueConnection includes the requested fields of the GraphQL type IssueConnection.
+type getWorkflowStateIssuesWorkflowStateIssuesIssueConnection struct {
+	Nodes    []getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionNodesIssue `json:"nodes"`
+	PageInfo getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionPageInfo     `json:"pageInfo"`
+}
+
+// GetNodes returns getWorkflowStateIssuesWorkflowStateIssuesIssueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateIssuesWorkflowStateIssuesIssueConnection) GetNodes() []getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionNodesIssue {
+	return v.Nodes
+}
+
+// GetPageInfo returns getWorkflowStateIssuesWorkflowStateIssuesIssueConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateIssuesWorkflowStateIssuesIssueConnection) GetPageInfo() getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionNodesIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionNodesIssue struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionNodesIssue.Id, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionNodesIssue) GetId() string {
+	return v.Id
+}
+
+// getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateIssuesWorkflowStateIssuesIssueConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// getWorkflowStateIssuesWorkflowStateTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type getWorkflowStateIssuesWorkflowStateTeam struct {
+	// The team's unique key. The key is used in URLs.
+	Key string `json:"key"`
+	// The organization that the team is associated with.
+	Organization getWorkflowStateIssuesWorkflowStateTeamOrganization `json:"organization"`
+}
+
+// GetKey returns getWorkflowStateIssuesWorkflowStateTeam.Key, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateIssuesWorkflowStateTeam) GetKey() string { return v.Key }
+
+// GetOrganization returns getWorkflowStateIssuesWorkflowStateTeam.Organization, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateIssuesWorkflowStateTeam) GetOrganization() getWorkflowStateIssuesWorkflowStateTeamOrganization {
+	return v.Organization
+}
+
+// getWorkflowStateIssuesWorkflowStateTeamOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization. Organizations are root-level objects that contain user accounts and teams.
+type getWorkflowStateIssuesWorkflowStateTeamOrganization struct {
+	// The organization's unique URL key.
+	UrlKey string `json:"urlKey"`
+}
+
+// GetUrlKey returns getWorkflowStateIssuesWorkflowStateTeamOrganization.UrlKey, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateIssuesWorkflowStateTeamOrganization) GetUrlKey() string { return v.UrlKey }
+
+// getWorkflowStateResponse is returned by getWorkflowState on success.
+type getWorkflowStateResponse struct {
+	// One specific state.
+	WorkflowState getWorkflowStateWorkflowState `json:"workflowState"`
+}
+
+// GetWorkflowState returns getWorkflowStateResponse.WorkflowState, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateResponse) GetWorkflowState() getWorkflowStateWorkflowState {
+	return v.WorkflowState
+}
+
+// getWorkflowStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type getWorkflowStateWorkflowState struct {
+	WorkflowState `json:"-"`
+}
+
+// GetId returns getWorkflowStateWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetId() string { return v.WorkflowState.Id }
+
+// GetName returns getWorkflowStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetName() string { return v.WorkflowState.Name }
+
+// GetColor returns getWorkflowStateWorkflowState.Color, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetColor() string { return v.WorkflowState.Color }
+
+// GetDescription returns getWorkflowStateWorkflowState.Description, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetDescription() *string { return v.WorkflowState.Description }
+
+// GetType returns getWorkflowStateWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetType() string { return v.WorkflowState.Type }
+
+// GetPosition returns getWorkflowStateWorkflowState.Position, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetPosition() float64 { return v.WorkflowState.Position }
+
+// GetArchivedAt returns getWorkflowStateWorkflowState.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetArchivedAt() *time.Time { return v.WorkflowState.ArchivedAt }
+
+// GetCreatedAt returns getWorkflowStateWorkflowState.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetCreatedAt() time.Time { return v.WorkflowState.CreatedAt }
+
+// GetUpdatedAt returns getWorkflowStateWorkflowState.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetUpdatedAt() time.Time { return v.WorkflowState.UpdatedAt }
+
+// GetTeam returns getWorkflowStateWorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *getWorkflowStateWorkflowState) GetTeam() WorkflowStateTeam { return v.WorkflowState.Team }
+
+func (v *getWorkflowStateWorkflowState) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getWorkflowStateWorkflowState
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getWorkflowStateWorkflowState = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WorkflowState)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetWorkflowStateWorkflowState struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type string `json:"type"`
+
+	Position float64 `json:"position"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Team WorkflowStateTeam `json:"team"`
+}
+
+func (v *getWorkflowStateWorkflowState) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getWorkflowStateWorkflowState) __premarshalJSON() (*__premarshalgetWorkflowStateWorkflowState, error) {
+	var retval __premarshalgetWorkflowStateWorkflowState
+
+	retval.Id = v.WorkflowState.Id
+	retval.Name = v.WorkflowState.Name
+	retval.Color = v.WorkflowState.Color
+	retval.Description = v.WorkflowState.Description
+	retval.Type = v.WorkflowState.Type
+	retval.Position = v.WorkflowState.Position
+	retval.ArchivedAt = v.WorkflowState.ArchivedAt
+	retval.CreatedAt = v.WorkflowState.CreatedAt
+	retval.UpdatedAt = v.WorkflowState.UpdatedAt
+	retval.Team = v.WorkflowState.Team
+	return &retval, nil
+}
+
+// getWorkflowSyncStatesResponse is returned by getWorkflowSyncStates on success.
+type getWorkflowSyncStatesResponse struct {
+	// All issue workflow states.
+	WorkflowStates getWorkflowSyncStatesWorkflowStatesWorkflowStateConnection `json:"workflowStates"`
+}
+
+// GetWorkflowStates returns getWorkflowSyncStatesResponse.WorkflowStates, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesResponse) GetWorkflowStates() getWorkflowSyncStatesWorkflowStatesWorkflowStateConnection {
+	return v.WorkflowStates
+}
+
+// getWorkflowSyncStatesWorkflowStatesWorkflowStateConnection includes the requested fields of the GraphQL type WorkflowStateConnection.
+type getWorkflowSyncStatesWorkflowStatesWorkflowStateConnection struct {
+	Nodes []getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState `json:"nodes"`
+}
+
+// GetNodes returns getWorkflowSyncStatesWorkflowStatesWorkflowStateConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnection) GetNodes() []getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
+	return v.Nodes
+}
+
+// getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
+	WorkflowState `json:"-"`
+}
+
+// GetId returns getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetId() string {
+	return v.WorkflowState.Id
+}
+
+// GetName returns getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetName() string {
+	return v.WorkflowState.Name
+}
+
+// GetColor returns getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Color, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetColor() string {
+	return v.WorkflowState.Color
+}
+
+// GetDescription returns getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Description, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetDescription() *string {
+	return v.WorkflowState.Description
+}
+
+// GetType returns getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetType() string {
+	return v.WorkflowState.Type
+}
+
+// GetPosition returns getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Position, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetPosition() float64 {
+	return v.WorkflowState.Position
+}
+
+// GetArchivedAt returns getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetArchivedAt() *time.Time {
+	return v.WorkflowState.ArchivedAt
+}
+
+// GetCreatedAt returns getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetCreatedAt() time.Time {
+	return v.WorkflowState.CreatedAt
+}
+
+// GetUpdatedAt returns getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetUpdatedAt() time.Time {
+	return v.WorkflowState.UpdatedAt
+}
+
+// GetTeam returns getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) GetTeam() WorkflowStateTeam {
+	return v.WorkflowState.Team
+}
+
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WorkflowState)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type string `json:"type"`
+
+	Position float64 `json:"position"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Team WorkflowStateTeam `json:"team"`
+}
+
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) __premarshalJSON() (*__premarshalgetWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, error) {
+	var retval __premarshalgetWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+
+	retval.Id = v.WorkflowState.Id
+	retval.Name = v.WorkflowState.Name
+	retval.Color = v.WorkflowState.Color
+	retval.Description = v.WorkflowState.Description
+	retval.Type = v.WorkflowState.Type
+	retval.Position = v.WorkflowState.Position
+	retval.ArchivedAt = v.WorkflowState.ArchivedAt
+	retval.CreatedAt = v.WorkflowState.CreatedAt
+	retval.UpdatedAt = v.WorkflowState.UpdatedAt
+	retval.Team = v.WorkflowState.Team
+	return &retval, nil
+}
+
+// getWorkspaceOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization. Organizations are root-level objects that contain user accounts and teams.
+type getWorkspaceOrganization struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The organization's unique URL key.
+	UrlKey string `json:"urlKey"`
+	// The organization's name.
+	Name string `json:"name"`
+}
+
+// GetId returns getWorkspaceOrganization.Id, and is useful for accessing the field via an interface.
+func (v *getWorkspaceOrganization) GetId() string { return v.Id }
+
+// GetUrlKey returns getWorkspaceOrganization.UrlKey, and is useful for accessing the field via an interface.
+func (v *getWorkspaceOrganization) GetUrlKey() string { return v.UrlKey }
+
+// GetName returns getWorkspaceOrganization.Name, and is useful for accessing the field via an interface.
+func (v *getWorkspaceOrganization) GetName() string { return v.Name }
+
+// getWorkspaceResponse is returned by getWorkspace on success.
+type getWorkspaceResponse struct {
+	// The user's organization.
+	Organization getWorkspaceOrganization `json:"organization"`
+}
+
+// GetOrganization returns getWorkspaceResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getWorkspaceResponse) GetOrganization() getWorkspaceOrganization { return v.Organization }
+
+// getWorkspaceSettingsOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization. Organizations are root-level objects that contain user accounts and teams.
+type getWorkspaceSettingsOrganization struct {
+	Organization `json:"-"`
+}
+
+// GetId returns getWorkspaceSettingsOrganization.Id, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetId() string { return v.Organization.Id }
+
+// GetName returns getWorkspaceSettingsOrganization.Name, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetName() string { return v.Organization.Name }
+
+// GetUrlKey returns getWorkspaceSettingsOrganization.UrlKey, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetUrlKey() string { return v.Organization.UrlKey }
+
+// GetLogoUrl returns getWorkspaceSettingsOrganization.LogoUrl, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetLogoUrl() *string { return v.Organization.LogoUrl }
+
+// GetGitBranchFormat returns getWorkspaceSettingsOrganization.GitBranchFormat, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetGitBranchFormat() *string {
+	return v.Organization.GitBranchFormat
+}
+
+// GetAllowMembersToInvite returns getWorkspaceSettingsOrganization.AllowMembersToInvite, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetAllowMembersToInvite() bool {
+	return v.Organization.AllowMembersToInvite
+}
+
+// GetRoadmapEnabled returns getWorkspaceSettingsOrganization.RoadmapEnabled, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetRoadmapEnabled() bool {
+	return v.Organization.RoadmapEnabled
+}
+
+// GetGitLinkbackMessagesEnabled returns getWorkspaceSettingsOrganization.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetGitLinkbackMessagesEnabled() bool {
+	return v.Organization.GitLinkbackMessagesEnabled
+}
+
+// GetGitPublicLinkbackMessagesEnabled returns getWorkspaceSettingsOrganization.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsOrganization) GetGitPublicLinkbackMessagesEnabled() bool {
+	return v.Organization.GitPublicLinkbackMessagesEnabled
+}
+
+func (v *getWorkspaceSettingsOrganization) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getWorkspaceSettingsOrganization
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getWorkspaceSettingsOrganization = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Organization)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetWorkspaceSettingsOrganization struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	UrlKey string `json:"urlKey"`
+
+	LogoUrl *string `json:"logoUrl"`
+
+	GitBranchFormat *string `json:"gitBranchFormat"`
+
+	AllowMembersToInvite bool `json:"allowMembersToInvite"`
+
+	RoadmapEnabled bool `json:"roadmapEnabled"`
+
+	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
+
+	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
+}
+
+func (v *getWorkspaceSettingsOrganization) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getWorkspaceSettingsOrganization) __premarshalJSON() (*__premarshalgetWorkspaceSettingsOrganization, error) {
+	var retval __premarshalgetWorkspaceSettingsOrganization
+
+	retval.Id = v.Organization.Id
+	retval.Name = v.Organization.Name
+	retval.UrlKey = v.Organization.UrlKey
+	retval.LogoUrl = v.Organization.LogoUrl
+	retval.GitBranchFormat = v.Organization.GitBranchFormat
+	retval.AllowMembersToInvite = v.Organization.AllowMembersToInvite
+	retval.RoadmapEnabled = v.Organization.RoadmapEnabled
+	retval.GitLinkbackMessagesEnabled = v.Organization.GitLinkbackMessagesEnabled
+	retval.GitPublicLinkbackMessagesEnabled = v.Organization.GitPublicLinkbackMessagesEnabled
+	return &retval, nil
+}
+
+// getWorkspaceSettingsResponse is returned by getWorkspaceSettings on success.
+type getWorkspaceSettingsResponse struct {
+	// The user's organization.
+	Organization getWorkspaceSettingsOrganization `json:"organization"`
+}
+
+// GetOrganization returns getWorkspaceSettingsResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getWorkspaceSettingsResponse) GetOrganization() getWorkspaceSettingsOrganization {
+	return v.Organization
+}
+
+// listCustomViewsCustomViewsCustomViewConnection includes the requested fields of the GraphQL type CustomViewConnection.
+type listCustomViewsCustomViewsCustomViewConnection struct {
+	Nodes    []listCustomViewsCustomViewsCustomViewConnectionNodesCustomView `json:"nodes"`
+	PageInfo listCustomViewsCustomViewsCustomViewConnectionPageInfo          `json:"pageInfo"`
+}
+
+// GetNodes returns listCustomViewsCustomViewsCustomViewConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnection) GetNodes() []listCustomViewsCustomViewsCustomViewConnectionNodesCustomView {
+	return v.Nodes
+}
+
+// GetPageInfo returns listCustomViewsCustomViewsCustomViewConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnection) GetPageInfo() listCustomViewsCustomViewsCustomViewConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listCustomViewsCustomViewsCustomViewConnectionNodesCustomView includes the requested fields of the GraphQL type CustomView.
+// The GraphQL type's documentation follows.
+//
+// A custom view that has been saved by a user.
+type listCustomViewsCustomViewsCustomViewConnectionNodesCustomView struct {
+	CustomView `json:"-"`
+}
+
+// GetId returns listCustomViewsCustomViewsCustomViewConnectionNodesCustomView.Id, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) GetId() string {
+	return v.CustomView.Id
+}
+
+// GetName returns listCustomViewsCustomViewsCustomViewConnectionNodesCustomView.Name, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) GetName() string {
+	return v.CustomView.Name
+}
+
+// GetDescription returns listCustomViewsCustomViewsCustomViewConnectionNodesCustomView.Description, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) GetDescription() *string {
+	return v.CustomView.Description
+}
+
+// GetIcon returns listCustomViewsCustomViewsCustomViewConnectionNodesCustomView.Icon, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) GetIcon() *string {
+	return v.CustomView.Icon
+}
+
+// GetColor returns listCustomViewsCustomViewsCustomViewConnectionNodesCustomView.Color, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) GetColor() *string {
+	return v.CustomView.Color
+}
+
+// GetShared returns listCustomViewsCustomViewsCustomViewConnectionNodesCustomView.Shared, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) GetShared() bool {
+	return v.CustomView.Shared
+}
+
+// GetFilterData returns listCustomViewsCustomViewsCustomViewConnectionNodesCustomView.FilterData, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) GetFilterData() map[string]interface{} {
+	return v.CustomView.FilterData
+}
+
+// GetTeam returns listCustomViewsCustomViewsCustomViewConnectionNodesCustomView.Team, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) GetTeam() *CustomViewTeam {
+	return v.CustomView.Team
+}
+
+// GetOwner returns listCustomViewsCustomViewsCustomViewConnectionNodesCustomView.Owner, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) GetOwner() CustomViewOwnerUser {
+	return v.CustomView.Owner
+}
+
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listCustomViewsCustomViewsCustomViewConnectionNodesCustomView
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listCustomViewsCustomViewsCustomViewConnectionNodesCustomView = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomView)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistCustomViewsCustomViewsCustomViewConnectionNodesCustomView struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Shared bool `json:"shared"`
+
+	FilterData map[string]interface{} `json:"filterData"`
+
+	Team *CustomViewTeam `json:"team"`
+
+	Owner CustomViewOwnerUser `json:"owner"`
+}
+
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listCustomViewsCustomViewsCustomViewConnectionNodesCustomView) __premarshalJSON() (*__premarshallistCustomViewsCustomViewsCustomViewConnectionNodesCustomView, error) {
+	var retval __premarshallistCustomViewsCustomViewsCustomViewConnectionNodesCustomView
+
+	retval.Id = v.CustomView.Id
+	retval.Name = v.CustomView.Name
+	retval.Description = v.CustomView.Description
+	retval.Icon = v.CustomView.Icon
+	retval.Color = v.CustomView.Color
+	retval.Shared = v.CustomView.Shared
+	retval.FilterData = v.CustomView.FilterData
+	retval.Team = v.CustomView.Team
+	retval.Owner = v.CustomView.Owner
+	return &retval, nil
+}
+
+// listCustomViewsCustomViewsCustomViewConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listCustomViewsCustomViewsCustomViewConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listCustomViewsCustomViewsCustomViewConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listCustomViewsCustomViewsCustomViewConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listCustomViewsCustomViewsCustomViewConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listCustomViewsResponse is returned by listCustomViews on success.
+type listCustomViewsResponse struct {
+	// Custom views for the user.
+	CustomViews listCustomViewsCustomViewsCustomViewConnection `json:"customViews"`
+}
+
+// GetCustomViews returns listCustomViewsResponse.CustomViews, and is useful for accessing the field via an interface.
+func (v *listCustomViewsResponse) GetCustomViews() listCustomViewsCustomViewsCustomViewConnection {
+	return v.CustomViews
+}
+
+// listCyclesResponse is returned by listCycles on success.
+type listCyclesResponse struct {
+	// One specific team.
+	Team listCyclesTeam `json:"team"`
+}
+
+// GetTeam returns listCyclesResponse.Team, and is useful for accessing the field via an interface.
+func (v *listCyclesResponse) GetTeam() listCyclesTeam { return v.Team }
+
+// listCyclesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type listCyclesTeam struct {
+	// Cycles associated with the team.
+	Cycles listCyclesTeamCyclesCycleConnection `json:"cycles"`
+}
+
+// GetCycles returns listCyclesTeam.Cycles, and is useful for accessing the field via an interface.
+func (v *listCyclesTeam) GetCycles() listCyclesTeamCyclesCycleConnection { return v.Cycles }
+
+// listCyclesTeamCyclesCycleConnection includes the requested fields of the GraphQL type CycleConnection.
+type listCyclesTeamCyclesCycleConnection struct {
+	Nodes    []listCyclesTeamCyclesCycleConnectionNodesCycle `json:"nodes"`
+	PageInfo listCyclesTeamCyclesCycleConnectionPageInfo     `json:"pageInfo"`
+}
+
+// GetNodes returns listCyclesTeamCyclesCycleConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnection) GetNodes() []listCyclesTeamCyclesCycleConnectionNodesCycle {
+	return v.Nodes
+}
+
+// GetPageInfo returns listCyclesTeamCyclesCycleConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnection) GetPageInfo() listCyclesTeamCyclesCycleConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listCyclesTeamCyclesCycleConnectionNodesCycle includes the requested fields of the GraphQL type Cycle.
+// The GraphQL type's documentation follows.
+//
+// A set of issues to be resolved in a specified amount of time.
+type listCyclesTeamCyclesCycleConnectionNodesCycle struct {
+	CycleDetails `json:"-"`
+}
+
+// GetId returns listCyclesTeamCyclesCycleConnectionNodesCycle.Id, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnectionNodesCycle) GetId() string { return v.CycleDetails.Id }
+
+// GetNumber returns listCyclesTeamCyclesCycleConnectionNodesCycle.Number, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnectionNodesCycle) GetNumber() float64 {
+	return v.CycleDetails.Number
+}
+
+// GetName returns listCyclesTeamCyclesCycleConnectionNodesCycle.Name, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnectionNodesCycle) GetName() *string { return v.CycleDetails.Name }
+
+// GetDescription returns listCyclesTeamCyclesCycleConnectionNodesCycle.Description, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnectionNodesCycle) GetDescription() *string {
+	return v.CycleDetails.Description
+}
+
+// GetStartsAt returns listCyclesTeamCyclesCycleConnectionNodesCycle.StartsAt, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnectionNodesCycle) GetStartsAt() time.Time {
+	return v.CycleDetails.StartsAt
+}
+
+// GetEndsAt returns listCyclesTeamCyclesCycleConnectionNodesCycle.EndsAt, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnectionNodesCycle) GetEndsAt() time.Time {
+	return v.CycleDetails.EndsAt
+}
+
+// GetCompletedAt returns listCyclesTeamCyclesCycleConnectionNodesCycle.CompletedAt, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnectionNodesCycle) GetCompletedAt() *time.Time {
+	return v.CycleDetails.CompletedAt
+}
+
+// GetProgress returns listCyclesTeamCyclesCycleConnectionNodesCycle.Progress, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnectionNodesCycle) GetProgress() float64 {
+	return v.CycleDetails.Progress
+}
+
+func (v *listCyclesTeamCyclesCycleConnectionNodesCycle) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listCyclesTeamCyclesCycleConnectionNodesCycle
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listCyclesTeamCyclesCycleConnectionNodesCycle = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CycleDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistCyclesTeamCyclesCycleConnectionNodesCycle struct {
+	Id string `json:"id"`
+
+	Number float64 `json:"number"`
+
+	Name *string `json:"name"`
+
+	Description *string `json:"description"`
+
+	StartsAt time.Time `json:"startsAt"`
+
+	EndsAt time.Time `json:"endsAt"`
+
+	CompletedAt *time.Time `json:"completedAt"`
+
+	Progress float64 `json:"progress"`
+}
+
+func (v *listCyclesTeamCyclesCycleConnectionNodesCycle) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listCyclesTeamCyclesCycleConnectionNodesCycle) __premarshalJSON() (*__premarshallistCyclesTeamCyclesCycleConnectionNodesCycle, error) {
+	var retval __premarshallistCyclesTeamCyclesCycleConnectionNodesCycle
+
+	retval.Id = v.CycleDetails.Id
+	retval.Number = v.CycleDetails.Number
+	retval.Name = v.CycleDetails.Name
+	retval.Description = v.CycleDetails.Description
+	retval.StartsAt = v.CycleDetails.StartsAt
+	retval.EndsAt = v.CycleDetails.EndsAt
+	retval.CompletedAt = v.CycleDetails.CompletedAt
+	retval.Progress = v.CycleDetails.Progress
+	return &retval, nil
+}
+
+// listCyclesTeamCyclesCycleConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listCyclesTeamCyclesCycleConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listCyclesTeamCyclesCycleConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns listCyclesTeamCyclesCycleConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listCyclesTeamCyclesCycleConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// listGitAutomationStatesResponse is returned by listGitAutomationStates on success.
+type listGitAutomationStatesResponse struct {
+	// One specific team.
+	Team listGitAutomationStatesTeam `json:"team"`
+}
+
+// GetTeam returns listGitAutomationStatesResponse.Team, and is useful for accessing the field via an interface.
+func (v *listGitAutomationStatesResponse) GetTeam() listGitAutomationStatesTeam { return v.Team }
+
+// listGitAutomationStatesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type listGitAutomationStatesTeam struct {
+	// The Git automation states for the team.
+	GitAutomationStates listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnection `json:"gitAutomationStates"`
+}
+
+// GetGitAutomationStates returns listGitAutomationStatesTeam.GitAutomationStates, and is useful for accessing the field via an interface.
+func (v *listGitAutomationStatesTeam) GetGitAutomationStates() listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnection {
+	return v.GitAutomationStates
+}
+
+// listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnection includes the requested fields of the GraphQL type GitAutomationStateConnection.
+type listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnection struct {
+	Nodes    []listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState `json:"nodes"`
+	PageInfo listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionPageInfo                  `json:"pageInfo"`
+}
+
+// GetNodes returns listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnection) GetNodes() []listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState {
+	return v.Nodes
+}
+
+// GetPageInfo returns listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnection) GetPageInfo() listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState includes the requested fields of the GraphQL type GitAutomationState.
+// The GraphQL type's documentation follows.
+//
+// A trigger that updates the issue status according to Git automations.
+type listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState struct {
+	GitAutomationState `json:"-"`
+}
+
+// GetId returns listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState.Id, and is useful for accessing the field via an interface.
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState) GetId() string {
+	return v.GitAutomationState.Id
+}
+
+// GetEvent returns listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState.Event, and is useful for accessing the field via an interface.
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState) GetEvent() GitAutomationStates {
+	return v.GitAutomationState.Event
+}
+
+// GetState returns listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState.State, and is useful for accessing the field via an interface.
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState) GetState() *GitAutomationStateStateWorkflowState {
+	return v.GitAutomationState.State
+}
+
+// GetTeam returns listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState.Team, and is useful for accessing the field via an interface.
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState) GetTeam() GitAutomationStateTeam {
+	return v.GitAutomationState.Team
+}
+
+// GetTargetBranch returns listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState.TargetBranch, and is useful for accessing the field via an interface.
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState) GetTargetBranch() *GitAutomationStateTargetBranchGitAutomationTargetBranch {
+	return v.GitAutomationState.TargetBranch
+}
+
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.GitAutomationState)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState struct {
+	Id string `json:"id"`
+
+	Event GitAutomationStates `json:"event"`
+
+	State *GitAutomationStateStateWorkflowState `json:"state"`
+
+	Team GitAutomationStateTeam `json:"team"`
+
+	TargetBranch *GitAutomationStateTargetBranchGitAutomationTargetBranch `json:"targetBranch"`
+}
+
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState) __premarshalJSON() (*__premarshallistGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState, error) {
+	var retval __premarshallistGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionNodesGitAutomationState
+
+	retval.Id = v.GitAutomationState.Id
+	retval.Event = v.GitAutomationState.Event
+	retval.State = v.GitAutomationState.State
+	retval.Team = v.GitAutomationState.Team
+	retval.TargetBranch = v.GitAutomationState.TargetBranch
+	return &retval, nil
+}
+
+// listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listGitAutomationStatesTeamGitAutomationStatesGitAutomationStateConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listIssuesIssuesIssueConnection includes the requested fields of the GraphQL type IssueConnection.
+type listIssuesIssuesIssueConnection struct {
+	Nodes    []listIssuesIssuesIssueConnectionNodesIssue `json:"nodes"`
+	PageInfo listIssuesIssuesIssueConnectionPageInfo     `json:"pageInfo"`
+}
+
+// GetNodes returns listIssuesIssuesIssueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnection) GetNodes() []listIssuesIssuesIssueConnectionNodesIssue {
+	return v.Nodes
+}
+
+// GetPageInfo returns listIssuesIssuesIssueConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnection) GetPageInfo() listIssuesIssuesIssueConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listIssuesIssuesIssueConnectionNodesIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type listIssuesIssuesIssueConnectionNodesIssue struct {
+	IssueSummary `json:"-"`
+}
+
+// GetId returns listIssuesIssuesIssueConnectionNodesIssue.Id, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionNodesIssue) GetId() string { return v.IssueSummary.Id }
+
+// GetIdentifier returns listIssuesIssuesIssueConnectionNodesIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionNodesIssue) GetIdentifier() string {
+	return v.IssueSummary.Identifier
+}
+
+// GetTitle returns listIssuesIssuesIssueConnectionNodesIssue.Title, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionNodesIssue) GetTitle() string { return v.IssueSummary.Title }
+
+// GetPriority returns listIssuesIssuesIssueConnectionNodesIssue.Priority, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionNodesIssue) GetPriority() float64 {
+	return v.IssueSummary.Priority
+}
+
+// GetUrl returns listIssuesIssuesIssueConnectionNodesIssue.Url, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionNodesIssue) GetUrl() string { return v.IssueSummary.Url }
+
+// GetCreatedAt returns listIssuesIssuesIssueConnectionNodesIssue.CreatedAt, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionNodesIssue) GetCreatedAt() time.Time {
+	return v.IssueSummary.CreatedAt
+}
+
+// GetTeam returns listIssuesIssuesIssueConnectionNodesIssue.Team, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionNodesIssue) GetTeam() IssueSummaryTeam {
+	return v.IssueSummary.Team
+}
+
+// GetState returns listIssuesIssuesIssueConnectionNodesIssue.State, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionNodesIssue) GetState() IssueSummaryStateWorkflowState {
+	return v.IssueSummary.State
+}
+
+// GetAssignee returns listIssuesIssuesIssueConnectionNodesIssue.Assignee, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionNodesIssue) GetAssignee() *IssueSummaryAssigneeUser {
+	return v.IssueSummary.Assignee
+}
+
+// GetLabels returns listIssuesIssuesIssueConnectionNodesIssue.Labels, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionNodesIssue) GetLabels() IssueSummaryLabelsIssueLabelConnection {
+	return v.IssueSummary.Labels
+}
+
+func (v *listIssuesIssuesIssueConnectionNodesIssue) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listIssuesIssuesIssueConnectionNodesIssue
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listIssuesIssuesIssueConnectionNodesIssue = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueSummary)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistIssuesIssuesIssueConnectionNodesIssue struct {
+	Id string `json:"id"`
+
+	Identifier string `json:"identifier"`
+
+	Title string `json:"title"`
+
+	Priority float64 `json:"priority"`
+
+	Url string `json:"url"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	Team IssueSummaryTeam `json:"team"`
+
+	State IssueSummaryStateWorkflowState `json:"state"`
+
+	Assignee *IssueSummaryAssigneeUser `json:"assignee"`
+
+	Labels IssueSummaryLabelsIssueLabelConnection `json:"labels"`
+}
+
+func (v *listIssuesIssuesIssueConnectionNodesIssue) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listIssuesIssuesIssueConnectionNodesIssue) __premarshalJSON() (*__premarshallistIssuesIssuesIssueConnectionNodesIssue, error) {
+	var retval __premarshallistIssuesIssuesIssueConnectionNodesIssue
+
+	retval.Id = v.IssueSummary.Id
+	retval.Identifier = v.IssueSummary.Identifier
+	retval.Title = v.IssueSummary.Title
+	retval.Priority = v.IssueSummary.Priority
+	retval.Url = v.IssueSummary.Url
+	retval.CreatedAt = v.IssueSummary.CreatedAt
+	retval.Team = v.IssueSummary.Team
+	retval.State = v.IssueSummary.State
+	retval.Assignee = v.IssueSummary.Assignee
+	retval.Labels = v.IssueSummary.Labels
+	return &retval, nil
+}
+
+// listIssuesIssuesIssueConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listIssuesIssuesIssueConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listIssuesIssuesIssueConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns listIssuesIssuesIssueConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listIssuesIssuesIssueConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// listIssuesResponse is returned by listIssues on success.
+type listIssuesResponse struct {
+	// All issues.
+	Issues listIssuesIssuesIssueConnection `json:"issues"`
+}
+
+// GetIssues returns listIssuesResponse.Issues, and is useful for accessing the field via an interface.
+func (v *listIssuesResponse) GetIssues() listIssuesIssuesIssueConnection { return v.Issues }
+
+// listLabelsOfTeamIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type listLabelsOfTeamIssueLabelsIssueLabelConnection struct {
+	Nodes    []listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+	PageInfo listLabelsOfTeamIssueLabelsIssueLabelConnectionPageInfo          `json:"pageInfo"`
+}
+
+// GetNodes returns listLabelsOfTeamIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnection) GetNodes() []listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
+}
+
+// GetPageInfo returns listLabelsOfTeamIssueLabelsIssueLabelConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnection) GetPageInfo() listLabelsOfTeamIssueLabelsIssueLabelConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	IssueLabel `json:"-"`
+	// Whether this label is considered to be a group.
+	IsGroup bool `json:"isGroup"`
+}
+
+// GetIsGroup returns listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel.IsGroup, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel) GetIsGroup() bool {
+	return v.IsGroup
+}
+
+// GetId returns listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string {
+	return v.IssueLabel.Id
+}
+
+// GetName returns listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel) GetName() string {
+	return v.IssueLabel.Name
+}
+
+// GetDescription returns listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel) GetDescription() *string {
+	return v.IssueLabel.Description
+}
+
+// GetColor returns listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel) GetColor() *string {
+	return v.IssueLabel.Color
+}
+
+// GetParent returns listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel) GetParent() *IssueLabelParentIssueLabel {
+	return v.IssueLabel.Parent
+}
+
+// GetTeam returns listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel) GetTeam() *IssueLabelTeam {
+	return v.IssueLabel.Team
+}
+
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueLabel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	IsGroup bool `json:"isGroup"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+
+	Team *IssueLabelTeam `json:"team"`
+}
+
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel) __premarshalJSON() (*__premarshallistLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel, error) {
+	var retval __premarshallistLabelsOfTeamIssueLabelsIssueLabelConnectionNodesIssueLabel
+
+	retval.IsGroup = v.IsGroup
+	retval.Id = v.IssueLabel.Id
+	retval.Name = v.IssueLabel.Name
+	retval.Description = v.IssueLabel.Description
+	retval.Color = v.IssueLabel.Color
+	retval.Parent = v.IssueLabel.Parent
+	retval.Team = v.IssueLabel.Team
+	return &retval, nil
+}
+
+// listLabelsOfTeamIssueLabelsIssueLabelConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listLabelsOfTeamIssueLabelsIssueLabelConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listLabelsOfTeamIssueLabelsIssueLabelConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listLabelsOfTeamIssueLabelsIssueLabelConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamIssueLabelsIssueLabelConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listLabelsOfTeamResponse is returned by listLabelsOfTeam on success.
+type listLabelsOfTeamResponse struct {
+	// All issue labels.
+	IssueLabels listLabelsOfTeamIssueLabelsIssueLabelConnection `json:"issueLabels"`
+}
+
+// GetIssueLabels returns listLabelsOfTeamResponse.IssueLabels, and is useful for accessing the field via an interface.
+func (v *listLabelsOfTeamResponse) GetIssueLabels() listLabelsOfTeamIssueLabelsIssueLabelConnection {
+	return v.IssueLabels
+}
+
+// listProjectMembersProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type listProjectMembersProject struct {
+	// Users that are members of the project.
+	Members listProjectMembersProjectMembersUserConnection `json:"members"`
+}
+
+// GetMembers returns listProjectMembersProject.Members, and is useful for accessing the field via an interface.
+func (v *listProjectMembersProject) GetMembers() listProjectMembersProjectMembersUserConnection {
+	return v.Members
+}
+
+// listProjectMembersProjectMembersUserConnection includes the requested fields of the GraphQL type UserConnection.
+type listProjectMembersProjectMembersUserConnection struct {
+	Nodes    []listProjectMembersProjectMembersUserConnectionNodesUser `json:"nodes"`
+	PageInfo listProjectMembersProjectMembersUserConnectionPageInfo    `json:"pageInfo"`
+}
+
+// GetNodes returns listProjectMembersProjectMembersUserConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listProjectMembersProjectMembersUserConnection) GetNodes() []listProjectMembersProjectMembersUserConnectionNodesUser {
+	return v.Nodes
+}
+
+// GetPageInfo returns listProjectMembersProjectMembersUserConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listProjectMembersProjectMembersUserConnection) GetPageInfo() listProjectMembersProjectMembersUserConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listProjectMembersProjectMembersUserConnectionNodesUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type listProjectMembersProjectMembersUserConnectionNodesUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns listProjectMembersProjectMembersUserConnectionNodesUser.Id, and is useful for accessing the field via an interface.
+func (v *listProjectMembersProjectMembersUserConnectionNodesUser) GetId() string { return v.Id }
+
+// listProjectMembersProjectMembersUserConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listProjectMembersProjectMembersUserConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listProjectMembersProjectMembersUserConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listProjectMembersProjectMembersUserConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listProjectMembersProjectMembersUserConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listProjectMembersProjectMembersUserConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listProjectMembersResponse is returned by listProjectMembers on success.
+type listProjectMembersResponse struct {
+	// One specific project.
+	Project listProjectMembersProject `json:"project"`
+}
+
+// GetProject returns listProjectMembersResponse.Project, and is useful for accessing the field via an interface.
+func (v *listProjectMembersResponse) GetProject() listProjectMembersProject { return v.Project }
+
+// listProjectStatusesOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization. Organizations are root-level objects that contain user accounts and teams.
+type listProjectStatusesOrganization struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The organization's project statuses.
+	ProjectStatuses []listProjectStatusesOrganizationProjectStatusesProjectStatus `json:"projectStatuses"`
+}
+
+// GetId returns listProjectStatusesOrganization.Id, and is useful for accessing the field via an interface.
+func (v *listProjectStatusesOrganization) GetId() string { return v.Id }
+
+// GetProjectStatuses returns listProjectStatusesOrganization.ProjectStatuses, and is useful for accessing the field via an interface.
+func (v *listProjectStatusesOrganization) GetProjectStatuses() []listProjectStatusesOrganizationProjectStatusesProjectStatus {
+	return v.ProjectStatuses
+}
+
+// listProjectStatusesOrganizationProjectStatusesProjectStatus includes the requested fields of the GraphQL type ProjectStatus.
+// The GraphQL type's documentation follows.
+//
+// A project status.
+type listProjectStatusesOrganizationProjectStatusesProjectStatus struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The name of the status.
+	Name string `json:"name"`
+	// The UI color of the status as a HEX string.
+	Color string `json:"color"`
+	// Description of the status.
+	Description *string `json:"description"`
+	// The position of the status in the workspace's project flow.
+	Position float64 `json:"position"`
+	// The type of the project status.
+	Type ProjectStatusType `json:"type"`
+	// Whether or not a project can be in this status indefinitely.
+	Indefinite bool `json:"indefinite"`
+}
+
+// GetId returns listProjectStatusesOrganizationProjectStatusesProjectStatus.Id, and is useful for accessing the field via an interface.
+func (v *listProjectStatusesOrganizationProjectStatusesProjectStatus) GetId() string { return v.Id }
+
+// GetName returns listProjectStatusesOrganizationProjectStatusesProjectStatus.Name, and is useful for accessing the field via an interface.
+func (v *listProjectStatusesOrganizationProjectStatusesProjectStatus) GetName() string { return v.Name }
+
+// GetColor returns listProjectStatusesOrganizationProjectStatusesProjectStatus.Color, and is useful for accessing the field via an interface.
+func (v *listProjectStatusesOrganizationProjectStatusesProjectStatus) GetColor() string {
+	return v.Color
+}
+
+// GetDescription returns listProjectStatusesOrganizationProjectStatusesProjectStatus.Description, and is useful for accessing the field via an interface.
+func (v *listProjectStatusesOrganizationProjectStatusesProjectStatus) GetDescription() *string {
+	return v.Description
+}
+
+// GetPosition returns listProjectStatusesOrganizationProjectStatusesProjectStatus.Position, and is useful for accessing the field via an interface.
+func (v *listProjectStatusesOrganizationProjectStatusesProjectStatus) GetPosition() float64 {
+	return v.Position
+}
+
+// GetType returns listProjectStatusesOrganizationProjectStatusesProjectStatus.Type, and is useful for accessing the field via an interface.
+func (v *listProjectStatusesOrganizationProjectStatusesProjectStatus) GetType() ProjectStatusType {
+	return v.Type
+}
+
+// GetIndefinite returns listProjectStatusesOrganizationProjectStatusesProjectStatus.Indefinite, and is useful for accessing the field via an interface.
+func (v *listProjectStatusesOrganizationProjectStatusesProjectStatus) GetIndefinite() bool {
+	return v.Indefinite
+}
+
+// listProjectStatusesResponse is returned by listProjectStatuses on success.
+type listProjectStatusesResponse struct {
+	// The user's organization.
+	Organization listProjectStatusesOrganization `json:"organization"`
+}
+
+// GetOrganization returns listProjectStatusesResponse.Organization, and is useful for accessing the field via an interface.
+func (v *listProjectStatusesResponse) GetOrganization() listProjectStatusesOrganization {
+	return v.Organization
+}
+
+// listProjectsProjectsProjectConnection includes the requested fields of the GraphQL type ProjectConnection.
+type listProjectsProjectsProjectConnection struct {
+	Nodes    []listProjectsProjectsProjectConnectionNodesProject `json:"nodes"`
+	PageInfo listProjectsProjectsProjectConnectionPageInfo       `json:"pageInfo"`
+}
+
+// GetNodes returns listProjectsProjectsProjectConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnection) GetNodes() []listProjectsProjectsProjectConnectionNodesProject {
+	return v.Nodes
+}
+
+// GetPageInfo returns listProjectsProjectsProjectConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnection) GetPageInfo() listProjectsProjectsProjectConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listProjectsProjectsProjectConnectionNodesProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type listProjectsProjectsProjectConnectionNodesProject struct {
+	ProjectDetails `json:"-"`
+}
+
+// GetId returns listProjectsProjectsProjectConnectionNodesProject.Id, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetId() string {
+	return v.ProjectDetails.Id
+}
+
+// GetSlugId returns listProjectsProjectsProjectConnectionNodesProject.SlugId, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetSlugId() string {
+	return v.ProjectDetails.SlugId
+}
+
+// GetName returns listProjectsProjectsProjectConnectionNodesProject.Name, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetName() string {
+	return v.ProjectDetails.Name
+}
+
+// GetDescription returns listProjectsProjectsProjectConnectionNodesProject.Description, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetDescription() string {
+	return v.ProjectDetails.Description
+}
+
+// GetIcon returns listProjectsProjectsProjectConnectionNodesProject.Icon, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetIcon() *string {
+	return v.ProjectDetails.Icon
+}
+
+// GetColor returns listProjectsProjectsProjectConnectionNodesProject.Color, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetColor() string {
+	return v.ProjectDetails.Color
+}
+
+// GetPriority returns listProjectsProjectsProjectConnectionNodesProject.Priority, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetPriority() int {
+	return v.ProjectDetails.Priority
+}
+
+// GetProgress returns listProjectsProjectsProjectConnectionNodesProject.Progress, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetProgress() float64 {
+	return v.ProjectDetails.Progress
+}
+
+// GetStartDate returns listProjectsProjectsProjectConnectionNodesProject.StartDate, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetStartDate() *string {
+	return v.ProjectDetails.StartDate
+}
+
+// GetTargetDate returns listProjectsProjectsProjectConnectionNodesProject.TargetDate, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetTargetDate() *string {
+	return v.ProjectDetails.TargetDate
+}
+
+// GetUrl returns listProjectsProjectsProjectConnectionNodesProject.Url, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetUrl() string {
+	return v.ProjectDetails.Url
+}
+
+// GetStatus returns listProjectsProjectsProjectConnectionNodesProject.Status, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetStatus() ProjectDetailsStatusProjectStatus {
+	return v.ProjectDetails.Status
+}
+
+// GetLead returns listProjectsProjectsProjectConnectionNodesProject.Lead, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetLead() *ProjectDetailsLeadUser {
+	return v.ProjectDetails.Lead
+}
+
+// GetTeams returns listProjectsProjectsProjectConnectionNodesProject.Teams, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionNodesProject) GetTeams() ProjectDetailsTeamsTeamConnection {
+	return v.ProjectDetails.Teams
+}
+
+func (v *listProjectsProjectsProjectConnectionNodesProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listProjectsProjectsProjectConnectionNodesProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listProjectsProjectsProjectConnectionNodesProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistProjectsProjectsProjectConnectionNodesProject struct {
+	Id string `json:"id"`
+
+	SlugId string `json:"slugId"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color string `json:"color"`
+
+	Priority int `json:"priority"`
+
+	Progress float64 `json:"progress"`
+
+	StartDate *string `json:"startDate"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Url string `json:"url"`
+
+	Status ProjectDetailsStatusProjectStatus `json:"status"`
+
+	Lead *ProjectDetailsLeadUser `json:"lead"`
+
+	Teams ProjectDetailsTeamsTeamConnection `json:"teams"`
+}
+
+func (v *listProjectsProjectsProjectConnectionNodesProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listProjectsProjectsProjectConnectionNodesProject) __premarshalJSON() (*__premarshallistProjectsProjectsProjectConnectionNodesProject, error) {
+	var retval __premarshallistProjectsProjectsProjectConnectionNodesProject
+
+	retval.Id = v.ProjectDetails.Id
+	retval.SlugId = v.ProjectDetails.SlugId
+	retval.Name = v.ProjectDetails.Name
+	retval.Description = v.ProjectDetails.Description
+	retval.Icon = v.ProjectDetails.Icon
+	retval.Color = v.ProjectDetails.Color
+	retval.Priority = v.ProjectDetails.Priority
+	retval.Progress = v.ProjectDetails.Progress
+	retval.StartDate = v.ProjectDetails.StartDate
+	retval.TargetDate = v.ProjectDetails.TargetDate
+	retval.Url = v.ProjectDetails.Url
+	retval.Status = v.ProjectDetails.Status
+	retval.Lead = v.ProjectDetails.Lead
+	retval.Teams = v.ProjectDetails.Teams
+	return &retval, nil
+}
+
+// listProjectsProjectsProjectConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listProjectsProjectsProjectConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listProjectsProjectsProjectConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns listProjectsProjectsProjectConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listProjectsProjectsProjectConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// listProjectsResponse is returned by listProjects on success.
+type listProjectsResponse struct {
+	// All projects.
+	Projects listProjectsProjectsProjectConnection `json:"projects"`
+}
+
+// GetProjects returns listProjectsResponse.Projects, and is useful for accessing the field via an interface.
+func (v *listProjectsResponse) GetProjects() listProjectsProjectsProjectConnection { return v.Projects }
+
+// listRoadmapToProjectsResponse is returned by listRoadmapToProjects on success.
+type listRoadmapToProjectsResponse struct {
+	// Custom views for the user.
+	RoadmapToProjects listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnection `json:"roadmapToProjects"`
+}
+
+// GetRoadmapToProjects returns listRoadmapToProjectsResponse.RoadmapToProjects, and is useful for accessing the field via an interface.
+func (v *listRoadmapToProjectsResponse) GetRoadmapToProjects() listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnection {
+	return v.RoadmapToProjects
+}
+
+// listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnection includes the requested fields of the GraphQL type RoadmapToProjectConnection.
+type listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnection struct {
+	Nodes    []listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject `json:"nodes"`
+	PageInfo listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionPageInfo                `json:"pageInfo"`
+}
+
+// GetNodes returns listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnection) GetNodes() []listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject {
+	return v.Nodes
+}
+
+// GetPageInfo returns listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnection) GetPageInfo() listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject includes the requested fields of the GraphQL type RoadmapToProject.
+// The GraphQL type's documentation follows.
+//
+// Join table between projects and roadmaps.
+type listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The sort order of the project within the roadmap.
+	SortOrder string `json:"sortOrder"`
+	// The project that the roadmap is associated with.
+	Project listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectProject `json:"project"`
+	// The roadmap that the project is associated with.
+	Roadmap listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectRoadmap `json:"roadmap"`
+}
+
+// GetId returns listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject.Id, and is useful for accessing the field via an interface.
+func (v *listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject) GetId() string {
+	return v.Id
+}
+
+// GetSortOrder returns listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject.SortOrder, and is useful for accessing the field via an interface.
+func (v *listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject) GetSortOrder() string {
+	return v.SortOrder
+}
+
+// GetProject returns listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject.Project, and is useful for accessing the field via an interface.
+func (v *listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject) GetProject() listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectProject {
+	return v.Project
+}
+
+// GetRoadmap returns listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject.Roadmap, and is useful for accessing the field via an interface.
+func (v *listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProject) GetRoadmap() listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectRoadmap {
+	return v.Roadmap
+}
+
+// listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectProject struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectProject.Id, and is useful for accessing the field via an interface.
+func (v *listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectProject) GetId() string {
+	return v.Id
+}
+
+// listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectRoadmap includes the requested fields of the GraphQL type Roadmap.
+// The GraphQL type's documentation follows.
+//
+// A roadmap for projects.
+type listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectRoadmap struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectRoadmap.Id, and is useful for accessing the field via an interface.
+func (v *listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionNodesRoadmapToProjectRoadmap) GetId() string {
+	return v.Id
+}
+
+// listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listRoadmapToProjectsRoadmapToProjectsRoadmapToProjectConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listTeamLabelsIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type listTeamLabelsIssueLabelsIssueLabelConnection struct {
+	Nodes []listTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+}
+
+// GetNodes returns listTeamLabelsIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listTeamLabelsIssueLabelsIssueLabelConnection) GetNodes() []listTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
+}
+
+// listTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type listTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The label's name.
+	Name string `json:"name"`
+	// The label's color as a HEX string.
+	Color string `json:"color"`
+}
+
+// GetId returns listTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *listTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string { return v.Id }
+
+// GetName returns listTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *listTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetName() string {
+	return v.Name
+}
+
+// GetColor returns listTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *listTeamLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetColor() string {
+	return v.Color
+}
+
+// listTeamLabelsResponse is returned by listTeamLabels on success.
+type listTeamLabelsResponse struct {
+	// All issue labels.
+	IssueLabels listTeamLabelsIssueLabelsIssueLabelConnection `json:"issueLabels"`
+}
+
+// GetIssueLabels returns listTeamLabelsResponse.IssueLabels, and is useful for accessing the field via an interface.
+func (v *listTeamLabelsResponse) GetIssueLabels() listTeamLabelsIssueLabelsIssueLabelConnection {
+	return v.IssueLabels
+}
+
+// listTeamsResponse is returned by listTeams on success.
+type listTeamsResponse struct {
+	// All teams whose issues can be accessed by the user. This might be different
+	// from `administrableTeams`, which also includes teams whose settings can be
+	// changed by the user.
+	Teams listTeamsTeamsTeamConnection `json:"teams"`
+}
+
+// GetTeams returns listTeamsResponse.Teams, and is useful for accessing the field via an interface.
+func (v *listTeamsResponse) GetTeams() listTeamsTeamsTeamConnection { return v.Teams }
+
+// listTeamsTeamsTeamConnection includes the requested fields of the GraphQL type TeamConnection.
+type listTeamsTeamsTeamConnection struct {
+	Nodes    []listTeamsTeamsTeamConnectionNodesTeam `json:"nodes"`
+	PageInfo listTeamsTeamsTeamConnectionPageInfo    `json:"pageInfo"`
+}
+
+// GetNodes returns listTeamsTeamsTeamConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listTeamsTeamsTeamConnection) GetNodes() []listTeamsTeamsTeamConnectionNodesTeam {
+	return v.Nodes
+}
+
+// GetPageInfo returns listTeamsTeamsTeamConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listTeamsTeamsTeamConnection) GetPageInfo() listTeamsTeamsTeamConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listTeamsTeamsTeamConnectionNodesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type listTeamsTeamsTeamConnectionNodesTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team's unique key. The key is used in URLs.
+	Key string `json:"key"`
+	// The team's name.
+	Name string `json:"name"`
+	// Whether the team is private or not.
+	Private bool `json:"private"`
+}
+
+// GetId returns listTeamsTeamsTeamConnectionNodesTeam.Id, and is useful for accessing the field via an interface.
+func (v *listTeamsTeamsTeamConnectionNodesTeam) GetId() string { return v.Id }
+
+// GetKey returns listTeamsTeamsTeamConnectionNodesTeam.Key, and is useful for accessing the field via an interface.
+func (v *listTeamsTeamsTeamConnectionNodesTeam) GetKey() string { return v.Key }
+
+// GetName returns listTeamsTeamsTeamConnectionNodesTeam.Name, and is useful for accessing the field via an interface.
+func (v *listTeamsTeamsTeamConnectionNodesTeam) GetName() string { return v.Name }
+
+// GetPrivate returns listTeamsTeamsTeamConnectionNodesTeam.Private, and is useful for accessing the field via an interface.
+func (v *listTeamsTeamsTeamConnectionNodesTeam) GetPrivate() bool { return v.Private }
+
+// listTeamsTeamsTeamConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listTeamsTeamsTeamConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listTeamsTeamsTeamConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listTeamsTeamsTeamConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns listTeamsTeamsTeamConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listTeamsTeamsTeamConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// listTemplatesResponse is returned by listTemplates on success.
+type listTemplatesResponse struct {
+	// All templates from all users.
+	Templates []listTemplatesTemplatesTemplate `json:"templates"`
+}
+
+// GetTemplates returns listTemplatesResponse.Templates, and is useful for accessing the field via an interface.
+func (v *listTemplatesResponse) GetTemplates() []listTemplatesTemplatesTemplate { return v.Templates }
+
+// listTemplatesTemplatesTemplate includes the requested fields of the GraphQL type Template.
+// The GraphQL type's documentation follows.
+//
+// A template object used for creating entities faster.
+type listTemplatesTemplatesTemplate struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The name of the template.
+	Name string `json:"name"`
+	// The entity type this template is for.
+	Type string `json:"type"`
+	// The team that the template is associated with. If null, the template is global to the workspace.
+	Team listTemplatesTemplatesTemplateTeam `json:"team"`
+}
+
+// GetId returns listTemplatesTemplatesTemplate.Id, and is useful for accessing the field via an interface.
+func (v *listTemplatesTemplatesTemplate) GetId() string { return v.Id }
+
+// GetName returns listTemplatesTemplatesTemplate.Name, and is useful for accessing the field via an interface.
+func (v *listTemplatesTemplatesTemplate) GetName() string { return v.Name }
+
+// GetType returns listTemplatesTemplatesTemplate.Type, and is useful for accessing the field via an interface.
+func (v *listTemplatesTemplatesTemplate) GetType() string { return v.Type }
+
+// GetTeam returns listTemplatesTemplatesTemplate.Team, and is useful for accessing the field via an interface.
+func (v *listTemplatesTemplatesTemplate) GetTeam() listTemplatesTemplatesTemplateTeam { return v.Team }
+
+// listTemplatesTemplatesTemplateTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type listTemplatesTemplatesTemplateTeam struct {
+	// The team's unique key. The key is used in URLs.
+	Key string `json:"key"`
+}
+
+// GetKey returns listTemplatesTemplatesTemplateTeam.Key, and is useful for accessing the field via an interface.
+func (v *listTemplatesTemplatesTemplateTeam) GetKey() string { return v.Key }
+
+// listWebhooksResponse is returned by listWebhooks on success.
+type listWebhooksResponse struct {
+	// All webhooks.
+	Webhooks listWebhooksWebhooksWebhookConnection `json:"webhooks"`
+}
+
+// GetWebhooks returns listWebhooksResponse.Webhooks, and is useful for accessing the field via an interface.
+func (v *listWebhooksResponse) GetWebhooks() listWebhooksWebhooksWebhookConnection { return v.Webhooks }
+
+// listWebhooksWebhooksWebhookConnection includes the requested fields of the GraphQL type WebhookConnection.
+type listWebhooksWebhooksWebhookConnection struct {
+	Nodes    []listWebhooksWebhooksWebhookConnectionNodesWebhook `json:"nodes"`
+	PageInfo listWebhooksWebhooksWebhookConnectionPageInfo       `json:"pageInfo"`
+}
+
+// GetNodes returns listWebhooksWebhooksWebhookConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnection) GetNodes() []listWebhooksWebhooksWebhookConnectionNodesWebhook {
+	return v.Nodes
+}
+
+// GetPageInfo returns listWebhooksWebhooksWebhookConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnection) GetPageInfo() listWebhooksWebhooksWebhookConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listWebhooksWebhooksWebhookConnectionNodesWebhook includes the requested fields of the GraphQL type Webhook.
+// The GraphQL type's documentation follows.
+//
+// A webhook used to send HTTP notifications over data updates.
+type listWebhooksWebhooksWebhookConnectionNodesWebhook struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Webhook label.
+	Label *string `json:"label"`
+	// Webhook URL.
+	Url *string `json:"url"`
+	// Whether the Webhook is enabled.
+	Enabled bool `json:"enabled"`
+	// The team that the webhook is associated with. If null, the webhook is
+	// associated with all public teams of the organization.
+	Team *listWebhooksWebhooksWebhookConnectionNodesWebhookTeam `json:"team"`
+	// Whether the Webhook is enabled for all public teams, including teams created after the webhook was created.
+	AllPublicTeams bool `json:"allPublicTeams"`
+	// The resource types this webhook is subscribed to.
+	ResourceTypes []string `json:"resourceTypes"`
+}
+
+// GetId returns listWebhooksWebhooksWebhookConnectionNodesWebhook.Id, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnectionNodesWebhook) GetId() string { return v.Id }
+
+// GetLabel returns listWebhooksWebhooksWebhookConnectionNodesWebhook.Label, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnectionNodesWebhook) GetLabel() *string { return v.Label }
+
+// GetUrl returns listWebhooksWebhooksWebhookConnectionNodesWebhook.Url, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnectionNodesWebhook) GetUrl() *string { return v.Url }
+
+// GetEnabled returns listWebhooksWebhooksWebhookConnectionNodesWebhook.Enabled, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnectionNodesWebhook) GetEnabled() bool { return v.Enabled }
+
+// GetTeam returns listWebhooksWebhooksWebhookConnectionNodesWebhook.Team, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnectionNodesWebhook) GetTeam() *listWebhooksWebhooksWebhookConnectionNodesWebhookTeam {
+	return v.Team
+}
+
+// GetAllPublicTeams returns listWebhooksWebhooksWebhookConnectionNodesWebhook.AllPublicTeams, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnectionNodesWebhook) GetAllPublicTeams() bool {
+	return v.AllPublicTeams
+}
+
+// GetResourceTypes returns listWebhooksWebhooksWebhookConnectionNodesWebhook.ResourceTypes, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnectionNodesWebhook) GetResourceTypes() []string {
+	return v.ResourceTypes
+}
+
+// listWebhooksWebhooksWebhookConnectionNodesWebhookTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type listWebhooksWebhooksWebhookConnectionNodesWebhookTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns listWebhooksWebhooksWebhookConnectionNodesWebhookTeam.Id, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnectionNodesWebhookTeam) GetId() string { return v.Id }
+
+// listWebhooksWebhooksWebhookConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listWebhooksWebhooksWebhookConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listWebhooksWebhooksWebhookConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns listWebhooksWebhooksWebhookConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listWebhooksWebhooksWebhookConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// listWorkspaceLabelsIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type listWorkspaceLabelsIssueLabelsIssueLabelConnection struct {
+	Nodes    []listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+	PageInfo listWorkspaceLabelsIssueLabelsIssueLabelConnectionPageInfo          `json:"pageInfo"`
+}
+
+// GetNodes returns listWorkspaceLabelsIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnection) GetNodes() []listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
+}
+
+// GetPageInfo returns listWorkspaceLabelsIssueLabelsIssueLabelConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnection) GetPageInfo() listWorkspaceLabelsIssueLabelsIssueLabelConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	IssueLabel `json:"-"`
+	// Whether this label is considered to be a group.
+	IsGroup bool `json:"isGroup"`
+}
+
+// GetIsGroup returns listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.IsGroup, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetIsGroup() bool {
+	return v.IsGroup
+}
+
+// GetId returns listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string {
+	return v.IssueLabel.Id
+}
+
+// GetName returns listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetName() string {
+	return v.IssueLabel.Name
+}
+
+// GetDescription returns listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetDescription() *string {
+	return v.IssueLabel.Description
+}
+
+// GetColor returns listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetColor() *string {
+	return v.IssueLabel.Color
+}
+
+// GetParent returns listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetParent() *IssueLabelParentIssueLabel {
+	return v.IssueLabel.Parent
+}
+
+// GetTeam returns listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetTeam() *IssueLabelTeam {
+	return v.IssueLabel.Team
+}
+
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueLabel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	IsGroup bool `json:"isGroup"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+
+	Team *IssueLabelTeam `json:"team"`
+}
+
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) __premarshalJSON() (*__premarshallistWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel, error) {
+	var retval __premarshallistWorkspaceLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel
+
+	retval.IsGroup = v.IsGroup
+	retval.Id = v.IssueLabel.Id
+	retval.Name = v.IssueLabel.Name
+	retval.Description = v.IssueLabel.Description
+	retval.Color = v.IssueLabel.Color
+	retval.Parent = v.IssueLabel.Parent
+	retval.Team = v.IssueLabel.Team
+	return &retval, nil
+}
+
+// listWorkspaceLabelsIssueLabelsIssueLabelConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listWorkspaceLabelsIssueLabelsIssueLabelConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listWorkspaceLabelsIssueLabelsIssueLabelConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listWorkspaceLabelsIssueLabelsIssueLabelConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsIssueLabelsIssueLabelConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listWorkspaceLabelsResponse is returned by listWorkspaceLabels on success.
+type listWorkspaceLabelsResponse struct {
+	// All issue labels.
+	IssueLabels listWorkspaceLabelsIssueLabelsIssueLabelConnection `json:"issueLabels"`
+}
+
+// GetIssueLabels returns listWorkspaceLabelsResponse.IssueLabels, and is useful for accessing the field via an interface.
+func (v *listWorkspaceLabelsResponse) GetIssueLabels() listWorkspaceLabelsIssueLabelsIssueLabelConnection {
+	return v.IssueLabels
+}
+
+// moveIssueToWorkflowStateIssueUpdateIssuePayload includes the requested fields of the GraphQL type IssuePayload.
+type moveIssueToWorkflowStateIssueUpdateIssuePayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns moveIssueToWorkflowStateIssueUpdateIssuePayload.Success, and is useful for accessing the field via an interface.
+func (v *moveIssueToWorkflowStateIssueUpdateIssuePayload) GetSuccess() bool { return v.Success }
+
+// moveIssueToWorkflowStateResponse is returned by moveIssueToWorkflowState on success.
+type moveIssueToWorkflowStateResponse struct {
+	// Updates an issue.
+	IssueUpdate moveIssueToWorkflowStateIssueUpdateIssuePayload `json:"issueUpdate"`
+}
+
+// GetIssueUpdate returns moveIssueToWorkflowStateResponse.IssueUpdate, and is useful for accessing the field via an interface.
+func (v *moveIssueToWorkflowStateResponse) GetIssueUpdate() moveIssueToWorkflowStateIssueUpdateIssuePayload {
+	return v.IssueUpdate
+}
+
+// setTeamAutoCloseStateResponse is returned by setTeamAutoCloseState on success.
+type setTeamAutoCloseStateResponse struct {
+	// Updates a team.
+	TeamUpdate setTeamAutoCloseStateTeamUpdateTeamPayload `json:"teamUpdate"`
+}
+
+// GetTeamUpdate returns setTeamAutoCloseStateResponse.TeamUpdate, and is useful for accessing the field via an interface.
+func (v *setTeamAutoCloseStateResponse) GetTeamUpdate() setTeamAutoCloseStateTeamUpdateTeamPayload {
+	return v.TeamUpdate
+}
+
+// setTeamAutoCloseStateTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
+type setTeamAutoCloseStateTeamUpdateTeamPayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns setTeamAutoCloseStateTeamUpdateTeamPayload.Success, and is useful for accessing the field via an interface.
+func (v *setTeamAutoCloseStateTeamUpdateTeamPayload) GetSuccess() bool { return v.Success }
+
+// setTeamDefaultIssueStateResponse is returned by setTeamDefaultIssueState on success.
+type setTeamDefaultIssueStateResponse struct {
+	// Updates a team.
+	TeamUpdate setTeamDefaultIssueStateTeamUpdateTeamPayload `json:"teamUpdate"`
+}
+
+// GetTeamUpdate returns setTeamDefaultIssueStateResponse.TeamUpdate, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultIssueStateResponse) GetTeamUpdate() setTeamDefaultIssueStateTeamUpdateTeamPayload {
+	return v.TeamUpdate
+}
+
+// setTeamDefaultIssueStateTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
+type setTeamDefaultIssueStateTeamUpdateTeamPayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns setTeamDefaultIssueStateTeamUpdateTeamPayload.Success, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultIssueStateTeamUpdateTeamPayload) GetSuccess() bool { return v.Success }
+
+// setTeamDefaultTemplatesResponse is returned by setTeamDefaultTemplates on success.
+type setTeamDefaultTemplatesResponse struct {
+	// Updates a team.
+	TeamUpdate setTeamDefaultTemplatesTeamUpdateTeamPayload `json:"teamUpdate"`
+}
+
+// GetTeamUpdate returns setTeamDefaultTemplatesResponse.TeamUpdate, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesResponse) GetTeamUpdate() setTeamDefaultTemplatesTeamUpdateTeamPayload {
+	return v.TeamUpdate
+}
+
+// setTeamDefaultTemplatesTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
+type setTeamDefaultTemplatesTeamUpdateTeamPayload struct {
+	// The team that was created or updated.
+	Team setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam `json:"team"`
+}
+
+// GetTeam returns setTeamDefaultTemplatesTeamUpdateTeamPayload.Team, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayload) GetTeam() setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam {
+	return v.Team
+}
+
+// setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam struct {
+	Team `json:"-"`
+}
+
+// GetId returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetId() string { return v.Team.Id }
+
+// GetName returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.Name, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetName() string { return v.Team.Name }
+
+// GetKey returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetKey() string { return v.Team.Key }
+
+// GetPrivate returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.Private, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetPrivate() bool { return v.Team.Private }
+
+// GetJoinByDefault returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.JoinByDefault, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetJoinByDefault() *bool {
+	return v.Team.JoinByDefault
+}
+
+// GetDescription returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.Description, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetDescription() *string {
+	return v.Team.Description
+}
+
+// GetIcon returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.Icon, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetIcon() *string { return v.Team.Icon }
+
+// GetColor returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.Color, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetColor() *string { return v.Team.Color }
+
+// GetTimezone returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.Timezone, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetTimezone() string {
+	return v.Team.Timezone
+}
+
+// GetIssueOrderingNoPriorityFirst returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetIssueOrderingNoPriorityFirst() bool {
+	return v.Team.IssueOrderingNoPriorityFirst
+}
+
+// GetGroupIssueHistory returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetGroupIssueHistory() bool {
+	return v.Team.GroupIssueHistory
+}
+
+// GetSetIssueSortOrderOnStateChange returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetSetIssueSortOrderOnStateChange() string {
+	return v.Team.SetIssueSortOrderOnStateChange
+}
+
+// GetAutoArchivePeriod returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetAutoArchivePeriod() float64 {
+	return v.Team.AutoArchivePeriod
+}
+
+// GetAutoClosePeriod returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetAutoClosePeriod() *float64 {
+	return v.Team.AutoClosePeriod
+}
+
+// GetTriageEnabled returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.TriageEnabled, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetTriageEnabled() bool {
+	return v.Team.TriageEnabled
+}
+
+// GetRequirePriorityToLeaveTriage returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetRequirePriorityToLeaveTriage() bool {
+	return v.Team.RequirePriorityToLeaveTriage
+}
+
+// GetCyclesEnabled returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetCyclesEnabled() bool {
+	return v.Team.CyclesEnabled
+}
+
+// GetCycleStartDay returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.CycleStartDay, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetCycleStartDay() float64 {
+	return v.Team.CycleStartDay
+}
+
+// GetCycleDuration returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.CycleDuration, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetCycleDuration() float64 {
+	return v.Team.CycleDuration
+}
+
+// GetCycleCooldownTime returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetCycleCooldownTime() float64 {
+	return v.Team.CycleCooldownTime
+}
+
+// GetUpcomingCycleCount returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetUpcomingCycleCount() float64 {
+	return v.Team.UpcomingCycleCount
+}
+
+// GetCycleIssueAutoAssignStarted returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignStarted() bool {
+	return v.Team.CycleIssueAutoAssignStarted
+}
+
+// GetCycleIssueAutoAssignCompleted returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignCompleted() bool {
+	return v.Team.CycleIssueAutoAssignCompleted
+}
+
+// GetCycleLockToActive returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.CycleLockToActive, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetCycleLockToActive() bool {
+	return v.Team.CycleLockToActive
+}
+
+// GetIssueEstimationType returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetIssueEstimationType() string {
+	return v.Team.IssueEstimationType
+}
+
+// GetIssueEstimationAllowZero returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetIssueEstimationAllowZero() bool {
+	return v.Team.IssueEstimationAllowZero
+}
+
+// GetIssueEstimationExtended returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetIssueEstimationExtended() bool {
+	return v.Team.IssueEstimationExtended
+}
+
+// GetDefaultIssueEstimate returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetDefaultIssueEstimate() float64 {
+	return v.Team.DefaultIssueEstimate
+}
+
+// GetDefaultTemplateForMembers returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.DefaultTemplateForMembers, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetDefaultTemplateForMembers() *TeamDefaultTemplateForMembersTemplate {
+	return v.Team.DefaultTemplateForMembers
+}
+
+// GetDefaultTemplateForNonMembers returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.DefaultTemplateForNonMembers, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetDefaultTemplateForNonMembers() *TeamDefaultTemplateForNonMembersTemplate {
+	return v.Team.DefaultTemplateForNonMembers
+}
+
+// GetDefaultProjectTemplate returns setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam.DefaultProjectTemplate, and is useful for accessing the field via an interface.
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) GetDefaultProjectTemplate() *TeamDefaultProjectTemplate {
+	return v.Team.DefaultProjectTemplate
+}
+
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Team)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalsetTeamDefaultTemplatesTeamUpdateTeamPayloadTeam struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Key string `json:"key"`
+
+	Private bool `json:"private"`
+
+	JoinByDefault *bool `json:"joinByDefault"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Timezone string `json:"timezone"`
+
+	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
+
+	GroupIssueHistory bool `json:"groupIssueHistory"`
+
+	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+
+	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+
+	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+
+	TriageEnabled bool `json:"triageEnabled"`
+
+	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
+
+	CyclesEnabled bool `json:"cyclesEnabled"`
+
+	CycleStartDay float64 `json:"cycleStartDay"`
+
+	CycleDuration float64 `json:"cycleDuration"`
+
+	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+
+	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+
+	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+
+	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+
+	CycleLockToActive bool `json:"cycleLockToActive"`
+
+	IssueEstimationType string `json:"issueEstimationType"`
+
+	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+
+	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+
+	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+
+	DefaultTemplateForMembers *TeamDefaultTemplateForMembersTemplate `json:"defaultTemplateForMembers"`
+
+	DefaultTemplateForNonMembers *TeamDefaultTemplateForNonMembersTemplate `json:"defaultTemplateForNonMembers"`
+
+	DefaultProjectTemplate *TeamDefaultProjectTemplate `json:"defaultProjectTemplate"`
+}
+
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *setTeamDefaultTemplatesTeamUpdateTeamPayloadTeam) __premarshalJSON() (*__premarshalsetTeamDefaultTemplatesTeamUpdateTeamPayloadTeam, error) {
+	var retval __premarshalsetTeamDefaultTemplatesTeamUpdateTeamPayloadTeam
+
+	retval.Id = v.Team.Id
+	retval.Name = v.Team.Name
+	retval.Key = v.Team.Key
+	retval.Private = v.Team.Private
+	retval.JoinByDefault = v.Team.JoinByDefault
+	retval.Description = v.Team.Description
+	retval.Icon = v.Team.Icon
+	retval.Color = v.Team.Color
+	retval.Timezone = v.Team.Timezone
+	retval.IssueOrderingNoPriorityFirst = v.Team.IssueOrderingNoPriorityFirst
+	retval.GroupIssueHistory = v.Team.GroupIssueHistory
+	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
+	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
+	retval.AutoClosePeriod = v.Team.AutoClosePeriod
+	retval.TriageEnabled = v.Team.TriageEnabled
+	retval.RequirePriorityToLeaveTriage = v.Team.RequirePriorityToLeaveTriage
+	retval.CyclesEnabled = v.Team.CyclesEnabled
+	retval.CycleStartDay = v.Team.CycleStartDay
+	retval.CycleDuration = v.Team.CycleDuration
+	retval.CycleCooldownTime = v.Team.CycleCooldownTime
+	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
+	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
+	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
+	retval.CycleLockToActive = v.Team.CycleLockToActive
+	retval.IssueEstimationType = v.Team.IssueEstimationType
+	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
+	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
+	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
+	retval.DefaultTemplateForMembers = v.Team.DefaultTemplateForMembers
+	retval.DefaultTemplateForNonMembers = v.Team.DefaultTemplateForNonMembers
+	retval.DefaultProjectTemplate = v.Team.DefaultProjectTemplate
+	return &retval, nil
+}
+
+// setTeamMarkedAsDuplicateWorkflowStateResponse is returned by setTeamMarkedAsDuplicateWorkflowState on success.
+type setTeamMarkedAsDuplicateWorkflowStateResponse struct {
+	// Updates a team.
+	TeamUpdate setTeamMarkedAsDuplicateWorkflowStateTeamUpdateTeamPayload `json:"teamUpdate"`
+}
+
+// GetTeamUpdate returns setTeamMarkedAsDuplicateWorkflowStateResponse.TeamUpdate, and is useful for accessing the field via an interface.
+func (v *setTeamMarkedAsDuplicateWorkflowStateResponse) GetTeamUpdate() setTeamMarkedAsDuplicateWorkflowStateTeamUpdateTeamPayload {
+	return v.TeamUpdate
+}
+
+// setTeamMarkedAsDuplicateWorkflowStateTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
+type setTeamMarkedAsDuplicateWorkflowStateTeamUpdateTeamPayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns setTeamMarkedAsDuplicateWorkflowStateTeamUpdateTeamPayload.Success, and is useful for accessing the field via an interface.
+func (v *setTeamMarkedAsDuplicateWorkflowStateTeamUpdateTeamPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// updateCustomViewCustomViewUpdateCustomViewPayload includes the requested fields of the GraphQL type CustomViewPayload.
+type updateCustomViewCustomViewUpdateCustomViewPayload struct {
+	// The custom view that was created or updated.
+	CustomView updateCustomViewCustomViewUpdateCustomViewPayloadCustomView `json:"customView"`
+}
+
+// GetCustomView returns updateCustomViewCustomViewUpdateCustomViewPayload.CustomView, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayload) GetCustomView() updateCustomViewCustomViewUpdateCustomViewPayloadCustomView {
+	return v.CustomView
+}
+
+// updateCustomViewCustomViewUpdateCustomViewPayloadCustomView includes the requested fields of the GraphQL type CustomView.
+// The GraphQL type's documentation follows.
+//
+// A custom view that has been saved by a user.
+type updateCustomViewCustomViewUpdateCustomViewPayloadCustomView struct {
+	CustomView `json:"-"`
+}
+
+// GetId returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Id, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetId() string {
+	return v.CustomView.Id
+}
+
+// GetName returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Name, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetName() string {
+	return v.CustomView.Name
+}
+
+// GetDescription returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Description, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetDescription() *string {
+	return v.CustomView.Description
+}
+
+// GetIcon returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Icon, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetIcon() *string {
+	return v.CustomView.Icon
+}
+
+// GetColor returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Color, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetColor() *string {
+	return v.CustomView.Color
+}
+
+// GetShared returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Shared, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetShared() bool {
+	return v.CustomView.Shared
+}
+
+// GetFilterData returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.FilterData, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetFilterData() map[string]interface{} {
+	return v.CustomView.FilterData
+}
+
+// GetTeam returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Team, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetTeam() *CustomViewTeam {
+	return v.CustomView.Team
+}
+
+// GetOwner returns updateCustomViewCustomViewUpdateCustomViewPayloadCustomView.Owner, and is useful for accessing the field via an interface.
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) GetOwner() CustomViewOwnerUser {
+	return v.CustomView.Owner
+}
+
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateCustomViewCustomViewUpdateCustomViewPayloadCustomView
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateCustomViewCustomViewUpdateCustomViewPayloadCustomView = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomView)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateCustomViewCustomViewUpdateCustomViewPayloadCustomView struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Shared bool `json:"shared"`
+
+	FilterData map[string]interface{} `json:"filterData"`
+
+	Team *CustomViewTeam `json:"team"`
+
+	Owner CustomViewOwnerUser `json:"owner"`
+}
+
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateCustomViewCustomViewUpdateCustomViewPayloadCustomView) __premarshalJSON() (*__premarshalupdateCustomViewCustomViewUpdateCustomViewPayloadCustomView, error) {
+	var retval __premarshalupdateCustomViewCustomViewUpdateCustomViewPayloadCustomView
+
+	retval.Id = v.CustomView.Id
+	retval.Name = v.CustomView.Name
+	retval.Description = v.CustomView.Description
+	retval.Icon = v.CustomView.Icon
+	retval.Color = v.CustomView.Color
+	retval.Shared = v.CustomView.Shared
+	retval.FilterData = v.CustomView.FilterData
+	retval.Team = v.CustomView.Team
+	retval.Owner = v.CustomView.Owner
+	return &retval, nil
+}
+
+// updateCustomViewResponse is returned by updateCustomView on success.
+type updateCustomViewResponse struct {
+	// Updates a custom view.
+	CustomViewUpdate updateCustomViewCustomViewUpdateCustomViewPayload `json:"customViewUpdate"`
+}
+
+// GetCustomViewUpdate returns updateCustomViewResponse.CustomViewUpdate, and is useful for accessing the field via an interface.
+func (v *updateCustomViewResponse) GetCustomViewUpdate() updateCustomViewCustomViewUpdateCustomViewPayload {
+	return v.CustomViewUpdate
+}
+
+// updateCustomerCustomerUpdateCustomerPayload includes the requested fields of the GraphQL type CustomerPayload.
+type updateCustomerCustomerUpdateCustomerPayload struct {
+	// The customer that was created or updated.
+	Customer updateCustomerCustomerUpdateCustomerPayloadCustomer `json:"customer"`
+}
+
+// GetCustomer returns updateCustomerCustomerUpdateCustomerPayload.Customer, and is useful for accessing the field via an interface.
+func (v *updateCustomerCustomerUpdateCustomerPayload) GetCustomer() updateCustomerCustomerUpdateCustomerPayloadCustomer {
+	return v.Customer
+}
+
+// updateCustomerCustomerUpdateCustomerPayloadCustomer includes the requested fields of the GraphQL type Customer.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer whose needs will be tied to issues or projects.
+type updateCustomerCustomerUpdateCustomerPayloadCustomer struct {
+	Customer `json:"-"`
+}
+
+// GetId returns updateCustomerCustomerUpdateCustomerPayloadCustomer.Id, and is useful for accessing the field via an interface.
+func (v *updateCustomerCustomerUpdateCustomerPayloadCustomer) GetId() string { return v.Customer.Id }
+
+// GetName returns updateCustomerCustomerUpdateCustomerPayloadCustomer.Name, and is useful for accessing the field via an interface.
+func (v *updateCustomerCustomerUpdateCustomerPayloadCustomer) GetName() string {
+	return v.Customer.Name
+}
+
+// GetDomains returns updateCustomerCustomerUpdateCustomerPayloadCustomer.Domains, and is useful for accessing the field via an interface.
+func (v *updateCustomerCustomerUpdateCustomerPayloadCustomer) GetDomains() []string {
+	return v.Customer.Domains
+}
+
+// GetExternalIds returns updateCustomerCustomerUpdateCustomerPayloadCustomer.ExternalIds, and is useful for accessing the field via an interface.
+func (v *updateCustomerCustomerUpdateCustomerPayloadCustomer) GetExternalIds() []string {
+	return v.Customer.ExternalIds
+}
+
+// GetSlackChannelId returns updateCustomerCustomerUpdateCustomerPayloadCustomer.SlackChannelId, and is useful for accessing the field via an interface.
+func (v *updateCustomerCustomerUpdateCustomerPayloadCustomer) GetSlackChannelId() *string {
+	return v.Customer.SlackChannelId
+}
+
+// GetOwner returns updateCustomerCustomerUpdateCustomerPayloadCustomer.Owner, and is useful for accessing the field via an interface.
+func (v *updateCustomerCustomerUpdateCustomerPayloadCustomer) GetOwner() CustomerOwnerUser {
+	return v.Customer.Owner
+}
+
+// GetStatus returns updateCustomerCustomerUpdateCustomerPayloadCustomer.Status, and is useful for accessing the field via an interface.
+func (v *updateCustomerCustomerUpdateCustomerPayloadCustomer) GetStatus() CustomerStatusRef {
+	return v.Customer.Status
+}
+
+func (v *updateCustomerCustomerUpdateCustomerPayloadCustomer) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateCustomerCustomerUpdateCustomerPayloadCustomer
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateCustomerCustomerUpdateCustomerPayloadCustomer = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Customer)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateCustomerCustomerUpdateCustomerPayloadCustomer struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Domains []string `json:"domains"`
+
+	ExternalIds []string `json:"externalIds"`
+
+	SlackChannelId *string `json:"slackChannelId"`
+
+	Owner CustomerOwnerUser `json:"owner"`
+
+	Status CustomerStatusRef `json:"status"`
+}
+
+func (v *updateCustomerCustomerUpdateCustomerPayloadCustomer) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateCustomerCustomerUpdateCustomerPayloadCustomer) __premarshalJSON() (*__premarshalupdateCustomerCustomerUpdateCustomerPayloadCustomer, error) {
+	var retval __premarshalupdateCustomerCustomerUpdateCustomerPayloadCustomer
+
+	retval.Id = v.Customer.Id
+	retval.Name = v.Customer.Name
+	retval.Domains = v.Customer.Domains
+	retval.ExternalIds = v.Customer.ExternalIds
+	retval.SlackChannelId = v.Customer.SlackChannelId
+	retval.Owner = v.Customer.Owner
+	retval.Status = v.Customer.Status
+	return &retval, nil
+}
+
+// updateCustomerNeedCustomerNeedUpdateCustomerNeedPayload includes the requested fields of the GraphQL type CustomerNeedPayload.
+type updateCustomerNeedCustomerNeedUpdateCustomerNeedPayload struct {
+	// The customer need that was created or updated.
+	Need updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed `json:"need"`
+}
+
+// GetNeed returns updateCustomerNeedCustomerNeedUpdateCustomerNeedPayload.Need, and is useful for accessing the field via an interface.
+func (v *updateCustomerNeedCustomerNeedUpdateCustomerNeedPayload) GetNeed() updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed {
+	return v.Need
+}
+
+// updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed includes the requested fields of the GraphQL type CustomerNeed.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer need, expressed through a reference to an issue, project, or comment.
+type updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed struct {
+	CustomerNeed `json:"-"`
+}
+
+// GetId returns updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed.Id, and is useful for accessing the field via an interface.
+func (v *updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed) GetId() string {
+	return v.CustomerNeed.Id
+}
+
+// GetPriority returns updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed.Priority, and is useful for accessing the field via an interface.
+func (v *updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed) GetPriority() float64 {
+	return v.CustomerNeed.Priority
+}
+
+// GetCustomer returns updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed.Customer, and is useful for accessing the field via an interface.
+func (v *updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed) GetCustomer() CustomerNeedCustomer {
+	return v.CustomerNeed.Customer
+}
+
+// GetIssue returns updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed.Issue, and is useful for accessing the field via an interface.
+func (v *updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed) GetIssue() *CustomerNeedIssue {
+	return v.CustomerNeed.Issue
+}
+
+// GetProject returns updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed.Project, and is useful for accessing the field via an interface.
+func (v *updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed) GetProject() *CustomerNeedProject {
+	return v.CustomerNeed.Project
+}
+
+// GetComment returns updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed.Comment, and is useful for accessing the field via an interface.
+func (v *updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed) GetComment() *CustomerNeedComment {
+	return v.CustomerNeed.Comment
+}
+
+func (v *updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomerNeed)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed struct {
+	Id string `json:"id"`
+
+	Priority float64 `json:"priority"`
+
+	Customer CustomerNeedCustomer `json:"customer"`
+
+	Issue *CustomerNeedIssue `json:"issue"`
+
+	Project *CustomerNeedProject `json:"project"`
+
+	Comment *CustomerNeedComment `json:"comment"`
+}
+
+func (v *updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed) __premarshalJSON() (*__premarshalupdateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed, error) {
+	var retval __premarshalupdateCustomerNeedCustomerNeedUpdateCustomerNeedPayloadNeedCustomerNeed
+
+	retval.Id = v.CustomerNeed.Id
+	retval.Priority = v.CustomerNeed.Priority
+	retval.Customer = v.CustomerNeed.Customer
+	retval.Issue = v.CustomerNeed.Issue
+	retval.Project = v.CustomerNeed.Project
+	retval.Comment = v.CustomerNeed.Comment
+	return &retval, nil
+}
+
+// updateCustomerNeedResponse is returned by updateCustomerNeed on success.
+type updateCustomerNeedResponse struct {
+	// [ALPHA] Updates a customer need
+	CustomerNeedUpdate updateCustomerNeedCustomerNeedUpdateCustomerNeedPayload `json:"customerNeedUpdate"`
+}
+
+// GetCustomerNeedUpdate returns updateCustomerNeedResponse.CustomerNeedUpdate, and is useful for accessing the field via an interface.
+func (v *updateCustomerNeedResponse) GetCustomerNeedUpdate() updateCustomerNeedCustomerNeedUpdateCustomerNeedPayload {
+	return v.CustomerNeedUpdate
+}
+
+// updateCustomerResponse is returned by updateCustomer on success.
+type updateCustomerResponse struct {
+	// [ALPHA] Updates a customer
+	CustomerUpdate updateCustomerCustomerUpdateCustomerPayload `json:"customerUpdate"`
+}
+
+// GetCustomerUpdate returns updateCustomerResponse.CustomerUpdate, and is useful for accessing the field via an interface.
+func (v *updateCustomerResponse) GetCustomerUpdate() updateCustomerCustomerUpdateCustomerPayload {
+	return v.CustomerUpdate
+}
+
+// updateCustomerStatusCustomerStatusUpdateCustomerStatusPayload includes the requested fields of the GraphQL type CustomerStatusPayload.
+type updateCustomerStatusCustomerStatusUpdateCustomerStatusPayload struct {
+	// The customer status that was created or updated.
+	Status updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus `json:"status"`
+}
+
+// GetStatus returns updateCustomerStatusCustomerStatusUpdateCustomerStatusPayload.Status, and is useful for accessing the field via an interface.
+func (v *updateCustomerStatusCustomerStatusUpdateCustomerStatusPayload) GetStatus() updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus {
+	return v.Status
+}
+
+// updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus includes the requested fields of the GraphQL type CustomerStatus.
+// The GraphQL type's documentation follows.
+//
+// [ALPHA] A customer status.
+type updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus struct {
+	CustomerStatus `json:"-"`
+}
+
+// GetId returns updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus.Id, and is useful for accessing the field via an interface.
+func (v *updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus) GetId() string {
+	return v.CustomerStatus.Id
+}
+
+// GetName returns updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus.Name, and is useful for accessing the field via an interface.
+func (v *updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus) GetName() string {
+	return v.CustomerStatus.Name
+}
+
+// GetColor returns updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus.Color, and is useful for accessing the field via an interface.
+func (v *updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus) GetColor() string {
+	return v.CustomerStatus.Color
+}
+
+// GetDescription returns updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus.Description, and is useful for accessing the field via an interface.
+func (v *updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus) GetDescription() *string {
+	return v.CustomerStatus.Description
+}
+
+// GetType returns updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus.Type, and is useful for accessing the field via an interface.
+func (v *updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus) GetType() CustomerStatusType {
+	return v.CustomerStatus.Type
+}
+
+// GetPosition returns updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus.Position, and is useful for accessing the field via an interface.
+func (v *updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus) GetPosition() float64 {
+	return v.CustomerStatus.Position
+}
+
+func (v *updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomerStatus)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type CustomerStatusType `json:"type"`
+
+	Position float64 `json:"position"`
+}
+
+func (v *updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus) __premarshalJSON() (*__premarshalupdateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus, error) {
+	var retval __premarshalupdateCustomerStatusCustomerStatusUpdateCustomerStatusPayloadStatusCustomerStatus
+
+	retval.Id = v.CustomerStatus.Id
+	retval.Name = v.CustomerStatus.Name
+	retval.Color = v.CustomerStatus.Color
+	retval.Description = v.CustomerStatus.Description
+	retval.Type = v.CustomerStatus.Type
+	retval.Position = v.CustomerStatus.Position
+	return &retval, nil
+}
+
+// updateCustomerStatusResponse is returned by updateCustomerStatus on success.
+type updateCustomerStatusResponse struct {
+	// [ALPHA] Updates a customer status.
+	CustomerStatusUpdate updateCustomerStatusCustomerStatusUpdateCustomerStatusPayload `json:"customerStatusUpdate"`
+}
+
+// GetCustomerStatusUpdate returns updateCustomerStatusResponse.CustomerStatusUpdate, and is useful for accessing the field via an interface.
+func (v *updateCustomerStatusResponse) GetCustomerStatusUpdate() updateCustomerStatusCustomerStatusUpdateCustomerStatusPayload {
+	return v.CustomerStatusUpdate
+}
+
+// updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload includes the requested fields of the GraphQL type GitAutomationStatePayload.
+type updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload struct {
+	// The automation state that was created or updated.
+	GitAutomationState updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState `json:"gitAutomationState"`
+}
+
+// GetGitAutomationState returns updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload.GitAutomationState, and is useful for accessing the field via an interface.
+func (v *updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload) GetGitAutomationState() updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState {
+	return v.GitAutomationState
+}
+
+// updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState includes the requested fields of the GraphQL type GitAutomationState.
+// The GraphQL type's documentation follows.
+//
+// A trigger that updates the issue status according to Git automations.
+type updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState struct {
+	GitAutomationState `json:"-"`
+}
+
+// GetId returns updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState.Id, and is useful for accessing the field via an interface.
+func (v *updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState) GetId() string {
+	return v.GitAutomationState.Id
+}
+
+// GetEvent returns updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState.Event, and is useful for accessing the field via an interface.
+func (v *updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState) GetEvent() GitAutomationStates {
+	return v.GitAutomationState.Event
+}
+
+// GetState returns updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState.State, and is useful for accessing the field via an interface.
+func (v *updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState) GetState() *GitAutomationStateStateWorkflowState {
+	return v.GitAutomationState.State
+}
+
+// GetTeam returns updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState.Team, and is useful for accessing the field via an interface.
+func (v *updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState) GetTeam() GitAutomationStateTeam {
+	return v.GitAutomationState.Team
+}
+
+// GetTargetBranch returns updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState.TargetBranch, and is useful for accessing the field via an interface.
+func (v *updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState) GetTargetBranch() *GitAutomationStateTargetBranchGitAutomationTargetBranch {
+	return v.GitAutomationState.TargetBranch
+}
+
+func (v *updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.GitAutomationState)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState struct {
+	Id string `json:"id"`
+
+	Event GitAutomationStates `json:"event"`
+
+	State *GitAutomationStateStateWorkflowState `json:"state"`
+
+	Team GitAutomationStateTeam `json:"team"`
+
+	TargetBranch *GitAutomationStateTargetBranchGitAutomationTargetBranch `json:"targetBranch"`
+}
+
+func (v *updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState) __premarshalJSON() (*__premarshalupdateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState, error) {
+	var retval __premarshalupdateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayloadGitAutomationState
+
+	retval.Id = v.GitAutomationState.Id
+	retval.Event = v.GitAutomationState.Event
+	retval.State = v.GitAutomationState.State
+	retval.Team = v.GitAutomationState.Team
+	retval.TargetBranch = v.GitAutomationState.TargetBranch
+	return &retval, nil
+}
+
+// updateGitAutomationStateResponse is returned by updateGitAutomationState on success.
+type updateGitAutomationStateResponse struct {
+	// Updates an existing state.
+	GitAutomationStateUpdate updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload `json:"gitAutomationStateUpdate"`
+}
+
+// GetGitAutomationStateUpdate returns updateGitAutomationStateResponse.GitAutomationStateUpdate, and is useful for accessing the field via an interface.
+func (v *updateGitAutomationStateResponse) GetGitAutomationStateUpdate() updateGitAutomationStateGitAutomationStateUpdateGitAutomationStatePayload {
+	return v.GitAutomationStateUpdate
+}
+
+// updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayload includes the requested fields of the GraphQL type InitiativeToProjectPayload.
+// The GraphQL type's documentation follows.
+//
+// The result of a initiativeToProject mutation.
+type updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayload struct {
+	// The initiativeToProject that was created or updated.
+	InitiativeToProject updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject `json:"initiativeToProject"`
+}
+
+// GetInitiativeToProject returns updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayload.InitiativeToProject, and is useful for accessing the field via an interface.
+func (v *updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayload) GetInitiativeToProject() updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject {
+	return v.InitiativeToProject
+}
+
+// updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject includes the requested fields of the GraphQL type InitiativeToProject.
+// The GraphQL type's documentation follows.
+//
+// Join table between projects and initiatives.
+type updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject struct {
+	InitiativeToProject `json:"-"`
+}
+
+// GetId returns updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject.Id, and is useful for accessing the field via an interface.
+func (v *updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject) GetId() string {
+	return v.InitiativeToProject.Id
+}
+
+// GetSortOrder returns updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject.SortOrder, and is useful for accessing the field via an interface.
+func (v *updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject) GetSortOrder() string {
+	return v.InitiativeToProject.SortOrder
+}
+
+// GetInitiative returns updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject.Initiative, and is useful for accessing the field via an interface.
+func (v *updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject) GetInitiative() InitiativeToProjectInitiative {
+	return v.InitiativeToProject.Initiative
+}
+
+// GetProject returns updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject.Project, and is useful for accessing the field via an interface.
+func (v *updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject) GetProject() InitiativeToProjectProject {
+	return v.InitiativeToProject.Project
+}
+
+func (v *updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.InitiativeToProject)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject struct {
+	Id string `json:"id"`
+
+	SortOrder string `json:"sortOrder"`
+
+	Initiative InitiativeToProjectInitiative `json:"initiative"`
+
+	Project InitiativeToProjectProject `json:"project"`
+}
+
+func (v *updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject) __premarshalJSON() (*__premarshalupdateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject, error) {
+	var retval __premarshalupdateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayloadInitiativeToProject
+
+	retval.Id = v.InitiativeToProject.Id
+	retval.SortOrder = v.InitiativeToProject.SortOrder
+	retval.Initiative = v.InitiativeToProject.Initiative
+	retval.Project = v.InitiativeToProject.Project
+	return &retval, nil
+}
+
+// updateInitiativeToProjectResponse is returned by updateInitiativeToProject on success.
+type updateInitiativeToProjectResponse struct {
+	// Updates a initiativeToProject.
+	InitiativeToProjectUpdate updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayload `json:"initiativeToProjectUpdate"`
+}
+
+// GetInitiativeToProjectUpdate returns updateInitiativeToProjectResponse.InitiativeToProjectUpdate, and is useful for accessing the field via an interface.
+func (v *updateInitiativeToProjectResponse) GetInitiativeToProjectUpdate() updateInitiativeToProjectInitiativeToProjectUpdateInitiativeToProjectPayload {
+	return v.InitiativeToProjectUpdate
+}
+
+// updateIssueIssueUpdateIssuePayload includes the requested fields of the GraphQL type IssuePayload.
+type updateIssueIssueUpdateIssuePayload struct {
+	// The issue that was created or updated.
+	Issue updateIssueIssueUpdateIssuePayloadIssue `json:"issue"`
+}
+
+// GetIssue returns updateIssueIssueUpdateIssuePayload.Issue, and is useful for accessing the field via an interface.
+func (v *updateIssueIssueUpdateIssuePayload) GetIssue() updateIssueIssueUpdateIssuePayloadIssue {
+	return v.Issue
+}
+
+// updateIssueIssueUpdateIssuePayloadIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type updateIssueIssueUpdateIssuePayloadIssue struct {
+	Issue `json:"-"`
+}
+
+// GetId returns updateIssueIssueUpdateIssuePayloadIssue.Id, and is useful for accessing the field via an interface.
+func (v *updateIssueIssueUpdateIssuePayloadIssue) GetId() string { return v.Issue.Id }
+
+// GetIdentifier returns updateIssueIssueUpdateIssuePayloadIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *updateIssueIssueUpdateIssuePayloadIssue) GetIdentifier() string { return v.Issue.Identifier }
+
+// GetTitle returns updateIssueIssueUpdateIssuePayloadIssue.Title, and is useful for accessing the field via an interface.
+func (v *updateIssueIssueUpdateIssuePayloadIssue) GetTitle() string { return v.Issue.Title }
+
+// GetDescription returns updateIssueIssueUpdateIssuePayloadIssue.Description, and is useful for accessing the field via an interface.
+func (v *updateIssueIssueUpdateIssuePayloadIssue) GetDescription() *string {
+	return v.Issue.Description
+}
+
+// GetPriority returns updateIssueIssueUpdateIssuePayloadIssue.Priority, and is useful for accessing the field via an interface.
+func (v *updateIssueIssueUpdateIssuePayloadIssue) GetPriority() float64 { return v.Issue.Priority }
+
+// GetTeam returns updateIssueIssueUpdateIssuePayloadIssue.Team, and is useful for accessing the field via an interface.
+func (v *updateIssueIssueUpdateIssuePayloadIssue) GetTeam() IssueTeam { return v.Issue.Team }
+
+// GetState returns updateIssueIssueUpdateIssuePayloadIssue.State, and is useful for accessing the field via an interface.
+func (v *updateIssueIssueUpdateIssuePayloadIssue) GetState() IssueStateWorkflowState {
+	return v.Issue.State
+}
+
+func (v *updateIssueIssueUpdateIssuePayloadIssue) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateIssueIssueUpdateIssuePayloadIssue
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateIssueIssueUpdateIssuePayloadIssue = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Issue)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateIssueIssueUpdateIssuePayloadIssue struct {
+	Id string `json:"id"`
+
+	Identifier string `json:"identifier"`
+
+	Title string `json:"title"`
+
+	Description *string `json:"description"`
+
+	Priority float64 `json:"priority"`
+
+	Team IssueTeam `json:"team"`
+
+	State IssueStateWorkflowState `json:"state"`
+}
+
+func (v *updateIssueIssueUpdateIssuePayloadIssue) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateIssueIssueUpdateIssuePayloadIssue) __premarshalJSON() (*__premarshalupdateIssueIssueUpdateIssuePayloadIssue, error) {
+	var retval __premarshalupdateIssueIssueUpdateIssuePayloadIssue
+
+	retval.Id = v.Issue.Id
+	retval.Identifier = v.Issue.Identifier
+	retval.Title = v.Issue.Title
+	retval.Description = v.Issue.Description
+	retval.Priority = v.Issue.Priority
+	retval.Team = v.Issue.Team
+	retval.State = v.Issue.State
+	return &retval, nil
+}
+
+// updateIssueRelationIssueRelationUpdateIssueRelationPayload includes the requested fields of the GraphQL type IssueRelationPayload.
+type updateIssueRelationIssueRelationUpdateIssueRelationPayload struct {
+	// The issue relation that was created or updated.
+	IssueRelation updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation `json:"issueRelation"`
+}
+
+// GetIssueRelation returns updateIssueRelationIssueRelationUpdateIssueRelationPayload.IssueRelation, and is useful for accessing the field via an interface.
+func (v *updateIssueRelationIssueRelationUpdateIssueRelationPayload) GetIssueRelation() updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation {
+	return v.IssueRelation
+}
+
+// updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation includes the requested fields of the GraphQL type IssueRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation between two issues.
+type updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation struct {
+	IssueRelation `json:"-"`
+}
+
+// GetId returns updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation.Id, and is useful for accessing the field via an interface.
+func (v *updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation) GetId() string {
+	return v.IssueRelation.Id
+}
+
+// GetType returns updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation.Type, and is useful for accessing the field via an interface.
+func (v *updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation) GetType() string {
+	return v.IssueRelation.Type
+}
+
+// GetIssue returns updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation.Issue, and is useful for accessing the field via an interface.
+func (v *updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation) GetIssue() IssueRelationIssue {
+	return v.IssueRelation.Issue
+}
+
+// GetRelatedIssue returns updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation.RelatedIssue, and is useful for accessing the field via an interface.
+func (v *updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation) GetRelatedIssue() IssueRelationRelatedIssue {
+	return v.IssueRelation.RelatedIssue
+}
+
+func (v *updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueRelation)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation struct {
+	Id string `json:"id"`
+
+	Type string `json:"type"`
+
+	Issue IssueRelationIssue `json:"issue"`
+
+	RelatedIssue IssueRelationRelatedIssue `json:"relatedIssue"`
+}
+
+func (v *updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation) __premarshalJSON() (*__premarshalupdateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation, error) {
+	var retval __premarshalupdateIssueRelationIssueRelationUpdateIssueRelationPayloadIssueRelation
+
+	retval.Id = v.IssueRelation.Id
+	retval.Type = v.IssueRelation.Type
+	retval.Issue = v.IssueRelation.Issue
+	retval.RelatedIssue = v.IssueRelation.RelatedIssue
+	return &retval, nil
+}
+
+// updateIssueRelationResponse is returned by updateIssueRelation on success.
+type updateIssueRelationResponse struct {
+	// Updates an issue relation.
+	IssueRelationUpdate updateIssueRelationIssueRelationUpdateIssueRelationPayload `json:"issueRelationUpdate"`
+}
+
+// GetIssueRelationUpdate returns updateIssueRelationResponse.IssueRelationUpdate, and is useful for accessing the field via an interface.
+func (v *updateIssueRelationResponse) GetIssueRelationUpdate() updateIssueRelationIssueRelationUpdateIssueRelationPayload {
+	return v.IssueRelationUpdate
+}
+
+// updateIssueResponse is returned by updateIssue on success.
+type updateIssueResponse struct {
+	// Updates an issue.
+	IssueUpdate updateIssueIssueUpdateIssuePayload `json:"issueUpdate"`
+}
+
+// GetIssueUpdate returns updateIssueResponse.IssueUpdate, and is useful for accessing the field via an interface.
+func (v *updateIssueResponse) GetIssueUpdate() updateIssueIssueUpdateIssuePayload {
+	return v.IssueUpdate
+}
+
+// updateLabelIssueLabelUpdateIssueLabelPayload includes the requested fields of the GraphQL type IssueLabelPayload.
+type updateLabelIssueLabelUpdateIssueLabelPayload struct {
+	// The label that was created or updated.
+	IssueLabel updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel `json:"issueLabel"`
+}
+
+// GetIssueLabel returns updateLabelIssueLabelUpdateIssueLabelPayload.IssueLabel, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayload) GetIssueLabel() updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel {
+	return v.IssueLabel
+}
+
+// updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel struct {
+	IssueLabel `json:"-"`
+}
+
+// GetId returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetId() string {
+	return v.IssueLabel.Id
+}
+
+// GetName returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetName() string {
+	return v.IssueLabel.Name
+}
+
+// GetDescription returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Description, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetDescription() *string {
+	return v.IssueLabel.Description
+}
+
+// GetColor returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Color, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetColor() *string {
+	return v.IssueLabel.Color
+}
+
+// GetParent returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Parent, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetParent() *IssueLabelParentIssueLabel {
+	return v.IssueLabel.Parent
+}
+
+// GetTeam returns updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) GetTeam() *IssueLabelTeam {
+	return v.IssueLabel.Team
+}
+
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueLabel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Parent *IssueLabelParentIssueLabel `json:"parent"`
+
+	Team *IssueLabelTeam `json:"team"`
+}
+
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel) __premarshalJSON() (*__premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel, error) {
+	var retval __premarshalupdateLabelIssueLabelUpdateIssueLabelPayloadIssueLabel
+
+	retval.Id = v.IssueLabel.Id
+	retval.Name = v.IssueLabel.Name
+	retval.Description = v.IssueLabel.Description
+	retval.Color = v.IssueLabel.Color
+	retval.Parent = v.IssueLabel.Parent
+	retval.Team = v.IssueLabel.Team
+	return &retval, nil
+}
+
+// updateLabelResponse is returned by updateLabel on success.
+type updateLabelResponse struct {
+	// Updates an label.
+	IssueLabelUpdate updateLabelIssueLabelUpdateIssueLabelPayload `json:"issueLabelUpdate"`
+}
+
+// GetIssueLabelUpdate returns updateLabelResponse.IssueLabelUpdate, and is useful for accessing the field via an interface.
+func (v *updateLabelResponse) GetIssueLabelUpdate() updateLabelIssueLabelUpdateIssueLabelPayload {
+	return v.IssueLabelUpdate
+}
+
+// updateProjectMembersProjectUpdateProjectPayload includes the requested fields of the GraphQL type ProjectPayload.
+type updateProjectMembersProjectUpdateProjectPayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns updateProjectMembersProjectUpdateProjectPayload.Success, and is useful for accessing the field via an interface.
+func (v *updateProjectMembersProjectUpdateProjectPayload) GetSuccess() bool { return v.Success }
+
+// updateProjectMembersResponse is returned by updateProjectMembers on success.
+type updateProjectMembersResponse struct {
+	// Updates a project.
+	ProjectUpdate updateProjectMembersProjectUpdateProjectPayload `json:"projectUpdate"`
+}
+
+// GetProjectUpdate returns updateProjectMembersResponse.ProjectUpdate, and is useful for accessing the field via an interface.
+func (v *updateProjectMembersResponse) GetProjectUpdate() updateProjectMembersProjectUpdateProjectPayload {
+	return v.ProjectUpdate
+}
+
+// updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload includes the requested fields of the GraphQL type ProjectMilestonePayload.
+type updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload struct {
+	// The project milestone that was created or updated.
+	ProjectMilestone updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone `json:"projectMilestone"`
+}
+
+// GetProjectMilestone returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload.ProjectMilestone, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload) GetProjectMilestone() updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone {
+	return v.ProjectMilestone
+}
+
+// updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone includes the requested fields of the GraphQL type ProjectMilestone.
+// The GraphQL type's documentation follows.
+//
+// A milestone for a project.
+type updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone struct {
+	ProjectMilestone `json:"-"`
+}
+
+// GetId returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone.Id, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) GetId() string {
+	return v.ProjectMilestone.Id
+}
+
+// GetName returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone.Name, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) GetName() string {
+	return v.ProjectMilestone.Name
+}
+
+// GetDescription returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone.Description, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) GetDescription() *string {
+	return v.ProjectMilestone.Description
+}
+
+// GetTargetDate returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone.TargetDate, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) GetTargetDate() *string {
+	return v.ProjectMilestone.TargetDate
+}
+
+// GetProject returns updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone.Project, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) GetProject() ProjectMilestoneProject {
+	return v.ProjectMilestone.Project
+}
+
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectMilestone)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Project ProjectMilestoneProject `json:"project"`
+}
+
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone) __premarshalJSON() (*__premarshalupdateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone, error) {
+	var retval __premarshalupdateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayloadProjectMilestone
+
+	retval.Id = v.ProjectMilestone.Id
+	retval.Name = v.ProjectMilestone.Name
+	retval.Description = v.ProjectMilestone.Description
+	retval.TargetDate = v.ProjectMilestone.TargetDate
+	retval.Project = v.ProjectMilestone.Project
+	return &retval, nil
+}
+
+// updateProjectMilestoneResponse is returned by updateProjectMilestone on success.
+type updateProjectMilestoneResponse struct {
+	// Updates a project milestone.
+	ProjectMilestoneUpdate updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload `json:"projectMilestoneUpdate"`
+}
+
+// GetProjectMilestoneUpdate returns updateProjectMilestoneResponse.ProjectMilestoneUpdate, and is useful for accessing the field via an interface.
+func (v *updateProjectMilestoneResponse) GetProjectMilestoneUpdate() updateProjectMilestoneProjectMilestoneUpdateProjectMilestonePayload {
+	return v.ProjectMilestoneUpdate
+}
+
+// updateProjectProjectUpdateProjectPayload includes the requested fields of the GraphQL type ProjectPayload.
+type updateProjectProjectUpdateProjectPayload struct {
+	// The project that was created or updated.
+	Project updateProjectProjectUpdateProjectPayloadProject `json:"project"`
+}
+
+// GetProject returns updateProjectProjectUpdateProjectPayload.Project, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayload) GetProject() updateProjectProjectUpdateProjectPayloadProject {
+	return v.Project
+}
+
+// updateProjectProjectUpdateProjectPayloadProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project.
+type updateProjectProjectUpdateProjectPayloadProject struct {
+	Project `json:"-"`
+}
+
+// GetId returns updateProjectProjectUpdateProjectPayloadProject.Id, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetId() string { return v.Project.Id }
+
+// GetName returns updateProjectProjectUpdateProjectPayloadProject.Name, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetName() string { return v.Project.Name }
+
+// GetDescription returns updateProjectProjectUpdateProjectPayloadProject.Description, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetDescription() string {
+	return v.Project.Description
+}
+
+// GetIcon returns updateProjectProjectUpdateProjectPayloadProject.Icon, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetIcon() *string { return v.Project.Icon }
+
+// GetColor returns updateProjectProjectUpdateProjectPayloadProject.Color, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetColor() string { return v.Project.Color }
+
+// GetPriority returns updateProjectProjectUpdateProjectPayloadProject.Priority, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetPriority() int {
+	return v.Project.Priority
+}
+
+// GetStartDate returns updateProjectProjectUpdateProjectPayloadProject.StartDate, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetStartDate() *string {
+	return v.Project.StartDate
+}
+
+// GetTargetDate returns updateProjectProjectUpdateProjectPayloadProject.TargetDate, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetTargetDate() *string {
+	return v.Project.TargetDate
+}
+
+// GetStatus returns updateProjectProjectUpdateProjectPayloadProject.Status, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetStatus() ProjectStatus {
+	return v.Project.Status
+}
+
+// GetLead returns updateProjectProjectUpdateProjectPayloadProject.Lead, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetLead() *ProjectLeadUser {
+	return v.Project.Lead
+}
+
+// GetTeams returns updateProjectProjectUpdateProjectPayloadProject.Teams, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProjectPayloadProject) GetTeams() ProjectTeamsTeamConnection {
+	return v.Project.Teams
+}
+
+func (v *updateProjectProjectUpdateProjectPayloadProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateProjectProjectUpdateProjectPayloadProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateProjectProjectUpdateProjectPayloadProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Project)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateProjectProjectUpdateProjectPayloadProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color string `json:"color"`
+
+	Priority int `json:"priority"`
+
+	StartDate *string `json:"startDate"`
+
+	TargetDate *string `json:"targetDate"`
+
+	Status ProjectStatus `json:"status"`
+
+	Lead *ProjectLeadUser `json:"lead"`
+
+	Teams ProjectTeamsTeamConnection `json:"teams"`
+}
+
+func (v *updateProjectProjectUpdateProjectPayloadProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateProjectProjectUpdateProjectPayloadProject) __premarshalJSON() (*__premarshalupdateProjectProjectUpdateProjectPayloadProject, error) {
+	var retval __premarshalupdateProjectProjectUpdateProjectPayloadProject
+
+	retval.Id = v.Project.Id
+	retval.Name = v.Project.Name
+	retval.Description = v.Project.Description
+	retval.Icon = v.Project.Icon
+	retval.Color = v.Project.Color
+	retval.Priority = v.Project.Priority
+	retval.StartDate = v.Project.StartDate
+	retval.TargetDate = v.Project.TargetDate
+	retval.Status = v.Project.Status
+	retval.Lead = v.Project.Lead
+	retval.Teams = v.Project.Teams
+	return &retval, nil
+}
+
+// updateProjectRelationProjectRelationUpdateProjectRelationPayload includes the requested fields of the GraphQL type ProjectRelationPayload.
+type updateProjectRelationProjectRelationUpdateProjectRelationPayload struct {
+	// The project relation that was created or updated.
+	ProjectRelation updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation `json:"projectRelation"`
+}
+
+// GetProjectRelation returns updateProjectRelationProjectRelationUpdateProjectRelationPayload.ProjectRelation, and is useful for accessing the field via an interface.
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayload) GetProjectRelation() updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation {
+	return v.ProjectRelation
+}
+
+// updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation includes the requested fields of the GraphQL type ProjectRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation between two projects.
+type updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation struct {
+	ProjectRelation `json:"-"`
+}
+
+// GetId returns updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation.Id, and is useful for accessing the field via an interface.
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation) GetId() string {
+	return v.ProjectRelation.Id
+}
+
+// GetType returns updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation.Type, and is useful for accessing the field via an interface.
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation) GetType() string {
+	return v.ProjectRelation.Type
+}
+
+// GetAnchorType returns updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation.AnchorType, and is useful for accessing the field via an interface.
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation) GetAnchorType() string {
+	return v.ProjectRelation.AnchorType
+}
+
+// GetRelatedAnchorType returns updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation.RelatedAnchorType, and is useful for accessing the field via an interface.
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation) GetRelatedAnchorType() string {
+	return v.ProjectRelation.RelatedAnchorType
+}
+
+// GetProject returns updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation.Project, and is useful for accessing the field via an interface.
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation) GetProject() ProjectRelationProject {
+	return v.ProjectRelation.Project
+}
+
+// GetProjectMilestone returns updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation.ProjectMilestone, and is useful for accessing the field via an interface.
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation) GetProjectMilestone() *ProjectRelationProjectMilestone {
+	return v.ProjectRelation.ProjectMilestone
+}
+
+// GetRelatedProject returns updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation.RelatedProject, and is useful for accessing the field via an interface.
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation) GetRelatedProject() ProjectRelationRelatedProject {
+	return v.ProjectRelation.RelatedProject
+}
+
+// GetRelatedProjectMilestone returns updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation.RelatedProjectMilestone, and is useful for accessing the field via an interface.
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation) GetRelatedProjectMilestone() *ProjectRelationRelatedProjectMilestone {
+	return v.ProjectRelation.RelatedProjectMilestone
+}
+
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectRelation)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation struct {
+	Id string `json:"id"`
+
+	Type string `json:"type"`
+
+	AnchorType string `json:"anchorType"`
+
+	RelatedAnchorType string `json:"relatedAnchorType"`
+
+	Project ProjectRelationProject `json:"project"`
+
+	ProjectMilestone *ProjectRelationProjectMilestone `json:"projectMilestone"`
+
+	RelatedProject ProjectRelationRelatedProject `json:"relatedProject"`
+
+	RelatedProjectMilestone *ProjectRelationRelatedProjectMilestone `json:"relatedProjectMilestone"`
+}
+
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation) __premarshalJSON() (*__premarshalupdateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation, error) {
+	var retval __premarshalupdateProjectRelationProjectRelationUpdateProjectRelationPayloadProjectRelation
+
+	retval.Id = v.ProjectRelation.Id
+	retval.Type = v.ProjectRelation.Type
+	retval.AnchorType = v.ProjectRelation.AnchorType
+	retval.RelatedAnchorType = v.ProjectRelation.RelatedAnchorType
+	retval.Project = v.ProjectRelation.Project
+	retval.ProjectMilestone = v.ProjectRelation.ProjectMilestone
+	retval.RelatedProject = v.ProjectRelation.RelatedProject
+	retval.RelatedProjectMilestone = v.ProjectRelation.RelatedProjectMilestone
+	return &retval, nil
+}
+
+// updateProjectRelationResponse is returned by updateProjectRelation on success.
+type updateProjectRelationResponse struct {
+	// [ALPHA] Updates a project relation.
+	ProjectRelationUpdate updateProjectRelationProjectRelationUpdateProjectRelationPayload `json:"projectRelationUpdate"`
+}
+
+// GetProjectRelationUpdate returns updateProjectRelationResponse.ProjectRelationUpdate, and is useful for accessing the field via an interface.
+func (v *updateProjectRelationResponse) GetProjectRelationUpdate() updateProjectRelationProjectRelationUpdateProjectRelationPayload {
+	return v.ProjectRelationUpdate
+}
+
+// updateProjectResponse is returned by updateProject on success.
+type updateProjectResponse struct {
+	// Updates a project.
+	ProjectUpdate updateProjectProjectUpdateProjectPayload `json:"projectUpdate"`
+}
+
+// GetProjectUpdate returns updateProjectResponse.ProjectUpdate, and is useful for accessing the field via an interface.
+func (v *updateProjectResponse) GetProjectUpdate() updateProjectProjectUpdateProjectPayload {
+	return v.ProjectUpdate
+}
+
+// updateRoadmapResponse is returned by updateRoadmap on success.
+type updateRoadmapResponse struct {
+	// Updates a roadmap.
+	RoadmapUpdate updateRoadmapRoadmapUpdateRoadmapPayload `json:"roadmapUpdate"`
+}
+
+// GetRoadmapUpdate returns updateRoadmapResponse.RoadmapUpdate, and is useful for accessing the field via an interface.
+func (v *updateRoadmapResponse) GetRoadmapUpdate() updateRoadmapRoadmapUpdateRoadmapPayload {
+	return v.RoadmapUpdate
+}
+
+// updateRoadmapRoadmapUpdateRoadmapPayload includes the requested fields of the GraphQL type RoadmapPayload.
+type updateRoadmapRoadmapUpdateRoadmapPayload struct {
+	// The roadmap that was created or updated.
+	Roadmap updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap `json:"roadmap"`
+}
+
+// GetRoadmap returns updateRoadmapRoadmapUpdateRoadmapPayload.Roadmap, and is useful for accessing the field via an interface.
+func (v *updateRoadmapRoadmapUpdateRoadmapPayload) GetRoadmap() updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap {
+	return v.Roadmap
+}
+
+// updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap includes the requested fields of the GraphQL type Roadmap.
+// The GraphQL type's documentation follows.
+//
+// A roadmap for projects.
+type updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap struct {
+	Roadmap `json:"-"`
+}
+
+// GetId returns updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap.Id, and is useful for accessing the field via an interface.
+func (v *updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap) GetId() string { return v.Roadmap.Id }
+
+// GetName returns updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap.Name, and is useful for accessing the field via an interface.
+func (v *updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap) GetName() string { return v.Roadmap.Name }
+
+// GetDescription returns updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap.Description, and is useful for accessing the field via an interface.
+func (v *updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap) GetDescription() *string {
+	return v.Roadmap.Description
+}
+
+// GetColor returns updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap.Color, and is useful for accessing the field via an interface.
+func (v *updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap) GetColor() *string { return v.Roadmap.Color }
+
+// GetOwner returns updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap.Owner, and is useful for accessing the field via an interface.
+func (v *updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap) GetOwner() RoadmapOwnerUser {
+	return v.Roadmap.Owner
+}
+
+func (v *updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Roadmap)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateRoadmapRoadmapUpdateRoadmapPayloadRoadmap struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	Color *string `json:"color"`
+
+	Owner RoadmapOwnerUser `json:"owner"`
+}
+
+func (v *updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateRoadmapRoadmapUpdateRoadmapPayloadRoadmap) __premarshalJSON() (*__premarshalupdateRoadmapRoadmapUpdateRoadmapPayloadRoadmap, error) {
+	var retval __premarshalupdateRoadmapRoadmapUpdateRoadmapPayloadRoadmap
+
+	retval.Id = v.Roadmap.Id
+	retval.Name = v.Roadmap.Name
+	retval.Description = v.Roadmap.Description
+	retval.Color = v.Roadmap.Color
+	retval.Owner = v.Roadmap.Owner
+	return &retval, nil
+}
+
+// updateRoadmapToProjectResponse is returned by updateRoadmapToProject on success.
+type updateRoadmapToProjectResponse struct {
+	// Updates a roadmapToProject.
+	RoadmapToProjectUpdate updateRoadmapToProjectRoadmapToProjectUpdateRoadmapToProjectPayload `json:"roadmapToProjectUpdate"`
+}
+
+// GetRoadmapToProjectUpdate returns updateRoadmapToProjectResponse.RoadmapToProjectUpdate, and is useful for accessing the field via an interface.
+func (v *updateRoadmapToProjectResponse) GetRoadmapToProjectUpdate() updateRoadmapToProjectRoadmapToProjectUpdateRoadmapToProjectPayload {
+	return v.RoadmapToProjectUpdate
+}
+
+// updateRoadmapToProjectRoadmapToProjectUpdateRoadmapToProjectPayload includes the requested fields of the GraphQL type RoadmapToProjectPayload.
+type updateRoadmapToProjectRoadmapToProjectUpdateRoadmapToProjectPayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns updateRoadmapToProjectRoadmapToProjectUpdateRoadmapToProjectPayload.Success, and is useful for accessing the field via an interface.
+func (v *updateRoadmapToProjectRoadmapToProjectUpdateRoadmapToProjectPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// updateTeamMembershipResponse is returned by updateTeamMembership on success.
+type updateTeamMembershipResponse struct {
+	// Updates a team membership.
+	TeamMembershipUpdate updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload `json:"teamMembershipUpdate"`
+}
+
+// GetTeamMembershipUpdate returns updateTeamMembershipResponse.TeamMembershipUpdate, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipResponse) GetTeamMembershipUpdate() updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload {
+	return v.TeamMembershipUpdate
+}
+
+// updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload includes the requested fields of the GraphQL type TeamMembershipPayload.
+type updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload struct {
+	// The team membership that was created or updated.
+	TeamMembership updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership `json:"teamMembership"`
+}
+
+// GetTeamMembership returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload.TeamMembership, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayload) GetTeamMembership() updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership {
+	return v.TeamMembership
+}
+
+// updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+// The GraphQL type's documentation follows.
+//
+// Defines the membership of a user to a team.
+type updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership struct {
+	TeamMembership `json:"-"`
+}
+
+// GetId returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) GetId() string {
+	return v.TeamMembership.Id
+}
+
+// GetOwner returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership.Owner, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) GetOwner() bool {
+	return v.TeamMembership.Owner
+}
+
+// GetTeam returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership.Team, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) GetTeam() TeamMembershipTeam {
+	return v.TeamMembership.Team
+}
+
+// GetUser returns updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership.User, and is useful for accessing the field via an interface.
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) GetUser() TeamMembershipUser {
+	return v.TeamMembership.User
+}
+
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamMembership)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership struct {
+	Id string `json:"id"`
+
+	Owner bool `json:"owner"`
+
+	Team TeamMembershipTeam `json:"team"`
+
+	User TeamMembershipUser `json:"user"`
+}
+
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership) __premarshalJSON() (*__premarshalupdateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership, error) {
+	var retval __premarshalupdateTeamMembershipTeamMembershipUpdateTeamMembershipPayloadTeamMembership
+
+	retval.Id = v.TeamMembership.Id
+	retval.Owner = v.TeamMembership.Owner
+	retval.Team = v.TeamMembership.Team
+	retval.User = v.TeamMembership.User
+	return &retval, nil
+}
+
+// updateTeamNotificationSubscriptionResponse is returned by updateTeamNotificationSubscription on success.
+type updateTeamNotificationSubscriptionResponse struct {
+	// Updates a team.
+	TeamUpdate updateTeamNotificationSubscriptionTeamUpdateTeamPayload `json:"teamUpdate"`
+}
+
+// GetTeamUpdate returns updateTeamNotificationSubscriptionResponse.TeamUpdate, and is useful for accessing the field via an interface.
+func (v *updateTeamNotificationSubscriptionResponse) GetTeamUpdate() updateTeamNotificationSubscriptionTeamUpdateTeamPayload {
+	return v.TeamUpdate
+}
+
+// updateTeamNotificationSubscriptionTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
+type updateTeamNotificationSubscriptionTeamUpdateTeamPayload struct {
+	// The team that was created or updated.
+	Team updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam `json:"team"`
+}
+
+// GetTeam returns updateTeamNotificationSubscriptionTeamUpdateTeamPayload.Team, and is useful for accessing the field via an interface.
+func (v *updateTeamNotificationSubscriptionTeamUpdateTeamPayload) GetTeam() updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam {
+	return v.Team
+}
+
+// updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam struct {
+	TeamNotificationSubscription `json:"-"`
+}
+
+// GetId returns updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
+func (v *updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam) GetId() string {
+	return v.TeamNotificationSubscription.Id
+}
+
+// GetSlackNewIssue returns updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam.SlackNewIssue, and is useful for accessing the field via an interface.
+func (v *updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam) GetSlackNewIssue() bool {
+	return v.TeamNotificationSubscription.SlackNewIssue
+}
+
+// GetSlackIssueComments returns updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam.SlackIssueComments, and is useful for accessing the field via an interface.
+func (v *updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam) GetSlackIssueComments() bool {
+	return v.TeamNotificationSubscription.SlackIssueComments
+}
+
+// GetSlackIssueStatuses returns updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam.SlackIssueStatuses, and is useful for accessing the field via an interface.
+func (v *updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam) GetSlackIssueStatuses() bool {
+	return v.TeamNotificationSubscription.SlackIssueStatuses
+}
+
+func (v *updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamNotificationSubscription)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam struct {
+	Id string `json:"id"`
+
+	SlackNewIssue bool `json:"slackNewIssue"`
+
+	SlackIssueComments bool `json:"slackIssueComments"`
+
+	SlackIssueStatuses bool `json:"slackIssueStatuses"`
+}
+
+func (v *updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam) __premarshalJSON() (*__premarshalupdateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam, error) {
+	var retval __premarshalupdateTeamNotificationSubscriptionTeamUpdateTeamPayloadTeam
+
+	retval.Id = v.TeamNotificationSubscription.Id
+	retval.SlackNewIssue = v.TeamNotificationSubscription.SlackNewIssue
+	retval.SlackIssueComments = v.TeamNotificationSubscription.SlackIssueComments
+	retval.SlackIssueStatuses = v.TeamNotificationSubscription.SlackIssueStatuses
+	return &retval, nil
+}
+
+// updateTeamResponse is returned by updateTeam on success.
+type updateTeamResponse struct {
+	// Updates a team.
+	TeamUpdate updateTeamTeamUpdateTeamPayload `json:"teamUpdate"`
+}
+
+// GetTeamUpdate returns updateTeamResponse.TeamUpdate, and is useful for accessing the field via an interface.
+func (v *updateTeamResponse) GetTeamUpdate() updateTeamTeamUpdateTeamPayload { return v.TeamUpdate }
+
+// updateTeamTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
+type updateTeamTeamUpdateTeamPayload struct {
+	// The team that was created or updated.
+	Team updateTeamTeamUpdateTeamPayloadTeam `json:"team"`
+}
+
+// GetTeam returns updateTeamTeamUpdateTeamPayload.Team, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayload) GetTeam() updateTeamTeamUpdateTeamPayloadTeam {
+	return v.Team
+}
+
+// updateTeamTeamUpdateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type updateTeamTeamUpdateTeamPayloadTeam struct {
+	Team `json:"-"`
+}
+
+// GetId returns updateTeamTeamUpdateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetId() string { return v.Team.Id }
+
+// GetName returns updateTeamTeamUpdateTeamPayloadTeam.Name, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetName() string { return v.Team.Name }
+
+// GetKey returns updateTeamTeamUpdateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetKey() string { return v.Team.Key }
+
+// GetPrivate returns updateTeamTeamUpdateTeamPayloadTeam.Private, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetPrivate() bool { return v.Team.Private }
+
+// GetJoinByDefault returns updateTeamTeamUpdateTeamPayloadTeam.JoinByDefault, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetJoinByDefault() *bool { return v.Team.JoinByDefault }
+
+// GetDescription returns updateTeamTeamUpdateTeamPayloadTeam.Description, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetDescription() *string { return v.Team.Description }
+
+// GetIcon returns updateTeamTeamUpdateTeamPayloadTeam.Icon, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIcon() *string { return v.Team.Icon }
+
+// GetColor returns updateTeamTeamUpdateTeamPayloadTeam.Color, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetColor() *string { return v.Team.Color }
+
+// GetTimezone returns updateTeamTeamUpdateTeamPayloadTeam.Timezone, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetTimezone() string { return v.Team.Timezone }
+
+// GetIssueOrderingNoPriorityFirst returns updateTeamTeamUpdateTeamPayloadTeam.IssueOrderingNoPriorityFirst, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueOrderingNoPriorityFirst() bool {
+	return v.Team.IssueOrderingNoPriorityFirst
+}
+
+// GetGroupIssueHistory returns updateTeamTeamUpdateTeamPayloadTeam.GroupIssueHistory, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetGroupIssueHistory() bool {
+	return v.Team.GroupIssueHistory
+}
+
+// GetSetIssueSortOrderOnStateChange returns updateTeamTeamUpdateTeamPayloadTeam.SetIssueSortOrderOnStateChange, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetSetIssueSortOrderOnStateChange() string {
+	return v.Team.SetIssueSortOrderOnStateChange
+}
+
+// GetAutoArchivePeriod returns updateTeamTeamUpdateTeamPayloadTeam.AutoArchivePeriod, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoArchivePeriod() float64 {
+	return v.Team.AutoArchivePeriod
+}
+
+// GetAutoClosePeriod returns updateTeamTeamUpdateTeamPayloadTeam.AutoClosePeriod, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetAutoClosePeriod() *float64 {
+	return v.Team.AutoClosePeriod
+}
+
+// GetTriageEnabled returns updateTeamTeamUpdateTeamPayloadTeam.TriageEnabled, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetTriageEnabled() bool { return v.Team.TriageEnabled }
+
+// GetRequirePriorityToLeaveTriage returns updateTeamTeamUpdateTeamPayloadTeam.RequirePriorityToLeaveTriage, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetRequirePriorityToLeaveTriage() bool {
+	return v.Team.RequirePriorityToLeaveTriage
+}
+
+// GetCyclesEnabled returns updateTeamTeamUpdateTeamPayloadTeam.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCyclesEnabled() bool { return v.Team.CyclesEnabled }
+
+// GetCycleStartDay returns updateTeamTeamUpdateTeamPayloadTeam.CycleStartDay, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleStartDay() float64 { return v.Team.CycleStartDay }
+
+// GetCycleDuration returns updateTeamTeamUpdateTeamPayloadTeam.CycleDuration, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleDuration() float64 { return v.Team.CycleDuration }
+
+// GetCycleCooldownTime returns updateTeamTeamUpdateTeamPayloadTeam.CycleCooldownTime, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleCooldownTime() float64 {
+	return v.Team.CycleCooldownTime
+}
+
+// GetUpcomingCycleCount returns updateTeamTeamUpdateTeamPayloadTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetUpcomingCycleCount() float64 {
+	return v.Team.UpcomingCycleCount
+}
+
+// GetCycleIssueAutoAssignStarted returns updateTeamTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignStarted, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignStarted() bool {
+	return v.Team.CycleIssueAutoAssignStarted
+}
+
+// GetCycleIssueAutoAssignCompleted returns updateTeamTeamUpdateTeamPayloadTeam.CycleIssueAutoAssignCompleted, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleIssueAutoAssignCompleted() bool {
+	return v.Team.CycleIssueAutoAssignCompleted
+}
+
+// GetCycleLockToActive returns updateTeamTeamUpdateTeamPayloadTeam.CycleLockToActive, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetCycleLockToActive() bool {
+	return v.Team.CycleLockToActive
+}
+
+// GetIssueEstimationType returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationType() string {
+	return v.Team.IssueEstimationType
+}
+
+// GetIssueEstimationAllowZero returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationAllowZero, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationAllowZero() bool {
+	return v.Team.IssueEstimationAllowZero
+}
+
+// GetIssueEstimationExtended returns updateTeamTeamUpdateTeamPayloadTeam.IssueEstimationExtended, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetIssueEstimationExtended() bool {
+	return v.Team.IssueEstimationExtended
+}
+
+// GetDefaultIssueEstimate returns updateTeamTeamUpdateTeamPayloadTeam.DefaultIssueEstimate, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetDefaultIssueEstimate() float64 {
+	return v.Team.DefaultIssueEstimate
+}
+
+// GetDefaultTemplateForMembers returns updateTeamTeamUpdateTeamPayloadTeam.DefaultTemplateForMembers, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetDefaultTemplateForMembers() *TeamDefaultTemplateForMembersTemplate {
+	return v.Team.DefaultTemplateForMembers
+}
+
+// GetDefaultTemplateForNonMembers returns updateTeamTeamUpdateTeamPayloadTeam.DefaultTemplateForNonMembers, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetDefaultTemplateForNonMembers() *TeamDefaultTemplateForNonMembersTemplate {
+	return v.Team.DefaultTemplateForNonMembers
+}
+
+// GetDefaultProjectTemplate returns updateTeamTeamUpdateTeamPayloadTeam.DefaultProjectTemplate, and is useful for accessing the field via an interface.
+func (v *updateTeamTeamUpdateTeamPayloadTeam) GetDefaultProjectTemplate() *TeamDefaultProjectTemplate {
+	return v.Team.DefaultProjectTemplate
+}
+
+func (v *updateTeamTeamUpdateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateTeamTeamUpdateTeamPayloadTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateTeamTeamUpdateTeamPayloadTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Team)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateTeamTeamUpdateTeamPayloadTeam struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Key string `json:"key"`
+
+	Private bool `json:"private"`
+
+	JoinByDefault *bool `json:"joinByDefault"`
+
+	Description *string `json:"description"`
+
+	Icon *string `json:"icon"`
+
+	Color *string `json:"color"`
+
+	Timezone string `json:"timezone"`
+
+	IssueOrderingNoPriorityFirst bool `json:"issueOrderingNoPriorityFirst"`
+
+	GroupIssueHistory bool `json:"groupIssueHistory"`
+
+	SetIssueSortOrderOnStateChange string `json:"setIssueSortOrderOnStateChange"`
+
+	AutoArchivePeriod float64 `json:"autoArchivePeriod"`
+
+	AutoClosePeriod *float64 `json:"autoClosePeriod"`
+
+	TriageEnabled bool `json:"triageEnabled"`
+
+	RequirePriorityToLeaveTriage bool `json:"requirePriorityToLeaveTriage"`
+
+	CyclesEnabled bool `json:"cyclesEnabled"`
+
+	CycleStartDay float64 `json:"cycleStartDay"`
+
+	CycleDuration float64 `json:"cycleDuration"`
+
+	CycleCooldownTime float64 `json:"cycleCooldownTime"`
+
+	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+
+	CycleIssueAutoAssignStarted bool `json:"cycleIssueAutoAssignStarted"`
+
+	CycleIssueAutoAssignCompleted bool `json:"cycleIssueAutoAssignCompleted"`
+
+	CycleLockToActive bool `json:"cycleLockToActive"`
+
+	IssueEstimationType string `json:"issueEstimationType"`
+
+	IssueEstimationAllowZero bool `json:"issueEstimationAllowZero"`
+
+	IssueEstimationExtended bool `json:"issueEstimationExtended"`
+
+	DefaultIssueEstimate float64 `json:"defaultIssueEstimate"`
+
+	DefaultTemplateForMembers *TeamDefaultTemplateForMembersTemplate `json:"defaultTemplateForMembers"`
+
+	DefaultTemplateForNonMembers *TeamDefaultTemplateForNonMembersTemplate `json:"defaultTemplateForNonMembers"`
+
+	DefaultProjectTemplate *TeamDefaultProjectTemplate `json:"defaultProjectTemplate"`
+}
+
+func (v *updateTeamTeamUpdateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateTeamTeamUpdateTeamPayloadTeam) __premarshalJSON() (*__premarshalupdateTeamTeamUpdateTeamPayloadTeam, error) {
+	var retval __premarshalupdateTeamTeamUpdateTeamPayloadTeam
+
+	retval.Id = v.Team.Id
+	retval.Name = v.Team.Name
+	retval.Key = v.Team.Key
+	retval.Private = v.Team.Private
+	retval.JoinByDefault = v.Team.JoinByDefault
+	retval.Description = v.Team.Description
+	retval.Icon = v.Team.Icon
+	retval.Color = v.Team.Color
+	retval.Timezone = v.Team.Timezone
+	retval.IssueOrderingNoPriorityFirst = v.Team.IssueOrderingNoPriorityFirst
+	retval.GroupIssueHistory = v.Team.GroupIssueHistory
+	retval.SetIssueSortOrderOnStateChange = v.Team.SetIssueSortOrderOnStateChange
+	retval.AutoArchivePeriod = v.Team.AutoArchivePeriod
+	retval.AutoClosePeriod = v.Team.AutoClosePeriod
+	retval.TriageEnabled = v.Team.TriageEnabled
+	retval.RequirePriorityToLeaveTriage = v.Team.RequirePriorityToLeaveTriage
+	retval.CyclesEnabled = v.Team.CyclesEnabled
+	retval.CycleStartDay = v.Team.CycleStartDay
+	retval.CycleDuration = v.Team.CycleDuration
+	retval.CycleCooldownTime = v.Team.CycleCooldownTime
+	retval.UpcomingCycleCount = v.Team.UpcomingCycleCount
+	retval.CycleIssueAutoAssignStarted = v.Team.CycleIssueAutoAssignStarted
+	retval.CycleIssueAutoAssignCompleted = v.Team.CycleIssueAutoAssignCompleted
+	retval.CycleLockToActive = v.Team.CycleLockToActive
+	retval.IssueEstimationType = v.Team.IssueEstimationType
+	retval.IssueEstimationAllowZero = v.Team.IssueEstimationAllowZero
+	retval.IssueEstimationExtended = v.Team.IssueEstimationExtended
+	retval.DefaultIssueEstimate = v.Team.DefaultIssueEstimate
+	retval.DefaultTemplateForMembers = v.Team.DefaultTemplateForMembers
+	retval.DefaultTemplateForNonMembers = v.Team.DefaultTemplateForNonMembers
+	retval.DefaultProjectTemplate = v.Team.DefaultProjectTemplate
+	return &retval, nil
 }
 
 // updateTeamWorkflowResponse is returned by updateTeamWorkflow on success.
@@ -3115,365 +16178,4633 @@ type updateTeamWorkflowResponse struct {
 	TeamUpdate updateTeamWorkflowTeamUpdateTeamPayload `json:"teamUpdate"`
 }
 
-// GetTeamUpdate returns updateTeamWorkflowResponse.TeamUpdate, and is useful for accessing the field via an interface.
-func (v *updateTeamWorkflowResponse) GetTeamUpdate() updateTeamWorkflowTeamUpdateTeamPayload {
-	return v.TeamUpdate
+// GetTeamUpdate returns updateTeamWorkflowResponse.TeamUpdate, and is useful for accessing the field via an interface.
+func (v *updateTeamWorkflowResponse) GetTeamUpdate() updateTeamWorkflowTeamUpdateTeamPayload {
+	return v.TeamUpdate
+}
+
+// updateTeamWorkflowTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
+type updateTeamWorkflowTeamUpdateTeamPayload struct {
+	// The team that was created or updated.
+	Team updateTeamWorkflowTeamUpdateTeamPayloadTeam `json:"team"`
+}
+
+// GetTeam returns updateTeamWorkflowTeamUpdateTeamPayload.Team, and is useful for accessing the field via an interface.
+func (v *updateTeamWorkflowTeamUpdateTeamPayload) GetTeam() updateTeamWorkflowTeamUpdateTeamPayloadTeam {
+	return v.Team
+}
+
+// updateTeamWorkflowTeamUpdateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type updateTeamWorkflowTeamUpdateTeamPayloadTeam struct {
+	TeamWorkflow `json:"-"`
+}
+
+// GetId returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
+func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetId() string { return v.TeamWorkflow.Id }
+
+// GetKey returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
+func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetKey() string { return v.TeamWorkflow.Key }
+
+// GetDraftWorkflowState returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.DraftWorkflowState, and is useful for accessing the field via an interface.
+func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetDraftWorkflowState() *TeamWorkflowDraftWorkflowState {
+	return v.TeamWorkflow.DraftWorkflowState
+}
+
+// GetStartWorkflowState returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.StartWorkflowState, and is useful for accessing the field via an interface.
+func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetStartWorkflowState() *TeamWorkflowStartWorkflowState {
+	return v.TeamWorkflow.StartWorkflowState
+}
+
+// GetReviewWorkflowState returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.ReviewWorkflowState, and is useful for accessing the field via an interface.
+func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetReviewWorkflowState() *TeamWorkflowReviewWorkflowState {
+	return v.TeamWorkflow.ReviewWorkflowState
+}
+
+// GetMergeWorkflowState returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.MergeWorkflowState, and is useful for accessing the field via an interface.
+func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetMergeWorkflowState() *TeamWorkflowMergeWorkflowState {
+	return v.TeamWorkflow.MergeWorkflowState
+}
+
+func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateTeamWorkflowTeamUpdateTeamPayloadTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateTeamWorkflowTeamUpdateTeamPayloadTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamWorkflow)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateTeamWorkflowTeamUpdateTeamPayloadTeam struct {
+	Id string `json:"id"`
+
+	Key string `json:"key"`
+
+	DraftWorkflowState *TeamWorkflowDraftWorkflowState `json:"draftWorkflowState"`
+
+	StartWorkflowState *TeamWorkflowStartWorkflowState `json:"startWorkflowState"`
+
+	ReviewWorkflowState *TeamWorkflowReviewWorkflowState `json:"reviewWorkflowState"`
+
+	MergeWorkflowState *TeamWorkflowMergeWorkflowState `json:"mergeWorkflowState"`
+}
+
+func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) __premarshalJSON() (*__premarshalupdateTeamWorkflowTeamUpdateTeamPayloadTeam, error) {
+	var retval __premarshalupdateTeamWorkflowTeamUpdateTeamPayloadTeam
+
+	retval.Id = v.TeamWorkflow.Id
+	retval.Key = v.TeamWorkflow.Key
+	retval.DraftWorkflowState = v.TeamWorkflow.DraftWorkflowState
+	retval.StartWorkflowState = v.TeamWorkflow.StartWorkflowState
+	retval.ReviewWorkflowState = v.TeamWorkflow.ReviewWorkflowState
+	retval.MergeWorkflowState = v.TeamWorkflow.MergeWorkflowState
+	return &retval, nil
+}
+
+// updateTemplateResponse is returned by updateTemplate on success.
+type updateTemplateResponse struct {
+	// Updates an existing template.
+	TemplateUpdate updateTemplateTemplateUpdateTemplatePayload `json:"templateUpdate"`
+}
+
+// GetTemplateUpdate returns updateTemplateResponse.TemplateUpdate, and is useful for accessing the field via an interface.
+func (v *updateTemplateResponse) GetTemplateUpdate() updateTemplateTemplateUpdateTemplatePayload {
+	return v.TemplateUpdate
+}
+
+// updateTemplateTemplateUpdateTemplatePayload includes the requested fields of the GraphQL type TemplatePayload.
+type updateTemplateTemplateUpdateTemplatePayload struct {
+	// The template that was created or updated.
+	Template updateTemplateTemplateUpdateTemplatePayloadTemplate `json:"template"`
+}
+
+// GetTemplate returns updateTemplateTemplateUpdateTemplatePayload.Template, and is useful for accessing the field via an interface.
+func (v *updateTemplateTemplateUpdateTemplatePayload) GetTemplate() updateTemplateTemplateUpdateTemplatePayloadTemplate {
+	return v.Template
+}
+
+// updateTemplateTemplateUpdateTemplatePayloadTemplate includes the requested fields of the GraphQL type Template.
+// The GraphQL type's documentation follows.
+//
+// A template object used for creating entities faster.
+type updateTemplateTemplateUpdateTemplatePayloadTemplate struct {
+	Template `json:"-"`
+}
+
+// GetId returns updateTemplateTemplateUpdateTemplatePayloadTemplate.Id, and is useful for accessing the field via an interface.
+func (v *updateTemplateTemplateUpdateTemplatePayloadTemplate) GetId() string { return v.Template.Id }
+
+// GetType returns updateTemplateTemplateUpdateTemplatePayloadTemplate.Type, and is useful for accessing the field via an interface.
+func (v *updateTemplateTemplateUpdateTemplatePayloadTemplate) GetType() string {
+	return v.Template.Type
+}
+
+// GetName returns updateTemplateTemplateUpdateTemplatePayloadTemplate.Name, and is useful for accessing the field via an interface.
+func (v *updateTemplateTemplateUpdateTemplatePayloadTemplate) GetName() string {
+	return v.Template.Name
+}
+
+// GetDescription returns updateTemplateTemplateUpdateTemplatePayloadTemplate.Description, and is useful for accessing the field via an interface.
+func (v *updateTemplateTemplateUpdateTemplatePayloadTemplate) GetDescription() *string {
+	return v.Template.Description
+}
+
+// GetTemplateData returns updateTemplateTemplateUpdateTemplatePayloadTemplate.TemplateData, and is useful for accessing the field via an interface.
+func (v *updateTemplateTemplateUpdateTemplatePayloadTemplate) GetTemplateData() json.RawMessage {
+	return v.Template.TemplateData
+}
+
+// GetTeam returns updateTemplateTemplateUpdateTemplatePayloadTemplate.Team, and is useful for accessing the field via an interface.
+func (v *updateTemplateTemplateUpdateTemplatePayloadTemplate) GetTeam() *TemplateTeam {
+	return v.Template.Team
+}
+
+func (v *updateTemplateTemplateUpdateTemplatePayloadTemplate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateTemplateTemplateUpdateTemplatePayloadTemplate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateTemplateTemplateUpdateTemplatePayloadTemplate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Template)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateTemplateTemplateUpdateTemplatePayloadTemplate struct {
+	Id string `json:"id"`
+
+	Type string `json:"type"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	TemplateData json.RawMessage `json:"templateData"`
+
+	Team *TemplateTeam `json:"team"`
+}
+
+func (v *updateTemplateTemplateUpdateTemplatePayloadTemplate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateTemplateTemplateUpdateTemplatePayloadTemplate) __premarshalJSON() (*__premarshalupdateTemplateTemplateUpdateTemplatePayloadTemplate, error) {
+	var retval __premarshalupdateTemplateTemplateUpdateTemplatePayloadTemplate
+
+	retval.Id = v.Template.Id
+	retval.Type = v.Template.Type
+	retval.Name = v.Template.Name
+	retval.Description = v.Template.Description
+	retval.TemplateData = v.Template.TemplateData
+	retval.Team = v.Template.Team
+	return &retval, nil
+}
+
+// updateWorkflowStateResponse is returned by updateWorkflowState on success.
+type updateWorkflowStateResponse struct {
+	// Updates a state.
+	WorkflowStateUpdate updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload `json:"workflowStateUpdate"`
+}
+
+// GetWorkflowStateUpdate returns updateWorkflowStateResponse.WorkflowStateUpdate, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateResponse) GetWorkflowStateUpdate() updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload {
+	return v.WorkflowStateUpdate
+}
+
+// updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload includes the requested fields of the GraphQL type WorkflowStatePayload.
+type updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload struct {
+	// The state that was created or updated.
+	WorkflowState updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState `json:"workflowState"`
+}
+
+// GetWorkflowState returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload.WorkflowState, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload) GetWorkflowState() updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState {
+	return v.WorkflowState
+}
+
+// updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState struct {
+	WorkflowState `json:"-"`
+}
+
+// GetId returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Id, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetId() string {
+	return v.WorkflowState.Id
+}
+
+// GetName returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetName() string {
+	return v.WorkflowState.Name
+}
+
+// GetColor returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Color, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetColor() string {
+	return v.WorkflowState.Color
+}
+
+// GetDescription returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Description, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetDescription() *string {
+	return v.WorkflowState.Description
+}
+
+// GetType returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetType() string {
+	return v.WorkflowState.Type
+}
+
+// GetPosition returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Position, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetPosition() float64 {
+	return v.WorkflowState.Position
+}
+
+// GetArchivedAt returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.ArchivedAt, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetArchivedAt() *time.Time {
+	return v.WorkflowState.ArchivedAt
+}
+
+// GetCreatedAt returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.CreatedAt, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetCreatedAt() time.Time {
+	return v.WorkflowState.CreatedAt
+}
+
+// GetUpdatedAt returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetUpdatedAt() time.Time {
+	return v.WorkflowState.UpdatedAt
+}
+
+// GetTeam returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Team, and is useful for accessing the field via an interface.
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetTeam() WorkflowStateTeam {
+	return v.WorkflowState.Team
+}
+
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WorkflowState)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Color string `json:"color"`
+
+	Description *string `json:"description"`
+
+	Type string `json:"type"`
+
+	Position float64 `json:"position"`
+
+	ArchivedAt *time.Time `json:"archivedAt"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Team WorkflowStateTeam `json:"team"`
+}
+
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) __premarshalJSON() (*__premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState, error) {
+	var retval __premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState
+
+	retval.Id = v.WorkflowState.Id
+	retval.Name = v.WorkflowState.Name
+	retval.Color = v.WorkflowState.Color
+	retval.Description = v.WorkflowState.Description
+	retval.Type = v.WorkflowState.Type
+	retval.Position = v.WorkflowState.Position
+	retval.ArchivedAt = v.WorkflowState.ArchivedAt
+	retval.CreatedAt = v.WorkflowState.CreatedAt
+	retval.UpdatedAt = v.WorkflowState.UpdatedAt
+	retval.Team = v.WorkflowState.Team
+	return &retval, nil
+}
+
+// updateWorkspaceSettingsOrganizationUpdateOrganizationPayload includes the requested fields of the GraphQL type OrganizationPayload.
+type updateWorkspaceSettingsOrganizationUpdateOrganizationPayload struct {
+	// The organization that was created or updated.
+	Organization updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization `json:"organization"`
+}
+
+// GetOrganization returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayload.Organization, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayload) GetOrganization() updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization {
+	return v.Organization
+}
+
+// updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization. Organizations are root-level objects that contain user accounts and teams.
+type updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization struct {
+	Organization `json:"-"`
+}
+
+// GetId returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.Id, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetId() string {
+	return v.Organization.Id
+}
+
+// GetName returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.Name, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetName() string {
+	return v.Organization.Name
+}
+
+// GetUrlKey returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.UrlKey, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetUrlKey() string {
+	return v.Organization.UrlKey
+}
+
+// GetLogoUrl returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.LogoUrl, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetLogoUrl() *string {
+	return v.Organization.LogoUrl
+}
+
+// GetGitBranchFormat returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.GitBranchFormat, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetGitBranchFormat() *string {
+	return v.Organization.GitBranchFormat
+}
+
+// GetAllowMembersToInvite returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.AllowMembersToInvite, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetAllowMembersToInvite() bool {
+	return v.Organization.AllowMembersToInvite
+}
+
+// GetRoadmapEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.RoadmapEnabled, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetRoadmapEnabled() bool {
+	return v.Organization.RoadmapEnabled
+}
+
+// GetGitLinkbackMessagesEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetGitLinkbackMessagesEnabled() bool {
+	return v.Organization.GitLinkbackMessagesEnabled
+}
+
+// GetGitPublicLinkbackMessagesEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetGitPublicLinkbackMessagesEnabled() bool {
+	return v.Organization.GitPublicLinkbackMessagesEnabled
+}
+
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Organization)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	UrlKey string `json:"urlKey"`
+
+	LogoUrl *string `json:"logoUrl"`
+
+	GitBranchFormat *string `json:"gitBranchFormat"`
+
+	AllowMembersToInvite bool `json:"allowMembersToInvite"`
+
+	RoadmapEnabled bool `json:"roadmapEnabled"`
+
+	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
+
+	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
+}
+
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) __premarshalJSON() (*__premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization, error) {
+	var retval __premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization
+
+	retval.Id = v.Organization.Id
+	retval.Name = v.Organization.Name
+	retval.UrlKey = v.Organization.UrlKey
+	retval.LogoUrl = v.Organization.LogoUrl
+	retval.GitBranchFormat = v.Organization.GitBranchFormat
+	retval.AllowMembersToInvite = v.Organization.AllowMembersToInvite
+	retval.RoadmapEnabled = v.Organization.RoadmapEnabled
+	retval.GitLinkbackMessagesEnabled = v.Organization.GitLinkbackMessagesEnabled
+	retval.GitPublicLinkbackMessagesEnabled = v.Organization.GitPublicLinkbackMessagesEnabled
+	return &retval, nil
+}
+
+// updateWorkspaceSettingsResponse is returned by updateWorkspaceSettings on success.
+type updateWorkspaceSettingsResponse struct {
+	// Updates the user's organization.
+	OrganizationUpdate updateWorkspaceSettingsOrganizationUpdateOrganizationPayload `json:"organizationUpdate"`
+}
+
+// GetOrganizationUpdate returns updateWorkspaceSettingsResponse.OrganizationUpdate, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceSettingsResponse) GetOrganizationUpdate() updateWorkspaceSettingsOrganizationUpdateOrganizationPayload {
+	return v.OrganizationUpdate
+}
+
+func createCustomView(
+	ctx context.Context,
+	client graphql.Client,
+	input CustomViewCreateInput,
+) (*createCustomViewResponse, error) {
+	req := &graphql.Request{
+		OpName: "createCustomView",
+		Query: `
+mutation createCustomView ($input: CustomViewCreateInput!) {
+	customViewCreate(input: $input) {
+		customView {
+			... CustomView
+		}
+	}
+}
+fragment CustomView on CustomView {
+	id
+	name
+	description
+	icon
+	color
+	shared
+	filterData
+	team {
+		id
+	}
+	owner {
+		id
+	}
+}
+`,
+		Variables: &__createCustomViewInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createCustomViewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createCustomer(
+	ctx context.Context,
+	client graphql.Client,
+	input CustomerCreateInput,
+) (*createCustomerResponse, error) {
+	req := &graphql.Request{
+		OpName: "createCustomer",
+		Query: `
+mutation createCustomer ($input: CustomerCreateInput!) {
+	customerCreate(input: $input) {
+		customer {
+			... Customer
+		}
+	}
+}
+fragment Customer on Customer {
+	id
+	name
+	domains
+	externalIds
+	slackChannelId
+	owner {
+		id
+	}
+	status {
+		id
+	}
+}
+`,
+		Variables: &__createCustomerInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createCustomerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createCustomerNeed(
+	ctx context.Context,
+	client graphql.Client,
+	input CustomerNeedCreateInput,
+) (*createCustomerNeedResponse, error) {
+	req := &graphql.Request{
+		OpName: "createCustomerNeed",
+		Query: `
+mutation createCustomerNeed ($input: CustomerNeedCreateInput!) {
+	customerNeedCreate(input: $input) {
+		need {
+			... CustomerNeed
+		}
+	}
+}
+fragment CustomerNeed on CustomerNeed {
+	id
+	priority
+	customer {
+		id
+	}
+	issue {
+		id
+	}
+	project {
+		id
+	}
+	comment {
+		id
+	}
+}
+`,
+		Variables: &__createCustomerNeedInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createCustomerNeedResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createCustomerStatus(
+	ctx context.Context,
+	client graphql.Client,
+	input CustomerStatusCreateInput,
+) (*createCustomerStatusResponse, error) {
+	req := &graphql.Request{
+		OpName: "createCustomerStatus",
+		Query: `
+mutation createCustomerStatus ($input: CustomerStatusCreateInput!) {
+	customerStatusCreate(input: $input) {
+		status {
+			... CustomerStatus
+		}
+	}
+}
+fragment CustomerStatus on CustomerStatus {
+	id
+	name
+	color
+	description
+	type
+	position
+}
+`,
+		Variables: &__createCustomerStatusInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createCustomerStatusResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createEmoji(
+	ctx context.Context,
+	client graphql.Client,
+	input EmojiCreateInput,
+) (*createEmojiResponse, error) {
+	req := &graphql.Request{
+		OpName: "createEmoji",
+		Query: `
+mutation createEmoji ($input: EmojiCreateInput!) {
+	emojiCreate(input: $input) {
+		emoji {
+			... Emoji
+		}
+	}
+}
+fragment Emoji on Emoji {
+	id
+	name
+	url
+	source
+}
+`,
+		Variables: &__createEmojiInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createEmojiResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createGitAutomationState(
+	ctx context.Context,
+	client graphql.Client,
+	input GitAutomationStateCreateInput,
+) (*createGitAutomationStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "createGitAutomationState",
+		Query: `
+mutation createGitAutomationState ($input: GitAutomationStateCreateInput!) {
+	gitAutomationStateCreate(input: $input) {
+		gitAutomationState {
+			... GitAutomationState
+		}
+	}
+}
+fragment GitAutomationState on GitAutomationState {
+	id
+	event
+	state {
+		id
+	}
+	team {
+		id
+	}
+	targetBranch {
+		id
+	}
+}
+`,
+		Variables: &__createGitAutomationStateInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createGitAutomationStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createInitiativeToProject(
+	ctx context.Context,
+	client graphql.Client,
+	input InitiativeToProjectCreateInput,
+) (*createInitiativeToProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "createInitiativeToProject",
+		Query: `
+mutation createInitiativeToProject ($input: InitiativeToProjectCreateInput!) {
+	initiativeToProjectCreate(input: $input) {
+		initiativeToProject {
+			... InitiativeToProject
+		}
+	}
+}
+fragment InitiativeToProject on InitiativeToProject {
+	id
+	sortOrder
+	initiative {
+		id
+	}
+	project {
+		id
+	}
+}
+`,
+		Variables: &__createInitiativeToProjectInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createInitiativeToProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createIssue(
+	ctx context.Context,
+	client graphql.Client,
+	input IssueCreateInput,
+) (*createIssueResponse, error) {
+	req := &graphql.Request{
+		OpName: "createIssue",
+		Query: `
+mutation createIssue ($input: IssueCreateInput!) {
+	issueCreate(input: $input) {
+		issue {
+			... Issue
+		}
+	}
+}
+fragment Issue on Issue {
+	id
+	identifier
+	title
+	description
+	priority
+	team {
+		id
+	}
+	state {
+		id
+	}
+}
+`,
+		Variables: &__createIssueInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createIssueResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createIssueRelation(
+	ctx context.Context,
+	client graphql.Client,
+	input IssueRelationCreateInput,
+) (*createIssueRelationResponse, error) {
+	req := &graphql.Request{
+		OpName: "createIssueRelation",
+		Query: `
+mutation createIssueRelation ($input: IssueRelationCreateInput!) {
+	issueRelationCreate(input: $input) {
+		issueRelation {
+			... IssueRelation
+		}
+	}
+}
+fragment IssueRelation on IssueRelation {
+	id
+	type
+	issue {
+		id
+		identifier
+	}
+	relatedIssue {
+		id
+		identifier
+	}
+}
+`,
+		Variables: &__createIssueRelationInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createIssueRelationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createLabel(
+	ctx context.Context,
+	client graphql.Client,
+	input IssueLabelCreateInput,
+) (*createLabelResponse, error) {
+	req := &graphql.Request{
+		OpName: "createLabel",
+		Query: `
+mutation createLabel ($input: IssueLabelCreateInput!) {
+	issueLabelCreate(input: $input) {
+		issueLabel {
+			... IssueLabel
+		}
+	}
+}
+fragment IssueLabel on IssueLabel {
+	id
+	name
+	description
+	color
+	parent {
+		id
+	}
+	team {
+		id
+	}
+}
+`,
+		Variables: &__createLabelInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createLabelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createProject(
+	ctx context.Context,
+	client graphql.Client,
+	input ProjectCreateInput,
+) (*createProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "createProject",
+		Query: `
+mutation createProject ($input: ProjectCreateInput!) {
+	projectCreate(input: $input) {
+		project {
+			... Project
+		}
+	}
+}
+fragment Project on Project {
+	id
+	name
+	description
+	icon
+	color
+	priority
+	startDate
+	targetDate
+	status {
+		id
+	}
+	lead {
+		id
+	}
+	teams {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__createProjectInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createProjectMilestone(
+	ctx context.Context,
+	client graphql.Client,
+	input ProjectMilestoneCreateInput,
+) (*createProjectMilestoneResponse, error) {
+	req := &graphql.Request{
+		OpName: "createProjectMilestone",
+		Query: `
+mutation createProjectMilestone ($input: ProjectMilestoneCreateInput!) {
+	projectMilestoneCreate(input: $input) {
+		projectMilestone {
+			... ProjectMilestone
+		}
+	}
+}
+fragment ProjectMilestone on ProjectMilestone {
+	id
+	name
+	description
+	targetDate
+	project {
+		id
+	}
+}
+`,
+		Variables: &__createProjectMilestoneInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createProjectMilestoneResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createProjectRelation(
+	ctx context.Context,
+	client graphql.Client,
+	input ProjectRelationCreateInput,
+) (*createProjectRelationResponse, error) {
+	req := &graphql.Request{
+		OpName: "createProjectRelation",
+		Query: `
+mutation createProjectRelation ($input: ProjectRelationCreateInput!) {
+	projectRelationCreate(input: $input) {
+		projectRelation {
+			... ProjectRelation
+		}
+	}
+}
+fragment ProjectRelation on ProjectRelation {
+	id
+	type
+	anchorType
+	relatedAnchorType
+	project {
+		id
+	}
+	projectMilestone {
+		id
+	}
+	relatedProject {
+		id
+	}
+	relatedProjectMilestone {
+		id
+	}
+}
+`,
+		Variables: &__createProjectRelationInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createProjectRelationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createRoadmap(
+	ctx context.Context,
+	client graphql.Client,
+	input RoadmapCreateInput,
+) (*createRoadmapResponse, error) {
+	req := &graphql.Request{
+		OpName: "createRoadmap",
+		Query: `
+mutation createRoadmap ($input: RoadmapCreateInput!) {
+	roadmapCreate(input: $input) {
+		roadmap {
+			... Roadmap
+		}
+	}
+}
+fragment Roadmap on Roadmap {
+	id
+	name
+	description
+	color
+	owner {
+		id
+	}
+}
+`,
+		Variables: &__createRoadmapInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createRoadmapResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createRoadmapToProject(
+	ctx context.Context,
+	client graphql.Client,
+	input RoadmapToProjectCreateInput,
+) (*createRoadmapToProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "createRoadmapToProject",
+		Query: `
+mutation createRoadmapToProject ($input: RoadmapToProjectCreateInput!) {
+	roadmapToProjectCreate(input: $input) {
+		success
+	}
+}
+`,
+		Variables: &__createRoadmapToProjectInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createRoadmapToProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createTeam(
+	ctx context.Context,
+	client graphql.Client,
+	input TeamCreateInput,
+) (*createTeamResponse, error) {
+	req := &graphql.Request{
+		OpName: "createTeam",
+		Query: `
+mutation createTeam ($input: TeamCreateInput!) {
+	teamCreate(input: $input) {
+		team {
+			... Team
+		}
+	}
+}
+fragment Team on Team {
+	id
+	name
+	key
+	private
+	joinByDefault
+	description
+	icon
+	color
+	timezone
+	issueOrderingNoPriorityFirst
+	groupIssueHistory
+	setIssueSortOrderOnStateChange
+	autoArchivePeriod
+	autoClosePeriod
+	triageEnabled
+	requirePriorityToLeaveTriage
+	cyclesEnabled
+	cycleStartDay
+	cycleDuration
+	cycleCooldownTime
+	upcomingCycleCount
+	cycleIssueAutoAssignStarted
+	cycleIssueAutoAssignCompleted
+	cycleLockToActive
+	issueEstimationType
+	issueEstimationAllowZero
+	issueEstimationExtended
+	defaultIssueEstimate
+	defaultTemplateForMembers {
+		id
+	}
+	defaultTemplateForNonMembers {
+		id
+	}
+	defaultProjectTemplate {
+		id
+	}
+}
+`,
+		Variables: &__createTeamInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createTeamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createTeamMembership(
+	ctx context.Context,
+	client graphql.Client,
+	teamId string,
+	userId string,
+	owner bool,
+) (*createTeamMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "createTeamMembership",
+		Query: `
+mutation createTeamMembership ($teamId: String!, $userId: String!, $owner: Boolean!) {
+	teamMembershipCreate(input: {teamId:$teamId,userId:$userId,owner:$owner}) {
+		teamMembership {
+			... TeamMembership
+		}
+	}
+}
+fragment TeamMembership on TeamMembership {
+	id
+	owner
+	team {
+		id
+	}
+	user {
+		id
+	}
+}
+`,
+		Variables: &__createTeamMembershipInput{
+			TeamId: teamId,
+			UserId: userId,
+			Owner:  owner,
+		},
+	}
+	var err error
+
+	var data createTeamMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createTemplate(
+	ctx context.Context,
+	client graphql.Client,
+	input TemplateCreateInput,
+) (*createTemplateResponse, error) {
+	req := &graphql.Request{
+		OpName: "createTemplate",
+		Query: `
+mutation createTemplate ($input: TemplateCreateInput!) {
+	templateCreate(input: $input) {
+		template {
+			... Template
+		}
+	}
+}
+fragment Template on Template {
+	id
+	type
+	name
+	description
+	templateData
+	team {
+		id
+	}
+}
+`,
+		Variables: &__createTemplateInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createTemplateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createWorkflowState(
+	ctx context.Context,
+	client graphql.Client,
+	input WorkflowStateCreateInput,
+) (*createWorkflowStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "createWorkflowState",
+		Query: `
+mutation createWorkflowState ($input: WorkflowStateCreateInput!) {
+	workflowStateCreate(input: $input) {
+		workflowState {
+			... WorkflowState
+		}
+	}
+}
+fragment WorkflowState on WorkflowState {
+	id
+	name
+	color
+	description
+	type
+	position
+	archivedAt
+	createdAt
+	updatedAt
+	team {
+		id
+		key
+		organization {
+			urlKey
+		}
+	}
+}
+`,
+		Variables: &__createWorkflowStateInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createWorkflowStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteCustomView(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteCustomViewResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteCustomView",
+		Query: `
+mutation deleteCustomView ($id: String!) {
+	customViewDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteCustomViewInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteCustomViewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteCustomer(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteCustomerResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteCustomer",
+		Query: `
+mutation deleteCustomer ($id: String!) {
+	customerDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteCustomerInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteCustomerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteCustomerNeed(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteCustomerNeedResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteCustomerNeed",
+		Query: `
+mutation deleteCustomerNeed ($id: String!) {
+	customerNeedDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteCustomerNeedInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteCustomerNeedResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteCustomerStatus(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteCustomerStatusResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteCustomerStatus",
+		Query: `
+mutation deleteCustomerStatus ($id: String!) {
+	customerStatusDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteCustomerStatusInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteCustomerStatusResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteEmoji(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteEmojiResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteEmoji",
+		Query: `
+mutation deleteEmoji ($id: String!) {
+	emojiDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteEmojiInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteEmojiResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteGitAutomationState(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteGitAutomationStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteGitAutomationState",
+		Query: `
+mutation deleteGitAutomationState ($id: String!) {
+	gitAutomationStateDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteGitAutomationStateInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteGitAutomationStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteInitiativeToProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteInitiativeToProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteInitiativeToProject",
+		Query: `
+mutation deleteInitiativeToProject ($id: String!) {
+	initiativeToProjectDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteInitiativeToProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteInitiativeToProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteIssue(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteIssueResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteIssue",
+		Query: `
+mutation deleteIssue ($id: String!) {
+	issueDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteIssueInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteIssueResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteIssueRelation(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteIssueRelationResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteIssueRelation",
+		Query: `
+mutation deleteIssueRelation ($id: String!) {
+	issueRelationDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteIssueRelationInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteIssueRelationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteLabel(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteLabelResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteLabel",
+		Query: `
+mutation deleteLabel ($id: String!) {
+	issueLabelDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteLabelInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteLabelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteProject",
+		Query: `
+mutation deleteProject ($id: String!) {
+	projectDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteProjectMilestone(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteProjectMilestoneResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteProjectMilestone",
+		Query: `
+mutation deleteProjectMilestone ($id: String!) {
+	projectMilestoneDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteProjectMilestoneInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteProjectMilestoneResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteProjectRelation(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteProjectRelationResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteProjectRelation",
+		Query: `
+mutation deleteProjectRelation ($id: String!) {
+	projectRelationDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteProjectRelationInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteProjectRelationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteRoadmap(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteRoadmapResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteRoadmap",
+		Query: `
+mutation deleteRoadmap ($id: String!) {
+	roadmapDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteRoadmapInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteRoadmapResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteRoadmapToProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteRoadmapToProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteRoadmapToProject",
+		Query: `
+mutation deleteRoadmapToProject ($id: String!) {
+	roadmapToProjectDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteRoadmapToProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteRoadmapToProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteTeam(
+	ctx context.Context,
+	client graphql.Client,
+	key string,
+) (*deleteTeamResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteTeam",
+		Query: `
+mutation deleteTeam ($key: String!) {
+	teamDelete(id: $key) {
+		success
+	}
+}
+`,
+		Variables: &__deleteTeamInput{
+			Key: key,
+		},
+	}
+	var err error
+
+	var data deleteTeamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteTeamMembership(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteTeamMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteTeamMembership",
+		Query: `
+mutation deleteTeamMembership ($id: String!) {
+	teamMembershipDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteTeamMembershipInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteTeamMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteTemplate(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteTemplateResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteTemplate",
+		Query: `
+mutation deleteTemplate ($id: String!) {
+	templateDelete(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteTemplateInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteTemplateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteWorkflowState(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteWorkflowStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteWorkflowState",
+		Query: `
+mutation deleteWorkflowState ($id: String!) {
+	workflowStateArchive(id: $id) {
+		success
+	}
+}
+`,
+		Variables: &__deleteWorkflowStateInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteWorkflowStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findCycle(
+	ctx context.Context,
+	client graphql.Client,
+	teamId string,
+	filter map[string]interface{},
+) (*findCycleResponse, error) {
+	req := &graphql.Request{
+		OpName: "findCycle",
+		Query: `
+query findCycle ($teamId: String!, $filter: CycleFilter!) {
+	team(id: $teamId) {
+		cycles(first: 1, filter: $filter) {
+			nodes {
+				... CycleDetails
+			}
+		}
+	}
+}
+fragment CycleDetails on Cycle {
+	id
+	number
+	name
+	description
+	startsAt
+	endsAt
+	completedAt
+	progress
+}
+`,
+		Variables: &__findCycleInput{
+			TeamId: teamId,
+			Filter: filter,
+		},
+	}
+	var err error
+
+	var data findCycleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findProjectByName(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*findProjectByNameResponse, error) {
+	req := &graphql.Request{
+		OpName: "findProjectByName",
+		Query: `
+query findProjectByName ($name: String!) {
+	projects(first: 250, filter: {name:{eqIgnoreCase:$name}}) {
+		nodes {
+			... ProjectDetails
+		}
+	}
+}
+fragment ProjectDetails on Project {
+	id
+	slugId
+	name
+	description
+	icon
+	color
+	priority
+	progress
+	startDate
+	targetDate
+	url
+	status {
+		id
+		type
+	}
+	lead {
+		id
+	}
+	teams {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findProjectByNameInput{
+			Name: name,
+		},
+	}
+	var err error
+
+	var data findProjectByNameResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findProjectBySlug(
+	ctx context.Context,
+	client graphql.Client,
+	slugId string,
+) (*findProjectBySlugResponse, error) {
+	req := &graphql.Request{
+		OpName: "findProjectBySlug",
+		Query: `
+query findProjectBySlug ($slugId: String!) {
+	projects(filter: {slugId:{eq:$slugId}}) {
+		nodes {
+			... ProjectDetails
+		}
+	}
+}
+fragment ProjectDetails on Project {
+	id
+	slugId
+	name
+	description
+	icon
+	color
+	priority
+	progress
+	startDate
+	targetDate
+	url
+	status {
+		id
+		type
+	}
+	lead {
+		id
+	}
+	teams {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findProjectBySlugInput{
+			SlugId: slugId,
+		},
+	}
+	var err error
+
+	var data findProjectBySlugResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findProjectMembership(
+	ctx context.Context,
+	client graphql.Client,
+	projectSlug string,
+	userEmail string,
+) (*findProjectMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "findProjectMembership",
+		Query: `
+query findProjectMembership ($projectSlug: String!, $userEmail: String!) {
+	projects(filter: {slugId:{eq:$projectSlug}}) {
+		nodes {
+			id
+		}
+	}
+	users(filter: {email:{eq:$userEmail}}) {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findProjectMembershipInput{
+			ProjectSlug: projectSlug,
+			UserEmail:   userEmail,
+		},
+	}
+	var err error
+
+	var data findProjectMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findProjectMilestone(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	projectId string,
+) (*findProjectMilestoneResponse, error) {
+	req := &graphql.Request{
+		OpName: "findProjectMilestone",
+		Query: `
+query findProjectMilestone ($name: String!, $projectId: String!) {
+	project(id: $projectId) {
+		projectMilestones(filter: {name:{eq:$name}}) {
+			nodes {
+				id
+			}
+		}
+	}
+}
+`,
+		Variables: &__findProjectMilestoneInput{
+			Name:      name,
+			ProjectId: projectId,
+		},
+	}
+	var err error
+
+	var data findProjectMilestoneResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findTeam(
+	ctx context.Context,
+	client graphql.Client,
+	key string,
+) (*findTeamResponse, error) {
+	req := &graphql.Request{
+		OpName: "findTeam",
+		Query: `
+query findTeam ($key: String!) {
+	teams(filter: {key:{eq:$key}}) {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findTeamInput{
+			Key: key,
+		},
+	}
+	var err error
+
+	var data findTeamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findTeamByName(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*findTeamByNameResponse, error) {
+	req := &graphql.Request{
+		OpName: "findTeamByName",
+		Query: `
+query findTeamByName ($name: String!) {
+	teams(filter: {name:{eqIgnoreCase:$name}}) {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findTeamByNameInput{
+			Name: name,
+		},
+	}
+	var err error
+
+	var data findTeamByNameResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findTeamLabel(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	key string,
+) (*findTeamLabelResponse, error) {
+	req := &graphql.Request{
+		OpName: "findTeamLabel",
+		Query: `
+query findTeamLabel ($name: String!, $key: String!) {
+	issueLabels(filter: {name:{eq:$name},team:{key:{eq:$key}}}) {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findTeamLabelInput{
+			Name: name,
+			Key:  key,
+		},
+	}
+	var err error
+
+	var data findTeamLabelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findTeamLabelByTeamId(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	teamId string,
+) (*findTeamLabelByTeamIdResponse, error) {
+	req := &graphql.Request{
+		OpName: "findTeamLabelByTeamId",
+		Query: `
+query findTeamLabelByTeamId ($name: String!, $teamId: ID!) {
+	issueLabels(filter: {name:{eq:$name},team:{id:{eq:$teamId}}}) {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findTeamLabelByTeamIdInput{
+			Name:   name,
+			TeamId: teamId,
+		},
+	}
+	var err error
+
+	var data findTeamLabelByTeamIdResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findTeamMembership(
+	ctx context.Context,
+	client graphql.Client,
+	teamKey string,
+	userEmail string,
+) (*findTeamMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "findTeamMembership",
+		Query: `
+query findTeamMembership ($teamKey: String!, $userEmail: String!) {
+	teams(filter: {key:{eq:$teamKey}}) {
+		nodes {
+			id
+		}
+	}
+	users(filter: {email:{eq:$userEmail}}) {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findTeamMembershipInput{
+			TeamKey:   teamKey,
+			UserEmail: userEmail,
+		},
+	}
+	var err error
+
+	var data findTeamMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findUserByDisplayName(
+	ctx context.Context,
+	client graphql.Client,
+	displayName string,
+) (*findUserByDisplayNameResponse, error) {
+	req := &graphql.Request{
+		OpName: "findUserByDisplayName",
+		Query: `
+query findUserByDisplayName ($displayName: String!) {
+	users(includeDisabled: true, filter: {displayName:{eqIgnoreCase:$displayName}}) {
+		nodes {
+			... User
+		}
+	}
+}
+fragment User on User {
+	id
+	name
+	displayName
+	email
+	admin
+	guest
+	active
+}
+`,
+		Variables: &__findUserByDisplayNameInput{
+			DisplayName: displayName,
+		},
+	}
+	var err error
+
+	var data findUserByDisplayNameResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findUserByEmail(
+	ctx context.Context,
+	client graphql.Client,
+	email string,
+) (*findUserByEmailResponse, error) {
+	req := &graphql.Request{
+		OpName: "findUserByEmail",
+		Query: `
+query findUserByEmail ($email: String!) {
+	users(includeDisabled: true, filter: {email:{eqIgnoreCase:$email}}) {
+		nodes {
+			... User
+		}
+	}
+}
+fragment User on User {
+	id
+	name
+	displayName
+	email
+	admin
+	guest
+	active
+}
+`,
+		Variables: &__findUserByEmailInput{
+			Email: email,
+		},
+	}
+	var err error
+
+	var data findUserByEmailResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findWorkflowState(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	key string,
+) (*findWorkflowStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "findWorkflowState",
+		Query: `
+query findWorkflowState ($name: String!, $key: String!) {
+	workflowStates(filter: {name:{eq:$name},team:{key:{eq:$key}}}) {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__findWorkflowStateInput{
+			Name: name,
+			Key:  key,
+		},
+	}
+	var err error
+
+	var data findWorkflowStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findWorkflowStateByTeamId(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+	teamId string,
+) (*findWorkflowStateByTeamIdResponse, error) {
+	req := &graphql.Request{
+		OpName: "findWorkflowStateByTeamId",
+		Query: `
+query findWorkflowStateByTeamId ($name: String!, $teamId: ID!) {
+	workflowStates(filter: {name:{eq:$name},team:{id:{eq:$teamId}}}) {
+		nodes {
+			id
+			type
+		}
+	}
+}
+`,
+		Variables: &__findWorkflowStateByTeamIdInput{
+			Name:   name,
+			TeamId: teamId,
+		},
+	}
+	var err error
+
+	var data findWorkflowStateByTeamIdResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func findWorkspaceLabel(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*findWorkspaceLabelResponse, error) {
+	req := &graphql.Request{
+		OpName: "findWorkspaceLabel",
+		Query: `
+query findWorkspaceLabel ($name: String!) {
+	issueLabels(filter: {name:{eq:$name}}) {
+		nodes {
+			id
+			team {
+				id
+			}
+		}
+	}
+}
+`,
+		Variables: &__findWorkspaceLabelInput{
+			Name: name,
+		},
+	}
+	var err error
+
+	var data findWorkspaceLabelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getCustomView(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getCustomViewResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCustomView",
+		Query: `
+query getCustomView ($id: String!) {
+	customView(id: $id) {
+		... CustomView
+	}
+}
+fragment CustomView on CustomView {
+	id
+	name
+	description
+	icon
+	color
+	shared
+	filterData
+	team {
+		id
+	}
+	owner {
+		id
+	}
+}
+`,
+		Variables: &__getCustomViewInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getCustomViewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getCustomer(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getCustomerResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCustomer",
+		Query: `
+query getCustomer ($id: String!) {
+	customer(id: $id) {
+		... Customer
+	}
+}
+fragment Customer on Customer {
+	id
+	name
+	domains
+	externalIds
+	slackChannelId
+	owner {
+		id
+	}
+	status {
+		id
+	}
+}
+`,
+		Variables: &__getCustomerInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getCustomerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getCustomerNeed(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getCustomerNeedResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCustomerNeed",
+		Query: `
+query getCustomerNeed ($id: String!) {
+	customerNeed(id: $id) {
+		... CustomerNeed
+	}
+}
+fragment CustomerNeed on CustomerNeed {
+	id
+	priority
+	customer {
+		id
+	}
+	issue {
+		id
+	}
+	project {
+		id
+	}
+	comment {
+		id
+	}
+}
+`,
+		Variables: &__getCustomerNeedInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getCustomerNeedResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getCustomerStatus(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getCustomerStatusResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCustomerStatus",
+		Query: `
+query getCustomerStatus ($id: String!) {
+	customerStatus(id: $id) {
+		... CustomerStatus
+	}
+}
+fragment CustomerStatus on CustomerStatus {
+	id
+	name
+	color
+	description
+	type
+	position
+}
+`,
+		Variables: &__getCustomerStatusInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getCustomerStatusResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getEmoji(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getEmojiResponse, error) {
+	req := &graphql.Request{
+		OpName: "getEmoji",
+		Query: `
+query getEmoji ($id: String!) {
+	emoji(id: $id) {
+		... Emoji
+	}
+}
+fragment Emoji on Emoji {
+	id
+	name
+	url
+	source
+}
+`,
+		Variables: &__getEmojiInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getEmojiResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getInitiativeToProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getInitiativeToProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "getInitiativeToProject",
+		Query: `
+query getInitiativeToProject ($id: String!) {
+	initiativeToProject(id: $id) {
+		... InitiativeToProject
+	}
+}
+fragment InitiativeToProject on InitiativeToProject {
+	id
+	sortOrder
+	initiative {
+		id
+	}
+	project {
+		id
+	}
+}
+`,
+		Variables: &__getInitiativeToProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getInitiativeToProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getIssue(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getIssueResponse, error) {
+	req := &graphql.Request{
+		OpName: "getIssue",
+		Query: `
+query getIssue ($id: String!) {
+	issue(id: $id) {
+		... Issue
+	}
+}
+fragment Issue on Issue {
+	id
+	identifier
+	title
+	description
+	priority
+	team {
+		id
+	}
+	state {
+		id
+	}
+}
+`,
+		Variables: &__getIssueInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getIssueResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getIssueRelation(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getIssueRelationResponse, error) {
+	req := &graphql.Request{
+		OpName: "getIssueRelation",
+		Query: `
+query getIssueRelation ($id: String!) {
+	issueRelation(id: $id) {
+		... IssueRelation
+	}
+}
+fragment IssueRelation on IssueRelation {
+	id
+	type
+	issue {
+		id
+		identifier
+	}
+	relatedIssue {
+		id
+		identifier
+	}
+}
+`,
+		Variables: &__getIssueRelationInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getIssueRelationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getIssueWorkflowState(
+	ctx context.Context,
+	client graphql.Client,
+	stateId string,
+	teamId string,
+) (*getIssueWorkflowStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "getIssueWorkflowState",
+		Query: `
+query getIssueWorkflowState ($stateId: String!, $teamId: String!) {
+	workflowState(id: $stateId) {
+		id
+		name
+		team {
+			id
+			name
+		}
+	}
+	team(id: $teamId) {
+		id
+		name
+	}
+}
+`,
+		Variables: &__getIssueWorkflowStateInput{
+			StateId: stateId,
+			TeamId:  teamId,
+		},
+	}
+	var err error
+
+	var data getIssueWorkflowStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getLabel(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getLabelResponse, error) {
+	req := &graphql.Request{
+		OpName: "getLabel",
+		Query: `
+query getLabel ($id: String!) {
+	issueLabel(id: $id) {
+		... IssueLabel
+	}
+}
+fragment IssueLabel on IssueLabel {
+	id
+	name
+	description
+	color
+	parent {
+		id
+	}
+	team {
+		id
+	}
+}
+`,
+		Variables: &__getLabelInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getLabelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProject",
+		Query: `
+query getProject ($id: String!) {
+	project(id: $id) {
+		... Project
+	}
+}
+fragment Project on Project {
+	id
+	name
+	description
+	icon
+	color
+	priority
+	startDate
+	targetDate
+	status {
+		id
+	}
+	lead {
+		id
+	}
+	teams {
+		nodes {
+			id
+		}
+	}
+}
+`,
+		Variables: &__getProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProjectMembership(
+	ctx context.Context,
+	client graphql.Client,
+	projectId string,
+	userId string,
+) (*getProjectMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProjectMembership",
+		Query: `
+query getProjectMembership ($projectId: String!, $userId: ID!) {
+	project(id: $projectId) {
+		id
+		members(filter: {id:{eq:$userId}}) {
+			nodes {
+				id
+			}
+		}
+	}
+}
+`,
+		Variables: &__getProjectMembershipInput{
+			ProjectId: projectId,
+			UserId:    userId,
+		},
+	}
+	var err error
+
+	var data getProjectMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProjectMilestone(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getProjectMilestoneResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProjectMilestone",
+		Query: `
+query getProjectMilestone ($id: String!) {
+	projectMilestone(id: $id) {
+		... ProjectMilestone
+	}
+}
+fragment ProjectMilestone on ProjectMilestone {
+	id
+	name
+	description
+	targetDate
+	project {
+		id
+	}
+}
+`,
+		Variables: &__getProjectMilestoneInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getProjectMilestoneResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProjectMilestoneIssues(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	after *string,
+) (*getProjectMilestoneIssuesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProjectMilestoneIssues",
+		Query: `
+query getProjectMilestoneIssues ($id: String!, $after: String) {
+	projectMilestone(id: $id) {
+		issues(first: 250, after: $after) {
+			nodes {
+				state {
+					type
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+`,
+		Variables: &__getProjectMilestoneIssuesInput{
+			Id:    id,
+			After: after,
+		},
+	}
+	var err error
+
+	var data getProjectMilestoneIssuesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProjectRelation(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getProjectRelationResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProjectRelation",
+		Query: `
+query getProjectRelation ($id: String!) {
+	projectRelation(id: $id) {
+		... ProjectRelation
+	}
+}
+fragment ProjectRelation on ProjectRelation {
+	id
+	type
+	anchorType
+	relatedAnchorType
+	project {
+		id
+	}
+	projectMilestone {
+		id
+	}
+	relatedProject {
+		id
+	}
+	relatedProjectMilestone {
+		id
+	}
+}
+`,
+		Variables: &__getProjectRelationInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getProjectRelationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getRoadmap(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getRoadmapResponse, error) {
+	req := &graphql.Request{
+		OpName: "getRoadmap",
+		Query: `
+query getRoadmap ($id: String!) {
+	roadmap(id: $id) {
+		... Roadmap
+	}
+}
+fragment Roadmap on Roadmap {
+	id
+	name
+	description
+	color
+	owner {
+		id
+	}
+}
+`,
+		Variables: &__getRoadmapInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getRoadmapResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeam(
+	ctx context.Context,
+	client graphql.Client,
+	key string,
+) (*getTeamResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeam",
+		Query: `
+query getTeam ($key: String!) {
+	team(id: $key) {
+		... Team
+	}
+}
+fragment Team on Team {
+	id
+	name
+	key
+	private
+	joinByDefault
+	description
+	icon
+	color
+	timezone
+	issueOrderingNoPriorityFirst
+	groupIssueHistory
+	setIssueSortOrderOnStateChange
+	autoArchivePeriod
+	autoClosePeriod
+	triageEnabled
+	requirePriorityToLeaveTriage
+	cyclesEnabled
+	cycleStartDay
+	cycleDuration
+	cycleCooldownTime
+	upcomingCycleCount
+	cycleIssueAutoAssignStarted
+	cycleIssueAutoAssignCompleted
+	cycleLockToActive
+	issueEstimationType
+	issueEstimationAllowZero
+	issueEstimationExtended
+	defaultIssueEstimate
+	defaultTemplateForMembers {
+		id
+	}
+	defaultTemplateForNonMembers {
+		id
+	}
+	defaultProjectTemplate {
+		id
+	}
+}
+`,
+		Variables: &__getTeamInput{
+			Key: key,
+		},
+	}
+	var err error
+
+	var data getTeamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamDefaultWorkflowStates(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getTeamDefaultWorkflowStatesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamDefaultWorkflowStates",
+		Query: `
+query getTeamDefaultWorkflowStates ($id: String!) {
+	team(id: $id) {
+		defaultIssueState {
+			id
+		}
+		markedAsDuplicateWorkflowState {
+			id
+		}
+		autoCloseStateId
+	}
+}
+`,
+		Variables: &__getTeamDefaultWorkflowStatesInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getTeamDefaultWorkflowStatesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamMembership(
+	ctx context.Context,
+	client graphql.Client,
+	teamId string,
+	userId string,
+) (*getTeamMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamMembership",
+		Query: `
+query getTeamMembership ($teamId: String!, $userId: String!) {
+	team(id: $teamId) {
+		membership(userId: $userId) {
+			... TeamMembership
+		}
+	}
+}
+fragment TeamMembership on TeamMembership {
+	id
+	owner
+	team {
+		id
+	}
+	user {
+		id
+	}
+}
+`,
+		Variables: &__getTeamMembershipInput{
+			TeamId: teamId,
+			UserId: userId,
+		},
+	}
+	var err error
+
+	var data getTeamMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamMembershipById(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getTeamMembershipByIdResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamMembershipById",
+		Query: `
+query getTeamMembershipById ($id: String!) {
+	teamMembership(id: $id) {
+		... TeamMembership
+	}
+}
+fragment TeamMembership on TeamMembership {
+	id
+	owner
+	team {
+		id
+	}
+	user {
+		id
+	}
+}
+`,
+		Variables: &__getTeamMembershipByIdInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getTeamMembershipByIdResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamNotificationSubscription(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getTeamNotificationSubscriptionResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamNotificationSubscription",
+		Query: `
+query getTeamNotificationSubscription ($id: String!) {
+	team(id: $id) {
+		... TeamNotificationSubscription
+	}
+}
+fragment TeamNotificationSubscription on Team {
+	id
+	slackNewIssue
+	slackIssueComments
+	slackIssueStatuses
+}
+`,
+		Variables: &__getTeamNotificationSubscriptionInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getTeamNotificationSubscriptionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamWorkflow(
+	ctx context.Context,
+	client graphql.Client,
+	key string,
+) (*getTeamWorkflowResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamWorkflow",
+		Query: `
+query getTeamWorkflow ($key: String!) {
+	team(id: $key) {
+		... TeamWorkflow
+	}
+}
+fragment TeamWorkflow on Team {
+	id
+	key
+	draftWorkflowState {
+		id
+	}
+	startWorkflowState {
+		id
+	}
+	reviewWorkflowState {
+		id
+	}
+	mergeWorkflowState {
+		id
+	}
+}
+`,
+		Variables: &__getTeamWorkflowInput{
+			Key: key,
+		},
+	}
+	var err error
+
+	var data getTeamWorkflowResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTeamWorkflowStates(
+	ctx context.Context,
+	client graphql.Client,
+	key string,
+) (*getTeamWorkflowStatesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTeamWorkflowStates",
+		Query: `
+query getTeamWorkflowStates ($key: String!) {
+	workflowStates(filter: {team:{key:{eq:$key}}}) {
+		nodes {
+			... WorkflowState
+		}
+	}
+}
+fragment WorkflowState on WorkflowState {
+	id
+	name
+	color
+	description
+	type
+	position
+	archivedAt
+	createdAt
+	updatedAt
+	team {
+		id
+		key
+		organization {
+			urlKey
+		}
+	}
+}
+`,
+		Variables: &__getTeamWorkflowStatesInput{
+			Key: key,
+		},
+	}
+	var err error
+
+	var data getTeamWorkflowStatesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTemplate(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getTemplateResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTemplate",
+		Query: `
+query getTemplate ($id: String!) {
+	template(id: $id) {
+		... Template
+	}
+}
+fragment Template on Template {
+	id
+	type
+	name
+	description
+	templateData
+	team {
+		id
+	}
+}
+`,
+		Variables: &__getTemplateInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getTemplateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getViewer(
+	ctx context.Context,
+	client graphql.Client,
+) (*getViewerResponse, error) {
+	req := &graphql.Request{
+		OpName: "getViewer",
+		Query: `
+query getViewer {
+	viewer {
+		id
+		name
+		email
+		organization {
+			id
+			name
+			urlKey
+		}
+	}
+}
+`,
+	}
+	var err error
+
+	var data getViewerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getWorkflowState(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getWorkflowStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorkflowState",
+		Query: `
+query getWorkflowState ($id: String!) {
+	workflowState(id: $id) {
+		... WorkflowState
+	}
+}
+fragment WorkflowState on WorkflowState {
+	id
+	name
+	color
+	description
+	type
+	position
+	archivedAt
+	createdAt
+	updatedAt
+	team {
+		id
+		key
+		organization {
+			urlKey
+		}
+	}
+}
+`,
+		Variables: &__getWorkflowStateInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getWorkflowStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getWorkflowStateIssues(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	after *string,
+) (*getWorkflowStateIssuesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorkflowStateIssues",
+		Query: `
+query getWorkflowStateIssues ($id: String!, $after: String) {
+	workflowState(id: $id) {
+		issues(first: 250, after: $after) {
+			nodes {
+				id
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+		team {
+			key
+			organization {
+				urlKey
+			}
+		}
+	}
+}
+`,
+		Variables: &__getWorkflowStateIssuesInput{
+			Id:    id,
+			After: after,
+		},
+	}
+	var err error
+
+	var data getWorkflowStateIssuesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
 
-// updateTeamWorkflowTeamUpdateTeamPayload includes the requested fields of the GraphQL type TeamPayload.
-type updateTeamWorkflowTeamUpdateTeamPayload struct {
-	// The team that was created or updated.
-	Team updateTeamWorkflowTeamUpdateTeamPayloadTeam `json:"team"`
+func getWorkflowSyncStates(
+	ctx context.Context,
+	client graphql.Client,
+	teamId string,
+) (*getWorkflowSyncStatesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorkflowSyncStates",
+		Query: `
+query getWorkflowSyncStates ($teamId: ID!) {
+	workflowStates(filter: {team:{id:{eq:$teamId}}}) {
+		nodes {
+			... WorkflowState
+		}
+	}
+}
+fragment WorkflowState on WorkflowState {
+	id
+	name
+	color
+	description
+	type
+	position
+	archivedAt
+	createdAt
+	updatedAt
+	team {
+		id
+		key
+		organization {
+			urlKey
+		}
+	}
 }
+`,
+		Variables: &__getWorkflowSyncStatesInput{
+			TeamId: teamId,
+		},
+	}
+	var err error
 
-// GetTeam returns updateTeamWorkflowTeamUpdateTeamPayload.Team, and is useful for accessing the field via an interface.
-func (v *updateTeamWorkflowTeamUpdateTeamPayload) GetTeam() updateTeamWorkflowTeamUpdateTeamPayloadTeam {
-	return v.Team
+	var data getWorkflowSyncStatesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
 
-// updateTeamWorkflowTeamUpdateTeamPayloadTeam includes the requested fields of the GraphQL type Team.
-// The GraphQL type's documentation follows.
-//
-// An organizational unit that contains issues.
-type updateTeamWorkflowTeamUpdateTeamPayloadTeam struct {
-	TeamWorkflow `json:"-"`
+func getWorkspace(
+	ctx context.Context,
+	client graphql.Client,
+) (*getWorkspaceResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorkspace",
+		Query: `
+query getWorkspace {
+	organization {
+		id
+		urlKey
+		name
+	}
 }
+`,
+	}
+	var err error
 
-// GetId returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.Id, and is useful for accessing the field via an interface.
-func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetId() string { return v.TeamWorkflow.Id }
+	var data getWorkspaceResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetKey returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.Key, and is useful for accessing the field via an interface.
-func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetKey() string { return v.TeamWorkflow.Key }
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// GetDraftWorkflowState returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.DraftWorkflowState, and is useful for accessing the field via an interface.
-func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetDraftWorkflowState() *TeamWorkflowDraftWorkflowState {
-	return v.TeamWorkflow.DraftWorkflowState
+	return &data, err
 }
 
-// GetStartWorkflowState returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.StartWorkflowState, and is useful for accessing the field via an interface.
-func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetStartWorkflowState() *TeamWorkflowStartWorkflowState {
-	return v.TeamWorkflow.StartWorkflowState
+func getWorkspaceSettings(
+	ctx context.Context,
+	client graphql.Client,
+) (*getWorkspaceSettingsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorkspaceSettings",
+		Query: `
+query getWorkspaceSettings {
+	organization {
+		... Organization
+	}
+}
+fragment Organization on Organization {
+	id
+	name
+	urlKey
+	logoUrl
+	gitBranchFormat
+	allowMembersToInvite
+	roadmapEnabled
+	gitLinkbackMessagesEnabled
+	gitPublicLinkbackMessagesEnabled
 }
+`,
+	}
+	var err error
 
-// GetReviewWorkflowState returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.ReviewWorkflowState, and is useful for accessing the field via an interface.
-func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetReviewWorkflowState() *TeamWorkflowReviewWorkflowState {
-	return v.TeamWorkflow.ReviewWorkflowState
+	var data getWorkspaceSettingsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
 
-// GetMergeWorkflowState returns updateTeamWorkflowTeamUpdateTeamPayloadTeam.MergeWorkflowState, and is useful for accessing the field via an interface.
-func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) GetMergeWorkflowState() *TeamWorkflowMergeWorkflowState {
-	return v.TeamWorkflow.MergeWorkflowState
+func listCustomViews(
+	ctx context.Context,
+	client graphql.Client,
+	after *string,
+) (*listCustomViewsResponse, error) {
+	req := &graphql.Request{
+		OpName: "listCustomViews",
+		Query: `
+query listCustomViews ($after: String) {
+	customViews(first: 250, after: $after) {
+		nodes {
+			... CustomView
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment CustomView on CustomView {
+	id
+	name
+	description
+	icon
+	color
+	shared
+	filterData
+	team {
+		id
+	}
+	owner {
+		id
+	}
 }
+`,
+		Variables: &__listCustomViewsInput{
+			After: after,
+		},
+	}
+	var err error
 
-func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) UnmarshalJSON(b []byte) error {
+	var data listCustomViewsResponse
+	resp := &graphql.Response{Data: &data}
 
-	if string(b) == "null" {
-		return nil
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func listCycles(
+	ctx context.Context,
+	client graphql.Client,
+	teamId string,
+	filter map[string]interface{},
+	after *string,
+) (*listCyclesResponse, error) {
+	req := &graphql.Request{
+		OpName: "listCycles",
+		Query: `
+query listCycles ($teamId: String!, $filter: CycleFilter!, $after: String) {
+	team(id: $teamId) {
+		cycles(first: 250, after: $after, filter: $filter) {
+			nodes {
+				... CycleDetails
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+fragment CycleDetails on Cycle {
+	id
+	number
+	name
+	description
+	startsAt
+	endsAt
+	completedAt
+	progress
+}
+`,
+		Variables: &__listCyclesInput{
+			TeamId: teamId,
+			Filter: filter,
+			After:  after,
+		},
 	}
+	var err error
 
-	var firstPass struct {
-		*updateTeamWorkflowTeamUpdateTeamPayloadTeam
-		graphql.NoUnmarshalJSON
+	var data listCyclesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func listGitAutomationStates(
+	ctx context.Context,
+	client graphql.Client,
+	teamId string,
+	after *string,
+) (*listGitAutomationStatesResponse, error) {
+	req := &graphql.Request{
+		OpName: "listGitAutomationStates",
+		Query: `
+query listGitAutomationStates ($teamId: String!, $after: String) {
+	team(id: $teamId) {
+		gitAutomationStates(first: 250, after: $after) {
+			nodes {
+				... GitAutomationState
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
 	}
-	firstPass.updateTeamWorkflowTeamUpdateTeamPayloadTeam = v
+}
+fragment GitAutomationState on GitAutomationState {
+	id
+	event
+	state {
+		id
+	}
+	team {
+		id
+	}
+	targetBranch {
+		id
+	}
+}
+`,
+		Variables: &__listGitAutomationStatesInput{
+			TeamId: teamId,
+			After:  after,
+		},
+	}
+	var err error
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
+	var data listGitAutomationStatesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func listIssues(
+	ctx context.Context,
+	client graphql.Client,
+	filter map[string]interface{},
+	first int,
+	after *string,
+) (*listIssuesResponse, error) {
+	req := &graphql.Request{
+		OpName: "listIssues",
+		Query: `
+query listIssues ($filter: IssueFilter!, $first: Int!, $after: String) {
+	issues(first: $first, after: $after, filter: $filter) {
+		nodes {
+			... IssueSummary
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment IssueSummary on Issue {
+	id
+	identifier
+	title
+	priority
+	url
+	createdAt
+	team {
+		id
+	}
+	state {
+		id
+		type
 	}
-
-	err = json.Unmarshal(
-		b, &v.TeamWorkflow)
-	if err != nil {
-		return err
+	assignee {
+		id
+	}
+	labels(first: 250) {
+		nodes {
+			id
+		}
 	}
-	return nil
 }
+`,
+		Variables: &__listIssuesInput{
+			Filter: filter,
+			First:  first,
+			After:  after,
+		},
+	}
+	var err error
 
-type __premarshalupdateTeamWorkflowTeamUpdateTeamPayloadTeam struct {
-	Id string `json:"id"`
-
-	Key string `json:"key"`
-
-	DraftWorkflowState *TeamWorkflowDraftWorkflowState `json:"draftWorkflowState"`
-
-	StartWorkflowState *TeamWorkflowStartWorkflowState `json:"startWorkflowState"`
+	var data listIssuesResponse
+	resp := &graphql.Response{Data: &data}
 
-	ReviewWorkflowState *TeamWorkflowReviewWorkflowState `json:"reviewWorkflowState"`
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-	MergeWorkflowState *TeamWorkflowMergeWorkflowState `json:"mergeWorkflowState"`
+	return &data, err
 }
 
-func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
+func listLabelsOfTeam(
+	ctx context.Context,
+	client graphql.Client,
+	teamId string,
+	after *string,
+) (*listLabelsOfTeamResponse, error) {
+	req := &graphql.Request{
+		OpName: "listLabelsOfTeam",
+		Query: `
+query listLabelsOfTeam ($teamId: ID!, $after: String) {
+	issueLabels(first: 250, after: $after, filter: {team:{id:{eq:$teamId}}}) {
+		nodes {
+			... IssueLabel
+			isGroup
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
 	}
-	return json.Marshal(premarshaled)
-}
-
-func (v *updateTeamWorkflowTeamUpdateTeamPayloadTeam) __premarshalJSON() (*__premarshalupdateTeamWorkflowTeamUpdateTeamPayloadTeam, error) {
-	var retval __premarshalupdateTeamWorkflowTeamUpdateTeamPayloadTeam
-
-	retval.Id = v.TeamWorkflow.Id
-	retval.Key = v.TeamWorkflow.Key
-	retval.DraftWorkflowState = v.TeamWorkflow.DraftWorkflowState
-	retval.StartWorkflowState = v.TeamWorkflow.StartWorkflowState
-	retval.ReviewWorkflowState = v.TeamWorkflow.ReviewWorkflowState
-	retval.MergeWorkflowState = v.TeamWorkflow.MergeWorkflowState
-	return &retval, nil
 }
-
-// updateWorkflowStateResponse is returned by updateWorkflowState on success.
-type updateWorkflowStateResponse struct {
-	// Updates a state.
-	WorkflowStateUpdate updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload `json:"workflowStateUpdate"`
+fragment IssueLabel on IssueLabel {
+	id
+	name
+	description
+	color
+	parent {
+		id
+	}
+	team {
+		id
+	}
 }
+`,
+		Variables: &__listLabelsOfTeamInput{
+			TeamId: teamId,
+			After:  after,
+		},
+	}
+	var err error
 
-// GetWorkflowStateUpdate returns updateWorkflowStateResponse.WorkflowStateUpdate, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateResponse) GetWorkflowStateUpdate() updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload {
-	return v.WorkflowStateUpdate
-}
+	var data listLabelsOfTeamResponse
+	resp := &graphql.Response{Data: &data}
 
-// updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload includes the requested fields of the GraphQL type WorkflowStatePayload.
-type updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload struct {
-	// The state that was created or updated.
-	WorkflowState updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState `json:"workflowState"`
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// GetWorkflowState returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload.WorkflowState, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayload) GetWorkflowState() updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState {
-	return v.WorkflowState
+	return &data, err
 }
 
-// updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState includes the requested fields of the GraphQL type WorkflowState.
-// The GraphQL type's documentation follows.
-//
-// A state in a team workflow.
-type updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState struct {
-	WorkflowState `json:"-"`
+func listProjectMembers(
+	ctx context.Context,
+	client graphql.Client,
+	projectId string,
+	after *string,
+) (*listProjectMembersResponse, error) {
+	req := &graphql.Request{
+		OpName: "listProjectMembers",
+		Query: `
+query listProjectMembers ($projectId: String!, $after: String) {
+	project(id: $projectId) {
+		members(first: 250, after: $after) {
+			nodes {
+				id
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
 }
+`,
+		Variables: &__listProjectMembersInput{
+			ProjectId: projectId,
+			After:     after,
+		},
+	}
+	var err error
 
-// GetId returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Id, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetId() string {
-	return v.WorkflowState.Id
-}
+	var data listProjectMembersResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetName returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Name, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetName() string {
-	return v.WorkflowState.Name
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// GetColor returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Color, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetColor() string {
-	return v.WorkflowState.Color
+	return &data, err
 }
 
-// GetDescription returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Description, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetDescription() *string {
-	return v.WorkflowState.Description
+func listProjectStatuses(
+	ctx context.Context,
+	client graphql.Client,
+) (*listProjectStatusesResponse, error) {
+	req := &graphql.Request{
+		OpName: "listProjectStatuses",
+		Query: `
+query listProjectStatuses {
+	organization {
+		id
+		projectStatuses {
+			id
+			name
+			color
+			description
+			position
+			type
+			indefinite
+		}
+	}
 }
+`,
+	}
+	var err error
 
-// GetType returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Type, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetType() string {
-	return v.WorkflowState.Type
-}
+	var data listProjectStatusesResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetPosition returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Position, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetPosition() float64 {
-	return v.WorkflowState.Position
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// GetTeam returns updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState.Team, and is useful for accessing the field via an interface.
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) GetTeam() WorkflowStateTeam {
-	return v.WorkflowState.Team
+	return &data, err
 }
 
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
+func listProjects(
+	ctx context.Context,
+	client graphql.Client,
+	filter map[string]interface{},
+	after *string,
+) (*listProjectsResponse, error) {
+	req := &graphql.Request{
+		OpName: "listProjects",
+		Query: `
+query listProjects ($filter: ProjectFilter!, $after: String) {
+	projects(first: 250, after: $after, filter: $filter) {
+		nodes {
+			... ProjectDetails
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
 	}
-
-	var firstPass struct {
-		*updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState
-		graphql.NoUnmarshalJSON
+}
+fragment ProjectDetails on Project {
+	id
+	slugId
+	name
+	description
+	icon
+	color
+	priority
+	progress
+	startDate
+	targetDate
+	url
+	status {
+		id
+		type
 	}
-	firstPass.updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
+	lead {
+		id
 	}
-
-	err = json.Unmarshal(
-		b, &v.WorkflowState)
-	if err != nil {
-		return err
+	teams {
+		nodes {
+			id
+		}
 	}
-	return nil
 }
+`,
+		Variables: &__listProjectsInput{
+			Filter: filter,
+			After:  after,
+		},
+	}
+	var err error
 
-type __premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState struct {
-	Id string `json:"id"`
-
-	Name string `json:"name"`
-
-	Color string `json:"color"`
-
-	Description *string `json:"description"`
-
-	Type string `json:"type"`
+	var data listProjectsResponse
+	resp := &graphql.Response{Data: &data}
 
-	Position float64 `json:"position"`
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-	Team WorkflowStateTeam `json:"team"`
+	return &data, err
 }
 
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
+func listRoadmapToProjects(
+	ctx context.Context,
+	client graphql.Client,
+	after *string,
+) (*listRoadmapToProjectsResponse, error) {
+	req := &graphql.Request{
+		OpName: "listRoadmapToProjects",
+		Query: `
+query listRoadmapToProjects ($after: String) {
+	roadmapToProjects(first: 250, after: $after) {
+		nodes {
+			id
+			sortOrder
+			project {
+				id
+			}
+			roadmap {
+				id
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
 	}
-	return json.Marshal(premarshaled)
-}
-
-func (v *updateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState) __premarshalJSON() (*__premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState, error) {
-	var retval __premarshalupdateWorkflowStateWorkflowStateUpdateWorkflowStatePayloadWorkflowState
-
-	retval.Id = v.WorkflowState.Id
-	retval.Name = v.WorkflowState.Name
-	retval.Color = v.WorkflowState.Color
-	retval.Description = v.WorkflowState.Description
-	retval.Type = v.WorkflowState.Type
-	retval.Position = v.WorkflowState.Position
-	retval.Team = v.WorkflowState.Team
-	return &retval, nil
 }
+`,
+		Variables: &__listRoadmapToProjectsInput{
+			After: after,
+		},
+	}
+	var err error
 
-// updateWorkspaceSettingsOrganizationUpdateOrganizationPayload includes the requested fields of the GraphQL type OrganizationPayload.
-type updateWorkspaceSettingsOrganizationUpdateOrganizationPayload struct {
-	// The organization that was created or updated.
-	Organization updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization `json:"organization"`
-}
+	var data listRoadmapToProjectsResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetOrganization returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayload.Organization, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayload) GetOrganization() updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization {
-	return v.Organization
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization includes the requested fields of the GraphQL type Organization.
-// The GraphQL type's documentation follows.
-//
-// An organization. Organizations are root-level objects that contain user accounts and teams.
-type updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization struct {
-	Organization `json:"-"`
+	return &data, err
 }
 
-// GetId returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.Id, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetId() string {
-	return v.Organization.Id
+func listTeamLabels(
+	ctx context.Context,
+	client graphql.Client,
+	teamId string,
+) (*listTeamLabelsResponse, error) {
+	req := &graphql.Request{
+		OpName: "listTeamLabels",
+		Query: `
+query listTeamLabels ($teamId: ID!) {
+	issueLabels(first: 250, filter: {team:{id:{eq:$teamId}}}) {
+		nodes {
+			id
+			name
+			color
+		}
+	}
 }
+`,
+		Variables: &__listTeamLabelsInput{
+			TeamId: teamId,
+		},
+	}
+	var err error
 
-// GetAllowMembersToInvite returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.AllowMembersToInvite, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetAllowMembersToInvite() bool {
-	return v.Organization.AllowMembersToInvite
-}
+	var data listTeamLabelsResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetRoadmapEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.RoadmapEnabled, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetRoadmapEnabled() bool {
-	return v.Organization.RoadmapEnabled
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// GetGitLinkbackMessagesEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.GitLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetGitLinkbackMessagesEnabled() bool {
-	return v.Organization.GitLinkbackMessagesEnabled
+	return &data, err
 }
 
-// GetGitPublicLinkbackMessagesEnabled returns updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization.GitPublicLinkbackMessagesEnabled, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) GetGitPublicLinkbackMessagesEnabled() bool {
-	return v.Organization.GitPublicLinkbackMessagesEnabled
+func listTeams(
+	ctx context.Context,
+	client graphql.Client,
+	after *string,
+) (*listTeamsResponse, error) {
+	req := &graphql.Request{
+		OpName: "listTeams",
+		Query: `
+query listTeams ($after: String) {
+	teams(first: 250, after: $after) {
+		nodes {
+			id
+			key
+			name
+			private
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
 }
-
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
+`,
+		Variables: &__listTeamsInput{
+			After: after,
+		},
 	}
+	var err error
 
-	var firstPass struct {
-		*updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization = v
+	var data listTeamsResponse
+	resp := &graphql.Response{Data: &data}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-	err = json.Unmarshal(
-		b, &v.Organization)
-	if err != nil {
-		return err
-	}
-	return nil
+	return &data, err
 }
 
-type __premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization struct {
-	Id string `json:"id"`
-
-	AllowMembersToInvite bool `json:"allowMembersToInvite"`
+func listTemplates(
+	ctx context.Context,
+	client graphql.Client,
+) (*listTemplatesResponse, error) {
+	req := &graphql.Request{
+		OpName: "listTemplates",
+		Query: `
+query listTemplates {
+	templates {
+		id
+		name
+		type
+		team {
+			key
+		}
+	}
+}
+`,
+	}
+	var err error
 
-	RoadmapEnabled bool `json:"roadmapEnabled"`
+	var data listTemplatesResponse
+	resp := &graphql.Response{Data: &data}
 
-	GitLinkbackMessagesEnabled bool `json:"gitLinkbackMessagesEnabled"`
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-	GitPublicLinkbackMessagesEnabled bool `json:"gitPublicLinkbackMessagesEnabled"`
+	return &data, err
 }
 
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
+func listWebhooks(
+	ctx context.Context,
+	client graphql.Client,
+	after *string,
+) (*listWebhooksResponse, error) {
+	req := &graphql.Request{
+		OpName: "listWebhooks",
+		Query: `
+query listWebhooks ($after: String) {
+	webhooks(first: 250, after: $after) {
+		nodes {
+			id
+			label
+			url
+			enabled
+			team {
+				id
+			}
+			allPublicTeams
+			resourceTypes
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
 	}
-	return json.Marshal(premarshaled)
 }
+`,
+		Variables: &__listWebhooksInput{
+			After: after,
+		},
+	}
+	var err error
 
-func (v *updateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization) __premarshalJSON() (*__premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization, error) {
-	var retval __premarshalupdateWorkspaceSettingsOrganizationUpdateOrganizationPayloadOrganization
-
-	retval.Id = v.Organization.Id
-	retval.AllowMembersToInvite = v.Organization.AllowMembersToInvite
-	retval.RoadmapEnabled = v.Organization.RoadmapEnabled
-	retval.GitLinkbackMessagesEnabled = v.Organization.GitLinkbackMessagesEnabled
-	retval.GitPublicLinkbackMessagesEnabled = v.Organization.GitPublicLinkbackMessagesEnabled
-	return &retval, nil
-}
+	var data listWebhooksResponse
+	resp := &graphql.Response{Data: &data}
 
-// updateWorkspaceSettingsResponse is returned by updateWorkspaceSettings on success.
-type updateWorkspaceSettingsResponse struct {
-	// Updates the user's organization.
-	OrganizationUpdate updateWorkspaceSettingsOrganizationUpdateOrganizationPayload `json:"organizationUpdate"`
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// GetOrganizationUpdate returns updateWorkspaceSettingsResponse.OrganizationUpdate, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceSettingsResponse) GetOrganizationUpdate() updateWorkspaceSettingsOrganizationUpdateOrganizationPayload {
-	return v.OrganizationUpdate
+	return &data, err
 }
 
-func createLabel(
+func listWorkspaceLabels(
 	ctx context.Context,
 	client graphql.Client,
-	input IssueLabelCreateInput,
-) (*createLabelResponse, error) {
+	after *string,
+) (*listWorkspaceLabelsResponse, error) {
 	req := &graphql.Request{
-		OpName: "createLabel",
+		OpName: "listWorkspaceLabels",
 		Query: `
-mutation createLabel ($input: IssueLabelCreateInput!) {
-	issueLabelCreate(input: $input) {
-		issueLabel {
+query listWorkspaceLabels ($after: String) {
+	issueLabels(first: 250, after: $after, filter: {team:{null:true}}) {
+		nodes {
 			... IssueLabel
+			isGroup
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
 		}
 	}
 }
@@ -3490,13 +20821,115 @@ fragment IssueLabel on IssueLabel {
 	}
 }
 `,
-		Variables: &__createLabelInput{
-			Input: input,
+		Variables: &__listWorkspaceLabelsInput{
+			After: after,
+		},
+	}
+	var err error
+
+	var data listWorkspaceLabelsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func moveIssueToWorkflowState(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	stateId string,
+) (*moveIssueToWorkflowStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "moveIssueToWorkflowState",
+		Query: `
+mutation moveIssueToWorkflowState ($id: String!, $stateId: String!) {
+	issueUpdate(id: $id, input: {stateId:$stateId}) {
+		success
+	}
+}
+`,
+		Variables: &__moveIssueToWorkflowStateInput{
+			Id:      id,
+			StateId: stateId,
+		},
+	}
+	var err error
+
+	var data moveIssueToWorkflowStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func setTeamAutoCloseState(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	stateId string,
+) (*setTeamAutoCloseStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "setTeamAutoCloseState",
+		Query: `
+mutation setTeamAutoCloseState ($id: String!, $stateId: String!) {
+	teamUpdate(id: $id, input: {autoCloseStateId:$stateId}) {
+		success
+	}
+}
+`,
+		Variables: &__setTeamAutoCloseStateInput{
+			Id:      id,
+			StateId: stateId,
+		},
+	}
+	var err error
+
+	var data setTeamAutoCloseStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func setTeamDefaultIssueState(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	stateId string,
+) (*setTeamDefaultIssueStateResponse, error) {
+	req := &graphql.Request{
+		OpName: "setTeamDefaultIssueState",
+		Query: `
+mutation setTeamDefaultIssueState ($id: String!, $stateId: String!) {
+	teamUpdate(id: $id, input: {defaultIssueStateId:$stateId}) {
+		success
+	}
+}
+`,
+		Variables: &__setTeamDefaultIssueStateInput{
+			Id:      id,
+			StateId: stateId,
 		},
 	}
 	var err error
 
-	var data createLabelResponse
+	var data setTeamDefaultIssueStateResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3508,16 +20941,19 @@ fragment IssueLabel on IssueLabel {
 	return &data, err
 }
 
-func createTeam(
+func setTeamDefaultTemplates(
 	ctx context.Context,
 	client graphql.Client,
-	input TeamCreateInput,
-) (*createTeamResponse, error) {
+	id string,
+	membersTemplateId *string,
+	nonMembersTemplateId *string,
+	projectTemplateId *string,
+) (*setTeamDefaultTemplatesResponse, error) {
 	req := &graphql.Request{
-		OpName: "createTeam",
+		OpName: "setTeamDefaultTemplates",
 		Query: `
-mutation createTeam ($input: TeamCreateInput!) {
-	teamCreate(input: $input) {
+mutation setTeamDefaultTemplates ($id: String!, $membersTemplateId: String, $nonMembersTemplateId: String, $projectTemplateId: String) {
+	teamUpdate(id: $id, input: {defaultTemplateForMembersId:$membersTemplateId,defaultTemplateForNonMembersId:$nonMembersTemplateId,defaultProjectTemplateId:$projectTemplateId}) {
 		team {
 			... Team
 		}
@@ -3528,6 +20964,7 @@ fragment Team on Team {
 	name
 	key
 	private
+	joinByDefault
 	description
 	icon
 	color
@@ -3538,6 +20975,7 @@ fragment Team on Team {
 	autoArchivePeriod
 	autoClosePeriod
 	triageEnabled
+	requirePriorityToLeaveTriage
 	cyclesEnabled
 	cycleStartDay
 	cycleDuration
@@ -3550,15 +20988,27 @@ fragment Team on Team {
 	issueEstimationAllowZero
 	issueEstimationExtended
 	defaultIssueEstimate
+	defaultTemplateForMembers {
+		id
+	}
+	defaultTemplateForNonMembers {
+		id
+	}
+	defaultProjectTemplate {
+		id
+	}
 }
 `,
-		Variables: &__createTeamInput{
-			Input: input,
+		Variables: &__setTeamDefaultTemplatesInput{
+			Id:                   id,
+			MembersTemplateId:    membersTemplateId,
+			NonMembersTemplateId: nonMembersTemplateId,
+			ProjectTemplateId:    projectTemplateId,
 		},
 	}
 	var err error
 
-	var data createTeamResponse
+	var data setTeamDefaultTemplatesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3570,40 +21020,80 @@ fragment Team on Team {
 	return &data, err
 }
 
-func createWorkflowState(
+func setTeamMarkedAsDuplicateWorkflowState(
 	ctx context.Context,
 	client graphql.Client,
-	input WorkflowStateCreateInput,
-) (*createWorkflowStateResponse, error) {
+	id string,
+	stateId string,
+) (*setTeamMarkedAsDuplicateWorkflowStateResponse, error) {
 	req := &graphql.Request{
-		OpName: "createWorkflowState",
+		OpName: "setTeamMarkedAsDuplicateWorkflowState",
 		Query: `
-mutation createWorkflowState ($input: WorkflowStateCreateInput!) {
-	workflowStateCreate(input: $input) {
-		workflowState {
-			... WorkflowState
+mutation setTeamMarkedAsDuplicateWorkflowState ($id: String!, $stateId: String!) {
+	teamUpdate(id: $id, input: {markedAsDuplicateWorkflowStateId:$stateId}) {
+		success
+	}
+}
+`,
+		Variables: &__setTeamMarkedAsDuplicateWorkflowStateInput{
+			Id:      id,
+			StateId: stateId,
+		},
+	}
+	var err error
+
+	var data setTeamMarkedAsDuplicateWorkflowStateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateCustomView(
+	ctx context.Context,
+	client graphql.Client,
+	input CustomViewUpdateInput,
+	id string,
+) (*updateCustomViewResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateCustomView",
+		Query: `
+mutation updateCustomView ($input: CustomViewUpdateInput!, $id: String!) {
+	customViewUpdate(input: $input, id: $id) {
+		customView {
+			... CustomView
 		}
 	}
 }
-fragment WorkflowState on WorkflowState {
+fragment CustomView on CustomView {
 	id
 	name
-	color
 	description
-	type
-	position
+	icon
+	color
+	shared
+	filterData
 	team {
 		id
 	}
+	owner {
+		id
+	}
 }
 `,
-		Variables: &__createWorkflowStateInput{
+		Variables: &__updateCustomViewInput{
 			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data createWorkflowStateResponse
+	var data updateCustomViewResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3615,27 +21105,44 @@ fragment WorkflowState on WorkflowState {
 	return &data, err
 }
 
-func deleteLabel(
+func updateCustomer(
 	ctx context.Context,
 	client graphql.Client,
+	input CustomerUpdateInput,
 	id string,
-) (*deleteLabelResponse, error) {
+) (*updateCustomerResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteLabel",
+		OpName: "updateCustomer",
 		Query: `
-mutation deleteLabel ($id: String!) {
-	issueLabelDelete(id: $id) {
-		success
+mutation updateCustomer ($input: CustomerUpdateInput!, $id: String!) {
+	customerUpdate(input: $input, id: $id) {
+		customer {
+			... Customer
+		}
+	}
+}
+fragment Customer on Customer {
+	id
+	name
+	domains
+	externalIds
+	slackChannelId
+	owner {
+		id
+	}
+	status {
+		id
 	}
 }
 `,
-		Variables: &__deleteLabelInput{
-			Id: id,
+		Variables: &__updateCustomerInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data deleteLabelResponse
+	var data updateCustomerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3647,27 +21154,47 @@ mutation deleteLabel ($id: String!) {
 	return &data, err
 }
 
-func deleteTeam(
+func updateCustomerNeed(
 	ctx context.Context,
 	client graphql.Client,
-	key string,
-) (*deleteTeamResponse, error) {
+	input CustomerNeedUpdateInput,
+	id string,
+) (*updateCustomerNeedResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteTeam",
+		OpName: "updateCustomerNeed",
 		Query: `
-mutation deleteTeam ($key: String!) {
-	teamDelete(id: $key) {
-		success
+mutation updateCustomerNeed ($input: CustomerNeedUpdateInput!, $id: String!) {
+	customerNeedUpdate(input: $input, id: $id) {
+		need {
+			... CustomerNeed
+		}
+	}
+}
+fragment CustomerNeed on CustomerNeed {
+	id
+	priority
+	customer {
+		id
+	}
+	issue {
+		id
+	}
+	project {
+		id
+	}
+	comment {
+		id
 	}
 }
 `,
-		Variables: &__deleteTeamInput{
-			Key: key,
+		Variables: &__updateCustomerNeedInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data deleteTeamResponse
+	var data updateCustomerNeedResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3679,27 +21206,39 @@ mutation deleteTeam ($key: String!) {
 	return &data, err
 }
 
-func deleteWorkflowState(
+func updateCustomerStatus(
 	ctx context.Context,
 	client graphql.Client,
+	input CustomerStatusUpdateInput,
 	id string,
-) (*deleteWorkflowStateResponse, error) {
+) (*updateCustomerStatusResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteWorkflowState",
+		OpName: "updateCustomerStatus",
 		Query: `
-mutation deleteWorkflowState ($id: String!) {
-	workflowStateArchive(id: $id) {
-		success
+mutation updateCustomerStatus ($input: CustomerStatusUpdateInput!, $id: String!) {
+	customerStatusUpdate(input: $input, id: $id) {
+		status {
+			... CustomerStatus
+		}
 	}
 }
+fragment CustomerStatus on CustomerStatus {
+	id
+	name
+	color
+	description
+	type
+	position
+}
 `,
-		Variables: &__deleteWorkflowStateInput{
-			Id: id,
+		Variables: &__updateCustomerStatusInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data deleteWorkflowStateResponse
+	var data updateCustomerStatusResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3711,31 +21250,44 @@ mutation deleteWorkflowState ($id: String!) {
 	return &data, err
 }
 
-func findTeamLabel(
+func updateGitAutomationState(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-	key string,
-) (*findTeamLabelResponse, error) {
+	input GitAutomationStateUpdateInput,
+	id string,
+) (*updateGitAutomationStateResponse, error) {
 	req := &graphql.Request{
-		OpName: "findTeamLabel",
+		OpName: "updateGitAutomationState",
 		Query: `
-query findTeamLabel ($name: String!, $key: String!) {
-	issueLabels(filter: {name:{eq:$name},team:{key:{eq:$key}}}) {
-		nodes {
-			id
+mutation updateGitAutomationState ($input: GitAutomationStateUpdateInput!, $id: String!) {
+	gitAutomationStateUpdate(input: $input, id: $id) {
+		gitAutomationState {
+			... GitAutomationState
 		}
 	}
 }
+fragment GitAutomationState on GitAutomationState {
+	id
+	event
+	state {
+		id
+	}
+	team {
+		id
+	}
+	targetBranch {
+		id
+	}
+}
 `,
-		Variables: &__findTeamLabelInput{
-			Name: name,
-			Key:  key,
+		Variables: &__updateGitAutomationStateInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data findTeamLabelResponse
+	var data updateGitAutomationStateResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3747,31 +21299,41 @@ query findTeamLabel ($name: String!, $key: String!) {
 	return &data, err
 }
 
-func findWorkflowState(
+func updateInitiativeToProject(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-	key string,
-) (*findWorkflowStateResponse, error) {
+	input InitiativeToProjectUpdateInput,
+	id string,
+) (*updateInitiativeToProjectResponse, error) {
 	req := &graphql.Request{
-		OpName: "findWorkflowState",
+		OpName: "updateInitiativeToProject",
 		Query: `
-query findWorkflowState ($name: String!, $key: String!) {
-	workflowStates(filter: {name:{eq:$name},team:{key:{eq:$key}}}) {
-		nodes {
-			id
+mutation updateInitiativeToProject ($input: InitiativeToProjectUpdateInput!, $id: String!) {
+	initiativeToProjectUpdate(input: $input, id: $id) {
+		initiativeToProject {
+			... InitiativeToProject
 		}
 	}
 }
+fragment InitiativeToProject on InitiativeToProject {
+	id
+	sortOrder
+	initiative {
+		id
+	}
+	project {
+		id
+	}
+}
 `,
-		Variables: &__findWorkflowStateInput{
-			Name: name,
-			Key:  key,
+		Variables: &__updateInitiativeToProjectInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data findWorkflowStateResponse
+	var data updateInitiativeToProjectResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3783,32 +21345,44 @@ query findWorkflowState ($name: String!, $key: String!) {
 	return &data, err
 }
 
-func findWorkspaceLabel(
+func updateIssue(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-) (*findWorkspaceLabelResponse, error) {
+	input IssueUpdateInput,
+	id string,
+) (*updateIssueResponse, error) {
 	req := &graphql.Request{
-		OpName: "findWorkspaceLabel",
+		OpName: "updateIssue",
 		Query: `
-query findWorkspaceLabel ($name: String!) {
-	issueLabels(filter: {name:{eq:$name}}) {
-		nodes {
-			id
-			team {
-				id
-			}
+mutation updateIssue ($input: IssueUpdateInput!, $id: String!) {
+	issueUpdate(input: $input, id: $id) {
+		issue {
+			... Issue
 		}
 	}
 }
+fragment Issue on Issue {
+	id
+	identifier
+	title
+	description
+	priority
+	team {
+		id
+	}
+	state {
+		id
+	}
+}
 `,
-		Variables: &__findWorkspaceLabelInput{
-			Name: name,
+		Variables: &__updateIssueInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data findWorkspaceLabelResponse
+	var data updateIssueResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3820,39 +21394,43 @@ query findWorkspaceLabel ($name: String!) {
 	return &data, err
 }
 
-func getLabel(
+func updateIssueRelation(
 	ctx context.Context,
 	client graphql.Client,
+	input IssueRelationUpdateInput,
 	id string,
-) (*getLabelResponse, error) {
+) (*updateIssueRelationResponse, error) {
 	req := &graphql.Request{
-		OpName: "getLabel",
+		OpName: "updateIssueRelation",
 		Query: `
-query getLabel ($id: String!) {
-	issueLabel(id: $id) {
-		... IssueLabel
+mutation updateIssueRelation ($input: IssueRelationUpdateInput!, $id: String!) {
+	issueRelationUpdate(input: $input, id: $id) {
+		issueRelation {
+			... IssueRelation
+		}
 	}
 }
-fragment IssueLabel on IssueLabel {
+fragment IssueRelation on IssueRelation {
 	id
-	name
-	description
-	color
-	parent {
+	type
+	issue {
 		id
+		identifier
 	}
-	team {
+	relatedIssue {
 		id
+		identifier
 	}
 }
 `,
-		Variables: &__getLabelInput{
-			Id: id,
+		Variables: &__updateIssueRelationInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data getLabelResponse
+	var data updateIssueRelationResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3864,55 +21442,43 @@ fragment IssueLabel on IssueLabel {
 	return &data, err
 }
 
-func getTeam(
+func updateLabel(
 	ctx context.Context,
 	client graphql.Client,
-	key string,
-) (*getTeamResponse, error) {
+	input IssueLabelUpdateInput,
+	id string,
+) (*updateLabelResponse, error) {
 	req := &graphql.Request{
-		OpName: "getTeam",
+		OpName: "updateLabel",
 		Query: `
-query getTeam ($key: String!) {
-	team(id: $key) {
-		... Team
+mutation updateLabel ($input: IssueLabelUpdateInput!, $id: String!) {
+	issueLabelUpdate(input: $input, id: $id) {
+		issueLabel {
+			... IssueLabel
+		}
 	}
 }
-fragment Team on Team {
+fragment IssueLabel on IssueLabel {
 	id
 	name
-	key
-	private
 	description
-	icon
-	color
-	timezone
-	issueOrderingNoPriorityFirst
-	groupIssueHistory
-	setIssueSortOrderOnStateChange
-	autoArchivePeriod
-	autoClosePeriod
-	triageEnabled
-	cyclesEnabled
-	cycleStartDay
-	cycleDuration
-	cycleCooldownTime
-	upcomingCycleCount
-	cycleIssueAutoAssignStarted
-	cycleIssueAutoAssignCompleted
-	cycleLockToActive
-	issueEstimationType
-	issueEstimationAllowZero
-	issueEstimationExtended
-	defaultIssueEstimate
+	color
+	parent {
+		id
+	}
+	team {
+		id
+	}
 }
 `,
-		Variables: &__getTeamInput{
-			Key: key,
+		Variables: &__updateLabelInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data getTeamResponse
+	var data updateLabelResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3924,43 +21490,52 @@ fragment Team on Team {
 	return &data, err
 }
 
-func getTeamWorkflow(
+func updateProject(
 	ctx context.Context,
 	client graphql.Client,
-	key string,
-) (*getTeamWorkflowResponse, error) {
+	input ProjectUpdateInput,
+	id string,
+) (*updateProjectResponse, error) {
 	req := &graphql.Request{
-		OpName: "getTeamWorkflow",
+		OpName: "updateProject",
 		Query: `
-query getTeamWorkflow ($key: String!) {
-	team(id: $key) {
-		... TeamWorkflow
+mutation updateProject ($input: ProjectUpdateInput!, $id: String!) {
+	projectUpdate(input: $input, id: $id) {
+		project {
+			... Project
+		}
 	}
 }
-fragment TeamWorkflow on Team {
+fragment Project on Project {
 	id
-	key
-	draftWorkflowState {
-		id
-	}
-	startWorkflowState {
+	name
+	description
+	icon
+	color
+	priority
+	startDate
+	targetDate
+	status {
 		id
 	}
-	reviewWorkflowState {
+	lead {
 		id
 	}
-	mergeWorkflowState {
-		id
+	teams {
+		nodes {
+			id
+		}
 	}
 }
 `,
-		Variables: &__getTeamWorkflowInput{
-			Key: key,
+		Variables: &__updateProjectInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data getTeamWorkflowResponse
+	var data updateProjectResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3972,40 +21547,29 @@ fragment TeamWorkflow on Team {
 	return &data, err
 }
 
-func getTeamWorkflowStates(
+func updateProjectMembers(
 	ctx context.Context,
 	client graphql.Client,
-	key string,
-) (*getTeamWorkflowStatesResponse, error) {
+	projectId string,
+	memberIds []string,
+) (*updateProjectMembersResponse, error) {
 	req := &graphql.Request{
-		OpName: "getTeamWorkflowStates",
+		OpName: "updateProjectMembers",
 		Query: `
-query getTeamWorkflowStates ($key: String!) {
-	workflowStates(filter: {team:{key:{eq:$key}}}) {
-		nodes {
-			... WorkflowState
-		}
-	}
-}
-fragment WorkflowState on WorkflowState {
-	id
-	name
-	color
-	description
-	type
-	position
-	team {
-		id
+mutation updateProjectMembers ($projectId: String!, $memberIds: [String!]!) {
+	projectUpdate(id: $projectId, input: {memberIds:$memberIds}) {
+		success
 	}
 }
 `,
-		Variables: &__getTeamWorkflowStatesInput{
-			Key: key,
+		Variables: &__updateProjectMembersInput{
+			ProjectId: projectId,
+			MemberIds: memberIds,
 		},
 	}
 	var err error
 
-	var data getTeamWorkflowStatesResponse
+	var data updateProjectMembersResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4017,38 +21581,40 @@ fragment WorkflowState on WorkflowState {
 	return &data, err
 }
 
-func getWorkflowState(
+func updateProjectMilestone(
 	ctx context.Context,
 	client graphql.Client,
+	input ProjectMilestoneUpdateInput,
 	id string,
-) (*getWorkflowStateResponse, error) {
+) (*updateProjectMilestoneResponse, error) {
 	req := &graphql.Request{
-		OpName: "getWorkflowState",
+		OpName: "updateProjectMilestone",
 		Query: `
-query getWorkflowState ($id: String!) {
-	workflowState(id: $id) {
-		... WorkflowState
+mutation updateProjectMilestone ($input: ProjectMilestoneUpdateInput!, $id: String!) {
+	projectMilestoneUpdate(input: $input, id: $id) {
+		projectMilestone {
+			... ProjectMilestone
+		}
 	}
 }
-fragment WorkflowState on WorkflowState {
+fragment ProjectMilestone on ProjectMilestone {
 	id
 	name
-	color
 	description
-	type
-	position
-	team {
+	targetDate
+	project {
 		id
 	}
 }
 `,
-		Variables: &__getWorkflowStateInput{
-			Id: id,
+		Variables: &__updateProjectMilestoneInput{
+			Input: input,
+			Id:    id,
 		},
 	}
 	var err error
 
-	var data getWorkflowStateResponse
+	var data updateProjectMilestoneResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4060,25 +21626,49 @@ fragment WorkflowState on WorkflowState {
 	return &data, err
 }
 
-func getWorkspace(
+func updateProjectRelation(
 	ctx context.Context,
 	client graphql.Client,
-) (*getWorkspaceResponse, error) {
+	input ProjectRelationUpdateInput,
+	id string,
+) (*updateProjectRelationResponse, error) {
 	req := &graphql.Request{
-		OpName: "getWorkspace",
+		OpName: "updateProjectRelation",
 		Query: `
-query getWorkspace {
-	organization {
+mutation updateProjectRelation ($input: ProjectRelationUpdateInput!, $id: String!) {
+	projectRelationUpdate(input: $input, id: $id) {
+		projectRelation {
+			... ProjectRelation
+		}
+	}
+}
+fragment ProjectRelation on ProjectRelation {
+	id
+	type
+	anchorType
+	relatedAnchorType
+	project {
+		id
+	}
+	projectMilestone {
+		id
+	}
+	relatedProject {
+		id
+	}
+	relatedProjectMilestone {
 		id
-		urlKey
-		name
 	}
 }
 `,
+		Variables: &__updateProjectRelationInput{
+			Input: input,
+			Id:    id,
+		},
 	}
 	var err error
 
-	var data getWorkspaceResponse
+	var data updateProjectRelationResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4090,30 +21680,40 @@ query getWorkspace {
 	return &data, err
 }
 
-func getWorkspaceSettings(
+func updateRoadmap(
 	ctx context.Context,
 	client graphql.Client,
-) (*getWorkspaceSettingsResponse, error) {
+	input RoadmapUpdateInput,
+	id string,
+) (*updateRoadmapResponse, error) {
 	req := &graphql.Request{
-		OpName: "getWorkspaceSettings",
+		OpName: "updateRoadmap",
 		Query: `
-query getWorkspaceSettings {
-	organization {
-		... Organization
+mutation updateRoadmap ($input: RoadmapUpdateInput!, $id: String!) {
+	roadmapUpdate(input: $input, id: $id) {
+		roadmap {
+			... Roadmap
+		}
 	}
 }
-fragment Organization on Organization {
+fragment Roadmap on Roadmap {
 	id
-	allowMembersToInvite
-	roadmapEnabled
-	gitLinkbackMessagesEnabled
-	gitPublicLinkbackMessagesEnabled
+	name
+	description
+	color
+	owner {
+		id
+	}
 }
 `,
+		Variables: &__updateRoadmapInput{
+			Input: input,
+			Id:    id,
+		},
 	}
 	var err error
 
-	var data getWorkspaceSettingsResponse
+	var data updateRoadmapResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4125,43 +21725,29 @@ fragment Organization on Organization {
 	return &data, err
 }
 
-func updateLabel(
+func updateRoadmapToProject(
 	ctx context.Context,
 	client graphql.Client,
-	input IssueLabelUpdateInput,
+	input RoadmapToProjectUpdateInput,
 	id string,
-) (*updateLabelResponse, error) {
+) (*updateRoadmapToProjectResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateLabel",
+		OpName: "updateRoadmapToProject",
 		Query: `
-mutation updateLabel ($input: IssueLabelUpdateInput!, $id: String!) {
-	issueLabelUpdate(input: $input, id: $id) {
-		issueLabel {
-			... IssueLabel
-		}
-	}
-}
-fragment IssueLabel on IssueLabel {
-	id
-	name
-	description
-	color
-	parent {
-		id
-	}
-	team {
-		id
+mutation updateRoadmapToProject ($input: RoadmapToProjectUpdateInput!, $id: String!) {
+	roadmapToProjectUpdate(input: $input, id: $id) {
+		success
 	}
 }
 `,
-		Variables: &__updateLabelInput{
+		Variables: &__updateRoadmapToProjectInput{
 			Input: input,
 			Id:    id,
 		},
 	}
 	var err error
 
-	var data updateLabelResponse
+	var data updateRoadmapToProjectResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4194,6 +21780,7 @@ fragment Team on Team {
 	name
 	key
 	private
+	joinByDefault
 	description
 	icon
 	color
@@ -4204,6 +21791,7 @@ fragment Team on Team {
 	autoArchivePeriod
 	autoClosePeriod
 	triageEnabled
+	requirePriorityToLeaveTriage
 	cyclesEnabled
 	cycleStartDay
 	cycleDuration
@@ -4216,6 +21804,15 @@ fragment Team on Team {
 	issueEstimationAllowZero
 	issueEstimationExtended
 	defaultIssueEstimate
+	defaultTemplateForMembers {
+		id
+	}
+	defaultTemplateForNonMembers {
+		id
+	}
+	defaultProjectTemplate {
+		id
+	}
 }
 `,
 		Variables: &__updateTeamInput{
@@ -4237,6 +21834,98 @@ fragment Team on Team {
 	return &data, err
 }
 
+func updateTeamMembership(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	owner bool,
+) (*updateTeamMembershipResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateTeamMembership",
+		Query: `
+mutation updateTeamMembership ($id: String!, $owner: Boolean!) {
+	teamMembershipUpdate(id: $id, input: {owner:$owner}) {
+		teamMembership {
+			... TeamMembership
+		}
+	}
+}
+fragment TeamMembership on TeamMembership {
+	id
+	owner
+	team {
+		id
+	}
+	user {
+		id
+	}
+}
+`,
+		Variables: &__updateTeamMembershipInput{
+			Id:    id,
+			Owner: owner,
+		},
+	}
+	var err error
+
+	var data updateTeamMembershipResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateTeamNotificationSubscription(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	slackNewIssue bool,
+	slackIssueComments bool,
+	slackIssueStatuses bool,
+) (*updateTeamNotificationSubscriptionResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateTeamNotificationSubscription",
+		Query: `
+mutation updateTeamNotificationSubscription ($id: String!, $slackNewIssue: Boolean!, $slackIssueComments: Boolean!, $slackIssueStatuses: Boolean!) {
+	teamUpdate(input: {slackNewIssue:$slackNewIssue,slackIssueComments:$slackIssueComments,slackIssueStatuses:$slackIssueStatuses}, id: $id) {
+		team {
+			... TeamNotificationSubscription
+		}
+	}
+}
+fragment TeamNotificationSubscription on Team {
+	id
+	slackNewIssue
+	slackIssueComments
+	slackIssueStatuses
+}
+`,
+		Variables: &__updateTeamNotificationSubscriptionInput{
+			Id:                 id,
+			SlackNewIssue:      slackNewIssue,
+			SlackIssueComments: slackIssueComments,
+			SlackIssueStatuses: slackIssueStatuses,
+		},
+	}
+	var err error
+
+	var data updateTeamNotificationSubscriptionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func updateTeamWorkflow(
 	ctx context.Context,
 	client graphql.Client,
@@ -4295,6 +21984,52 @@ fragment TeamWorkflow on Team {
 	return &data, err
 }
 
+func updateTemplate(
+	ctx context.Context,
+	client graphql.Client,
+	input TemplateUpdateInput,
+	id string,
+) (*updateTemplateResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateTemplate",
+		Query: `
+mutation updateTemplate ($input: TemplateUpdateInput!, $id: String!) {
+	templateUpdate(input: $input, id: $id) {
+		template {
+			... Template
+		}
+	}
+}
+fragment Template on Template {
+	id
+	type
+	name
+	description
+	templateData
+	team {
+		id
+	}
+}
+`,
+		Variables: &__updateTemplateInput{
+			Input: input,
+			Id:    id,
+		},
+	}
+	var err error
+
+	var data updateTemplateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func updateWorkflowState(
 	ctx context.Context,
 	client graphql.Client,
@@ -4318,8 +22053,15 @@ fragment WorkflowState on WorkflowState {
 	description
 	type
 	position
+	archivedAt
+	createdAt
+	updatedAt
 	team {
 		id
+		key
+		organization {
+			urlKey
+		}
 	}
 }
 `,
@@ -4359,6 +22101,10 @@ mutation updateWorkspaceSettings ($input: OrganizationUpdateInput!) {
 }
 fragment Organization on Organization {
 	id
+	name
+	urlKey
+	logoUrl
+	gitBranchFormat
 	allowMembersToInvite
 	roadmapEnabled
 	gitLinkbackMessagesEnabled
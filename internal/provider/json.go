@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jsonObjectValue checks that a JSON encoded attribute holds an object and
+// returns it for sending to the API as is.
+func jsonObjectValue(attribute path.Path, value types.String) (json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var object map[string]interface{}
+
+	if err := json.Unmarshal([]byte(value.ValueString()), &object); err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid JSON Object",
+			fmt.Sprintf("Expected a JSON encoded object, got error: %s", err),
+		)
+
+		return nil, diags
+	}
+
+	return json.RawMessage(value.ValueString()), diags
+}
+
+// jsonStringValue encodes a value returned by the API for a JSON encoded
+// attribute. The current encoding is kept when it is equivalent, otherwise
+// every formatting difference would show up as a change.
+func jsonStringValue(current types.String, value interface{}) (types.String, error) {
+	var decoded interface{}
+
+	if err := json.Unmarshal([]byte(current.ValueString()), &decoded); err == nil && reflect.DeepEqual(decoded, value) {
+		return current, nil
+	}
+
+	encoded, err := json.Marshal(value)
+
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(string(encoded)), nil
+}
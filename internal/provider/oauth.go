@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var oauthTokenUrl = "https://api.linear.app/oauth/token"
+
+type oauthToken struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+// authorization is the value of the Authorization header for the token.
+// Unlike personal API keys, OAuth tokens are sent as bearer tokens.
+func (t *oauthToken) authorization() string {
+	return "Bearer " + t.AccessToken
+}
+
+// requestClientCredentialsToken obtains a token which acts as the OAuth
+// application itself, so that changes are attributed to the application
+// rather than to a user.
+func requestClientCredentialsToken(ctx context.Context, client *http.Client, clientId string, clientSecret string, scopes []string) (*oauthToken, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", clientId)
+	form.Set("client_secret", clientSecret)
+	form.Set("scope", strings.Join(scopes, ","))
+
+	return requestOAuthToken(ctx, client, form)
+}
+
+// requestRefreshedToken exchanges the refresh token of a user authorization
+// for a new access token.
+func requestRefreshedToken(ctx context.Context, client *http.Client, clientId string, clientSecret string, refreshToken string) (*oauthToken, error) {
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("client_id", clientId)
+	form.Set("client_secret", clientSecret)
+	form.Set("refresh_token", refreshToken)
+
+	return requestOAuthToken(ctx, client, form)
+}
+
+func requestOAuthToken(ctx context.Context, client *http.Client, form url.Values) (*oauthToken, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, oauthTokenUrl, strings.NewReader(form.Encode()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token request returned %s: %s", response.Status, strings.TrimSpace(string(body)))
+	}
+
+	var token oauthToken
+
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, err
+	}
+
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("token response has no access token")
+	}
+
+	return &token, nil
+}
+
+// oauthTokenSource keeps the OAuth token used by the provider, and requests a
+// new one when it expires or is rejected, so that long runs keep working.
+type oauthTokenSource struct {
+	request func(ctx context.Context) (*oauthToken, error)
+
+	mu            sync.Mutex
+	authorization string
+	expiry        time.Time
+}
+
+// token returns the Authorization header value, requesting a new token first
+// when the current one has expired.
+func (s *oauthTokenSource) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.authorization != "" && (s.expiry.IsZero() || time.Now().Before(s.expiry)) {
+		return s.authorization, nil
+	}
+
+	return s.refreshLocked(ctx)
+}
+
+// refresh requests a new token after the stale one was rejected, unless it
+// was already replaced by a concurrent request.
+func (s *oauthTokenSource) refresh(ctx context.Context, stale string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.authorization != "" && s.authorization != stale {
+		return s.authorization, nil
+	}
+
+	return s.refreshLocked(ctx)
+}
+
+func (s *oauthTokenSource) refreshLocked(ctx context.Context) (string, error) {
+	token, err := s.request(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	s.authorization = token.authorization()
+	s.expiry = time.Time{}
+
+	// Refresh a little early so that requests in flight don't race the
+	// expiry.
+	if token.ExpiresIn > 0 {
+		s.expiry = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - time.Minute)
+	}
+
+	tflog.Debug(ctx, "obtained oauth token", map[string]interface{}{
+		"expires_in": token.ExpiresIn,
+	})
+
+	return s.authorization, nil
+}
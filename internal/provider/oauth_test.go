@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// testOAuthServer answers token requests with a new access token every time,
+// after checking the form with the given function.
+func testOAuthServer(t *testing.T, check func(r *http.Request), expiresIn string) *atomic.Int64 {
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("unable to parse form: %s", err)
+		}
+
+		check(r)
+
+		switch requests.Add(1) {
+		case 1:
+			w.Write([]byte(`{"access_token": "first", "refresh_token": "rotated", "token_type": "Bearer", "expires_in": ` + expiresIn + `}`))
+		default:
+			w.Write([]byte(`{"access_token": "second", "token_type": "Bearer", "expires_in": ` + expiresIn + `}`))
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	previous := oauthTokenUrl
+	oauthTokenUrl = server.URL
+
+	t.Cleanup(func() { oauthTokenUrl = previous })
+
+	return &requests
+}
+
+func TestRequestOAuthToken(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("client credentials", func(t *testing.T) {
+		testOAuthServer(t, func(r *http.Request) {
+			if r.Form.Get("grant_type") != "client_credentials" || r.Form.Get("client_id") != "id" || r.Form.Get("client_secret") != "secret" || r.Form.Get("scope") != "read,write" {
+				t.Fatalf("unexpected form: %v", r.Form)
+			}
+		}, "3600")
+
+		token, err := requestClientCredentialsToken(ctx, http.DefaultClient, "id", "secret", []string{"read", "write"})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if token.authorization() != "Bearer first" || token.ExpiresIn != 3600 {
+			t.Fatalf("unexpected token: %+v", token)
+		}
+	})
+
+	t.Run("refresh token", func(t *testing.T) {
+		testOAuthServer(t, func(r *http.Request) {
+			if r.Form.Get("grant_type") != "refresh_token" || r.Form.Get("refresh_token") != "refresh" {
+				t.Fatalf("unexpected form: %v", r.Form)
+			}
+		}, "3600")
+
+		token, err := requestRefreshedToken(ctx, http.DefaultClient, "id", "secret", "refresh")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if token.RefreshToken != "rotated" {
+			t.Fatalf("expected the rotated refresh token, got %+v", token)
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, `{"error": "invalid_client"}`, http.StatusUnauthorized)
+		}))
+
+		defer server.Close()
+
+		previous := oauthTokenUrl
+		oauthTokenUrl = server.URL
+
+		defer func() { oauthTokenUrl = previous }()
+
+		_, err := requestClientCredentialsToken(ctx, http.DefaultClient, "id", "secret", nil)
+
+		if err == nil || !strings.Contains(err.Error(), "401") || !strings.Contains(err.Error(), "invalid_client") {
+			t.Fatalf("expected the status and body in the error, got %v", err)
+		}
+	})
+}
+
+func TestOAuthTokenSource(t *testing.T) {
+	ctx := context.Background()
+
+	newSource := func(expiresIn string) (*oauthTokenSource, *atomic.Int64) {
+		requests := testOAuthServer(t, func(r *http.Request) {}, expiresIn)
+
+		return &oauthTokenSource{
+			request: func(ctx context.Context) (*oauthToken, error) {
+				return requestClientCredentialsToken(ctx, http.DefaultClient, "id", "secret", nil)
+			},
+		}, requests
+	}
+
+	t.Run("cached", func(t *testing.T) {
+		source, requests := newSource("3600")
+
+		for i := 0; i < 2; i++ {
+			if authorization, err := source.token(ctx); err != nil || authorization != "Bearer first" {
+				t.Fatalf("unexpected token %q, got error: %v", authorization, err)
+			}
+		}
+
+		if requests.Load() != 1 {
+			t.Fatalf("expected the token to be requested once, got %d requests", requests.Load())
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		// Tokens are refreshed a minute early, so this one is expired at once
+		source, requests := newSource("1")
+
+		source.token(ctx)
+
+		if authorization, err := source.token(ctx); err != nil || authorization != "Bearer second" {
+			t.Fatalf("expected a new token, got %q with error: %v", authorization, err)
+		}
+
+		if requests.Load() != 2 {
+			t.Fatalf("expected the token to be requested twice, got %d requests", requests.Load())
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		source, requests := newSource("3600")
+
+		stale, _ := source.token(ctx)
+
+		if authorization, err := source.refresh(ctx, stale); err != nil || authorization != "Bearer second" {
+			t.Fatalf("expected a new token, got %q with error: %v", authorization, err)
+		}
+
+		// A concurrent request already replaced the stale token
+		if authorization, err := source.refresh(ctx, stale); err != nil || authorization != "Bearer second" {
+			t.Fatalf("expected the current token, got %q with error: %v", authorization, err)
+		}
+
+		if requests.Load() != 2 {
+			t.Fatalf("expected the token to be requested twice, got %d requests", requests.Load())
+		}
+	})
+}
+
+func TestAuthedTransportRefreshesRejectedToken(t *testing.T) {
+	requests := testOAuthServer(t, func(r *http.Request) {}, "3600")
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer second" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Write([]byte(`{"data": {}}`))
+	}))
+
+	defer api.Close()
+
+	client := &http.Client{
+		Transport: &authedTransport{
+			oauth: &oauthTokenSource{
+				request: func(ctx context.Context) (*oauthToken, error) {
+					return requestClientCredentialsToken(ctx, http.DefaultClient, "id", "secret", nil)
+				},
+			},
+			wrapped: http.DefaultTransport,
+		},
+	}
+
+	response, err := client.Post(api.URL, "application/json", strings.NewReader(`{}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK || requests.Load() != 2 {
+		t.Fatalf("expected the request to be sent again with a new token, got %s after %d token requests", response.Status, requests.Load())
+	}
+}
@@ -2,28 +2,48 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/Khan/genqlient/graphql"
 )
 
 var (
-	envVarName          = "LINEAR_TOKEN"
-	errMissingAuthToken = "Required token could not be found. Please set the token using an input variable in the provider configuration block or by using the `" + envVarName + "` environment variable."
+	envVarName                  = "LINEAR_TOKEN"
+	oauthClientIdEnvVarName     = "LINEAR_OAUTH_CLIENT_ID"
+	oauthClientSecretEnvVarName = "LINEAR_OAUTH_CLIENT_SECRET"
+	readOnlyEnvVarName          = "LINEAR_READ_ONLY"
+	errMissingAuthToken         = "Required token could not be found. Please set the token using an input variable in the provider configuration block or by using the `" + envVarName + "` environment variable, or configure an OAuth application with the `oauth` block or the `" + oauthClientIdEnvVarName + "` and `" + oauthClientSecretEnvVarName + "` environment variables."
+	defaultOAuthScopes          = []string{"read", "write"}
 )
 
 func colorRegex() *regexp.Regexp {
 	return regexp.MustCompile("^#[0-9a-fA-F]{6}$")
 }
 
+func dateRegex() *regexp.Regexp {
+	return regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+}
+
 func uuidRegex() *regexp.Regexp {
 	return regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
 }
@@ -38,7 +58,30 @@ type LinearProvider struct {
 }
 
 type LinearProviderModel struct {
-	Token types.String `tfsdk:"token"`
+	Token           types.String `tfsdk:"token"`
+	ApiKeyCommand   types.String `tfsdk:"api_key_command"`
+	OAuth           types.Object `tfsdk:"oauth"`
+	CheckCollisions types.Bool   `tfsdk:"check_collisions"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	MaxRetryTime    types.String `tfsdk:"max_retry_time"`
+	RequestTimeout  types.String `tfsdk:"request_timeout"`
+	MaxConcurrent   types.Int64  `tfsdk:"max_concurrent_requests"`
+	ExtraHeaders    types.Map    `tfsdk:"extra_headers"`
+	ProxyUrl        types.String `tfsdk:"proxy_url"`
+	CaCertificates  types.String `tfsdk:"ca_certificates"`
+	MinTlsVersion   types.String `tfsdk:"min_tls_version"`
+	DefaultTeam     types.String `tfsdk:"default_team"`
+	ReadOnly        types.Bool   `tfsdk:"read_only"`
+	UserAgent       types.String `tfsdk:"user_agent"`
+	CacheReads      types.Bool   `tfsdk:"cache_reads"`
+}
+
+type LinearProviderOAuthModel struct {
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
 }
 
 func (p *LinearProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -53,6 +96,122 @@ func (p *LinearProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				MarkdownDescription: "The token used to authenticate with Linear.",
 				Optional:            true,
 			},
+			"api_key_command": schema.StringAttribute{
+				MarkdownDescription: "Command run through the shell to obtain the token, e.g. `vault kv get -field=token secret/linear`. Its output, without the surrounding whitespace, is used as the token.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("token"), path.MatchRoot("oauth")),
+				},
+			},
+			"oauth": schema.SingleNestedAttribute{
+				MarkdownDescription: "OAuth application to authenticate as, using the client credentials grant. Changes are then attributed to the application instead of a user. With a `refresh_token`, the provider authenticates as the user who authorized the application instead. Expired tokens are renewed automatically during the run.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "Client ID of the OAuth application.",
+						Required:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "Client secret of the OAuth application.",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"scopes": schema.ListAttribute{
+						MarkdownDescription: "Scopes requested for the application token. Defaults to `read` and `write`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"access_token": schema.StringAttribute{
+						MarkdownDescription: "Access token of a user authorization, used until it expires. Requires `refresh_token`.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("refresh_token")),
+						},
+					},
+					"refresh_token": schema.StringAttribute{
+						MarkdownDescription: "Refresh token of a user authorization, exchanged for new access tokens whenever they expire.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("scopes")),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("token")),
+				},
+			},
+			"check_collisions": schema.BoolAttribute{
+				MarkdownDescription: "Whether to warn at plan time when a workflow state or team label has the same name or color as another one in its team which is not managed by Terraform. Duplicate workflow state names are warned about either way, as applying would fail. *This queries the team for every planned change.*",
+				Optional:            true,
+			},
+			"default_team": schema.StringAttribute{
+				MarkdownDescription: "Key or identifier of the team which `linear_workflow_state` and `linear_team_label` are created in when their `team_id` is not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether to refuse every change to Linear, while still reading from it. Changes then fail before any request is sent, and `auto_correct` only warns about drift. It can also be enabled by setting the `LINEAR_READ_ONLY` environment variable to `true`, which the configuration can not override.",
+				Optional:            true,
+			},
+			"cache_reads": schema.BoolAttribute{
+				MarkdownDescription: "Whether to remember the responses of identical read queries during a single plan or apply, so they are only sent once. Any change made by the provider forgets them. **Default** `true`.",
+				Optional:            true,
+			},
+			"user_agent": schema.StringAttribute{
+				MarkdownDescription: "Product tokens appended to the `User-Agent` header of the requests, e.g. `release-pipeline/1.4.0`, to tell apart the API traffic of different pipelines. The `TF_APPEND_USER_AGENT` environment variable is appended as well.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "How many times to retry an API request which was rate limited or failed with a transient error. Mutations are only retried when they were rate limited or could not reach the API. Defaults to `4`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_retry_time": schema.StringAttribute{
+				MarkdownDescription: "How long to keep retrying an API request, as a duration like `90s` or `5m`, `0s` disables retries. Defaults to `2m`.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long a single API request may take before it is aborted, as a duration like `30s`. A query which timed out is retried. Requests are not bounded when not set or `0s`.",
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers to send with every request to Linear, e.g. for an egress gateway. The `Authorization`, `Content-Type` and `User-Agent` headers are set by the provider and ignored with a warning.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to send the requests to Linear through, e.g. `http://proxy.example.com:3128`. When not set, the proxy is taken from the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"ca_certificates": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificates of additional certificate authorities to trust, e.g. the one of a TLS intercepting proxy. Use `file()` to read them from a bundle.",
+				Optional:            true,
+			},
+			"min_tls_version": schema.StringAttribute{
+				MarkdownDescription: "Minimum TLS version of the connections to Linear, one of `1.2` or `1.3`. Defaults to `1.2`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("1.2", "1.3"),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "How many API requests may be in flight at the same time, to avoid tripping the rate limits of Linear with a high parallelism. Requests are not limited when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -72,12 +231,173 @@ func (p *LinearProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		token = data.Token.ValueString()
 	}
 
+	if token == "" && !data.ApiKeyCommand.IsNull() {
+		commandToken, err := tokenFromCommand(ctx, data.ApiKeyCommand.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("api_key_command"), "Unable to Authenticate", fmt.Sprintf("Unable to obtain the token from the command, got error: %s", err))
+			return
+		}
+
+		token = commandToken
+	}
+
 	// If a token wasn't set in the provider configuration block, try and fetch it
 	// from the environment variable.
-	if token == "" {
+	if token == "" && data.OAuth.IsNull() {
 		token = os.Getenv(envVarName)
 	}
 
+	maxRetries := defaultMaxRetries
+
+	if !data.MaxRetries.IsNull() {
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	maxRetryTime, diags := durationValue(path.Root("max_retry_time"), data.MaxRetryTime, defaultMaxRetryTime)
+	resp.Diagnostics.Append(diags...)
+
+	requestTimeout, diags := durationValue(path.Root("request_timeout"), data.RequestTimeout, 0)
+	resp.Diagnostics.Append(diags...)
+
+	userAgent := []string{
+		fmt.Sprintf("Terraform/%s", req.TerraformVersion),
+		fmt.Sprintf("terraform-provider-linear/%s", p.version),
+	}
+
+	if value := strings.TrimSpace(os.Getenv("TF_APPEND_USER_AGENT")); value != "" {
+		userAgent = append(userAgent, value)
+	}
+
+	if !data.UserAgent.IsNull() {
+		userAgent = append(userAgent, data.UserAgent.ValueString())
+	}
+
+	extraHeaders := map[string]string{}
+
+	if !data.ExtraHeaders.IsNull() {
+		resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+	}
+
+	headers, diags := requestHeaders(strings.Join(userAgent, " "), extraHeaders)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	base := http.DefaultTransport.(*http.Transport).Clone()
+
+	if !data.ProxyUrl.IsNull() {
+		proxyUrl, err := url.Parse(data.ProxyUrl.ValueString())
+
+		if err != nil || proxyUrl.Scheme == "" || proxyUrl.Host == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL", fmt.Sprintf("Expected an absolute URL like `http://proxy.example.com:3128`, got: %q", data.ProxyUrl.ValueString()))
+			return
+		}
+
+		base.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	tlsClientConfig, err := tlsConfig(data.CaCertificates.ValueString(), data.MinTlsVersion.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ca_certificates"), "Invalid CA Certificates", fmt.Sprintf("Unable to read the CA certificates, got error: %s", err))
+		return
+	}
+
+	base.TLSClientConfig = tlsClientConfig
+
+	transport := http.RoundTripper(base)
+
+	transport = &headersTransport{
+		headers: headers,
+		wrapped: transport,
+	}
+
+	if requestTimeout > 0 {
+		transport = &timeoutTransport{
+			timeout: requestTimeout,
+			wrapped: transport,
+		}
+	}
+
+	retry := &retryTransport{
+		maxRetries:   maxRetries,
+		maxRetryTime: maxRetryTime,
+		wrapped:      transport,
+	}
+
+	transport = retry
+
+	var oauthTokens *oauthTokenSource
+
+	// Without a token, authenticate as the OAuth application if one is
+	// configured.
+	if token == "" {
+		clientId := os.Getenv(oauthClientIdEnvVarName)
+		clientSecret := os.Getenv(oauthClientSecretEnvVarName)
+		scopes := defaultOAuthScopes
+		accessToken := ""
+		refreshToken := ""
+
+		if !data.OAuth.IsNull() {
+			var oauth LinearProviderOAuthModel
+
+			resp.Diagnostics.Append(data.OAuth.As(ctx, &oauth, basetypes.ObjectAsOptions{})...)
+
+			if !oauth.Scopes.IsNull() {
+				resp.Diagnostics.Append(oauth.Scopes.ElementsAs(ctx, &scopes, false)...)
+			}
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			clientId = oauth.ClientId.ValueString()
+			clientSecret = oauth.ClientSecret.ValueString()
+			accessToken = oauth.AccessToken.ValueString()
+			refreshToken = oauth.RefreshToken.ValueString()
+		}
+
+		if clientId != "" && clientSecret != "" {
+			tokenClient := &http.Client{Transport: transport}
+
+			oauthTokens = &oauthTokenSource{
+				request: func(ctx context.Context) (*oauthToken, error) {
+					return requestClientCredentialsToken(ctx, tokenClient, clientId, clientSecret, scopes)
+				},
+			}
+
+			if refreshToken != "" {
+				oauthTokens.request = func(ctx context.Context) (*oauthToken, error) {
+					refreshed, err := requestRefreshedToken(ctx, tokenClient, clientId, clientSecret, refreshToken)
+
+					// Linear may rotate the refresh token, the new one is only
+					// kept for the rest of the run.
+					if err == nil && refreshed.RefreshToken != "" {
+						refreshToken = refreshed.RefreshToken
+					}
+
+					return refreshed, err
+				}
+			}
+
+			if accessToken != "" {
+				oauthTokens.authorization = (&oauthToken{AccessToken: accessToken}).authorization()
+			}
+
+			authorization, err := oauthTokens.token(ctx)
+
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Authenticate", fmt.Sprintf("Unable to obtain a token for the OAuth application, got error: %s", err))
+				return
+			}
+
+			token = authorization
+		}
+	}
+
 	// If we still don't have a token at this point, we return an error.
 	if token == "" {
 		resp.Diagnostics.AddError("Missing API token", errMissingAuthToken)
@@ -87,22 +407,127 @@ func (p *LinearProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	httpClient := http.Client{
 		Transport: &authedTransport{
 			token:   token,
-			wrapped: http.DefaultTransport,
+			oauth:   oauthTokens,
+			wrapped: transport,
 		},
 	}
 
-	client := graphql.NewClient("https://api.linear.app/graphql", &httpClient)
+	linear := &linearClient{
+		wrapped:         graphql.NewClient("https://api.linear.app/graphql", &httpClient),
+		checkCollisions: data.CheckCollisions.ValueBool(),
+	}
+
+	retry.usage = &linear.usage
+	registerClient(linear)
+
+	if data.CacheReads.IsNull() || data.CacheReads.ValueBool() {
+		linear.cache = newReadCache()
+	}
+
+	readOnly, _ := strconv.ParseBool(os.Getenv(readOnlyEnvVarName))
+
+	if readOnly || data.ReadOnly.ValueBool() {
+		linear.readOnly = true
+	}
+
+	if !data.DefaultTeam.IsNull() {
+		linear.defaultTeam = &defaultTeam{value: data.DefaultTeam.ValueString()}
+	}
+
+	if !data.MaxConcurrent.IsNull() {
+		linear.slots = make(chan struct{}, data.MaxConcurrent.ValueInt64())
+	}
+
+	resp.Diagnostics.Append(linear.validateCredentials(ctx)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := graphql.Client(linear)
 
 	resp.DataSourceData = &client
 	resp.ResourceData = &client
 }
 
+// reservedHeaders are set by the provider itself, extra_headers can not
+// replace them.
+var reservedHeaders = map[string]bool{
+	"Authorization": true,
+	"Content-Type":  true,
+	"User-Agent":    true,
+}
+
+// requestHeaders returns the headers to add to every request, leaving out the
+// extra headers which are reserved with a warning.
+func requestHeaders(userAgent string, extraHeaders map[string]string) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	headers := map[string]string{"User-Agent": userAgent}
+
+	for name, value := range extraHeaders {
+		canonical := http.CanonicalHeaderKey(name)
+
+		if reservedHeaders[canonical] {
+			diags.AddAttributeWarning(
+				path.Root("extra_headers").AtMapKey(name),
+				"Reserved Header Ignored",
+				fmt.Sprintf("The %s header is set by the provider and can not be replaced, its value in extra_headers is ignored.", canonical),
+			)
+
+			continue
+		}
+
+		headers[canonical] = value
+	}
+
+	return headers, diags
+}
+
+// durationValue parses a duration attribute of the provider, falling back to
+// the default when it is not set.
+func durationValue(attribute path.Path, value types.String, fallback time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() {
+		return fallback, diags
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(attribute, "Invalid Duration", fmt.Sprintf("Expected a non-negative duration like `90s` or `5m`, got: %q", value.ValueString()))
+	}
+
+	return duration, diags
+}
+
 func (p *LinearProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewCustomEmojiResource,
+		NewCustomViewResource,
+		NewCustomerResource,
+		NewCustomerNeedResource,
+		NewCustomerStatusResource,
+		NewGitAutomationStateResource,
+		NewInitiativeProjectResource,
+		NewIssueResource,
+		NewIssueRelationResource,
+		NewIssueTemplateResource,
+		NewProjectResource,
+		NewProjectMembershipResource,
+		NewProjectMilestoneResource,
+		NewProjectRelationResource,
+		NewProjectTemplateResource,
+		NewRoadmapResource,
 		NewTeamResource,
 		NewTeamLabelResource,
+		NewTeamMembershipResource,
+		NewTeamNotificationSubscriptionResource,
+		NewTeamSettingsResource,
 		NewTeamWorkflowResource,
 		NewWorkflowStateResource,
+		NewWorkflowSyncResource,
 		NewWorkspaceLabelResource,
 		NewWorkspaceSettingsResource,
 	}
@@ -110,7 +535,21 @@ func (p *LinearProvider) Resources(ctx context.Context) []func() resource.Resour
 
 func (p *LinearProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewCustomViewsDataSource,
+		NewCycleDataSource,
+		NewCyclesDataSource,
+		NewIssuesDataSource,
+		NewProjectDataSource,
+		NewProjectStatusesDataSource,
+		NewProjectsDataSource,
+		NewTeamDataSource,
+		NewTeamLabelsDataSource,
+		NewTeamsDataSource,
+		NewUserDataSource,
+		NewWebhooksDataSource,
+		NewWorkflowStateDataSource,
 		NewWorkspaceDataSource,
+		NewWorkspaceLabelsDataSource,
 	}
 }
 
@@ -1,11 +1,24 @@
 package provider
 
 import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -21,3 +34,247 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("LINEAR_TOKEN must be set for acceptance tests")
 	}
 }
+
+// testAccClient sends requests to Linear outside of Terraform, e.g. to set up
+// objects which are not managed by it.
+func testAccClient() graphql.Client {
+	return graphql.NewClient("https://api.linear.app/graphql", &http.Client{
+		Transport: &authedTransport{
+			token:   os.Getenv("LINEAR_TOKEN"),
+			wrapped: http.DefaultTransport,
+		},
+	})
+}
+
+func TestAccProviderRequestIdInErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(`max_retries = 0`, `
+resource "linear_issue_relation" "test" {
+  type = "related"
+  issue_id = "not-a-uuid"
+  related_issue_id = "not-a-uuid-either"
+}
+`),
+				ExpectError: regexp.MustCompile(`Unable\s+to\s+create\s+issue\s+relation(.|\n)*\(request\s+id:\s+\S+`),
+			},
+		},
+	})
+}
+
+func TestAccProviderMaxConcurrentRequests(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(`max_concurrent_requests = 0`, testAccWorkspaceDataSourceConfig),
+				ExpectError: regexp.MustCompile(`max_concurrent_requests\s+value\s+must\s+be\s+at\s+least\s+1`),
+			},
+			// The data sources are read one request at a time
+			{
+				Config: testAccProviderConfig(`max_concurrent_requests = 1`, testAccWorkspaceDataSourceConfig+testAccTeamDataSourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_workspace.test", "url_key", "terraform-test"),
+					resource.TestCheckResourceAttrPair("data.linear_team.name", "id", "data.linear_team.key", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderProxyUrl(t *testing.T) {
+	var tunnels atomic.Int64
+
+	// The provider runs in the test process, so it can reach this proxy
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect || r.Host != "api.linear.app:443" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+
+		upstream, err := net.Dial("tcp", r.Host)
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		conn, _, err := w.(http.Hijacker).Hijack()
+
+		if err != nil {
+			upstream.Close()
+			return
+		}
+
+		tunnels.Add(1)
+		conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+
+		go func() {
+			io.Copy(upstream, conn)
+			upstream.Close()
+		}()
+
+		io.Copy(conn, upstream)
+		conn.Close()
+	}))
+
+	defer proxy.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(`proxy_url = "proxy.example.com"`, testAccWorkspaceDataSourceConfig),
+				ExpectError: regexp.MustCompile("Invalid Proxy URL"),
+			},
+			{
+				Config: testAccProviderConfig(fmt.Sprintf(`proxy_url = %q`, proxy.URL), testAccWorkspaceDataSourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_workspace.test", "url_key", "terraform-test"),
+					func(s *terraform.State) error {
+						if tunnels.Load() == 0 {
+							return fmt.Errorf("expected the requests to go through the proxy")
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderTls(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(`ca_certificates = "not a certificate"`, testAccWorkspaceDataSourceConfig),
+				ExpectError: regexp.MustCompile("Invalid CA Certificates"),
+			},
+			{
+				Config:      testAccProviderConfig(`min_tls_version = "1.1"`, testAccWorkspaceDataSourceConfig),
+				ExpectError: regexp.MustCompile(`min_tls_version\s+value\s+must\s+be\s+one\s+of`),
+			},
+			{
+				Config: testAccProviderConfig(`min_tls_version = "1.3"`, testAccWorkspaceDataSourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_workspace.test", "url_key", "terraform-test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderValidatesCredentials(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Configuring fails before the workspace is read
+			{
+				Config:      testAccProviderConfig(`token = "lin_api_invalid"`, testAccWorkspaceDataSourceConfig),
+				ExpectError: regexp.MustCompile(`Unable\s+to\s+authenticate\s+with\s+Linear`),
+			},
+		},
+	})
+}
+
+func TestAccProviderUserAgent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(`user_agent = ""`, testAccWorkspaceDataSourceConfig),
+				ExpectError: regexp.MustCompile(`user_agent\s+string\s+length\s+must\s+be\s+at\s+least\s+1`),
+			},
+			{
+				Config: testAccProviderConfig(`user_agent = "release-pipeline/1.4.0"`, testAccWorkspaceDataSourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_workspace.test", "url_key", "terraform-test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderApiKeyCommand(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(`api_key_command = "echo 'not logged in' >&2; exit 1"`, testAccWorkspaceDataSourceConfig),
+				ExpectError: regexp.MustCompile(`exit\s+status\s+1:\s+not\s+logged\s+in`),
+			},
+			{
+				Config: testAccProviderConfig(`api_key_command = "printenv LINEAR_TOKEN"`, testAccWorkspaceDataSourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_workspace.test", "url_key", "terraform-test"),
+				),
+			},
+		},
+	})
+}
+
+// testAccProviderConfig configures the provider with the given attributes
+// in front of the given configuration.
+func testAccProviderConfig(attributes string, config string) string {
+	return fmt.Sprintf(`
+provider "linear" {
+  %s
+}
+%s`, attributes, config)
+}
+
+func TestDurationValue(t *testing.T) {
+	tests := []struct {
+		value    types.String
+		expected time.Duration
+		err      bool
+	}{
+		{value: types.StringNull(), expected: time.Minute},
+		{value: types.StringValue("90s"), expected: 90 * time.Second},
+		{value: types.StringValue("0s"), expected: 0},
+		{value: types.StringValue("-1s"), err: true},
+		{value: types.StringValue("soon"), err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value.String(), func(t *testing.T) {
+			duration, diags := durationValue(path.Root("request_timeout"), test.value, time.Minute)
+
+			if diags.HasError() != test.err {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !test.err && duration != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, duration)
+			}
+		})
+	}
+}
+
+func TestRequestHeaders(t *testing.T) {
+	headers, diags := requestHeaders("terraform-provider-linear/test", map[string]string{
+		"user-agent":    "curl/8.0",
+		"Authorization": "Bearer other",
+		"content-type":  "text/plain",
+		"x-gateway-key": "key",
+	})
+
+	if diags.HasError() || diags.WarningsCount() != 3 {
+		t.Fatalf("expected a warning per reserved header, got %v", diags)
+	}
+
+	if len(headers) != 2 || headers["User-Agent"] != "terraform-provider-linear/test" || headers["X-Gateway-Key"] != "key" {
+		t.Fatalf("expected only the user agent and the extra header, got %v", headers)
+	}
+}
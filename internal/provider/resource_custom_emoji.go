@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CustomEmojiResource{}
+var _ resource.ResourceWithImportState = &CustomEmojiResource{}
+
+func NewCustomEmojiResource() resource.Resource {
+	return &CustomEmojiResource{}
+}
+
+type CustomEmojiResource struct {
+	client *graphql.Client
+}
+
+type CustomEmojiResourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Url    types.String `tfsdk:"url"`
+	Source types.String `tfsdk:"source"`
+}
+
+func (r *CustomEmojiResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_emoji"
+}
+
+func (r *CustomEmojiResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear custom emoji. Emojis cannot be changed once created, so any change replaces the emoji.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the emoji.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the emoji, used as `:name:` in reactions and text.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the emoji image.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Source of the emoji.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomEmojiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomEmojiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CustomEmojiResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := EmojiCreateInput{
+		Name: data.Name.ValueString(),
+		Url:  data.Url.ValueString(),
+	}
+
+	response, err := createEmoji(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom emoji, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a custom emoji", map[string]interface{}{
+		"resource":  "linear_custom_emoji",
+		"operation": "create",
+		"id":        response.EmojiCreate.Emoji.Id,
+	})
+
+	readCustomEmoji(data, response.EmojiCreate.Emoji.Emoji)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomEmojiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomEmojiResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getEmoji(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom emoji, got error: %s", err))
+		return
+	}
+
+	readCustomEmoji(data, response.Emoji.Emoji)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomEmojiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CustomEmojiResourceModel
+
+	// All configurable attributes require replacement, there is nothing to
+	// update in place.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomEmojiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CustomEmojiResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteEmoji(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom emoji, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a custom emoji", map[string]interface{}{
+		"resource":  "linear_custom_emoji",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
+}
+
+func (r *CustomEmojiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The API accepts both the identifier and the name of an emoji.
+	response, err := getEmoji(ctx, *r.client, req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import custom emoji, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.Emoji.Id)...)
+}
+
+func readCustomEmoji(data *CustomEmojiResourceModel, emoji Emoji) {
+	data.Id = types.StringValue(emoji.Id)
+	data.Name = types.StringValue(emoji.Name)
+	data.Source = types.StringValue(emoji.Source)
+
+	// The image is copied when the emoji is created, so the URL returned by
+	// the API differs from the configured one. Keep the configured URL to
+	// avoid replacing the emoji on every plan.
+	if data.Url.IsNull() || data.Url.IsUnknown() {
+		data.Url = types.StringValue(emoji.Url)
+	}
+}
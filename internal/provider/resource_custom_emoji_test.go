@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomEmojiResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomEmojiResourceConfigDefault("terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_custom_emoji.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_custom_emoji.test", "name", "terraform"),
+					resource.TestCheckResourceAttr("linear_custom_emoji.test", "url", "https://www.terraform.io/favicon.ico"),
+					resource.TestCheckResourceAttrSet("linear_custom_emoji.test", "source"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_custom_emoji.test",
+				ImportState:             true,
+				ImportStateId:           "terraform",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"url"},
+			},
+			// Replace and Read testing
+			{
+				Config: testAccCustomEmojiResourceConfigDefault("terraform-provider"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_custom_emoji.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_custom_emoji.test", "name", "terraform-provider"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCustomEmojiResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_custom_emoji" "test" {
+  name = "%s"
+  url = "https://www.terraform.io/favicon.ico"
+}
+`, name)
+}
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CustomViewResource{}
+var _ resource.ResourceWithImportState = &CustomViewResource{}
+
+func NewCustomViewResource() resource.Resource {
+	return &CustomViewResource{}
+}
+
+type CustomViewResource struct {
+	client *graphql.Client
+}
+
+type CustomViewResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Icon        types.String `tfsdk:"icon"`
+	Color       types.String `tfsdk:"color"`
+	FilterData  types.String `tfsdk:"filter_data"`
+	TeamId      types.String `tfsdk:"team_id"`
+	OwnerId     types.String `tfsdk:"owner_id"`
+	Shared      types.Bool   `tfsdk:"shared"`
+}
+
+func (r *CustomViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_view"
+}
+
+func (r *CustomViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear custom view.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the custom view.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the custom view.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the custom view.",
+				Optional:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the custom view.",
+				Optional:            true,
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Color of the custom view.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(colorRegex(), "must be a hex color"),
+				},
+			},
+			"filter_data": schema.StringAttribute{
+				MarkdownDescription: "Issue filter of the custom view as a JSON encoded object, e.g. built with `jsonencode`.",
+				Required:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team the custom view is scoped to. The view is workspace wide when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user owning the custom view. Defaults to the authenticated user.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"shared": schema.BoolAttribute{
+				MarkdownDescription: "Whether the custom view is shared with everyone in the workspace. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *CustomViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CustomViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filterData, diags := jsonObjectValue(path.Root("filter_data"), data.FilterData)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := CustomViewCreateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Icon:        data.Icon.ValueStringPointer(),
+		Color:       data.Color.ValueStringPointer(),
+		TeamId:      data.TeamId.ValueStringPointer(),
+		FilterData:  filterData,
+		Shared:      data.Shared.ValueBool(),
+	}
+
+	if !data.OwnerId.IsUnknown() {
+		input.OwnerId = data.OwnerId.ValueStringPointer()
+	}
+
+	response, err := createCustomView(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom view, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a custom view", map[string]interface{}{
+		"resource":  "linear_custom_view",
+		"operation": "create",
+		"id":        response.CustomViewCreate.CustomView.Id,
+	})
+
+	resp.Diagnostics.Append(readCustomView(data, response.CustomViewCreate.CustomView.CustomView)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getCustomView(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom view, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(readCustomView(data, response.CustomView.CustomView)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CustomViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filterData, diags := jsonObjectValue(path.Root("filter_data"), data.FilterData)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := CustomViewUpdateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Icon:        data.Icon.ValueStringPointer(),
+		Color:       data.Color.ValueStringPointer(),
+		TeamId:      data.TeamId.ValueStringPointer(),
+		FilterData:  filterData,
+		Shared:      data.Shared.ValueBool(),
+	}
+
+	if !data.OwnerId.IsUnknown() {
+		input.OwnerId = data.OwnerId.ValueStringPointer()
+	}
+
+	response, err := updateCustomView(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom view, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a custom view", map[string]interface{}{
+		"resource":  "linear_custom_view",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+	})
+
+	resp.Diagnostics.Append(readCustomView(data, response.CustomViewUpdate.CustomView.CustomView)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CustomViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteCustomView(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom view, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a custom view", map[string]interface{}{
+		"resource":  "linear_custom_view",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
+}
+
+func (r *CustomViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func readCustomView(data *CustomViewResourceModel, customView CustomView) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(customView.Id)
+	data.Name = types.StringValue(customView.Name)
+	data.Description = types.StringPointerValue(customView.Description)
+	data.Icon = types.StringPointerValue(customView.Icon)
+	data.Color = types.StringPointerValue(customView.Color)
+	data.OwnerId = types.StringValue(customView.Owner.Id)
+	data.Shared = types.BoolValue(customView.Shared)
+
+	if customView.Team != nil {
+		data.TeamId = types.StringValue(customView.Team.Id)
+	} else {
+		data.TeamId = types.StringNull()
+	}
+
+	filterData, err := jsonStringValue(data.FilterData, customView.FilterData)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to encode custom view filter, got error: %s", err))
+		return diags
+	}
+
+	data.FilterData = filterData
+
+	return diags
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomViewResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomViewResourceConfigDefault("Ready for QA"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_custom_view.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "name", "Ready for QA"),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "description"),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "icon"),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "color"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "filter_data", `{"priority":{"eq":1}}`),
+					resource.TestCheckNoResourceAttr("linear_custom_view.test", "team_id"),
+					resource.TestMatchResourceAttr("linear_custom_view.test", "owner_id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "shared", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_custom_view.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCustomViewResourceConfigNonDefault("Stale"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_custom_view.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "name", "Stale"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "color", "#00ffff"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "filter_data", `{"updatedAt":{"lt":"-P30D"}}`),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_custom_view.test", "shared", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_custom_view.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCustomViewResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_custom_view" "test" {
+  name = "%s"
+  filter_data = jsonencode({ priority = { eq = 1 } })
+}
+`, name)
+}
+
+func testAccCustomViewResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_custom_view" "test" {
+  name = "%s"
+  description = "Managed by Terraform"
+  color = "#00ffff"
+  filter_data = jsonencode({ updatedAt = { lt = "-P30D" } })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  shared = true
+}
+`, name)
+}
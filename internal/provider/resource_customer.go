@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CustomerResource{}
+var _ resource.ResourceWithImportState = &CustomerResource{}
+
+func NewCustomerResource() resource.Resource {
+	return &CustomerResource{}
+}
+
+type CustomerResource struct {
+	client *graphql.Client
+}
+
+type CustomerResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Domains        types.Set    `tfsdk:"domains"`
+	ExternalIds    types.Set    `tfsdk:"external_ids"`
+	SlackChannelId types.String `tfsdk:"slack_channel_id"`
+	OwnerId        types.String `tfsdk:"owner_id"`
+	StatusId       types.String `tfsdk:"status_id"`
+}
+
+func (r *CustomerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer"
+}
+
+func (r *CustomerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear customer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the customer.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the customer.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"domains": schema.SetAttribute{
+				MarkdownDescription: "Domains associated with the customer.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.UTF8LengthAtLeast(1),
+					),
+				},
+			},
+			"external_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the customer in external systems, e.g. a CRM.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.UTF8LengthAtLeast(1),
+					),
+				},
+			},
+			"slack_channel_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the Slack channel used to interact with the customer.",
+				Optional:            true,
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user owning the customer. Defaults to the authenticated user.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"status_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the customer status. Defaults to the first status of the workspace.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CustomerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains := []string{}
+	externalIds := []string{}
+
+	if !data.Domains.IsNull() {
+		resp.Diagnostics.Append(data.Domains.ElementsAs(ctx, &domains, false)...)
+	}
+
+	if !data.ExternalIds.IsNull() {
+		resp.Diagnostics.Append(data.ExternalIds.ElementsAs(ctx, &externalIds, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := CustomerCreateInput{
+		Name:           data.Name.ValueString(),
+		Domains:        domains,
+		ExternalIds:    externalIds,
+		SlackChannelId: data.SlackChannelId.ValueStringPointer(),
+	}
+
+	if !data.OwnerId.IsUnknown() {
+		input.OwnerId = data.OwnerId.ValueStringPointer()
+	}
+
+	if !data.StatusId.IsUnknown() {
+		input.StatusId = data.StatusId.ValueStringPointer()
+	}
+
+	response, err := createCustomer(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create customer, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a customer", map[string]interface{}{
+		"resource":  "linear_customer",
+		"operation": "create",
+		"id":        response.CustomerCreate.Customer.Id,
+	})
+
+	resp.Diagnostics.Append(readCustomer(ctx, data, response.CustomerCreate.Customer.Customer)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getCustomer(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read customer, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(readCustomer(ctx, data, response.Customer.Customer)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CustomerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains := []string{}
+	externalIds := []string{}
+
+	if !data.Domains.IsNull() {
+		resp.Diagnostics.Append(data.Domains.ElementsAs(ctx, &domains, false)...)
+	}
+
+	if !data.ExternalIds.IsNull() {
+		resp.Diagnostics.Append(data.ExternalIds.ElementsAs(ctx, &externalIds, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := CustomerUpdateInput{
+		Name:           data.Name.ValueString(),
+		Domains:        domains,
+		ExternalIds:    externalIds,
+		SlackChannelId: data.SlackChannelId.ValueStringPointer(),
+	}
+
+	if !data.OwnerId.IsUnknown() {
+		input.OwnerId = data.OwnerId.ValueStringPointer()
+	}
+
+	if !data.StatusId.IsUnknown() {
+		input.StatusId = data.StatusId.ValueStringPointer()
+	}
+
+	response, err := updateCustomer(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update customer, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a customer", map[string]interface{}{
+		"resource":  "linear_customer",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+	})
+
+	resp.Diagnostics.Append(readCustomer(ctx, data, response.CustomerUpdate.Customer.Customer)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CustomerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteCustomer(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete customer, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a customer", map[string]interface{}{
+		"resource":  "linear_customer",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
+}
+
+func (r *CustomerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func readCustomer(ctx context.Context, data *CustomerResourceModel, customer Customer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(customer.Id)
+	data.Name = types.StringValue(customer.Name)
+	data.SlackChannelId = types.StringPointerValue(customer.SlackChannelId)
+	data.OwnerId = types.StringValue(customer.Owner.Id)
+	data.StatusId = types.StringValue(customer.Status.Id)
+
+	data.Domains = types.SetNull(types.StringType)
+	data.ExternalIds = types.SetNull(types.StringType)
+
+	if len(customer.Domains) > 0 {
+		domains, d := types.SetValueFrom(ctx, types.StringType, customer.Domains)
+		diags.Append(d...)
+		data.Domains = domains
+	}
+
+	if len(customer.ExternalIds) > 0 {
+		externalIds, d := types.SetValueFrom(ctx, types.StringType, customer.ExternalIds)
+		diags.Append(d...)
+		data.ExternalIds = externalIds
+	}
+
+	return diags
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CustomerNeedResource{}
+var _ resource.ResourceWithImportState = &CustomerNeedResource{}
+
+func NewCustomerNeedResource() resource.Resource {
+	return &CustomerNeedResource{}
+}
+
+type CustomerNeedResource struct {
+	client *graphql.Client
+}
+
+type CustomerNeedResourceModel struct {
+	Id         types.String  `tfsdk:"id"`
+	CustomerId types.String  `tfsdk:"customer_id"`
+	IssueId    types.String  `tfsdk:"issue_id"`
+	ProjectId  types.String  `tfsdk:"project_id"`
+	CommentId  types.String  `tfsdk:"comment_id"`
+	Priority   types.Float64 `tfsdk:"priority"`
+}
+
+func (r *CustomerNeedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer_need"
+}
+
+func (r *CustomerNeedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear customer need (customer request).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the customer need.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the customer.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"issue_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the issue the need is referencing.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("project_id")),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project the need is referencing.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"comment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the comment the need is referencing.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"priority": schema.Float64Attribute{
+				MarkdownDescription: "Priority of the need. **Default** `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(0),
+			},
+		},
+	}
+}
+
+func (r *CustomerNeedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomerNeedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CustomerNeedResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := CustomerNeedCreateInput{
+		CustomerId: data.CustomerId.ValueString(),
+		IssueId:    data.IssueId.ValueStringPointer(),
+		ProjectId:  data.ProjectId.ValueStringPointer(),
+		CommentId:  data.CommentId.ValueStringPointer(),
+		Priority:   data.Priority.ValueFloat64(),
+	}
+
+	response, err := createCustomerNeed(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create customer need, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a customer need", map[string]interface{}{
+		"resource":    "linear_customer_need",
+		"operation":   "create",
+		"id":          response.CustomerNeedCreate.Need.Id,
+		"customer_id": data.CustomerId.ValueString(),
+	})
+
+	readCustomerNeed(data, response.CustomerNeedCreate.Need.CustomerNeed)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerNeedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomerNeedResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getCustomerNeed(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read customer need, got error: %s", err))
+		return
+	}
+
+	readCustomerNeed(data, response.CustomerNeed.CustomerNeed)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerNeedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CustomerNeedResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := CustomerNeedUpdateInput{
+		Priority: data.Priority.ValueFloat64(),
+	}
+
+	response, err := updateCustomerNeed(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update customer need, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a customer need", map[string]interface{}{
+		"resource":    "linear_customer_need",
+		"operation":   "update",
+		"id":          data.Id.ValueString(),
+		"customer_id": data.CustomerId.ValueString(),
+	})
+
+	readCustomerNeed(data, response.CustomerNeedUpdate.Need.CustomerNeed)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerNeedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CustomerNeedResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteCustomerNeed(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete customer need, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a customer need", map[string]interface{}{
+		"resource":    "linear_customer_need",
+		"operation":   "delete",
+		"id":          data.Id.ValueString(),
+		"customer_id": data.CustomerId.ValueString(),
+	})
+}
+
+func (r *CustomerNeedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func readCustomerNeed(data *CustomerNeedResourceModel, customerNeed CustomerNeed) {
+	data.Id = types.StringValue(customerNeed.Id)
+	data.CustomerId = types.StringValue(customerNeed.Customer.Id)
+	data.Priority = types.Float64Value(customerNeed.Priority)
+
+	if customerNeed.Issue != nil {
+		data.IssueId = types.StringValue(customerNeed.Issue.Id)
+	}
+
+	if customerNeed.Project != nil {
+		data.ProjectId = types.StringValue(customerNeed.Project.Id)
+	}
+
+	if customerNeed.Comment != nil {
+		data.CommentId = types.StringValue(customerNeed.Comment.Id)
+	}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomerNeedResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomerNeedResourceConfig(0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_customer_need.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrPair("linear_customer_need.test", "customer_id", "linear_customer.test", "id"),
+					resource.TestCheckResourceAttrPair("linear_customer_need.test", "issue_id", "linear_issue.test", "id"),
+					resource.TestCheckNoResourceAttr("linear_customer_need.test", "project_id"),
+					resource.TestCheckNoResourceAttr("linear_customer_need.test", "comment_id"),
+					resource.TestCheckResourceAttr("linear_customer_need.test", "priority", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_customer_need.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCustomerNeedResourceConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_customer_need.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrPair("linear_customer_need.test", "customer_id", "linear_customer.test", "id"),
+					resource.TestCheckResourceAttrPair("linear_customer_need.test", "issue_id", "linear_issue.test", "id"),
+					resource.TestCheckResourceAttr("linear_customer_need.test", "priority", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_customer_need.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCustomerNeedResourceConfig(priority int) string {
+	return fmt.Sprintf(`
+resource "linear_customer" "test" {
+  name = "Acme Needs"
+}
+
+resource "linear_issue" "test" {
+  title = "Export to CSV"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_customer_need" "test" {
+  customer_id = linear_customer.test.id
+  issue_id = linear_issue.test.id
+  priority = %d
+}
+`, priority)
+}
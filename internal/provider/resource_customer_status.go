@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CustomerStatusResource{}
+var _ resource.ResourceWithImportState = &CustomerStatusResource{}
+
+func NewCustomerStatusResource() resource.Resource {
+	return &CustomerStatusResource{}
+}
+
+type CustomerStatusResource struct {
+	client *graphql.Client
+}
+
+type CustomerStatusResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Position    types.Number `tfsdk:"position"`
+	Color       types.String `tfsdk:"color"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *CustomerStatusResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer_status"
+}
+
+func (r *CustomerStatusResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear customer status.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the customer status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the customer status.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the customer status.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"active", "inactive"}...),
+				},
+			},
+			"position": schema.NumberAttribute{
+				MarkdownDescription: "Position of the customer status.",
+				Required:            true,
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Color of the customer status.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(colorRegex(), "must be a hex color"),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the customer status.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *CustomerStatusResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomerStatusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CustomerStatusResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	position, _ := data.Position.ValueBigFloat().Float64()
+
+	input := CustomerStatusCreateInput{
+		Name:        data.Name.ValueString(),
+		Color:       data.Color.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Position:    position,
+		Type:        CustomerStatusType(data.Type.ValueString()),
+	}
+
+	response, err := createCustomerStatus(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create customer status, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a customer status", map[string]interface{}{
+		"resource":  "linear_customer_status",
+		"operation": "create",
+		"id":        response.CustomerStatusCreate.Status.Id,
+	})
+
+	readCustomerStatus(data, response.CustomerStatusCreate.Status.CustomerStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerStatusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomerStatusResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getCustomerStatus(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read customer status, got error: %s", err))
+		return
+	}
+
+	readCustomerStatus(data, response.CustomerStatus.CustomerStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerStatusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CustomerStatusResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	position, _ := data.Position.ValueBigFloat().Float64()
+
+	input := CustomerStatusUpdateInput{
+		Name:        data.Name.ValueString(),
+		Color:       data.Color.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Position:    position,
+		Type:        CustomerStatusType(data.Type.ValueString()),
+	}
+
+	response, err := updateCustomerStatus(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update customer status, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a customer status", map[string]interface{}{
+		"resource":  "linear_customer_status",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+	})
+
+	readCustomerStatus(data, response.CustomerStatusUpdate.Status.CustomerStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerStatusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CustomerStatusResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteCustomerStatus(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete customer status, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a customer status", map[string]interface{}{
+		"resource":  "linear_customer_status",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
+}
+
+func (r *CustomerStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func readCustomerStatus(data *CustomerStatusResourceModel, customerStatus CustomerStatus) {
+	data.Id = types.StringValue(customerStatus.Id)
+	data.Name = types.StringValue(customerStatus.Name)
+	data.Type = types.StringValue(string(customerStatus.Type))
+	data.Position = types.NumberValue(big.NewFloat(customerStatus.Position))
+	data.Color = types.StringValue(customerStatus.Color)
+	data.Description = types.StringPointerValue(customerStatus.Description)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomerStatusResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomerStatusResourceConfigDefault("Prospect"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_customer_status.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_customer_status.test", "name", "Prospect"),
+					resource.TestCheckResourceAttr("linear_customer_status.test", "type", "active"),
+					resource.TestCheckResourceAttr("linear_customer_status.test", "position", "10"),
+					resource.TestCheckResourceAttr("linear_customer_status.test", "color", "#00ff00"),
+					resource.TestCheckNoResourceAttr("linear_customer_status.test", "description"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_customer_status.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCustomerStatusResourceConfigNonDefault("Churned"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_customer_status.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_customer_status.test", "name", "Churned"),
+					resource.TestCheckResourceAttr("linear_customer_status.test", "type", "inactive"),
+					resource.TestCheckResourceAttr("linear_customer_status.test", "position", "20"),
+					resource.TestCheckResourceAttr("linear_customer_status.test", "color", "#ff0000"),
+					resource.TestCheckResourceAttr("linear_customer_status.test", "description", "Managed by Terraform"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_customer_status.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCustomerStatusResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_customer_status" "test" {
+  name = "%s"
+  type = "active"
+  position = 10
+  color = "#00ff00"
+}
+`, name)
+}
+
+func testAccCustomerStatusResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_customer_status" "test" {
+  name = "%s"
+  type = "inactive"
+  position = 20
+  color = "#ff0000"
+  description = "Managed by Terraform"
+}
+`, name)
+}
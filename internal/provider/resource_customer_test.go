@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomerResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomerResourceConfigDefault("Acme"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_customer.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_customer.test", "name", "Acme"),
+					resource.TestCheckNoResourceAttr("linear_customer.test", "domains"),
+					resource.TestCheckNoResourceAttr("linear_customer.test", "external_ids"),
+					resource.TestCheckNoResourceAttr("linear_customer.test", "slack_channel_id"),
+					resource.TestMatchResourceAttr("linear_customer.test", "owner_id", uuidRegex()),
+					resource.TestMatchResourceAttr("linear_customer.test", "status_id", uuidRegex()),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_customer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCustomerResourceConfigNonDefault("Acme Corp"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_customer.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_customer.test", "name", "Acme Corp"),
+					resource.TestCheckResourceAttr("linear_customer.test", "domains.#", "2"),
+					resource.TestCheckTypeSetElemAttr("linear_customer.test", "domains.*", "acme.com"),
+					resource.TestCheckTypeSetElemAttr("linear_customer.test", "domains.*", "acme.io"),
+					resource.TestCheckResourceAttr("linear_customer.test", "external_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("linear_customer.test", "external_ids.*", "crm-42"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_customer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update with null values
+			{
+				Config: testAccCustomerResourceConfigDefault("Acme"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_customer.test", "name", "Acme"),
+					resource.TestCheckNoResourceAttr("linear_customer.test", "domains"),
+					resource.TestCheckNoResourceAttr("linear_customer.test", "external_ids"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCustomerResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_customer" "test" {
+  name = "%s"
+}
+`, name)
+}
+
+func testAccCustomerResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_customer" "test" {
+  name = "%s"
+  domains = ["acme.com", "acme.io"]
+  external_ids = ["crm-42"]
+}
+`, name)
+}
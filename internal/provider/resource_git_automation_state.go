@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &GitAutomationStateResource{}
+var _ resource.ResourceWithImportState = &GitAutomationStateResource{}
+
+func NewGitAutomationStateResource() resource.Resource {
+	return &GitAutomationStateResource{}
+}
+
+type GitAutomationStateResource struct {
+	client *graphql.Client
+}
+
+type GitAutomationStateResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	TeamId         types.String `tfsdk:"team_id"`
+	Event          types.String `tfsdk:"event"`
+	StateId        types.String `tfsdk:"state_id"`
+	TargetBranchId types.String `tfsdk:"target_branch_id"`
+}
+
+func (r *GitAutomationStateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_automation_state"
+}
+
+func (r *GitAutomationStateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear team git automation state, moving issues to a workflow state when a pull request event happens.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the git automation state.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"event": schema.StringAttribute{
+				MarkdownDescription: "Pull request event triggering the automation, one of `draft` (draft opened), `start` (opened), `review` (review requested), `mergeable` (ready for merge) or `merge` (merged).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"draft", "start", "review", "mergeable", "merge"}...),
+				},
+			},
+			"state_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workflow state issues are moved to. When not set, no action is taken on the event.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"target_branch_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the target branch the automation is restricted to. When not set, all branches are targeted.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *GitAutomationStateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GitAutomationStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *GitAutomationStateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := GitAutomationStateCreateInput{
+		TeamId:         data.TeamId.ValueString(),
+		Event:          GitAutomationStates(data.Event.ValueString()),
+		StateId:        data.StateId.ValueStringPointer(),
+		TargetBranchId: data.TargetBranchId.ValueStringPointer(),
+	}
+
+	response, err := createGitAutomationState(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create git automation state, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a git automation state", map[string]interface{}{
+		"resource":  "linear_git_automation_state",
+		"operation": "create",
+		"id":        response.GitAutomationStateCreate.GitAutomationState.Id,
+		"team_id":   data.TeamId.ValueString(),
+	})
+
+	readGitAutomationState(data, response.GitAutomationStateCreate.GitAutomationState.GitAutomationState)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitAutomationStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *GitAutomationStateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	automationState, err := findGitAutomationState(ctx, *r.client, data.TeamId.ValueString(), func(node GitAutomationState) bool {
+		return node.Id == data.Id.ValueString()
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read git automation state, got error: %s", err))
+		return
+	}
+
+	// The automation was removed from the team outside of terraform.
+	if automationState == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	readGitAutomationState(data, *automationState)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitAutomationStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *GitAutomationStateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := GitAutomationStateUpdateInput{
+		Event:          GitAutomationStates(data.Event.ValueString()),
+		StateId:        data.StateId.ValueStringPointer(),
+		TargetBranchId: data.TargetBranchId.ValueStringPointer(),
+	}
+
+	response, err := updateGitAutomationState(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update git automation state, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a git automation state", map[string]interface{}{
+		"resource":  "linear_git_automation_state",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+		"team_id":   data.TeamId.ValueString(),
+	})
+
+	readGitAutomationState(data, response.GitAutomationStateUpdate.GitAutomationState.GitAutomationState)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitAutomationStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *GitAutomationStateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteGitAutomationState(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete git automation state, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a git automation state", map[string]interface{}{
+		"resource":  "linear_git_automation_state",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+		"team_id":   data.TeamId.ValueString(),
+	})
+}
+
+func (r *GitAutomationStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team_key:event. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	// Only the automations targeting all branches can be identified by
+	// their event.
+	automationState, err := findGitAutomationState(ctx, *r.client, parts[0], func(node GitAutomationState) bool {
+		return string(node.Event) == parts[1] && node.TargetBranch == nil
+	})
+
+	if err != nil || automationState == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import git automation state, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), automationState.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), automationState.Team.Id)...)
+}
+
+// findGitAutomationState returns the first git automation state of the team
+// matching the given function, or nil if there is none.
+func findGitAutomationState(ctx context.Context, client graphql.Client, teamId string, match func(GitAutomationState) bool) (*GitAutomationState, error) {
+	var after *string
+
+	for {
+		response, err := listGitAutomationStates(ctx, client, teamId, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range response.Team.GitAutomationStates.Nodes {
+			if match(node.GitAutomationState) {
+				return &node.GitAutomationState, nil
+			}
+		}
+
+		if !response.Team.GitAutomationStates.PageInfo.HasNextPage {
+			return nil, nil
+		}
+
+		cursor := response.Team.GitAutomationStates.PageInfo.EndCursor
+		after = &cursor
+	}
+}
+
+func readGitAutomationState(data *GitAutomationStateResourceModel, automationState GitAutomationState) {
+	data.Id = types.StringValue(automationState.Id)
+	data.TeamId = types.StringValue(automationState.Team.Id)
+	data.Event = types.StringValue(string(automationState.Event))
+
+	if automationState.State != nil {
+		data.StateId = types.StringValue(automationState.State.Id)
+	} else {
+		data.StateId = types.StringNull()
+	}
+
+	if automationState.TargetBranch != nil {
+		data.TargetBranchId = types.StringValue(automationState.TargetBranch.Id)
+	} else {
+		data.TargetBranchId = types.StringNull()
+	}
+}
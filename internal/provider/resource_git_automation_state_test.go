@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGitAutomationStateResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGitAutomationStateResourceConfigDefault("mergeable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_git_automation_state.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_git_automation_state.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_git_automation_state.test", "event", "mergeable"),
+					resource.TestCheckNoResourceAttr("linear_git_automation_state.test", "state_id"),
+					resource.TestCheckNoResourceAttr("linear_git_automation_state.test", "target_branch_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_git_automation_state.test",
+				ImportState:       true,
+				ImportStateId:     "DEF:mergeable",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccGitAutomationStateResourceConfigNonDefault("mergeable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_git_automation_state.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_git_automation_state.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_git_automation_state.test", "event", "mergeable"),
+					resource.TestCheckResourceAttrPair("linear_git_automation_state.test", "state_id", "linear_workflow_state.test", "id"),
+					resource.TestCheckNoResourceAttr("linear_git_automation_state.test", "target_branch_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_git_automation_state.test",
+				ImportState:       true,
+				ImportStateId:     "DEF:mergeable",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGitAutomationStateResourceConfigDefault(event string) string {
+	return fmt.Sprintf(`
+resource "linear_git_automation_state" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  event = "%s"
+}
+`, event)
+}
+
+func testAccGitAutomationStateResourceConfigNonDefault(event string) string {
+	return fmt.Sprintf(`
+resource "linear_workflow_state" "test" {
+  name = "Ready to merge"
+  type = "started"
+  color = "#00ff00"
+  position = 30
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_git_automation_state" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  event = "%s"
+  state_id = linear_workflow_state.test.id
+}
+`, event)
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &InitiativeProjectResource{}
+var _ resource.ResourceWithImportState = &InitiativeProjectResource{}
+
+func NewInitiativeProjectResource() resource.Resource {
+	return &InitiativeProjectResource{}
+}
+
+type InitiativeProjectResource struct {
+	client *graphql.Client
+}
+
+type InitiativeProjectResourceModel struct {
+	Id           types.String  `tfsdk:"id"`
+	InitiativeId types.String  `tfsdk:"initiative_id"`
+	ProjectId    types.String  `tfsdk:"project_id"`
+	SortOrder    types.Float64 `tfsdk:"sort_order"`
+}
+
+func (r *InitiativeProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_initiative_project"
+}
+
+func (r *InitiativeProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear project attached to an initiative.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the link between the initiative and the project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"initiative_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the initiative.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"sort_order": schema.Float64Attribute{
+				MarkdownDescription: "Sort order of the project within the initiative.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InitiativeProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InitiativeProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *InitiativeProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := InitiativeToProjectCreateInput{
+		InitiativeId: data.InitiativeId.ValueString(),
+		ProjectId:    data.ProjectId.ValueString(),
+	}
+
+	if !data.SortOrder.IsUnknown() {
+		input.SortOrder = data.SortOrder.ValueFloat64Pointer()
+	}
+
+	response, err := createInitiativeToProject(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create initiative project, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an initiative project", map[string]interface{}{
+		"resource":      "linear_initiative_project",
+		"operation":     "create",
+		"id":            response.InitiativeToProjectCreate.InitiativeToProject.Id,
+		"initiative_id": data.InitiativeId.ValueString(),
+		"project_id":    data.ProjectId.ValueString(),
+	})
+
+	resp.Diagnostics.Append(readInitiativeProject(data, response.InitiativeToProjectCreate.InitiativeToProject.InitiativeToProject)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *InitiativeProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getInitiativeToProject(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read initiative project, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(readInitiativeProject(data, response.InitiativeToProject.InitiativeToProject)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *InitiativeProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := InitiativeToProjectUpdateInput{}
+
+	if !data.SortOrder.IsUnknown() {
+		input.SortOrder = data.SortOrder.ValueFloat64Pointer()
+	}
+
+	response, err := updateInitiativeToProject(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update initiative project, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated an initiative project", map[string]interface{}{
+		"resource":      "linear_initiative_project",
+		"operation":     "update",
+		"id":            data.Id.ValueString(),
+		"initiative_id": data.InitiativeId.ValueString(),
+		"project_id":    data.ProjectId.ValueString(),
+	})
+
+	resp.Diagnostics.Append(readInitiativeProject(data, response.InitiativeToProjectUpdate.InitiativeToProject.InitiativeToProject)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *InitiativeProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteInitiativeToProject(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete initiative project, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an initiative project", map[string]interface{}{
+		"resource":      "linear_initiative_project",
+		"operation":     "delete",
+		"id":            data.Id.ValueString(),
+		"initiative_id": data.InitiativeId.ValueString(),
+		"project_id":    data.ProjectId.ValueString(),
+	})
+}
+
+func (r *InitiativeProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func readInitiativeProject(data *InitiativeProjectResourceModel, initiativeToProject InitiativeToProject) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The API returns the sort order as a string even though it is set as a float.
+	sortOrder, err := strconv.ParseFloat(initiativeToProject.SortOrder, 64)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to parse initiative project sort order, got error: %s", err))
+		return diags
+	}
+
+	data.Id = types.StringValue(initiativeToProject.Id)
+	data.InitiativeId = types.StringValue(initiativeToProject.Initiative.Id)
+	data.ProjectId = types.StringValue(initiativeToProject.Project.Id)
+	data.SortOrder = types.Float64Value(sortOrder)
+
+	return diags
+}
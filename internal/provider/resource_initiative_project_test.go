@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The provider cannot manage initiatives yet, so the acceptance test needs an
+// existing initiative of the test workspace.
+func testAccInitiativeId(t *testing.T) string {
+	initiativeId := os.Getenv("LINEAR_TEST_INITIATIVE_ID")
+
+	if initiativeId == "" {
+		t.Skip("LINEAR_TEST_INITIATIVE_ID must be set for initiative acceptance tests")
+	}
+
+	return initiativeId
+}
+
+func TestAccInitiativeProjectResourceDefault(t *testing.T) {
+	initiativeId := testAccInitiativeId(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInitiativeProjectResourceConfigDefault(initiativeId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_initiative_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_initiative_project.test", "initiative_id", initiativeId),
+					resource.TestCheckResourceAttrPair("linear_initiative_project.test", "project_id", "linear_project.test", "id"),
+					resource.TestCheckResourceAttrSet("linear_initiative_project.test", "sort_order"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_initiative_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccInitiativeProjectResourceConfigNonDefault(initiativeId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_initiative_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_initiative_project.test", "initiative_id", initiativeId),
+					resource.TestCheckResourceAttrPair("linear_initiative_project.test", "project_id", "linear_project.test", "id"),
+					resource.TestCheckResourceAttr("linear_initiative_project.test", "sort_order", "42"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_initiative_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccInitiativeProjectResourceConfigDefault(initiativeId string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "test" {
+  name = "Terraform Initiative"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_initiative_project" "test" {
+  initiative_id = "%s"
+  project_id = linear_project.test.id
+}
+`, initiativeId)
+}
+
+func testAccInitiativeProjectResourceConfigNonDefault(initiativeId string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "test" {
+  name = "Terraform Initiative"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_initiative_project" "test" {
+  initiative_id = "%s"
+  project_id = linear_project.test.id
+  sort_order = 42
+}
+`, initiativeId)
+}
@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &IssueResource{}
+var _ resource.ResourceWithImportState = &IssueResource{}
+var _ resource.ResourceWithModifyPlan = &IssueResource{}
+
+func NewIssueResource() resource.Resource {
+	return &IssueResource{}
+}
+
+type IssueResource struct {
+	client *graphql.Client
+}
+
+type IssueResourceModel struct {
+	Id           types.String  `tfsdk:"id"`
+	Identifier   types.String  `tfsdk:"identifier"`
+	Title        types.String  `tfsdk:"title"`
+	Description  types.String  `tfsdk:"description"`
+	TeamId       types.String  `tfsdk:"team_id"`
+	StateId      types.String  `tfsdk:"state_id"`
+	Priority     types.Float64 `tfsdk:"priority"`
+	TemplateId   types.String  `tfsdk:"template_id"`
+	CreateAsUser types.String  `tfsdk:"create_as_user"`
+}
+
+func (r *IssueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue"
+}
+
+func (r *IssueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear issue.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the issue.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Human readable identifier of the issue, e.g. `ENG-123`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the issue.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the issue in markdown. Computed from the template when `template_id` is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"state_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workflow state of the issue, which must belong to the team. Defaults to the default state of the team.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"priority": schema.Float64Attribute{
+				MarkdownDescription: "Priority of the issue. No priority is 0, urgent is 1, high is 2, medium is 3, low is 4. Computed from the template when `template_id` is set. **Default** `0`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Float64{
+					float64validator.OneOf([]float64{0, 1, 2, 3, 4}...),
+				},
+			},
+			"create_as_user": schema.StringAttribute{
+				MarkdownDescription: "Display name the issue is shown as created by, when creating it with an OAuth application token using the `actor=app` mode. This is a free-form name, not the identifier of a Linear user. Only used on create. The provider has no comment resource, so comments can not be attributed this way.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the issue template to create the issue from. The template is only applied on create, the fields it sets which are not configured are read back.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *IssueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IssueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, config, state *IssueResourceModel
+
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a template, unset attributes are cleared like before. The
+	// template sets them otherwise, which is read back after creating.
+	if plan.TemplateId.IsNull() {
+		if config.Description.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("description"), types.StringNull())...)
+		}
+
+		if config.Priority.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("priority"), types.Float64Value(0))...)
+		}
+	}
+
+	if r.client != nil {
+		resp.Diagnostics.Append(r.planWorkflowState(ctx, plan, state)...)
+	}
+}
+
+// planWorkflowState checks that the workflow state belongs to the team of the
+// issue, which the API only reports vaguely when applying.
+func (r *IssueResource) planWorkflowState(ctx context.Context, plan *IssueResourceModel, state *IssueResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.StateId.IsUnknown() || plan.StateId.IsNull() || plan.TeamId.IsUnknown() {
+		return diags
+	}
+
+	// Only check when the workflow state or the team changes
+	if state != nil && plan.StateId.Equal(state.StateId) && plan.TeamId.Equal(state.TeamId) {
+		return diags
+	}
+
+	response, err := getIssueWorkflowState(ctx, *r.client, plan.StateId.ValueString(), plan.TeamId.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to check workflow state of issue, got error: %s", err))
+		return diags
+	}
+
+	if response.WorkflowState.Team.Id != response.Team.Id {
+		diags.AddAttributeError(
+			path.Root("state_id"),
+			"Invalid Workflow State",
+			fmt.Sprintf("Workflow state %q belongs to team %q, not to team %q of the issue.", response.WorkflowState.Name, response.WorkflowState.Team.Name, response.Team.Name),
+		)
+	}
+
+	return diags
+}
+
+func (r *IssueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IssueResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := IssueCreateInput{
+		Title:        data.Title.ValueString(),
+		TeamId:       data.TeamId.ValueString(),
+		TemplateId:   data.TemplateId.ValueString(),
+		CreateAsUser: data.CreateAsUser.ValueString(),
+	}
+
+	// The unknown attributes are left to the template
+	if !data.Description.IsUnknown() {
+		input.Description = data.Description.ValueStringPointer()
+	}
+
+	if !data.Priority.IsUnknown() {
+		priority := int(data.Priority.ValueFloat64())
+		input.Priority = &priority
+	}
+
+	if !data.StateId.IsUnknown() {
+		input.StateId = data.StateId.ValueStringPointer()
+	}
+
+	response, err := createIssue(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an issue", map[string]interface{}{
+		"resource":   "linear_issue",
+		"operation":  "create",
+		"id":         response.IssueCreate.Issue.Id,
+		"identifier": response.IssueCreate.Issue.Identifier,
+	})
+
+	readIssue(data, response.IssueCreate.Issue.Issue)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IssueResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getIssue(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read issue, got error: %s", err))
+		return
+	}
+
+	readIssue(data, response.Issue.Issue)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IssueResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := IssueUpdateInput{
+		Title:       data.Title.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Priority:    int(data.Priority.ValueFloat64()),
+	}
+
+	if !data.StateId.IsUnknown() {
+		input.StateId = data.StateId.ValueStringPointer()
+	}
+
+	response, err := updateIssue(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated an issue", map[string]interface{}{
+		"resource":   "linear_issue",
+		"operation":  "update",
+		"id":         data.Id.ValueString(),
+		"identifier": data.Identifier.ValueString(),
+	})
+
+	readIssue(data, response.IssueUpdate.Issue.Issue)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IssueResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteIssue(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an issue", map[string]interface{}{
+		"resource":   "linear_issue",
+		"operation":  "delete",
+		"id":         data.Id.ValueString(),
+		"identifier": data.Identifier.ValueString(),
+	})
+}
+
+func (r *IssueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The API accepts both the uuid and the human readable identifier of an
+	// issue, so resolve the import identifier to the uuid used in the state.
+	response, err := getIssue(ctx, *r.client, req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import issue, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.Issue.Id)...)
+}
+
+func readIssue(data *IssueResourceModel, issue Issue) {
+	data.Id = types.StringValue(issue.Id)
+	data.Identifier = types.StringValue(issue.Identifier)
+	data.Title = types.StringValue(issue.Title)
+	data.TeamId = types.StringValue(issue.Team.Id)
+	data.StateId = types.StringValue(issue.State.Id)
+	data.Priority = types.Float64Value(issue.Priority)
+
+	if issue.Description != nil && *issue.Description != "" {
+		data.Description = types.StringValue(*issue.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+}
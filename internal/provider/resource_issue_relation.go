@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &IssueRelationResource{}
+var _ resource.ResourceWithImportState = &IssueRelationResource{}
+
+func NewIssueRelationResource() resource.Resource {
+	return &IssueRelationResource{}
+}
+
+type IssueRelationResource struct {
+	client *graphql.Client
+}
+
+type IssueRelationResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Type           types.String `tfsdk:"type"`
+	IssueId        types.String `tfsdk:"issue_id"`
+	RelatedIssueId types.String `tfsdk:"related_issue_id"`
+}
+
+func (r *IssueRelationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_relation"
+}
+
+func (r *IssueRelationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear issue relation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the issue relation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the relation, from the issue to the related issue.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"blocks", "duplicate", "related"}...),
+				},
+			},
+			"issue_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the issue, either its uuid or its human readable identifier, e.g. `ENG-123`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"related_issue_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the related issue, either its uuid or its human readable identifier, e.g. `ENG-123`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *IssueRelationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IssueRelationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IssueRelationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := IssueRelationCreateInput{
+		Type:           IssueRelationType(data.Type.ValueString()),
+		IssueId:        data.IssueId.ValueString(),
+		RelatedIssueId: data.RelatedIssueId.ValueString(),
+	}
+
+	response, err := createIssueRelation(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue relation, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an issue relation", map[string]interface{}{
+		"resource":         "linear_issue_relation",
+		"operation":        "create",
+		"id":               response.IssueRelationCreate.IssueRelation.Id,
+		"issue_id":         data.IssueId.ValueString(),
+		"related_issue_id": data.RelatedIssueId.ValueString(),
+	})
+
+	readIssueRelation(data, response.IssueRelationCreate.IssueRelation.IssueRelation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueRelationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IssueRelationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getIssueRelation(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read issue relation, got error: %s", err))
+		return
+	}
+
+	readIssueRelation(data, response.IssueRelation.IssueRelation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueRelationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IssueRelationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := IssueRelationUpdateInput{
+		Type: data.Type.ValueString(),
+	}
+
+	response, err := updateIssueRelation(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue relation, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated an issue relation", map[string]interface{}{
+		"resource":         "linear_issue_relation",
+		"operation":        "update",
+		"id":               data.Id.ValueString(),
+		"issue_id":         data.IssueId.ValueString(),
+		"related_issue_id": data.RelatedIssueId.ValueString(),
+	})
+
+	readIssueRelation(data, response.IssueRelationUpdate.IssueRelation.IssueRelation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueRelationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IssueRelationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteIssueRelation(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue relation, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an issue relation", map[string]interface{}{
+		"resource":  "linear_issue_relation",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
+}
+
+func (r *IssueRelationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func readIssueRelation(data *IssueRelationResourceModel, issueRelation IssueRelation) {
+	data.Id = types.StringValue(issueRelation.Id)
+	data.Type = types.StringValue(issueRelation.Type)
+	data.IssueId = issueReference(data.IssueId, issueRelation.Issue.Id, issueRelation.Issue.Identifier)
+	data.RelatedIssueId = issueReference(data.RelatedIssueId, issueRelation.RelatedIssue.Id, issueRelation.RelatedIssue.Identifier)
+}
+
+// issueReference keeps the configured reference to an issue when it still
+// points to the same issue, whether it is the uuid or the human readable
+// identifier. Otherwise the human readable identifier is used.
+func issueReference(current types.String, id string, identifier string) types.String {
+	if current.ValueString() == id || strings.EqualFold(current.ValueString(), identifier) {
+		return current
+	}
+
+	return types.StringValue(identifier)
+}
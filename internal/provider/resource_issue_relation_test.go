@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIssueRelationResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIssueRelationResourceConfigDefault("blocks"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_issue_relation.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_issue_relation.test", "type", "blocks"),
+					resource.TestCheckResourceAttrPair("linear_issue_relation.test", "issue_id", "linear_issue.blocker", "identifier"),
+					resource.TestCheckResourceAttrPair("linear_issue_relation.test", "related_issue_id", "linear_issue.release", "identifier"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_issue_relation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccIssueRelationResourceConfigDefault("related"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_issue_relation.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_issue_relation.test", "type", "related"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_issue_relation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIssueRelationResourceConfigDefault(relationType string) string {
+	return fmt.Sprintf(`
+resource "linear_issue" "release" {
+  title = "Release tracking"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_issue" "blocker" {
+  title = "Release blocker"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_issue_relation" "test" {
+  type = "%s"
+  issue_id = linear_issue.blocker.identifier
+  related_issue_id = linear_issue.release.identifier
+}
+`, relationType)
+}
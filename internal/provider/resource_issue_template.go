@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const issueTemplateType = "issue"
+
+var _ resource.Resource = &IssueTemplateResource{}
+var _ resource.ResourceWithImportState = &IssueTemplateResource{}
+
+func NewIssueTemplateResource() resource.Resource {
+	return &IssueTemplateResource{}
+}
+
+type IssueTemplateResource struct {
+	client *graphql.Client
+}
+
+// TemplateResourceModel is shared by the issue and project template resources.
+type TemplateResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	TemplateData types.String `tfsdk:"template_data"`
+	TeamId       types.String `tfsdk:"team_id"`
+}
+
+func (r *IssueTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_template"
+}
+
+func (r *IssueTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear issue template.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the template.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the template.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the template.",
+				Optional:            true,
+			},
+			"template_data": schema.StringAttribute{
+				MarkdownDescription: "Issue fields pre-filled by the template as a JSON encoded object, e.g. built with `jsonencode`.",
+				Required:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *IssueTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IssueTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TemplateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templateData, diags := jsonObjectValue(path.Root("template_data"), data.TemplateData)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := TemplateCreateInput{
+		Type:         issueTemplateType,
+		TeamId:       data.TeamId.ValueStringPointer(),
+		Name:         data.Name.ValueString(),
+		Description:  data.Description.ValueStringPointer(),
+		TemplateData: templateData,
+	}
+
+	response, err := createTemplate(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue template, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an issue template", map[string]interface{}{
+		"resource":  "linear_issue_template",
+		"operation": "create",
+		"id":        response.TemplateCreate.Template.Id,
+		"team_id":   data.TeamId.ValueString(),
+	})
+
+	resp.Diagnostics.Append(readTemplate(data, response.TemplateCreate.Template.Template)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TemplateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getTemplate(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read issue template, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(readTemplate(data, response.Template.Template)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TemplateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templateData, diags := jsonObjectValue(path.Root("template_data"), data.TemplateData)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := TemplateUpdateInput{
+		Name:         data.Name.ValueString(),
+		Description:  data.Description.ValueStringPointer(),
+		TemplateData: templateData,
+	}
+
+	response, err := updateTemplate(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue template, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated an issue template", map[string]interface{}{
+		"resource":  "linear_issue_template",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+		"team_id":   data.TeamId.ValueString(),
+	})
+
+	resp.Diagnostics.Append(readTemplate(data, response.TemplateUpdate.Template.Template)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TemplateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteTemplate(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue template, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an issue template", map[string]interface{}{
+		"resource":  "linear_issue_template",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+		"team_id":   data.TeamId.ValueString(),
+	})
+}
+
+func (r *IssueTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team_key:template_name. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	response, err := listTemplates(ctx, *r.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import issue template, got error: %s", err))
+		return
+	}
+
+	var ids []string
+
+	for _, template := range response.Templates {
+		if template.Type == issueTemplateType && template.Team.Key == parts[0] && template.Name == parts[1] {
+			ids = append(ids, template.Id)
+		}
+	}
+
+	if len(ids) != 1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import issue template, found %d issue templates named %q in team %s", len(ids), parts[1], parts[0]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[0])...)
+}
+
+func readTemplate(data *TemplateResourceModel, template Template) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var decoded interface{}
+
+	data.Id = types.StringValue(template.Id)
+	data.Name = types.StringValue(template.Name)
+	data.Description = types.StringPointerValue(template.Description)
+
+	if template.Team != nil {
+		data.TeamId = types.StringValue(template.Team.Id)
+	} else {
+		data.TeamId = types.StringNull()
+	}
+
+	if err := json.Unmarshal(template.TemplateData, &decoded); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to decode template data, got error: %s", err))
+		return diags
+	}
+
+	templateData, err := jsonStringValue(data.TemplateData, decoded)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to encode template data, got error: %s", err))
+		return diags
+	}
+
+	data.TemplateData = templateData
+
+	return diags
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIssueTemplateResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIssueTemplateResourceConfigDefault("Incident"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_issue_template.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_issue_template.test", "name", "Incident"),
+					resource.TestCheckNoResourceAttr("linear_issue_template.test", "description"),
+					resource.TestCheckResourceAttr("linear_issue_template.test", "template_data", `{"title":"Incident: "}`),
+					resource.TestCheckResourceAttr("linear_issue_template.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_issue_template.test",
+				ImportState:       true,
+				ImportStateId:     "DEF:Incident",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccIssueTemplateResourceConfigNonDefault("Bug"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_issue_template.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_issue_template.test", "name", "Bug"),
+					resource.TestCheckResourceAttr("linear_issue_template.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("linear_issue_template.test", "template_data", `{"priority":2,"title":"Bug: "}`),
+					resource.TestCheckResourceAttr("linear_issue_template.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_issue_template.test",
+				ImportState:       true,
+				ImportStateId:     "DEF:Bug",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIssueTemplateResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_issue_template" "test" {
+  name = "%s"
+  template_data = jsonencode({ title = "Incident: " })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`, name)
+}
+
+func testAccIssueTemplateResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_issue_template" "test" {
+  name = "%s"
+  description = "Managed by Terraform"
+  template_data = jsonencode({ title = "Bug: ", priority = 2 })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`, name)
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIssueResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIssueResourceConfigDefault("Rotate credentials"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_issue.test", "id", uuidRegex()),
+					resource.TestMatchResourceAttr("linear_issue.test", "identifier", regexp.MustCompile("^DEF-[0-9]+$")),
+					resource.TestCheckResourceAttr("linear_issue.test", "title", "Rotate credentials"),
+					resource.TestCheckNoResourceAttr("linear_issue.test", "description"),
+					resource.TestCheckResourceAttr("linear_issue.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestMatchResourceAttr("linear_issue.test", "state_id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_issue.test", "priority", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_issue.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccIssueImportStateId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccIssueResourceConfigNonDefault("Rotate production credentials"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_issue.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_issue.test", "title", "Rotate production credentials"),
+					resource.TestCheckResourceAttr("linear_issue.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttrPair("linear_issue.test", "state_id", "linear_workflow_state.test", "id"),
+					resource.TestCheckResourceAttr("linear_issue.test", "priority", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_issue.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccIssueImportStateId,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIssueResourceTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIssueResourceConfigTemplate("Rotate credentials"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_issue.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_issue.test", "title", "Rotate credentials"),
+					resource.TestCheckResourceAttr("linear_issue.test", "description", "Created from a template"),
+					resource.TestCheckResourceAttr("linear_issue.test", "priority", "2"),
+					resource.TestCheckResourceAttrPair("linear_issue.test", "template_id", "linear_issue_template.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_issue.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccIssueImportStateId,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_id"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIssueResourceWorkflowStateOfOtherTeam(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The workflow state must be known when planning the issue
+			{
+				Config: testAccIssueResourceConfigOtherTeam(false),
+			},
+			// Plan testing
+			{
+				Config:      testAccIssueResourceConfigOtherTeam(true),
+				ExpectError: regexp.MustCompile(`Workflow state "Scheduled" belongs to team "Other Issues", not to\s+team`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIssueImportStateId(s *terraform.State) (string, error) {
+	issue, ok := s.RootModule().Resources["linear_issue.test"]
+
+	if !ok {
+		return "", fmt.Errorf("issue not found in state")
+	}
+
+	return issue.Primary.Attributes["identifier"], nil
+}
+
+func testAccIssueResourceConfigDefault(title string) string {
+	return fmt.Sprintf(`
+resource "linear_issue" "test" {
+  title = "%s"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`, title)
+}
+
+func testAccIssueResourceConfigNonDefault(title string) string {
+	return fmt.Sprintf(`
+resource "linear_workflow_state" "test" {
+  name = "Scheduled"
+  type = "unstarted"
+  position = 5
+  color = "#00ffff"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_issue" "test" {
+  title = "%s"
+  description = "Managed by Terraform"
+  state_id = linear_workflow_state.test.id
+  priority = 2
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`, title)
+}
+
+func testAccIssueResourceConfigTemplate(title string) string {
+	return fmt.Sprintf(`
+resource "linear_issue_template" "test" {
+  name = "Credentials"
+  template_data = jsonencode({ description = "Created from a template", priority = 2 })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_issue" "test" {
+  title = "%s"
+  template_id = linear_issue_template.test.id
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`, title)
+}
+
+func testAccIssueResourceConfigOtherTeam(issue bool) string {
+	config := `
+resource "linear_team" "test" {
+  key = "OIS"
+  name = "Other Issues"
+}
+
+resource "linear_workflow_state" "test" {
+  name = "Scheduled"
+  type = "unstarted"
+  position = 5
+  color = "#00ffff"
+  team_id = linear_team.test.id
+}
+`
+
+	if issue {
+		config += `
+resource "linear_issue" "test" {
+  title = "Rotate credentials"
+  state_id = linear_workflow_state.test.id
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`
+	}
+
+	return config
+}
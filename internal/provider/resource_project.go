@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ProjectResource{}
+var _ resource.ResourceWithImportState = &ProjectResource{}
+
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+type ProjectResource struct {
+	client *graphql.Client
+}
+
+type ProjectResourceModel struct {
+	Id          types.String  `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	Icon        types.String  `tfsdk:"icon"`
+	Color       types.String  `tfsdk:"color"`
+	TeamIds     types.Set     `tfsdk:"team_ids"`
+	StatusId    types.String  `tfsdk:"status_id"`
+	LeadId      types.String  `tfsdk:"lead_id"`
+	Priority    types.Float64 `tfsdk:"priority"`
+	StartDate   types.String  `tfsdk:"start_date"`
+	TargetDate  types.String  `tfsdk:"target_date"`
+	TemplateId  types.String  `tfsdk:"template_id"`
+}
+
+func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the project.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the project.",
+				Optional:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the project.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Color of the project.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(colorRegex(), "must be a hex color"),
+				},
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the teams the project is associated with.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+					),
+				},
+			},
+			"status_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project status.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"lead_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user leading the project.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"priority": schema.Float64Attribute{
+				MarkdownDescription: "Priority of the project. No priority is 0, urgent is 1, high is 2, medium is 3, low is 4. **Default** `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(0),
+				Validators: []validator.Float64{
+					float64validator.OneOf([]float64{0, 1, 2, 3, 4}...),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "Planned start date of the project, in `YYYY-MM-DD` format.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex(), "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"target_date": schema.StringAttribute{
+				MarkdownDescription: "Planned target date of the project, in `YYYY-MM-DD` format.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex(), "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project template to create the project from. The template is only applied on create.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProjectResourceModel
+	var teamIds []string
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.TeamIds.ElementsAs(ctx, &teamIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := ProjectCreateInput{
+		Name:                  data.Name.ValueString(),
+		Description:           data.Description.ValueStringPointer(),
+		TeamIds:               teamIds,
+		LeadId:                data.LeadId.ValueStringPointer(),
+		Priority:              int(data.Priority.ValueFloat64()),
+		StartDate:             data.StartDate.ValueStringPointer(),
+		TargetDate:            data.TargetDate.ValueStringPointer(),
+		LastAppliedTemplateId: data.TemplateId.ValueString(),
+	}
+
+	if !data.Icon.IsUnknown() {
+		input.Icon = data.Icon.ValueStringPointer()
+	}
+
+	if !data.Color.IsUnknown() {
+		input.Color = data.Color.ValueStringPointer()
+	}
+
+	if !data.StatusId.IsUnknown() {
+		input.StatusId = data.StatusId.ValueStringPointer()
+	}
+
+	response, err := createProject(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a project", map[string]interface{}{
+		"resource":  "linear_project",
+		"operation": "create",
+		"id":        response.ProjectCreate.Project.Id,
+	})
+
+	resp.Diagnostics.Append(readProject(ctx, data, response.ProjectCreate.Project.Project)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getProject(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(readProject(ctx, data, response.Project.Project)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProjectResourceModel
+	var teamIds []string
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.TeamIds.ElementsAs(ctx, &teamIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := ProjectUpdateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Icon:        data.Icon.ValueStringPointer(),
+		TeamIds:     teamIds,
+		LeadId:      data.LeadId.ValueStringPointer(),
+		Priority:    int(data.Priority.ValueFloat64()),
+		StartDate:   data.StartDate.ValueStringPointer(),
+		TargetDate:  data.TargetDate.ValueStringPointer(),
+	}
+
+	if !data.Color.IsUnknown() {
+		input.Color = data.Color.ValueStringPointer()
+	}
+
+	if !data.StatusId.IsUnknown() {
+		input.StatusId = data.StatusId.ValueStringPointer()
+	}
+
+	response, err := updateProject(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a project", map[string]interface{}{
+		"resource":  "linear_project",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+	})
+
+	resp.Diagnostics.Append(readProject(ctx, data, response.ProjectUpdate.Project.Project)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteProject(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a project", map[string]interface{}{
+		"resource":  "linear_project",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func readProject(ctx context.Context, data *ProjectResourceModel, project Project) diag.Diagnostics {
+	teamIds := []string{}
+
+	for _, team := range project.Teams.Nodes {
+		teamIds = append(teamIds, team.Id)
+	}
+
+	teamIdsValue, diags := types.SetValueFrom(ctx, types.StringType, teamIds)
+
+	data.Id = types.StringValue(project.Id)
+	data.Name = types.StringValue(project.Name)
+	data.Icon = types.StringPointerValue(project.Icon)
+	data.Color = types.StringValue(project.Color)
+	data.TeamIds = teamIdsValue
+	data.StatusId = types.StringValue(project.Status.Id)
+	data.Priority = types.Float64Value(float64(project.Priority))
+	data.StartDate = types.StringPointerValue(project.StartDate)
+	data.TargetDate = types.StringPointerValue(project.TargetDate)
+
+	if project.Description != "" {
+		data.Description = types.StringValue(project.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	if project.Lead != nil {
+		data.LeadId = types.StringValue(project.Lead.Id)
+	} else {
+		data.LeadId = types.StringNull()
+	}
+
+	return diags
+}
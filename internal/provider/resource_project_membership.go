@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ProjectMembershipResource{}
+var _ resource.ResourceWithImportState = &ProjectMembershipResource{}
+
+// The API only allows replacing the whole member list of a project, so
+// memberships of the same project must not be changed concurrently.
+var projectMembershipMutex sync.Mutex
+
+func NewProjectMembershipResource() resource.Resource {
+	return &ProjectMembershipResource{}
+}
+
+type ProjectMembershipResource struct {
+	client *graphql.Client
+}
+
+type ProjectMembershipResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	ProjectId types.String `tfsdk:"project_id"`
+	UserId    types.String `tfsdk:"user_id"`
+}
+
+func (r *ProjectMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_membership"
+}
+
+func (r *ProjectMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear project membership.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the membership, in `project_id:user_id` format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProjectMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectMembershipMutex.Lock()
+	defer projectMembershipMutex.Unlock()
+
+	memberIds, err := listProjectMemberIds(ctx, *r.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project membership, got error: %s", err))
+		return
+	}
+
+	memberIds = append(memberIds, data.UserId.ValueString())
+
+	_, err = updateProjectMembers(ctx, *r.client, data.ProjectId.ValueString(), memberIds)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project membership, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.ProjectId.ValueString(), data.UserId.ValueString()))
+
+	tflog.Trace(ctx, "created a project membership", map[string]interface{}{
+		"resource":   "linear_project_membership",
+		"operation":  "create",
+		"id":         data.Id.ValueString(),
+		"project_id": data.ProjectId.ValueString(),
+		"user_id":    data.UserId.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProjectMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getProjectMembership(ctx, *r.client, data.ProjectId.ValueString(), data.UserId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project membership, got error: %s", err))
+		return
+	}
+
+	// The user was removed from the project outside of terraform.
+	if len(response.Project.Members.Nodes) != 1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", response.Project.Id, response.Project.Members.Nodes[0].Id))
+	data.ProjectId = types.StringValue(response.Project.Id)
+	data.UserId = types.StringValue(response.Project.Members.Nodes[0].Id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProjectMembershipResourceModel
+
+	// All configurable attributes require replacement, there is nothing to
+	// update in place.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProjectMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectMembershipMutex.Lock()
+	defer projectMembershipMutex.Unlock()
+
+	memberIds, err := listProjectMemberIds(ctx, *r.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project membership, got error: %s", err))
+		return
+	}
+
+	remainingIds := []string{}
+
+	for _, memberId := range memberIds {
+		if memberId != data.UserId.ValueString() {
+			remainingIds = append(remainingIds, memberId)
+		}
+	}
+
+	_, err = updateProjectMembers(ctx, *r.client, data.ProjectId.ValueString(), remainingIds)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project membership, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a project membership", map[string]interface{}{
+		"resource":   "linear_project_membership",
+		"operation":  "delete",
+		"id":         data.Id.ValueString(),
+		"project_id": data.ProjectId.ValueString(),
+		"user_id":    data.UserId.ValueString(),
+	})
+}
+
+func (r *ProjectMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_slug:user_email. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	response, err := findProjectMembership(ctx, *r.client, parts[0], parts[1])
+
+	if err != nil || len(response.Projects.Nodes) != 1 || len(response.Users.Nodes) != 1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import project membership, got error: %s", err))
+		return
+	}
+
+	projectId := response.Projects.Nodes[0].Id
+	userId := response.Users.Nodes[0].Id
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s:%s", projectId, userId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+}
+
+// listProjectMemberIds returns the identifiers of all the members of the
+// project.
+func listProjectMemberIds(ctx context.Context, client graphql.Client, projectId string) ([]string, error) {
+	var memberIds []string
+	var after *string
+
+	for {
+		response, err := listProjectMembers(ctx, client, projectId, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range response.Project.Members.Nodes {
+			memberIds = append(memberIds, node.Id)
+		}
+
+		if !response.Project.Members.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := response.Project.Members.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	return memberIds, nil
+}
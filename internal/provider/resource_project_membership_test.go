@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectMembershipResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectMembershipResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("linear_project_membership.test", "project_id", "linear_project.test", "id"),
+					resource.TestCheckResourceAttrPair("linear_project_membership.test", "user_id", "linear_roadmap.test", "owner_id"),
+				),
+			},
+			// Importing needs the project slug and the user email which are
+			// not exposed by any resource, so it is not tested here.
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectMembershipResourceConfigDefault() string {
+	return `
+resource "linear_project" "test" {
+  name = "Terraform Membership"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+# The owner of a roadmap defaults to the authenticated user.
+resource "linear_roadmap" "test" {
+  name = "Terraform Membership"
+}
+
+resource "linear_project_membership" "test" {
+  project_id = linear_project.test.id
+  user_id = linear_roadmap.test.owner_id
+}
+`
+}
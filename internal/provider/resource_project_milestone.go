@@ -0,0 +1,372 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ProjectMilestoneResource{}
+var _ resource.ResourceWithImportState = &ProjectMilestoneResource{}
+
+func NewProjectMilestoneResource() resource.Resource {
+	return &ProjectMilestoneResource{}
+}
+
+type ProjectMilestoneResource struct {
+	client *graphql.Client
+}
+
+type ProjectMilestoneResourceModel struct {
+	Id                  types.String  `tfsdk:"id"`
+	Name                types.String  `tfsdk:"name"`
+	Description         types.String  `tfsdk:"description"`
+	TargetDate          types.String  `tfsdk:"target_date"`
+	ProjectId           types.String  `tfsdk:"project_id"`
+	CountIssues         types.Bool    `tfsdk:"count_issues"`
+	IssueCountTotal     types.Int64   `tfsdk:"issue_count_total"`
+	IssueCountCompleted types.Int64   `tfsdk:"issue_count_completed"`
+	Progress            types.Float64 `tfsdk:"progress"`
+}
+
+func (r *ProjectMilestoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_milestone"
+}
+
+func (r *ProjectMilestoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear project milestone.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the milestone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the milestone.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the milestone.",
+				Optional:            true,
+			},
+			"target_date": schema.StringAttribute{
+				MarkdownDescription: "Planned completion date of the milestone, in `YYYY-MM-DD` format.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex(), "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"count_issues": schema.BoolAttribute{
+				MarkdownDescription: "Whether to read the issues of the milestone to set `issue_count_total`, `issue_count_completed` and `progress`, which is slow for large milestones. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"issue_count_total": schema.Int64Attribute{
+				MarkdownDescription: "Number of issues in the milestone, only set when `count_issues` is enabled.",
+				Computed:            true,
+			},
+			"issue_count_completed": schema.Int64Attribute{
+				MarkdownDescription: "Number of completed issues in the milestone, only set when `count_issues` is enabled.",
+				Computed:            true,
+			},
+			"progress": schema.Float64Attribute{
+				MarkdownDescription: "Share of the issues of the milestone which are completed, from `0` to `1`, without the canceled ones. Only set when `count_issues` is enabled.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectMilestoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectMilestoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProjectMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := ProjectMilestoneCreateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		TargetDate:  data.TargetDate.ValueStringPointer(),
+		ProjectId:   data.ProjectId.ValueString(),
+	}
+
+	response, err := createProjectMilestone(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project milestone, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a project milestone", map[string]interface{}{
+		"resource":   "linear_project_milestone",
+		"operation":  "create",
+		"id":         response.ProjectMilestoneCreate.ProjectMilestone.Id,
+		"project_id": data.ProjectId.ValueString(),
+	})
+
+	readProjectMilestone(data, response.ProjectMilestoneCreate.ProjectMilestone.ProjectMilestone)
+
+	resp.Diagnostics.Append(readProjectMilestoneProgress(ctx, *r.client, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMilestoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProjectMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getProjectMilestone(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project milestone, got error: %s", err))
+		return
+	}
+
+	readProjectMilestone(data, response.ProjectMilestone.ProjectMilestone)
+
+	resp.Diagnostics.Append(readProjectMilestoneProgress(ctx, *r.client, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMilestoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProjectMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := ProjectMilestoneUpdateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		TargetDate:  data.TargetDate.ValueStringPointer(),
+	}
+
+	response, err := updateProjectMilestone(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project milestone, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a project milestone", map[string]interface{}{
+		"resource":   "linear_project_milestone",
+		"operation":  "update",
+		"id":         data.Id.ValueString(),
+		"project_id": data.ProjectId.ValueString(),
+	})
+
+	readProjectMilestone(data, response.ProjectMilestoneUpdate.ProjectMilestone.ProjectMilestone)
+
+	resp.Diagnostics.Append(readProjectMilestoneProgress(ctx, *r.client, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMilestoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProjectMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteProjectMilestone(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project milestone, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a project milestone", map[string]interface{}{
+		"resource":   "linear_project_milestone",
+		"operation":  "delete",
+		"id":         data.Id.ValueString(),
+		"project_id": data.ProjectId.ValueString(),
+	})
+}
+
+func (r *ProjectMilestoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_id:milestone_name or project_name:milestone_name. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	projectId := parts[0]
+
+	// Look the project up by name when it is not given by identifier. A
+	// project whose name contains a colon has to be given by identifier.
+	if !uuidRegex().MatchString(projectId) {
+		projects, err := findProjectByName(ctx, *r.client, projectId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find project, got error: %s", err))
+			return
+		}
+
+		if len(projects.Projects.Nodes) != 1 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find project, expected exactly one project named %q, got: %d", projectId, len(projects.Projects.Nodes)))
+			return
+		}
+
+		projectId = projects.Projects.Nodes[0].Id
+	}
+
+	response, err := findProjectMilestone(ctx, *r.client, parts[1], projectId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import project milestone, got error: %s", err))
+		return
+	}
+
+	if len(response.Project.ProjectMilestones.Nodes) != 1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import project milestone, expected exactly one milestone named %q, got: %d", parts[1], len(response.Project.ProjectMilestones.Nodes)))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.Project.ProjectMilestones.Nodes[0].Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("count_issues"), false)...)
+}
+
+func readProjectMilestone(data *ProjectMilestoneResourceModel, projectMilestone ProjectMilestone) {
+	data.Id = types.StringValue(projectMilestone.Id)
+	data.Name = types.StringValue(projectMilestone.Name)
+	data.Description = types.StringPointerValue(projectMilestone.Description)
+	data.TargetDate = types.StringPointerValue(projectMilestone.TargetDate)
+	data.ProjectId = types.StringValue(projectMilestone.Project.Id)
+}
+
+// readProjectMilestoneProgress counts the issues of the milestone when
+// count_issues is enabled, going through all of them.
+func readProjectMilestoneProgress(ctx context.Context, client graphql.Client, data *ProjectMilestoneResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.IssueCountTotal = types.Int64Null()
+	data.IssueCountCompleted = types.Int64Null()
+	data.Progress = types.Float64Null()
+
+	if !data.CountIssues.ValueBool() {
+		return diags
+	}
+
+	total, completed, canceled := 0, 0, 0
+	var after *string
+
+	for {
+		response, err := getProjectMilestoneIssues(ctx, client, data.Id.ValueString(), after)
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to count project milestone issues, got error: %s", err))
+			return diags
+		}
+
+		issues := response.ProjectMilestone.Issues
+
+		for _, issue := range issues.Nodes {
+			total++
+
+			switch issue.State.Type {
+			case "completed":
+				completed++
+			case "canceled":
+				canceled++
+			}
+		}
+
+		if !issues.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := issues.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	data.IssueCountTotal = types.Int64Value(int64(total))
+	data.IssueCountCompleted = types.Int64Value(int64(completed))
+	data.Progress = types.Float64Value(0)
+
+	if total > canceled {
+		data.Progress = types.Float64Value(float64(completed) / float64(total-canceled))
+	}
+
+	return diags
+}
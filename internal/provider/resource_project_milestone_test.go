@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccProjectMilestoneResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectMilestoneResourceConfigDefault("Alpha"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_milestone.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "name", "Alpha"),
+					resource.TestCheckNoResourceAttr("linear_project_milestone.test", "description"),
+					resource.TestCheckNoResourceAttr("linear_project_milestone.test", "target_date"),
+					resource.TestCheckResourceAttrPair("linear_project_milestone.test", "project_id", "linear_project.test", "id"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "count_issues", "false"),
+					resource.TestCheckNoResourceAttr("linear_project_milestone.test", "issue_count_total"),
+					resource.TestCheckNoResourceAttr("linear_project_milestone.test", "progress"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_milestone.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectMilestoneImportStateId("Alpha"),
+				ImportStateVerify: true,
+			},
+			// ImportState testing with the project name
+			{
+				ResourceName:      "linear_project_milestone.test",
+				ImportState:       true,
+				ImportStateId:     "Terraform Milestones:Alpha",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectMilestoneResourceConfigNonDefault("Beta"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_milestone.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "name", "Beta"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "target_date", "2024-03-31"),
+					resource.TestCheckResourceAttrPair("linear_project_milestone.test", "project_id", "linear_project.test", "id"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "count_issues", "true"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "issue_count_total", "0"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "issue_count_completed", "0"),
+					resource.TestCheckResourceAttr("linear_project_milestone.test", "progress", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_project_milestone.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccProjectMilestoneImportStateId("Beta"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"count_issues", "issue_count_total", "issue_count_completed", "progress"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectMilestoneImportStateId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		project, ok := s.RootModule().Resources["linear_project.test"]
+
+		if !ok {
+			return "", fmt.Errorf("project not found in state")
+		}
+
+		return fmt.Sprintf("%s:%s", project.Primary.ID, name), nil
+	}
+}
+
+func testAccProjectMilestoneResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "test" {
+  name = "Terraform Milestones"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_project_milestone" "test" {
+  name = "%s"
+  project_id = linear_project.test.id
+}
+`, name)
+}
+
+func testAccProjectMilestoneResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "test" {
+  name = "Terraform Milestones"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_project_milestone" "test" {
+  name = "%s"
+  description = "Managed by Terraform"
+  target_date = "2024-03-31"
+  count_issues = true
+  project_id = linear_project.test.id
+}
+`, name)
+}
@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ProjectRelationResource{}
+var _ resource.ResourceWithImportState = &ProjectRelationResource{}
+
+func NewProjectRelationResource() resource.Resource {
+	return &ProjectRelationResource{}
+}
+
+type ProjectRelationResource struct {
+	client *graphql.Client
+}
+
+type ProjectRelationResourceModel struct {
+	Id                        types.String `tfsdk:"id"`
+	Type                      types.String `tfsdk:"type"`
+	ProjectId                 types.String `tfsdk:"project_id"`
+	ProjectMilestoneId        types.String `tfsdk:"project_milestone_id"`
+	AnchorType                types.String `tfsdk:"anchor_type"`
+	RelatedProjectId          types.String `tfsdk:"related_project_id"`
+	RelatedProjectMilestoneId types.String `tfsdk:"related_project_milestone_id"`
+	RelatedAnchorType         types.String `tfsdk:"related_anchor_type"`
+}
+
+var projectRelationAnchorTypes = []string{"start", "end", "milestone"}
+
+func (r *ProjectRelationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_relation"
+}
+
+func (r *ProjectRelationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear project relation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project relation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the relation, from the project to the related project.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"blocks", "related"}...),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"project_milestone_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the milestone of the project the relation is anchored to.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"anchor_type": schema.StringAttribute{
+				MarkdownDescription: "Anchor of the relation on the project, one of `start`, `end` or `milestone`. **Default** `end`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("end"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectRelationAnchorTypes...),
+				},
+			},
+			"related_project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the related project.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"related_project_milestone_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the milestone of the related project the relation is anchored to.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"related_anchor_type": schema.StringAttribute{
+				MarkdownDescription: "Anchor of the relation on the related project, one of `start`, `end` or `milestone`. **Default** `start`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("start"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectRelationAnchorTypes...),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectRelationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectRelationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProjectRelationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := ProjectRelationCreateInput{
+		Type:                      data.Type.ValueString(),
+		ProjectId:                 data.ProjectId.ValueString(),
+		ProjectMilestoneId:        data.ProjectMilestoneId.ValueStringPointer(),
+		AnchorType:                data.AnchorType.ValueString(),
+		RelatedProjectId:          data.RelatedProjectId.ValueString(),
+		RelatedProjectMilestoneId: data.RelatedProjectMilestoneId.ValueStringPointer(),
+		RelatedAnchorType:         data.RelatedAnchorType.ValueString(),
+	}
+
+	response, err := createProjectRelation(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project relation, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a project relation", map[string]interface{}{
+		"resource":           "linear_project_relation",
+		"operation":          "create",
+		"id":                 response.ProjectRelationCreate.ProjectRelation.Id,
+		"project_id":         data.ProjectId.ValueString(),
+		"related_project_id": data.RelatedProjectId.ValueString(),
+	})
+
+	readProjectRelation(data, response.ProjectRelationCreate.ProjectRelation.ProjectRelation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectRelationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProjectRelationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getProjectRelation(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project relation, got error: %s", err))
+		return
+	}
+
+	readProjectRelation(data, response.ProjectRelation.ProjectRelation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectRelationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProjectRelationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := ProjectRelationUpdateInput{
+		Type:                      data.Type.ValueString(),
+		ProjectId:                 data.ProjectId.ValueString(),
+		ProjectMilestoneId:        data.ProjectMilestoneId.ValueStringPointer(),
+		AnchorType:                data.AnchorType.ValueString(),
+		RelatedProjectId:          data.RelatedProjectId.ValueString(),
+		RelatedProjectMilestoneId: data.RelatedProjectMilestoneId.ValueStringPointer(),
+		RelatedAnchorType:         data.RelatedAnchorType.ValueString(),
+	}
+
+	response, err := updateProjectRelation(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project relation, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a project relation", map[string]interface{}{
+		"resource":           "linear_project_relation",
+		"operation":          "update",
+		"id":                 data.Id.ValueString(),
+		"project_id":         data.ProjectId.ValueString(),
+		"related_project_id": data.RelatedProjectId.ValueString(),
+	})
+
+	readProjectRelation(data, response.ProjectRelationUpdate.ProjectRelation.ProjectRelation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectRelationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProjectRelationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteProjectRelation(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project relation, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a project relation", map[string]interface{}{
+		"resource":  "linear_project_relation",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
+}
+
+func (r *ProjectRelationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func readProjectRelation(data *ProjectRelationResourceModel, projectRelation ProjectRelation) {
+	data.Id = types.StringValue(projectRelation.Id)
+	data.Type = types.StringValue(projectRelation.Type)
+	data.ProjectId = types.StringValue(projectRelation.Project.Id)
+	data.AnchorType = types.StringValue(projectRelation.AnchorType)
+	data.RelatedProjectId = types.StringValue(projectRelation.RelatedProject.Id)
+	data.RelatedAnchorType = types.StringValue(projectRelation.RelatedAnchorType)
+
+	if projectRelation.ProjectMilestone != nil {
+		data.ProjectMilestoneId = types.StringValue(projectRelation.ProjectMilestone.Id)
+	} else {
+		data.ProjectMilestoneId = types.StringNull()
+	}
+
+	if projectRelation.RelatedProjectMilestone != nil {
+		data.RelatedProjectMilestoneId = types.StringValue(projectRelation.RelatedProjectMilestone.Id)
+	} else {
+		data.RelatedProjectMilestoneId = types.StringNull()
+	}
+}
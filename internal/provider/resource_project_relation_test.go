@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectRelationResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectRelationResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_relation.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_relation.test", "type", "blocks"),
+					resource.TestCheckResourceAttrPair("linear_project_relation.test", "project_id", "linear_project.upstream", "id"),
+					resource.TestCheckNoResourceAttr("linear_project_relation.test", "project_milestone_id"),
+					resource.TestCheckResourceAttr("linear_project_relation.test", "anchor_type", "end"),
+					resource.TestCheckResourceAttrPair("linear_project_relation.test", "related_project_id", "linear_project.downstream", "id"),
+					resource.TestCheckNoResourceAttr("linear_project_relation.test", "related_project_milestone_id"),
+					resource.TestCheckResourceAttr("linear_project_relation.test", "related_anchor_type", "start"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_relation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectRelationResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_relation.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_relation.test", "type", "blocks"),
+					resource.TestCheckResourceAttrPair("linear_project_relation.test", "project_milestone_id", "linear_project_milestone.test", "id"),
+					resource.TestCheckResourceAttr("linear_project_relation.test", "anchor_type", "milestone"),
+					resource.TestCheckNoResourceAttr("linear_project_relation.test", "related_project_milestone_id"),
+					resource.TestCheckResourceAttr("linear_project_relation.test", "related_anchor_type", "end"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_relation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectRelationResourceConfigDefault() string {
+	return `
+resource "linear_project" "upstream" {
+  name = "Terraform Upstream"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_project" "downstream" {
+  name = "Terraform Downstream"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_project_relation" "test" {
+  type = "blocks"
+  project_id = linear_project.upstream.id
+  related_project_id = linear_project.downstream.id
+}
+`
+}
+
+func testAccProjectRelationResourceConfigNonDefault() string {
+	return `
+resource "linear_project" "upstream" {
+  name = "Terraform Upstream"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_project_milestone" "test" {
+  name = "API ready"
+  project_id = linear_project.upstream.id
+}
+
+resource "linear_project" "downstream" {
+  name = "Terraform Downstream"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_project_relation" "test" {
+  type = "blocks"
+  project_id = linear_project.upstream.id
+  project_milestone_id = linear_project_milestone.test.id
+  anchor_type = "milestone"
+  related_project_id = linear_project.downstream.id
+  related_anchor_type = "end"
+}
+`
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectTemplateResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectTemplateResourceConfigDefault("Launch"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_template.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_template.test", "name", "Launch"),
+					resource.TestCheckNoResourceAttr("linear_project_template.test", "description"),
+					resource.TestCheckResourceAttr("linear_project_template.test", "template_data", `{"name":"Launch: "}`),
+					resource.TestCheckNoResourceAttr("linear_project_template.test", "team_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectTemplateResourceConfigNonDefault("Migration"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_template.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_template.test", "name", "Migration"),
+					resource.TestCheckResourceAttr("linear_project_template.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("linear_project_template.test", "template_data", `{"name":"Migration: ","priority":3}`),
+					resource.TestCheckNoResourceAttr("linear_project_template.test", "team_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProjectTemplateResourceTeam(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectTemplateResourceConfigTeam("Launch"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project_template.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project_template.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectTemplateResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project_template" "test" {
+  name = "%s"
+  template_data = jsonencode({ name = "Launch: " })
+}
+`, name)
+}
+
+func testAccProjectTemplateResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project_template" "test" {
+  name = "%s"
+  description = "Managed by Terraform"
+  template_data = jsonencode({ name = "Migration: ", priority = 3 })
+}
+`, name)
+}
+
+func testAccProjectTemplateResourceConfigTeam(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project_template" "test" {
+  name = "%s"
+  template_data = jsonencode({ name = "Launch: " })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`, name)
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigDefault("Terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project.test", "name", "Terraform"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "description"),
+					resource.TestCheckResourceAttr("linear_project.test", "team_ids.#", "1"),
+					resource.TestCheckResourceAttr("linear_project.test", "team_ids.0", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestMatchResourceAttr("linear_project.test", "status_id", uuidRegex()),
+					resource.TestCheckNoResourceAttr("linear_project.test", "lead_id"),
+					resource.TestCheckResourceAttr("linear_project.test", "priority", "0"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "start_date"),
+					resource.TestCheckNoResourceAttr("linear_project.test", "target_date"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfigNonDefault("Terraform Provider"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project.test", "name", "Terraform Provider"),
+					resource.TestCheckResourceAttr("linear_project.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("linear_project.test", "color", "#00ffff"),
+					resource.TestCheckResourceAttr("linear_project.test", "priority", "2"),
+					resource.TestCheckResourceAttr("linear_project.test", "start_date", "2024-01-01"),
+					resource.TestCheckResourceAttr("linear_project.test", "target_date", "2024-03-31"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProjectResourceTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigTemplate("Terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_project.test", "name", "Terraform"),
+					resource.TestCheckResourceAttrPair("linear_project.test", "template_id", "linear_project_template.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "linear_project.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_id"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "test" {
+  name = "%s"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+`, name)
+}
+
+func testAccProjectResourceConfigNonDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "test" {
+  name = "%s"
+  description = "Managed by Terraform"
+  color = "#00ffff"
+  priority = 2
+  start_date = "2024-01-01"
+  target_date = "2024-03-31"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+`, name)
+}
+
+func testAccProjectResourceConfigTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "linear_project_template" "test" {
+  name = "Launch"
+  template_data = jsonencode({ name = "Launch: " })
+}
+
+resource "linear_project" "test" {
+  name = "%s"
+  template_id = linear_project_template.test.id
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+`, name)
+}
@@ -0,0 +1,403 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RoadmapResource{}
+var _ resource.ResourceWithImportState = &RoadmapResource{}
+
+func NewRoadmapResource() resource.Resource {
+	return &RoadmapResource{}
+}
+
+type RoadmapResource struct {
+	client *graphql.Client
+}
+
+type RoadmapResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+	OwnerId     types.String `tfsdk:"owner_id"`
+	ProjectIds  types.List   `tfsdk:"project_ids"`
+}
+
+// roadmapLink is a project placed on a roadmap.
+type roadmapLink struct {
+	id        string
+	projectId string
+	sortOrder float64
+}
+
+func (r *RoadmapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roadmap"
+}
+
+func (r *RoadmapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear roadmap.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the roadmap.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the roadmap.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the roadmap.",
+				Optional:            true,
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Color of the roadmap.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(colorRegex(), "must be a hex color"),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user owning the roadmap. Defaults to the authenticated user.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"project_ids": schema.ListAttribute{
+				MarkdownDescription: "Identifiers of the projects on the roadmap, in the order they appear. The projects of the roadmap are not managed when not set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *RoadmapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RoadmapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *RoadmapResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := RoadmapCreateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Color:       data.Color.ValueStringPointer(),
+	}
+
+	if !data.OwnerId.IsUnknown() {
+		input.OwnerId = data.OwnerId.ValueStringPointer()
+	}
+
+	response, err := createRoadmap(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create roadmap, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a roadmap", map[string]interface{}{
+		"resource":  "linear_roadmap",
+		"operation": "create",
+		"id":        response.RoadmapCreate.Roadmap.Id,
+	})
+
+	readRoadmap(data, response.RoadmapCreate.Roadmap.Roadmap)
+
+	resp.Diagnostics.Append(syncRoadmapProjects(ctx, *r.client, data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoadmapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *RoadmapResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getRoadmap(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read roadmap, got error: %s", err))
+		return
+	}
+
+	readRoadmap(data, response.Roadmap.Roadmap)
+
+	links, err := listRoadmapLinks(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read roadmap projects, got error: %s", err))
+		return
+	}
+
+	// Leave the projects unmanaged when they were not configured and there
+	// are none, otherwise report the ones on the roadmap as drift.
+	if !data.ProjectIds.IsNull() || len(links) > 0 {
+		projectIds := []string{}
+
+		for _, link := range links {
+			projectIds = append(projectIds, link.projectId)
+		}
+
+		projectIdsValue, diags := types.ListValueFrom(ctx, types.StringType, projectIds)
+
+		resp.Diagnostics.Append(diags...)
+
+		data.ProjectIds = projectIdsValue
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoadmapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *RoadmapResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := RoadmapUpdateInput{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Color:       data.Color.ValueStringPointer(),
+	}
+
+	if !data.OwnerId.IsUnknown() {
+		input.OwnerId = data.OwnerId.ValueStringPointer()
+	}
+
+	response, err := updateRoadmap(ctx, *r.client, input, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update roadmap, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a roadmap", map[string]interface{}{
+		"resource":  "linear_roadmap",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+	})
+
+	readRoadmap(data, response.RoadmapUpdate.Roadmap.Roadmap)
+
+	resp.Diagnostics.Append(syncRoadmapProjects(ctx, *r.client, data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoadmapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *RoadmapResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteRoadmap(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete roadmap, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a roadmap", map[string]interface{}{
+		"resource":  "linear_roadmap",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
+}
+
+func (r *RoadmapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func readRoadmap(data *RoadmapResourceModel, roadmap Roadmap) {
+	data.Id = types.StringValue(roadmap.Id)
+	data.Name = types.StringValue(roadmap.Name)
+	data.Description = types.StringPointerValue(roadmap.Description)
+	data.Color = types.StringPointerValue(roadmap.Color)
+	data.OwnerId = types.StringValue(roadmap.Owner.Id)
+}
+
+// listRoadmapLinks returns the projects placed on a roadmap, ordered as they
+// appear on it.
+func listRoadmapLinks(ctx context.Context, client graphql.Client, roadmapId string) ([]roadmapLink, error) {
+	var links []roadmapLink
+	var after *string
+
+	for {
+		response, err := listRoadmapToProjects(ctx, client, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range response.RoadmapToProjects.Nodes {
+			if node.Roadmap.Id != roadmapId {
+				continue
+			}
+
+			sortOrder, err := strconv.ParseFloat(node.SortOrder, 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid sort order %q of %s: %w", node.SortOrder, node.Id, err)
+			}
+
+			links = append(links, roadmapLink{id: node.Id, projectId: node.Project.Id, sortOrder: sortOrder})
+		}
+
+		if !response.RoadmapToProjects.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := response.RoadmapToProjects.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	sort.SliceStable(links, func(i, j int) bool {
+		return links[i].sortOrder < links[j].sortOrder
+	})
+
+	return links, nil
+}
+
+// syncRoadmapProjects places the configured projects on the roadmap in the
+// configured order and removes the ones which are not configured.
+func syncRoadmapProjects(ctx context.Context, client graphql.Client, data *RoadmapResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var projectIds []string
+
+	if data.ProjectIds.IsNull() {
+		return diags
+	}
+
+	diags.Append(data.ProjectIds.ElementsAs(ctx, &projectIds, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	links, err := listRoadmapLinks(ctx, client, data.Id.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read roadmap projects, got error: %s", err))
+		return diags
+	}
+
+	existing := map[string]roadmapLink{}
+
+	for _, link := range links {
+		existing[link.projectId] = link
+	}
+
+	for i, projectId := range projectIds {
+		sortOrder := float64(i)
+		link, ok := existing[projectId]
+
+		delete(existing, projectId)
+
+		if !ok {
+			input := RoadmapToProjectCreateInput{
+				ProjectId: projectId,
+				RoadmapId: data.Id.ValueString(),
+				SortOrder: sortOrder,
+			}
+
+			if _, err := createRoadmapToProject(ctx, client, input); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to add project %s to roadmap, got error: %s", projectId, err))
+				return diags
+			}
+
+			continue
+		}
+
+		if link.sortOrder != sortOrder {
+			input := RoadmapToProjectUpdateInput{
+				SortOrder: sortOrder,
+			}
+
+			if _, err := updateRoadmapToProject(ctx, client, input, link.id); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to reorder project %s on roadmap, got error: %s", projectId, err))
+				return diags
+			}
+		}
+	}
+
+	for projectId, link := range existing {
+		if _, err := deleteRoadmapToProject(ctx, client, link.id); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove project %s from roadmap, got error: %s", projectId, err))
+			return diags
+		}
+	}
+
+	tflog.Trace(ctx, "synced roadmap projects", map[string]interface{}{
+		"resource":  "linear_roadmap",
+		"operation": "sync",
+		"id":        data.Id.ValueString(),
+		"projects":  len(projectIds),
+	})
+
+	return diags
+}
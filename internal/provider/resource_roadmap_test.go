@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoadmapResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoadmapResourceConfigDefault("Q1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_roadmap.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_roadmap.test", "name", "Q1"),
+					resource.TestCheckNoResourceAttr("linear_roadmap.test", "description"),
+					resource.TestCheckNoResourceAttr("linear_roadmap.test", "color"),
+					resource.TestMatchResourceAttr("linear_roadmap.test", "owner_id", uuidRegex()),
+					resource.TestCheckNoResourceAttr("linear_roadmap.test", "project_ids"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_roadmap.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRoadmapResourceConfigNonDefault("Q2", "first", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_roadmap.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_roadmap.test", "name", "Q2"),
+					resource.TestCheckResourceAttr("linear_roadmap.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("linear_roadmap.test", "color", "#00ffff"),
+					resource.TestCheckResourceAttr("linear_roadmap.test", "project_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("linear_roadmap.test", "project_ids.0", "linear_project.first", "id"),
+					resource.TestCheckResourceAttrPair("linear_roadmap.test", "project_ids.1", "linear_project.second", "id"),
+				),
+			},
+			// Reorder testing
+			{
+				Config: testAccRoadmapResourceConfigNonDefault("Q2", "second", "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_roadmap.test", "project_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("linear_roadmap.test", "project_ids.0", "linear_project.second", "id"),
+					resource.TestCheckResourceAttrPair("linear_roadmap.test", "project_ids.1", "linear_project.first", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_roadmap.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRoadmapResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "linear_roadmap" "test" {
+  name = "%s"
+}
+`, name)
+}
+
+func testAccRoadmapResourceConfigNonDefault(name string, first string, second string) string {
+	return fmt.Sprintf(`
+resource "linear_project" "first" {
+  name = "Terraform Roadmap First"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_project" "second" {
+  name = "Terraform Roadmap Second"
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+}
+
+resource "linear_roadmap" "test" {
+  name = "%s"
+  description = "Managed by Terraform"
+  color = "#00ffff"
+  project_ids = [linear_project.%s.id, linear_project.%s.id]
+}
+`, name, first, second)
+}
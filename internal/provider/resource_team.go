@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"sort"
@@ -10,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
@@ -38,11 +41,13 @@ type TeamResource struct {
 }
 
 type TeamResourceTriageModel struct {
-	Enabled types.Bool `tfsdk:"enabled"`
+	Enabled         types.Bool `tfsdk:"enabled"`
+	RequirePriority types.Bool `tfsdk:"require_priority"`
 }
 
 var triageAttrTypes = map[string]attr.Type{
-	"enabled": types.BoolType,
+	"enabled":          types.BoolType,
+	"require_priority": types.BoolType,
 }
 
 type TeamResourceCyclesModel struct {
@@ -102,6 +107,7 @@ type TeamResourceModel struct {
 	Key                        types.String  `tfsdk:"key"`
 	Name                       types.String  `tfsdk:"name"`
 	Private                    types.Bool    `tfsdk:"private"`
+	JoinByDefault              types.Bool    `tfsdk:"join_by_default"`
 	Description                types.String  `tfsdk:"description"`
 	Icon                       types.String  `tfsdk:"icon"`
 	Color                      types.String  `tfsdk:"color"`
@@ -119,6 +125,7 @@ type TeamResourceModel struct {
 	StartedWorkflowState       types.Object  `tfsdk:"started_workflow_state"`
 	CompletedWorkflowState     types.Object  `tfsdk:"completed_workflow_state"`
 	CanceledWorkflowState      types.Object  `tfsdk:"canceled_workflow_state"`
+	AdoptExisting              types.Bool    `tfsdk:"adopt_existing"`
 }
 
 func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -151,12 +158,26 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringvalidator.UTF8LengthAtLeast(2),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing team with the same key instead of failing to create a new one. When the adopted team can not be set up completely, it is kept and adopted again when Terraform replaces it. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"private": schema.BoolAttribute{
 				MarkdownDescription: "Privacy of the team. **Default** `false`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"join_by_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether new users of the workspace join the team automatically. *Can only be changed by workspace admins.*",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the team.",
 				Optional:            true,
@@ -192,193 +213,14 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringvalidator.UTF8LengthAtLeast(1),
 				},
 			},
-			"no_priority_issues_first": schema.BoolAttribute{
-				MarkdownDescription: "Prefer issues without priority at the top during issue prioritization order. **Default** `true`.",
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(true),
-			},
-			"enable_issue_history_grouping": schema.BoolAttribute{
-				MarkdownDescription: "Enable issue history grouping for the team. **Default** `true`.",
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(true),
-			},
-			"enable_issue_default_to_bottom": schema.BoolAttribute{
-				MarkdownDescription: "Enable moving issues to bottom of the column when changing state. **Default** `false`.",
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
-			},
-			"auto_archive_period": schema.Float64Attribute{
-				MarkdownDescription: "Period after which closed and completed issues are automatically archived, in months. **Default** `6`.",
-				Optional:            true,
-				Computed:            true,
-				Default:             float64default.StaticFloat64(6),
-				Validators: []validator.Float64{
-					float64validator.OneOf([]float64{1, 3, 6, 9, 12}...),
-				},
-			},
-			"auto_close_period": schema.Float64Attribute{
-				MarkdownDescription: "Period after which non-completed or non-canceled issues are automatically closed, in months. **Default** `6`. *Use `0` for turning this off.*",
-				Optional:            true,
-				Computed:            true,
-				Default:             float64default.StaticFloat64(6),
-				Validators: []validator.Float64{
-					float64validator.OneOf([]float64{0, 1, 3, 6, 9, 12}...),
-				},
-			},
-			"triage": schema.SingleNestedAttribute{
-				MarkdownDescription: "Triage settings of the team.",
-				Optional:            true,
-				Computed:            true,
-				Default: objectdefault.StaticValue(
-					types.ObjectValueMust(
-						triageAttrTypes,
-						map[string]attr.Value{
-							"enabled": types.BoolValue(false),
-						},
-					),
-				),
-				Attributes: map[string]schema.Attribute{
-					"enabled": schema.BoolAttribute{
-						MarkdownDescription: "Enable triage mode for the team. **Default** `false`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             booldefault.StaticBool(false),
-					},
-				},
-			},
-			"cycles": schema.SingleNestedAttribute{
-				MarkdownDescription: "Cycle settings of the team.",
-				Optional:            true,
-				Computed:            true,
-				Default: objectdefault.StaticValue(
-					types.ObjectValueMust(
-						cyclesAttrTypes,
-						map[string]attr.Value{
-							"enabled":            types.BoolValue(false),
-							"start_day":          types.Float64Value(0),
-							"duration":           types.Float64Value(1),
-							"cooldown":           types.Float64Value(0),
-							"upcoming":           types.Float64Value(2),
-							"auto_add_started":   types.BoolValue(true),
-							"auto_add_completed": types.BoolValue(true),
-							"need_for_active":    types.BoolValue(false),
-						},
-					),
-				),
-				Attributes: map[string]schema.Attribute{
-					"enabled": schema.BoolAttribute{
-						MarkdownDescription: "Enable cycles for the team. **Default** `false`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             booldefault.StaticBool(false),
-					},
-					"start_day": schema.Float64Attribute{
-						MarkdownDescription: "Start day of the cycle. Sunday is 0, Saturday is 6. **Default** `0`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             float64default.StaticFloat64(0),
-						Validators: []validator.Float64{
-							float64validator.OneOf([]float64{0, 1, 2, 3, 4, 5, 6}...),
-						},
-					},
-					"duration": schema.Float64Attribute{
-						MarkdownDescription: "Duration of the cycle in weeks. **Default** `1`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             float64default.StaticFloat64(1),
-						Validators: []validator.Float64{
-							float64validator.OneOf([]float64{1, 2, 3, 4, 5, 6, 7, 8}...),
-						},
-					},
-					"cooldown": schema.Float64Attribute{
-						MarkdownDescription: "Cooldown time between cycles in weeks. **Default** `0`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             float64default.StaticFloat64(0),
-						Validators: []validator.Float64{
-							float64validator.OneOf([]float64{0, 1, 2, 3}...),
-						},
-					},
-					"upcoming": schema.Float64Attribute{
-						MarkdownDescription: "Number of upcoming cycles to automatically create. **Default** `2`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             float64default.StaticFloat64(2),
-						Validators: []validator.Float64{
-							float64validator.OneOf([]float64{1, 2, 3, 4, 6, 8, 10}...),
-						},
-					},
-					"auto_add_started": schema.BoolAttribute{
-						MarkdownDescription: "Auto add started issues that don't belong to any cycle to the active cycle. **Default** `true`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             booldefault.StaticBool(true),
-					},
-					"auto_add_completed": schema.BoolAttribute{
-						MarkdownDescription: "Auto add completed issues that don't belong to any cycle to the active cycle. **Default** `true`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             booldefault.StaticBool(true),
-					},
-					"need_for_active": schema.BoolAttribute{
-						MarkdownDescription: "Whether all active issues need to have a cycle. **Default** `false`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             booldefault.StaticBool(false),
-					},
-				},
-			},
-			"estimation": schema.SingleNestedAttribute{
-				MarkdownDescription: "Issue estimation settings of the team.",
-				Optional:            true,
-				Computed:            true,
-				Default: objectdefault.StaticValue(
-					types.ObjectValueMust(
-						estimationAttrTypes,
-						map[string]attr.Value{
-							"type":       types.StringValue("notUsed"),
-							"extended":   types.BoolValue(false),
-							"allow_zero": types.BoolValue(false),
-							"default":    types.Float64Value(1),
-						},
-					),
-				),
-				Attributes: map[string]schema.Attribute{
-					"type": schema.StringAttribute{
-						MarkdownDescription: "Issue estimation type for the team. **Default** `notUsed`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             stringdefault.StaticString("notUsed"),
-						Validators: []validator.String{
-							stringvalidator.OneOf([]string{"notUsed", "exponential", "fibonacci", "linear", "tShirt"}...),
-						},
-					},
-					"extended": schema.BoolAttribute{
-						MarkdownDescription: "Whether the team uses extended estimation. **Default** `false`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             booldefault.StaticBool(false),
-					},
-					"allow_zero": schema.BoolAttribute{
-						MarkdownDescription: "Whether zero is allowed as an estimation. **Default** `false`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             booldefault.StaticBool(false),
-					},
-					"default": schema.Float64Attribute{
-						MarkdownDescription: "Default estimation for issues that are unestimated. **Default** `1`.",
-						Optional:            true,
-						Computed:            true,
-						Default:             float64default.StaticFloat64(1),
-						Validators: []validator.Float64{
-							float64validator.OneOf([]float64{0, 1}...),
-						},
-					},
-				},
-			},
+			"no_priority_issues_first":       teamNoPriorityIssuesFirstAttribute(),
+			"enable_issue_history_grouping":  teamEnableIssueHistoryGroupingAttribute(),
+			"enable_issue_default_to_bottom": teamEnableIssueDefaultToBottomAttribute(),
+			"auto_archive_period":            teamAutoArchivePeriodAttribute(),
+			"auto_close_period":              teamAutoClosePeriodAttribute(),
+			"triage":                         teamTriageAttribute(),
+			"cycles":                         teamCyclesAttribute(),
+			"estimation":                     teamEstimationAttribute(),
 			"backlog_workflow_state": schema.SingleNestedAttribute{
 				MarkdownDescription: "Settings for the `backlog` workflow state that is created by default for the team. *Position is always `0`. This can not be deleted.*",
 				Optional:            true,
@@ -717,6 +559,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	input.TriageEnabled = triageData.Enabled.ValueBool()
+	input.RequirePriorityToLeaveTriage = triageData.RequirePriority.ValueBool()
 
 	resp.Diagnostics.Append(data.Cycles.As(ctx, &cyclesData, basetypes.ObjectAsOptions{})...)
 
@@ -744,19 +587,89 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	input.IssueEstimationAllowZero = estimationData.AllowZero.ValueBool()
 	input.DefaultIssueEstimate = estimationData.Default.ValueFloat64()
 
-	response, err := createTeam(ctx, *r.client, input)
+	var existingId string
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team, got error: %s", err))
-		return
+	if data.AdoptExisting.ValueBool() {
+		existing, err := findTeam(ctx, *r.client, input.Key)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find existing team, got error: %s", err))
+			return
+		}
+
+		if len(existing.Teams.Nodes) == 1 {
+			existingId = existing.Teams.Nodes[0].Id
+		}
 	}
 
-	tflog.Trace(ctx, "created a team")
+	adopt := existingId != ""
+
+	var team Team
+
+	if adopt {
+		response, err := updateTeam(ctx, *r.client, teamCreateToUpdateInput(input), existingId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt team, got error: %s", err))
+			return
+		}
+
+		team = response.TeamUpdate.Team.Team
+
+		tflog.Trace(ctx, "adopted a team", map[string]interface{}{
+			"resource":  "linear_team",
+			"operation": "create",
+			"id":        team.Id,
+			"key":       team.Key,
+		})
+	} else {
+		response, err := createTeam(ctx, *r.client, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team, got error: %s", err))
+			return
+		}
+
+		team = response.TeamCreate.Team.Team
+
+		tflog.Trace(ctx, "created a team", map[string]interface{}{
+			"resource":  "linear_team",
+			"operation": "create",
+			"id":        team.Id,
+			"key":       team.Key,
+		})
+	}
+
+	// From here on the team exists in Linear. When one of the following steps
+	// fails, the team is saved with what was set up so far and the incomplete
+	// setup is recorded in the private state. Terraform replaces the tainted
+	// team on the next apply, or finishes the setup in place once untainted.
+	defer func() {
+		if resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(setTeamSetup(ctx, resp.Private, &teamSetup{Adopted: adopt})...)
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.Plan, &resp.State, teamOptionalAttributes)...)
+	}()
 
-	team := response.TeamCreate.Team
+	// Joining by default can not be set when creating a team
+	if !data.JoinByDefault.IsUnknown() {
+		updateInput := teamCreateToUpdateInput(input)
+		updateInput.JoinByDefault = data.JoinByDefault.ValueBoolPointer()
+
+		response, err := updateTeam(ctx, *r.client, updateInput, team.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team, got error: %s", err))
+		} else {
+			team = response.TeamUpdate.Team.Team
+		}
+	}
 
 	data.Id = types.StringValue(team.Id)
 	data.Private = types.BoolValue(team.Private)
+	data.JoinByDefault = types.BoolValue(team.JoinByDefault != nil && *team.JoinByDefault)
 	data.Description = types.StringPointerValue(team.Description)
 	data.Icon = types.StringPointerValue(team.Icon)
 	data.Color = types.StringPointerValue(team.Color)
@@ -775,7 +688,8 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	data.Triage = types.ObjectValueMust(
 		triageAttrTypes,
 		map[string]attr.Value{
-			"enabled": types.BoolValue(team.TriageEnabled),
+			"enabled":          types.BoolValue(team.TriageEnabled),
+			"require_priority": types.BoolValue(team.RequirePriorityToLeaveTriage),
 		},
 	)
 
@@ -809,10 +723,16 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	if workflowStatesErr != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get team workflow states, got error: %s", workflowStatesErr))
+		clearTeamWorkflowStates(data)
 		return
 	}
 
-	tflog.Trace(ctx, "read team workflow states")
+	tflog.Trace(ctx, "read team workflow states", map[string]interface{}{
+		"resource":  "linear_team",
+		"operation": "create",
+		"id":        team.Id,
+		"key":       team.Key,
+	})
 
 	backlogWorkflowState := findWorkflowStateType(workflowStatesResponse.WorkflowStates.Nodes, "backlog")
 	unstartedWorkflowState := findWorkflowStateType(workflowStatesResponse.WorkflowStates.Nodes, "unstarted")
@@ -822,28 +742,18 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	if backlogWorkflowState == nil || unstartedWorkflowState == nil || startedWorkflowState == nil || completedWorkflowState == nil || canceledWorkflowState == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to find all workflow states in a new team")
+		clearTeamWorkflowStates(data)
 		return
 	}
 
-	// Update the workflow states
-
-	backlog := updateTeamWorkflowStateInCreate(ctx, r, data.BacklogWorkflowState, resp, backlogWorkflowState.Id)
-	unstarted := updateTeamWorkflowStateInCreate(ctx, r, data.UnstartedWorkflowState, resp, unstartedWorkflowState.Id)
-	started := updateTeamWorkflowStateInCreate(ctx, r, data.StartedWorkflowState, resp, startedWorkflowState.Id)
-	completed := updateTeamWorkflowStateInCreate(ctx, r, data.CompletedWorkflowState, resp, completedWorkflowState.Id)
-	canceled := updateTeamWorkflowStateInCreate(ctx, r, data.CanceledWorkflowState, resp, canceledWorkflowState.Id)
-
-	if backlog == nil || unstarted == nil || started == nil || completed == nil || canceled == nil {
-		return
-	}
-
-	data.BacklogWorkflowState = *backlog
-	data.UnstartedWorkflowState = *unstarted
-	data.StartedWorkflowState = *started
-	data.CompletedWorkflowState = *completed
-	data.CanceledWorkflowState = *canceled
+	// Update the workflow states. A failed update keeps the values currently
+	// in Linear, so that the state matches what actually exists.
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	data.BacklogWorkflowState = updateTeamWorkflowStateInCreate(ctx, r, data.BacklogWorkflowState, resp, *backlogWorkflowState)
+	data.UnstartedWorkflowState = updateTeamWorkflowStateInCreate(ctx, r, data.UnstartedWorkflowState, resp, *unstartedWorkflowState)
+	data.StartedWorkflowState = updateTeamWorkflowStateInCreate(ctx, r, data.StartedWorkflowState, resp, *startedWorkflowState)
+	data.CompletedWorkflowState = updateTeamWorkflowStateInCreate(ctx, r, data.CompletedWorkflowState, resp, *completedWorkflowState)
+	data.CanceledWorkflowState = updateTeamWorkflowStateInCreate(ctx, r, data.CanceledWorkflowState, resp, *canceledWorkflowState)
 }
 
 func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -867,6 +777,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.Id = types.StringValue(team.Id)
 	data.Name = types.StringValue(team.Name)
 	data.Private = types.BoolValue(team.Private)
+	data.JoinByDefault = types.BoolValue(team.JoinByDefault != nil && *team.JoinByDefault)
 	data.Description = types.StringPointerValue(team.Description)
 	data.Icon = types.StringPointerValue(team.Icon)
 	data.Color = types.StringPointerValue(team.Color)
@@ -885,7 +796,8 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.Triage = types.ObjectValueMust(
 		triageAttrTypes,
 		map[string]attr.Value{
-			"enabled": types.BoolValue(team.TriageEnabled),
+			"enabled":          types.BoolValue(team.TriageEnabled),
+			"require_priority": types.BoolValue(team.RequirePriorityToLeaveTriage),
 		},
 	)
 
@@ -920,7 +832,12 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	tflog.Trace(ctx, "read team workflow states")
+	tflog.Trace(ctx, "read team workflow states", map[string]interface{}{
+		"resource":  "linear_team",
+		"operation": "read",
+		"id":        team.Id,
+		"key":       team.Key,
+	})
 
 	backlogWorkflowState := findWorkflowStateType(workflowStatesResponse.WorkflowStates.Nodes, "backlog")
 	unstartedWorkflowState := findWorkflowStateType(workflowStatesResponse.WorkflowStates.Nodes, "unstarted")
@@ -940,6 +857,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.CanceledWorkflowState = readWorkflowStateToObject(*canceledWorkflowState)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.State, &resp.State, teamOptionalAttributes)...)
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -988,6 +906,11 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		input.Name = data.Name.ValueString()
 	}
 
+	// Only sent when changed, as only workspace admins are allowed to change it
+	if !data.JoinByDefault.IsUnknown() && !data.JoinByDefault.Equal(state.JoinByDefault) {
+		input.JoinByDefault = data.JoinByDefault.ValueBoolPointer()
+	}
+
 	if !data.Icon.IsUnknown() {
 		value := data.Icon.ValueString()
 		input.Icon = &value
@@ -1010,6 +933,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	input.TriageEnabled = triageData.Enabled.ValueBool()
+	input.RequirePriorityToLeaveTriage = triageData.RequirePriority.ValueBool()
 
 	resp.Diagnostics.Append(data.Cycles.As(ctx, &cyclesData, basetypes.ObjectAsOptions{})...)
 
@@ -1044,12 +968,18 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	tflog.Trace(ctx, "updated a team")
+	tflog.Trace(ctx, "updated a team", map[string]interface{}{
+		"resource":  "linear_team",
+		"operation": "update",
+		"id":        response.TeamUpdate.Team.Id,
+		"key":       response.TeamUpdate.Team.Key,
+	})
 
 	team := response.TeamUpdate.Team
 
 	data.Id = types.StringValue(team.Id)
 	data.Private = types.BoolValue(team.Private)
+	data.JoinByDefault = types.BoolValue(team.JoinByDefault != nil && *team.JoinByDefault)
 	data.Description = types.StringPointerValue(team.Description)
 	data.Icon = types.StringPointerValue(team.Icon)
 	data.Color = types.StringPointerValue(team.Color)
@@ -1068,7 +998,8 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	data.Triage = types.ObjectValueMust(
 		triageAttrTypes,
 		map[string]attr.Value{
-			"enabled": types.BoolValue(team.TriageEnabled),
+			"enabled":          types.BoolValue(team.TriageEnabled),
+			"require_priority": types.BoolValue(team.RequirePriorityToLeaveTriage),
 		},
 	)
 
@@ -1115,6 +1046,19 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	data.CanceledWorkflowState = *canceled
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.Plan, &resp.State, teamOptionalAttributes)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The setup of the team is complete once it was updated successfully
+	setup, diags := readTeamSetup(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if setup != nil {
+		resp.Diagnostics.Append(setTeamSetup(ctx, resp.Private, nil)...)
+	}
 }
 
 func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1126,6 +1070,26 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	setup, diags := readTeamSetup(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A team which existed before it was adopted is kept when its setup was
+	// not completed, so that it is adopted again when the team is replaced.
+	if setup != nil && setup.Adopted {
+		tflog.Trace(ctx, "released an incompletely adopted team", map[string]interface{}{
+			"resource":  "linear_team",
+			"operation": "delete",
+			"id":        data.Id.ValueString(),
+			"key":       data.Key.ValueString(),
+		})
+
+		return
+	}
+
 	_, err := deleteTeam(ctx, *r.client, data.Key.ValueString())
 
 	if err != nil {
@@ -1133,11 +1097,318 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	tflog.Trace(ctx, "deleted a team")
+	tflog.Trace(ctx, "deleted a team", map[string]interface{}{
+		"resource":  "linear_team",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+		"key":       data.Key.ValueString(),
+	})
 }
 
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
+}
+
+func teamNoPriorityIssuesFirstAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Prefer issues without priority at the top during issue prioritization order. **Default** `true`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+	}
+}
+
+func teamEnableIssueHistoryGroupingAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Enable issue history grouping for the team. **Default** `true`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+	}
+}
+
+func teamEnableIssueDefaultToBottomAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Enable moving issues to bottom of the column when changing state. **Default** `false`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+func teamAutoArchivePeriodAttribute() schema.Float64Attribute {
+	return schema.Float64Attribute{
+		MarkdownDescription: "Period after which closed and completed issues are automatically archived, in months. **Default** `6`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             float64default.StaticFloat64(6),
+		Validators: []validator.Float64{
+			float64validator.OneOf([]float64{1, 3, 6, 9, 12}...),
+		},
+	}
+}
+
+func teamAutoClosePeriodAttribute() schema.Float64Attribute {
+	return schema.Float64Attribute{
+		MarkdownDescription: "Period after which non-completed or non-canceled issues are automatically closed, in months. **Default** `6`. *Use `0` for turning this off.*",
+		Optional:            true,
+		Computed:            true,
+		Default:             float64default.StaticFloat64(6),
+		Validators: []validator.Float64{
+			float64validator.OneOf([]float64{0, 1, 3, 6, 9, 12}...),
+		},
+	}
+}
+
+func teamTriageAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Triage settings of the team. Sending the issues created by non-members to triage is not part of the Linear API and can not be managed.",
+		Optional:            true,
+		Computed:            true,
+		Default: objectdefault.StaticValue(
+			types.ObjectValueMust(
+				triageAttrTypes,
+				map[string]attr.Value{
+					"enabled":          types.BoolValue(false),
+					"require_priority": types.BoolValue(false),
+				},
+			),
+		),
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable triage mode for the team. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"require_priority": schema.BoolAttribute{
+				MarkdownDescription: "Whether an issue needs a priority before it can leave triage. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func teamCyclesAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Cycle settings of the team.",
+		Optional:            true,
+		Computed:            true,
+		Default: objectdefault.StaticValue(
+			types.ObjectValueMust(
+				cyclesAttrTypes,
+				map[string]attr.Value{
+					"enabled":            types.BoolValue(false),
+					"start_day":          types.Float64Value(0),
+					"duration":           types.Float64Value(1),
+					"cooldown":           types.Float64Value(0),
+					"upcoming":           types.Float64Value(2),
+					"auto_add_started":   types.BoolValue(true),
+					"auto_add_completed": types.BoolValue(true),
+					"need_for_active":    types.BoolValue(false),
+				},
+			),
+		),
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable cycles for the team. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"start_day": schema.Float64Attribute{
+				MarkdownDescription: "Start day of the cycle. Sunday is 0, Saturday is 6. **Default** `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(0),
+				Validators: []validator.Float64{
+					float64validator.OneOf([]float64{0, 1, 2, 3, 4, 5, 6}...),
+				},
+			},
+			"duration": schema.Float64Attribute{
+				MarkdownDescription: "Duration of the cycle in weeks. **Default** `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(1),
+				Validators: []validator.Float64{
+					float64validator.OneOf([]float64{1, 2, 3, 4, 5, 6, 7, 8}...),
+				},
+			},
+			"cooldown": schema.Float64Attribute{
+				MarkdownDescription: "Cooldown time between cycles in weeks. **Default** `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(0),
+				Validators: []validator.Float64{
+					float64validator.OneOf([]float64{0, 1, 2, 3}...),
+				},
+			},
+			"upcoming": schema.Float64Attribute{
+				MarkdownDescription: "Number of upcoming cycles to automatically create. **Default** `2`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(2),
+				Validators: []validator.Float64{
+					float64validator.OneOf([]float64{1, 2, 3, 4, 6, 8, 10}...),
+				},
+			},
+			"auto_add_started": schema.BoolAttribute{
+				MarkdownDescription: "Auto add started issues that don't belong to any cycle to the active cycle. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"auto_add_completed": schema.BoolAttribute{
+				MarkdownDescription: "Auto add completed issues that don't belong to any cycle to the active cycle. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"need_for_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether all active issues need to have a cycle. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func teamEstimationAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Issue estimation settings of the team.",
+		Optional:            true,
+		Computed:            true,
+		Default: objectdefault.StaticValue(
+			types.ObjectValueMust(
+				estimationAttrTypes,
+				map[string]attr.Value{
+					"type":       types.StringValue("notUsed"),
+					"extended":   types.BoolValue(false),
+					"allow_zero": types.BoolValue(false),
+					"default":    types.Float64Value(1),
+				},
+			),
+		),
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Issue estimation type for the team. **Default** `notUsed`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("notUsed"),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"notUsed", "exponential", "fibonacci", "linear", "tShirt"}...),
+				},
+			},
+			"extended": schema.BoolAttribute{
+				MarkdownDescription: "Whether the team uses extended estimation. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allow_zero": schema.BoolAttribute{
+				MarkdownDescription: "Whether zero is allowed as an estimation. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default": schema.Float64Attribute{
+				MarkdownDescription: "Default estimation for issues that are unestimated. **Default** `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(1),
+				Validators: []validator.Float64{
+					float64validator.OneOf([]float64{0, 1}...),
+				},
+			},
+		},
+	}
+}
+
+// teamOptionalAttributes are the attributes of a team backed by the optional
+// fields of the API.
+var teamOptionalAttributes = append([]optionalAttribute{
+	{path.MatchRoot("timezone"), "Team", "timezone"},
+	{path.MatchRoot("backlog_workflow_state").AtName("description"), "WorkflowState", "description"},
+	{path.MatchRoot("unstarted_workflow_state").AtName("description"), "WorkflowState", "description"},
+	{path.MatchRoot("started_workflow_state").AtName("description"), "WorkflowState", "description"},
+	{path.MatchRoot("completed_workflow_state").AtName("description"), "WorkflowState", "description"},
+	{path.MatchRoot("canceled_workflow_state").AtName("description"), "WorkflowState", "description"},
+}, teamSettingsOptionalAttributes...)
+
+// teamSetupKey is the private state key recording that the setup of a team
+// was not completed when creating it.
+const teamSetupKey = "setup"
+
+// teamSetup is the incomplete setup of a team.
+type teamSetup struct {
+	// Adopted is whether the team existed before it was adopted.
+	Adopted bool `json:"adopted"`
+}
+
+// readTeamSetup returns the setup recorded by setTeamSetup, if any.
+func readTeamSetup(ctx context.Context, private privateState) (*teamSetup, diag.Diagnostics) {
+	var setup *teamSetup
+
+	value, diags := private.GetKey(ctx, teamSetupKey)
+
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	if err := json.Unmarshal(value, &setup); err != nil {
+		diags.AddError("Invalid Private State", "Unable to read the setup of the team, got error: "+err.Error())
+	}
+
+	return setup, diags
+}
+
+// setTeamSetup records the incomplete setup of a team, or forgets it when nil.
+func setTeamSetup(ctx context.Context, private privateState, setup *teamSetup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, err := json.Marshal(setup)
+
+	if err != nil {
+		diags.AddError("Invalid Private State", "Unable to record the setup of the team, got error: "+err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, teamSetupKey, value)
+}
+
+func teamCreateToUpdateInput(input TeamCreateInput) TeamUpdateInput {
+	return TeamUpdateInput{
+		Name:                           input.Name,
+		Private:                        input.Private,
+		Description:                    input.Description,
+		Icon:                           input.Icon,
+		Color:                          input.Color,
+		Timezone:                       input.Timezone,
+		IssueOrderingNoPriorityFirst:   input.IssueOrderingNoPriorityFirst,
+		GroupIssueHistory:              input.GroupIssueHistory,
+		SetIssueSortOrderOnStateChange: input.SetIssueSortOrderOnStateChange,
+		AutoArchivePeriod:              input.AutoArchivePeriod,
+		AutoClosePeriod:                input.AutoClosePeriod,
+		TriageEnabled:                  input.TriageEnabled,
+		RequirePriorityToLeaveTriage:   input.RequirePriorityToLeaveTriage,
+		CyclesEnabled:                  input.CyclesEnabled,
+		CycleStartDay:                  input.CycleStartDay,
+		CycleDuration:                  input.CycleDuration,
+		CycleCooldownTime:              input.CycleCooldownTime,
+		UpcomingCycleCount:             input.UpcomingCycleCount,
+		CycleIssueAutoAssignStarted:    input.CycleIssueAutoAssignStarted,
+		CycleIssueAutoAssignCompleted:  input.CycleIssueAutoAssignCompleted,
+		CycleLockToActive:              input.CycleLockToActive,
+		IssueEstimationType:            input.IssueEstimationType,
+		IssueEstimationExtended:        input.IssueEstimationExtended,
+		IssueEstimationAllowZero:       input.IssueEstimationAllowZero,
+		DefaultIssueEstimate:           input.DefaultIssueEstimate,
+	}
 }
 
 func findWorkflowStateType(workflowStates []getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, ty string) *getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
@@ -1187,13 +1458,15 @@ func updateWorkflowStateToObject(workflowState updateWorkflowStateWorkflowStateU
 	)
 }
 
-func updateTeamWorkflowStateInCreate(ctx context.Context, r *TeamResource, data types.Object, resp *resource.CreateResponse, id string) *types.Object {
+func updateTeamWorkflowStateInCreate(ctx context.Context, r *TeamResource, data types.Object, resp *resource.CreateResponse, workflowState getTeamWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) types.Object {
 	var workflowStateData *TeamResourceWorkflowStateModel
 
-	resp.Diagnostics.Append(data.As(ctx, &workflowStateData, basetypes.ObjectAsOptions{})...)
+	diags := data.As(ctx, &workflowStateData, basetypes.ObjectAsOptions{})
 
-	if resp.Diagnostics.HasError() {
-		return nil
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return readWorkflowStateToObject(workflowState)
 	}
 
 	workflowStateInput := WorkflowStateUpdateInput{
@@ -1202,16 +1475,22 @@ func updateTeamWorkflowStateInCreate(ctx context.Context, r *TeamResource, data
 		Color:       workflowStateData.Color.ValueString(),
 	}
 
-	workflowStateResponse, workflowStateErr := updateWorkflowState(ctx, *r.client, workflowStateInput, id)
+	workflowStateResponse, workflowStateErr := updateWorkflowState(ctx, *r.client, workflowStateInput, workflowState.Id)
 
 	if workflowStateErr != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workflow state, got error: %s", workflowStateErr))
-		return nil
+		return readWorkflowStateToObject(workflowState)
 	}
 
-	ret := updateWorkflowStateToObject(workflowStateResponse.WorkflowStateUpdate.WorkflowState)
+	return updateWorkflowStateToObject(workflowStateResponse.WorkflowStateUpdate.WorkflowState)
+}
 
-	return &ret
+func clearTeamWorkflowStates(data *TeamResourceModel) {
+	data.BacklogWorkflowState = types.ObjectNull(workflowStateAttrTypes)
+	data.UnstartedWorkflowState = types.ObjectNull(workflowStateAttrTypes)
+	data.StartedWorkflowState = types.ObjectNull(workflowStateAttrTypes)
+	data.CompletedWorkflowState = types.ObjectNull(workflowStateAttrTypes)
+	data.CanceledWorkflowState = types.ObjectNull(workflowStateAttrTypes)
 }
 
 func updateTeamWorkflowStateInUpdate(ctx context.Context, r *TeamResource, data types.Object, resp *resource.UpdateResponse, id string) *types.Object {
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,6 +20,7 @@ import (
 
 var _ resource.Resource = &TeamLabelResource{}
 var _ resource.ResourceWithImportState = &TeamLabelResource{}
+var _ resource.ResourceWithModifyPlan = &TeamLabelResource{}
 
 func NewTeamLabelResource() resource.Resource {
 	return &TeamLabelResource{}
@@ -29,12 +31,14 @@ type TeamLabelResource struct {
 }
 
 type TeamLabelResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Color       types.String `tfsdk:"color"`
-	ParentId    types.String `tfsdk:"parent_id"`
-	TeamId      types.String `tfsdk:"team_id"`
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Color         types.String `tfsdk:"color"`
+	ParentId      types.String `tfsdk:"parent_id"`
+	TeamId        types.String `tfsdk:"team_id"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	AutoCorrect   types.Bool   `tfsdk:"auto_correct"`
 }
 
 func (r *TeamLabelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -82,15 +86,29 @@ func (r *TeamLabelResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 			"team_id": schema.StringAttribute{
-				MarkdownDescription: "Identifier of the team.",
-				Required:            true,
+				MarkdownDescription: "Identifier of the team. Defaults to the `default_team` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing label in the team with the same name instead of failing to create a new one. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"auto_correct": schema.BoolAttribute{
+				MarkdownDescription: "Whether to restore the color of the label on the next apply when it was changed outside of Terraform. Refreshing only detects the change. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -115,6 +133,54 @@ func (r *TeamLabelResource) Configure(ctx context.Context, req resource.Configur
 	r.client = client
 }
 
+func (r *TeamLabelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client != nil {
+		resp.Diagnostics.Append(planDefaultTeam(ctx, *r.client, req, resp)...)
+		resp.Diagnostics.Append(planLabelDrift(ctx, *r.client, req, resp)...)
+	}
+
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() || r.client == nil || !collisionChecksEnabled(*r.client) || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state *TeamLabelResourceModel
+
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.TeamId.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	// Only check when the name or color changes
+	if state != nil && plan.Name.Equal(state.Name) && plan.Color.Equal(state.Color) {
+		return
+	}
+
+	response, err := listTeamLabels(ctx, *r.client, plan.TeamId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check team label collisions, got error: %s", err))
+		return
+	}
+
+	candidates := []collisionCandidate{}
+
+	for _, node := range response.IssueLabels.Nodes {
+		candidates = append(candidates, collisionCandidate{id: node.Id, name: node.Name, color: node.Color})
+	}
+
+	resp.Diagnostics.Append(collisionWarnings(*r.client, "Label", plan.Id.ValueString(), plan.Name.ValueString(), plan.Color.ValueString(), candidates)...)
+}
+
 func (r *TeamLabelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *TeamLabelResourceModel
 
@@ -136,16 +202,56 @@ func (r *TeamLabelResource) Create(ctx context.Context, req resource.CreateReque
 		input.Color = &value
 	}
 
-	response, err := createLabel(ctx, *r.client, input)
+	existingId := ""
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team label, got error: %s", err))
-		return
+	if data.AdoptExisting.ValueBool() {
+		existing, err := findTeamLabelByTeamId(ctx, *r.client, input.Name, data.TeamId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find existing team label, got error: %s", err))
+			return
+		}
+
+		if len(existing.IssueLabels.Nodes) == 1 {
+			existingId = existing.IssueLabels.Nodes[0].Id
+		}
 	}
 
-	tflog.Trace(ctx, "created a team label")
+	var issueLabel IssueLabel
+
+	if existingId != "" {
+		response, err := updateLabel(ctx, *r.client, labelCreateToUpdateInput(input), existingId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt team label, got error: %s", err))
+			return
+		}
+
+		issueLabel = response.IssueLabelUpdate.IssueLabel.IssueLabel
+
+		tflog.Trace(ctx, "adopted a team label", map[string]interface{}{
+			"resource":  "linear_team_label",
+			"operation": "create",
+			"id":        issueLabel.Id,
+			"team_id":   data.TeamId.ValueString(),
+		})
+	} else {
+		response, err := createLabel(ctx, *r.client, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team label, got error: %s", err))
+			return
+		}
 
-	issueLabel := response.IssueLabelCreate.IssueLabel
+		issueLabel = response.IssueLabelCreate.IssueLabel.IssueLabel
+
+		tflog.Trace(ctx, "created a team label", map[string]interface{}{
+			"resource":  "linear_team_label",
+			"operation": "create",
+			"id":        issueLabel.Id,
+			"team_id":   data.TeamId.ValueString(),
+		})
+	}
 
 	data.Id = types.StringValue(issueLabel.Id)
 	data.Name = types.StringValue(issueLabel.Name)
@@ -160,7 +266,10 @@ func (r *TeamLabelResource) Create(ctx context.Context, req resource.CreateReque
 		data.TeamId = types.StringValue(issueLabel.Team.Id)
 	}
 
+	registerManaged(*r.client, data.Id.ValueString())
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.Plan, &resp.State, labelOptionalAttributes)...)
 }
 
 func (r *TeamLabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -179,7 +288,30 @@ func (r *TeamLabelResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	issueLabel := response.IssueLabel
+	issueLabel := response.IssueLabel.IssueLabel
+
+	// The drift is only recorded here, refreshing must not change anything
+	if data.AutoCorrect.ValueBool() {
+		drifted, diags := recordDrift(ctx, resp.Private, driftedValues{Color: data.Color.ValueStringPointer()}, driftedValues{Color: issueLabel.Color})
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if drifted && readOnlyEnabled(*r.client) {
+			resp.Diagnostics.AddWarning(
+				"Label Drifted",
+				fmt.Sprintf("Color of label %q was changed outside of Terraform and is not restored, as the provider is read-only.", issueLabel.Name),
+			)
+		} else if drifted {
+			resp.Diagnostics.AddWarning(
+				"Label Drifted",
+				fmt.Sprintf("Color of label %q was changed outside of Terraform and will be restored on the next apply.", issueLabel.Name),
+			)
+		}
+	}
 
 	data.Id = types.StringValue(issueLabel.Id)
 	data.Name = types.StringValue(issueLabel.Name)
@@ -194,7 +326,10 @@ func (r *TeamLabelResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.TeamId = types.StringValue(issueLabel.Team.Id)
 	}
 
+	registerManaged(*r.client, data.Id.ValueString())
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.State, &resp.State, labelOptionalAttributes)...)
 }
 
 func (r *TeamLabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -224,7 +359,12 @@ func (r *TeamLabelResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	tflog.Trace(ctx, "updated a team label")
+	tflog.Trace(ctx, "updated a team label", map[string]interface{}{
+		"resource":  "linear_team_label",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+		"team_id":   data.TeamId.ValueString(),
+	})
 
 	issueLabel := response.IssueLabelUpdate.IssueLabel
 
@@ -241,7 +381,11 @@ func (r *TeamLabelResource) Update(ctx context.Context, req resource.UpdateReque
 		data.TeamId = types.StringValue(issueLabel.Team.Id)
 	}
 
+	registerManaged(*r.client, data.Id.ValueString())
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.Plan, &resp.State, labelOptionalAttributes)...)
+	resp.Diagnostics.Append(clearDrift(ctx, resp.Private)...)
 }
 
 func (r *TeamLabelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -260,7 +404,12 @@ func (r *TeamLabelResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	tflog.Trace(ctx, "deleted a team label")
+	tflog.Trace(ctx, "deleted a team label", map[string]interface{}{
+		"resource":  "linear_team_label",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+		"team_id":   data.TeamId.ValueString(),
+	})
 }
 
 func (r *TeamLabelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -288,4 +437,6 @@ func (r *TeamLabelResource) ImportState(ctx context.Context, req resource.Import
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.IssueLabels.Nodes[0].Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("auto_correct"), false)...)
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -127,6 +128,38 @@ func TestAccTeamLabelResourceNonDefault(t *testing.T) {
 	})
 }
 
+func TestAccTeamLabelResourceAdoptExisting(t *testing.T) {
+	existingId := ""
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					teamId := "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+					response, err := createLabel(context.Background(), testAccClient(), IssueLabelCreateInput{Name: "Adopted", TeamId: &teamId})
+
+					if err != nil {
+						t.Fatalf("unable to create the label to adopt, got error: %s", err)
+					}
+
+					existingId = response.IssueLabelCreate.IssueLabel.Id
+				},
+				Config: testAccTeamLabelResourceConfigAdoptExisting(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("linear_team_label.test", "id", &existingId),
+					resource.TestCheckResourceAttr("linear_team_label.test", "name", "Adopted"),
+					resource.TestCheckResourceAttr("linear_team_label.test", "color", "#00ff00"),
+					resource.TestCheckResourceAttr("linear_team_label.test", "adopt_existing", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccTeamLabelResourceConfigDefault(name string) string {
 	return fmt.Sprintf(`
 resource "linear_team_label" "test" {
@@ -147,3 +180,50 @@ resource "linear_team_label" "test" {
 }
 `, name)
 }
+
+func TestAccTeamLabelResourceDefaultTeam(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamLabelResourceConfigDefaultTeam("Default Team Debt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_team_label.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_team_label.test", "name", "Default Team Debt"),
+					resource.TestCheckResourceAttr("linear_team_label.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+				),
+			},
+			// Nothing to change once the team is known
+			{
+				Config:   testAccTeamLabelResourceConfigDefaultTeam("Default Team Debt"),
+				PlanOnly: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamLabelResourceConfigDefaultTeam(name string) string {
+	return fmt.Sprintf(`
+provider "linear" {
+  default_team = "DEF"
+}
+
+resource "linear_team_label" "test" {
+  name = "%s"
+}
+`, name)
+}
+
+func testAccTeamLabelResourceConfigAdoptExisting() string {
+	return `
+resource "linear_team_label" "test" {
+  name = "Adopted"
+  color = "#00ff00"
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  adopt_existing = true
+}
+`
+}
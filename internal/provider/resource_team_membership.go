@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TeamMembershipResource{}
+var _ resource.ResourceWithImportState = &TeamMembershipResource{}
+
+func NewTeamMembershipResource() resource.Resource {
+	return &TeamMembershipResource{}
+}
+
+type TeamMembershipResource struct {
+	client *graphql.Client
+}
+
+type TeamMembershipResourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	TeamId types.String `tfsdk:"team_id"`
+	UserId types.String `tfsdk:"user_id"`
+	Owner  types.Bool   `tfsdk:"owner"`
+}
+
+func (r *TeamMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_membership"
+}
+
+func (r *TeamMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear team membership.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the membership.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"owner": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is an owner of the team, which allows them to manage its settings and members. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *TeamMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TeamMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createTeamMembership(ctx, *r.client, data.TeamId.ValueString(), data.UserId.ValueString(), data.Owner.ValueBool())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team membership, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a team membership", map[string]interface{}{
+		"resource":  "linear_team_membership",
+		"operation": "create",
+		"id":        response.TeamMembershipCreate.TeamMembership.Id,
+		"team_id":   data.TeamId.ValueString(),
+		"user_id":   data.UserId.ValueString(),
+	})
+
+	readTeamMembership(data, response.TeamMembershipCreate.TeamMembership.TeamMembership)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TeamMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getTeamMembership(ctx, *r.client, data.TeamId.ValueString(), data.UserId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team membership, got error: %s", err))
+		return
+	}
+
+	// The user was removed from the team outside of terraform.
+	if response.Team.Membership == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	readTeamMembership(data, response.Team.Membership.TeamMembership)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TeamMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateTeamMembership(ctx, *r.client, data.Id.ValueString(), data.Owner.ValueBool())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team membership, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a team membership", map[string]interface{}{
+		"resource":  "linear_team_membership",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+		"team_id":   data.TeamId.ValueString(),
+		"user_id":   data.UserId.ValueString(),
+	})
+
+	readTeamMembership(data, response.TeamMembershipUpdate.TeamMembership.TeamMembership)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TeamMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteTeamMembership(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team membership, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a team membership", map[string]interface{}{
+		"resource":  "linear_team_membership",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+		"team_id":   data.TeamId.ValueString(),
+		"user_id":   data.UserId.ValueString(),
+	})
+}
+
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var teamId, userId string
+
+	if uuidRegex().MatchString(req.ID) {
+		response, err := getTeamMembershipById(ctx, *r.client, req.ID)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import team membership, got error: %s", err))
+			return
+		}
+
+		teamId = response.TeamMembership.Team.Id
+		userId = response.TeamMembership.User.Id
+	} else {
+		parts := strings.SplitN(req.ID, ":", 2)
+
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier to be an id or with format: user_email:team_key. Got: %q", req.ID),
+			)
+
+			return
+		}
+
+		response, err := findTeamMembership(ctx, *r.client, parts[1], parts[0])
+
+		if err != nil || len(response.Teams.Nodes) != 1 || len(response.Users.Nodes) != 1 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import team membership, got error: %s", err))
+			return
+		}
+
+		teamId = response.Teams.Nodes[0].Id
+		userId = response.Users.Nodes[0].Id
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+}
+
+func readTeamMembership(data *TeamMembershipResourceModel, membership TeamMembership) {
+	data.Id = types.StringValue(membership.Id)
+	data.TeamId = types.StringValue(membership.Team.Id)
+	data.UserId = types.StringValue(membership.User.Id)
+	data.Owner = types.BoolValue(membership.Owner)
+}
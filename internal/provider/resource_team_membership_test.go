@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamMembershipResource(t *testing.T) {
+	email := os.Getenv("LINEAR_TEST_MEMBER_EMAIL")
+
+	if email == "" {
+		t.Skip("LINEAR_TEST_MEMBER_EMAIL must be set to a user other than the authenticated one for team membership acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamMembershipResourceConfig(email, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_team_membership.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrPair("linear_team_membership.test", "team_id", "linear_team.test", "id"),
+					resource.TestCheckResourceAttrPair("linear_team_membership.test", "user_id", "data.linear_user.test", "id"),
+					resource.TestCheckResourceAttr("linear_team_membership.test", "owner", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_team_membership.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:MEM", email),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTeamMembershipResourceConfig(email, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_team_membership.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrPair("linear_team_membership.test", "team_id", "linear_team.test", "id"),
+					resource.TestCheckResourceAttrPair("linear_team_membership.test", "user_id", "data.linear_user.test", "id"),
+					resource.TestCheckResourceAttr("linear_team_membership.test", "owner", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_team_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamMembershipResourceConfig(email string, owner bool) string {
+	return fmt.Sprintf(`
+resource "linear_team" "test" {
+  key = "MEM"
+  name = "Membership"
+}
+
+data "linear_user" "test" {
+  email = "%s"
+}
+
+resource "linear_team_membership" "test" {
+  team_id = linear_team.test.id
+  user_id = data.linear_user.test.id
+  owner = %t
+}
+`, email, owner)
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TeamNotificationSubscriptionResource{}
+var _ resource.ResourceWithImportState = &TeamNotificationSubscriptionResource{}
+
+func NewTeamNotificationSubscriptionResource() resource.Resource {
+	return &TeamNotificationSubscriptionResource{}
+}
+
+type TeamNotificationSubscriptionResource struct {
+	client *graphql.Client
+}
+
+type TeamNotificationSubscriptionResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	TeamId             types.String `tfsdk:"team_id"`
+	SlackNewIssue      types.Bool   `tfsdk:"slack_new_issue"`
+	SlackIssueComments types.Bool   `tfsdk:"slack_issue_comments"`
+	SlackIssueStatuses types.Bool   `tfsdk:"slack_issue_statuses"`
+}
+
+func (r *TeamNotificationSubscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_notification_subscription"
+}
+
+func (r *TeamNotificationSubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear team notifications pushed to the Slack channel connected to the team. *The Slack channel itself is connected through the Linear Slack integration, destroying this resource resets the notifications to their defaults.*",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"slack_new_issue": schema.BoolAttribute{
+				MarkdownDescription: "Whether to send new issue notifications to Slack. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"slack_issue_comments": schema.BoolAttribute{
+				MarkdownDescription: "Whether to send new issue comment notifications to Slack. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"slack_issue_statuses": schema.BoolAttribute{
+				MarkdownDescription: "Whether to send issue status update notifications to Slack. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *TeamNotificationSubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamNotificationSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TeamNotificationSubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateTeamNotificationSubscription(
+		ctx,
+		*r.client,
+		data.TeamId.ValueString(),
+		data.SlackNewIssue.ValueBool(),
+		data.SlackIssueComments.ValueBool(),
+		data.SlackIssueStatuses.ValueBool(),
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team notification subscription, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a team notification subscription", map[string]interface{}{
+		"resource":  "linear_team_notification_subscription",
+		"operation": "create",
+		"id":        response.TeamUpdate.Team.Id,
+	})
+
+	readTeamNotificationSubscription(data, response.TeamUpdate.Team.TeamNotificationSubscription)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamNotificationSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TeamNotificationSubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getTeamNotificationSubscription(ctx, *r.client, data.TeamId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team notification subscription, got error: %s", err))
+		return
+	}
+
+	readTeamNotificationSubscription(data, response.Team.TeamNotificationSubscription)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamNotificationSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TeamNotificationSubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateTeamNotificationSubscription(
+		ctx,
+		*r.client,
+		data.TeamId.ValueString(),
+		data.SlackNewIssue.ValueBool(),
+		data.SlackIssueComments.ValueBool(),
+		data.SlackIssueStatuses.ValueBool(),
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team notification subscription, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a team notification subscription", map[string]interface{}{
+		"resource":  "linear_team_notification_subscription",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+	})
+
+	readTeamNotificationSubscription(data, response.TeamUpdate.Team.TeamNotificationSubscription)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamNotificationSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TeamNotificationSubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := updateTeamNotificationSubscription(ctx, *r.client, data.TeamId.ValueString(), true, true, true)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team notification subscription, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a team notification subscription", map[string]interface{}{
+		"resource":  "linear_team_notification_subscription",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
+}
+
+func (r *TeamNotificationSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("team_id"), req, resp)
+}
+
+func readTeamNotificationSubscription(data *TeamNotificationSubscriptionResourceModel, team TeamNotificationSubscription) {
+	data.Id = types.StringValue(team.Id)
+	data.TeamId = types.StringValue(team.Id)
+	data.SlackNewIssue = types.BoolValue(team.SlackNewIssue)
+	data.SlackIssueComments = types.BoolValue(team.SlackIssueComments)
+	data.SlackIssueStatuses = types.BoolValue(team.SlackIssueStatuses)
+}
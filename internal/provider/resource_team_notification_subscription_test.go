@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamNotificationSubscriptionResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamNotificationSubscriptionResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_notification_subscription.test", "id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_team_notification_subscription.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_team_notification_subscription.test", "slack_new_issue", "true"),
+					resource.TestCheckResourceAttr("linear_team_notification_subscription.test", "slack_issue_comments", "true"),
+					resource.TestCheckResourceAttr("linear_team_notification_subscription.test", "slack_issue_statuses", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_team_notification_subscription.test",
+				ImportState:       true,
+				ImportStateId:     "ff0a060a-eceb-4b34-9140-fd7231f0cd28",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTeamNotificationSubscriptionResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_notification_subscription.test", "id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_team_notification_subscription.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_team_notification_subscription.test", "slack_new_issue", "true"),
+					resource.TestCheckResourceAttr("linear_team_notification_subscription.test", "slack_issue_comments", "false"),
+					resource.TestCheckResourceAttr("linear_team_notification_subscription.test", "slack_issue_statuses", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_team_notification_subscription.test",
+				ImportState:       true,
+				ImportStateId:     "ff0a060a-eceb-4b34-9140-fd7231f0cd28",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamNotificationSubscriptionResourceConfigDefault() string {
+	return `
+resource "linear_team_notification_subscription" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`
+}
+
+func testAccTeamNotificationSubscriptionResourceConfigNonDefault() string {
+	return `
+resource "linear_team_notification_subscription" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  slack_issue_comments = false
+  slack_issue_statuses = false
+}
+`
+}
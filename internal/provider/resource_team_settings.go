@@ -0,0 +1,533 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TeamSettingsResource{}
+var _ resource.ResourceWithImportState = &TeamSettingsResource{}
+
+func NewTeamSettingsResource() resource.Resource {
+	return &TeamSettingsResource{}
+}
+
+type TeamSettingsResource struct {
+	client *graphql.Client
+}
+
+type TeamSettingsResourceModel struct {
+	Id                              types.String  `tfsdk:"id"`
+	TeamId                          types.String  `tfsdk:"team_id"`
+	NoPriorityIssuesFirst           types.Bool    `tfsdk:"no_priority_issues_first"`
+	EnableIssueHistoryGrouping      types.Bool    `tfsdk:"enable_issue_history_grouping"`
+	EnableIssueDefaultToBottom      types.Bool    `tfsdk:"enable_issue_default_to_bottom"`
+	AutoArchivePeriod               types.Float64 `tfsdk:"auto_archive_period"`
+	AutoClosePeriod                 types.Float64 `tfsdk:"auto_close_period"`
+	Triage                          types.Object  `tfsdk:"triage"`
+	Cycles                          types.Object  `tfsdk:"cycles"`
+	Estimation                      types.Object  `tfsdk:"estimation"`
+	DefaultIssueTemplateId          types.String  `tfsdk:"default_issue_template_id"`
+	DefaultNonMemberIssueTemplateId types.String  `tfsdk:"default_non_member_issue_template_id"`
+	DefaultProjectTemplateId        types.String  `tfsdk:"default_project_template_id"`
+}
+
+func (r *TeamSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_settings"
+}
+
+func (r *TeamSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Linear team settings. Settings which are not set are left as they are. *The team itself is never created or deleted by this resource, destroying it leaves the settings as they are.*",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"no_priority_issues_first":       teamSettingsAttribute(teamNoPriorityIssuesFirstAttribute()),
+			"enable_issue_history_grouping":  teamSettingsAttribute(teamEnableIssueHistoryGroupingAttribute()),
+			"enable_issue_default_to_bottom": teamSettingsAttribute(teamEnableIssueDefaultToBottomAttribute()),
+			"auto_archive_period":            teamSettingsAttribute(teamAutoArchivePeriodAttribute()),
+			"auto_close_period":              teamSettingsAttribute(teamAutoClosePeriodAttribute()),
+			"triage":                         teamSettingsAttribute(teamTriageAttribute()),
+			"cycles":                         teamSettingsAttribute(teamCyclesAttribute()),
+			"estimation":                     teamSettingsAttribute(teamEstimationAttribute()),
+			"default_issue_template_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the issue template used by default for members of the team.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"default_non_member_issue_template_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the issue template used by default for non-members of the team.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"default_project_template_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project template used by default for the team.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TeamSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, data, "create")...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.Plan, &resp.State, teamSettingsOptionalAttributes)...)
+}
+
+func (r *TeamSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TeamSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getTeam(ctx, *r.client, data.TeamId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team settings, got error: %s", err))
+		return
+	}
+
+	readTeamSettings(data, response.Team.Team)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.State, &resp.State, teamSettingsOptionalAttributes)...)
+}
+
+func (r *TeamSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TeamSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, data, "update")...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.Plan, &resp.State, teamSettingsOptionalAttributes)...)
+}
+
+func (r *TeamSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TeamSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The settings are left as they are, the team is not managed here.
+
+	tflog.Trace(ctx, "deleted team settings", map[string]interface{}{
+		"resource":  "linear_team_settings",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
+}
+
+func (r *TeamSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("team_id"), req, resp)
+}
+
+// update applies the settings on top of the current values of the team, so
+// that the fields which are not managed here are left as they are.
+func (r *TeamSettingsResource) update(ctx context.Context, data *TeamSettingsResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var triageData *TeamResourceTriageModel
+	var cyclesData *TeamResourceCyclesModel
+	var estimationData *TeamResourceEstimationModel
+
+	// Settings which are not set are unknown, and are left as they are.
+	options := basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}
+
+	diags.Append(data.Triage.As(ctx, &triageData, options)...)
+	diags.Append(data.Cycles.As(ctx, &cyclesData, options)...)
+	diags.Append(data.Estimation.As(ctx, &estimationData, options)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := getTeam(ctx, *r.client, data.TeamId.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
+		return diags
+	}
+
+	input := teamToUpdateInput(current.Team.Team)
+
+	setBoolSetting(&input.IssueOrderingNoPriorityFirst, data.NoPriorityIssuesFirst)
+	setBoolSetting(&input.GroupIssueHistory, data.EnableIssueHistoryGrouping)
+
+	if isKnown(data.EnableIssueDefaultToBottom) {
+		if data.EnableIssueDefaultToBottom.ValueBool() {
+			input.SetIssueSortOrderOnStateChange = "last"
+		} else {
+			input.SetIssueSortOrderOnStateChange = "first"
+		}
+	}
+
+	setFloat64Setting(&input.AutoArchivePeriod, data.AutoArchivePeriod)
+
+	if isKnown(data.AutoClosePeriod) {
+		input.AutoClosePeriod = nil
+
+		if data.AutoClosePeriod.ValueFloat64() != 0 {
+			value := data.AutoClosePeriod.ValueFloat64()
+			input.AutoClosePeriod = &value
+		}
+	}
+
+	if triageData != nil {
+		setBoolSetting(&input.TriageEnabled, triageData.Enabled)
+		setBoolSetting(&input.RequirePriorityToLeaveTriage, triageData.RequirePriority)
+	}
+
+	if cyclesData != nil {
+		setBoolSetting(&input.CyclesEnabled, cyclesData.Enabled)
+		setFloat64Setting(&input.CycleStartDay, cyclesData.StartDay)
+		setFloat64Setting(&input.UpcomingCycleCount, cyclesData.Upcoming)
+		setBoolSetting(&input.CycleIssueAutoAssignStarted, cyclesData.AutoAddStarted)
+		setBoolSetting(&input.CycleIssueAutoAssignCompleted, cyclesData.AutoAddCompleted)
+		setBoolSetting(&input.CycleLockToActive, cyclesData.NeedForActive)
+
+		if isKnown(cyclesData.Duration) {
+			input.CycleDuration = int(cyclesData.Duration.ValueFloat64())
+		}
+
+		if isKnown(cyclesData.Cooldown) {
+			input.CycleCooldownTime = int(cyclesData.Cooldown.ValueFloat64())
+		}
+	}
+
+	if estimationData != nil {
+		if isKnown(estimationData.Type) {
+			input.IssueEstimationType = estimationData.Type.ValueString()
+		}
+
+		setBoolSetting(&input.IssueEstimationExtended, estimationData.Extended)
+		setBoolSetting(&input.IssueEstimationAllowZero, estimationData.AllowZero)
+		setFloat64Setting(&input.DefaultIssueEstimate, estimationData.Default)
+	}
+
+	_, err = updateTeam(ctx, *r.client, input, data.TeamId.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s team settings, got error: %s", operation, err))
+		return diags
+	}
+
+	var membersTemplateId, nonMembersTemplateId, projectTemplateId string
+
+	if current.Team.DefaultTemplateForMembers != nil {
+		membersTemplateId = current.Team.DefaultTemplateForMembers.Id
+	}
+
+	if current.Team.DefaultTemplateForNonMembers != nil {
+		nonMembersTemplateId = current.Team.DefaultTemplateForNonMembers.Id
+	}
+
+	if current.Team.DefaultProjectTemplate != nil {
+		projectTemplateId = current.Team.DefaultProjectTemplate.Id
+	}
+
+	// The default templates are not part of the update above, which can only
+	// leave them out and not clear them.
+	response, err := setTeamDefaultTemplates(
+		ctx,
+		*r.client,
+		data.TeamId.ValueString(),
+		templateIdSetting(data.DefaultIssueTemplateId, membersTemplateId),
+		templateIdSetting(data.DefaultNonMemberIssueTemplateId, nonMembersTemplateId),
+		templateIdSetting(data.DefaultProjectTemplateId, projectTemplateId),
+	)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s team settings, got error: %s", operation, err))
+		return diags
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("%sd team settings", operation), map[string]interface{}{
+		"resource":  "linear_team_settings",
+		"operation": operation,
+		"id":        response.TeamUpdate.Team.Id,
+		"key":       response.TeamUpdate.Team.Key,
+	})
+
+	readTeamSettings(data, response.TeamUpdate.Team.Team)
+
+	return diags
+}
+
+func readTeamSettings(data *TeamSettingsResourceModel, team Team) {
+	data.Id = types.StringValue(team.Id)
+	data.TeamId = types.StringValue(team.Id)
+	data.NoPriorityIssuesFirst = types.BoolValue(team.IssueOrderingNoPriorityFirst)
+	data.EnableIssueHistoryGrouping = types.BoolValue(team.GroupIssueHistory)
+	data.EnableIssueDefaultToBottom = types.BoolValue(team.SetIssueSortOrderOnStateChange == "last")
+	data.AutoArchivePeriod = types.Float64Value(team.AutoArchivePeriod)
+
+	if team.AutoClosePeriod != nil {
+		data.AutoClosePeriod = types.Float64Value(*team.AutoClosePeriod)
+	} else {
+		data.AutoClosePeriod = types.Float64Value(0)
+	}
+
+	data.Triage = types.ObjectValueMust(
+		triageAttrTypes,
+		map[string]attr.Value{
+			"enabled":          types.BoolValue(team.TriageEnabled),
+			"require_priority": types.BoolValue(team.RequirePriorityToLeaveTriage),
+		},
+	)
+
+	data.Cycles = types.ObjectValueMust(
+		cyclesAttrTypes,
+		map[string]attr.Value{
+			"enabled":            types.BoolValue(team.CyclesEnabled),
+			"start_day":          types.Float64Value(team.CycleStartDay),
+			"duration":           types.Float64Value(team.CycleDuration),
+			"cooldown":           types.Float64Value(team.CycleCooldownTime),
+			"upcoming":           types.Float64Value(team.UpcomingCycleCount),
+			"auto_add_started":   types.BoolValue(team.CycleIssueAutoAssignStarted),
+			"auto_add_completed": types.BoolValue(team.CycleIssueAutoAssignCompleted),
+			"need_for_active":    types.BoolValue(team.CycleLockToActive),
+		},
+	)
+
+	data.Estimation = types.ObjectValueMust(
+		estimationAttrTypes,
+		map[string]attr.Value{
+			"type":       types.StringValue(team.IssueEstimationType),
+			"extended":   types.BoolValue(team.IssueEstimationExtended),
+			"allow_zero": types.BoolValue(team.IssueEstimationAllowZero),
+			"default":    types.Float64Value(team.DefaultIssueEstimate),
+		},
+	)
+
+	data.DefaultIssueTemplateId = types.StringNull()
+	data.DefaultNonMemberIssueTemplateId = types.StringNull()
+	data.DefaultProjectTemplateId = types.StringNull()
+
+	if team.DefaultTemplateForMembers != nil {
+		data.DefaultIssueTemplateId = types.StringValue(team.DefaultTemplateForMembers.Id)
+	}
+
+	if team.DefaultTemplateForNonMembers != nil {
+		data.DefaultNonMemberIssueTemplateId = types.StringValue(team.DefaultTemplateForNonMembers.Id)
+	}
+
+	if team.DefaultProjectTemplate != nil {
+		data.DefaultProjectTemplateId = types.StringValue(team.DefaultProjectTemplate.Id)
+	}
+}
+
+// teamSettingsOptionalAttributes are the settings of a team backed by the
+// optional fields of the API.
+var teamSettingsOptionalAttributes = []optionalAttribute{
+	{path.MatchRoot("no_priority_issues_first"), "Team", "issueOrderingNoPriorityFirst"},
+	{path.MatchRoot("enable_issue_history_grouping"), "Team", "groupIssueHistory"},
+	{path.MatchRoot("enable_issue_default_to_bottom"), "Team", "setIssueSortOrderOnStateChange"},
+	{path.MatchRoot("cycles").AtName("need_for_active"), "Team", "cycleLockToActive"},
+	{path.MatchRoot("estimation").AtName("extended"), "Team", "issueEstimationExtended"},
+}
+
+// teamToUpdateInput builds an update which keeps every field of the team as
+// it currently is.
+func teamToUpdateInput(team Team) TeamUpdateInput {
+	return TeamUpdateInput{
+		Private:                        team.Private,
+		Description:                    team.Description,
+		Icon:                           team.Icon,
+		Color:                          team.Color,
+		Timezone:                       team.Timezone,
+		IssueOrderingNoPriorityFirst:   team.IssueOrderingNoPriorityFirst,
+		GroupIssueHistory:              team.GroupIssueHistory,
+		SetIssueSortOrderOnStateChange: team.SetIssueSortOrderOnStateChange,
+		AutoArchivePeriod:              team.AutoArchivePeriod,
+		AutoClosePeriod:                team.AutoClosePeriod,
+		TriageEnabled:                  team.TriageEnabled,
+		RequirePriorityToLeaveTriage:   team.RequirePriorityToLeaveTriage,
+		CyclesEnabled:                  team.CyclesEnabled,
+		CycleStartDay:                  team.CycleStartDay,
+		CycleDuration:                  int(team.CycleDuration),
+		CycleCooldownTime:              int(team.CycleCooldownTime),
+		UpcomingCycleCount:             team.UpcomingCycleCount,
+		CycleIssueAutoAssignStarted:    team.CycleIssueAutoAssignStarted,
+		CycleIssueAutoAssignCompleted:  team.CycleIssueAutoAssignCompleted,
+		CycleLockToActive:              team.CycleLockToActive,
+		IssueEstimationType:            team.IssueEstimationType,
+		IssueEstimationExtended:        team.IssueEstimationExtended,
+		IssueEstimationAllowZero:       team.IssueEstimationAllowZero,
+		DefaultIssueEstimate:           team.DefaultIssueEstimate,
+	}
+}
+
+// teamSettingsAttribute turns an attribute of the team resource into one
+// which is left as it is when not set, instead of falling back to a default.
+func teamSettingsAttribute(attribute schema.Attribute) schema.Attribute {
+	switch attribute := attribute.(type) {
+	case schema.BoolAttribute:
+		attribute.MarkdownDescription = withoutDefaultDescription(attribute.MarkdownDescription)
+		attribute.Default = nil
+		attribute.PlanModifiers = []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
+
+		return attribute
+	case schema.Float64Attribute:
+		attribute.MarkdownDescription = withoutDefaultDescription(attribute.MarkdownDescription)
+		attribute.Default = nil
+		attribute.PlanModifiers = []planmodifier.Float64{float64planmodifier.UseStateForUnknown()}
+
+		return attribute
+	case schema.StringAttribute:
+		attribute.MarkdownDescription = withoutDefaultDescription(attribute.MarkdownDescription)
+		attribute.Default = nil
+		attribute.PlanModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+
+		return attribute
+	case schema.SingleNestedAttribute:
+		attributes := map[string]schema.Attribute{}
+
+		for name, nested := range attribute.Attributes {
+			attributes[name] = teamSettingsAttribute(nested)
+		}
+
+		attribute.Attributes = attributes
+		attribute.Default = nil
+		attribute.PlanModifiers = []planmodifier.Object{objectplanmodifier.UseStateForUnknown()}
+
+		return attribute
+	}
+
+	return attribute
+}
+
+var defaultDescriptionRegex = regexp.MustCompile(` \*\*Default\*\* ` + "`[^`]*`" + `\.`)
+
+func withoutDefaultDescription(description string) string {
+	return defaultDescriptionRegex.ReplaceAllString(description, "")
+}
+
+func isKnown(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+func setBoolSetting(target *bool, value types.Bool) {
+	if isKnown(value) {
+		*target = value.ValueBool()
+	}
+}
+
+func setFloat64Setting(target *float64, value types.Float64) {
+	if isKnown(value) {
+		*target = value.ValueFloat64()
+	}
+}
+
+// templateIdSetting is the default template to set, which is the current one
+// when the attribute is not set.
+func templateIdSetting(value types.String, current string) *string {
+	if !value.IsUnknown() {
+		return value.ValueStringPointer()
+	}
+
+	if current == "" {
+		return nil
+	}
+
+	return &current
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamSettingsResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_settings.test", "id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttrSet("linear_team_settings.test", "no_priority_issues_first"),
+					resource.TestCheckResourceAttrSet("linear_team_settings.test", "enable_issue_history_grouping"),
+					resource.TestCheckResourceAttrSet("linear_team_settings.test", "enable_issue_default_to_bottom"),
+					resource.TestCheckResourceAttrSet("linear_team_settings.test", "auto_archive_period"),
+					resource.TestCheckResourceAttrSet("linear_team_settings.test", "auto_close_period"),
+					resource.TestCheckResourceAttrSet("linear_team_settings.test", "triage.enabled"),
+					resource.TestCheckResourceAttrSet("linear_team_settings.test", "triage.require_priority"),
+					resource.TestCheckResourceAttrSet("linear_team_settings.test", "cycles.enabled"),
+					resource.TestCheckResourceAttrSet("linear_team_settings.test", "estimation.type"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "linear_team_settings.test",
+				ImportState:       true,
+				ImportStateId:     "ff0a060a-eceb-4b34-9140-fd7231f0cd28",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTeamSettingsResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_settings.test", "id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "no_priority_issues_first", "false"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "enable_issue_history_grouping", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "enable_issue_default_to_bottom", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "no_priority_issues_first", "false"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "enable_issue_default_to_bottom", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "auto_archive_period", "3"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "auto_close_period", "0"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "triage.enabled", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "triage.require_priority", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "cycles.enabled", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "cycles.duration", "2"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "estimation.type", "fibonacci"),
+					resource.TestCheckResourceAttrPair("linear_team_settings.test", "default_issue_template_id", "linear_issue_template.test", "id"),
+					resource.TestCheckNoResourceAttr("linear_team_settings.test", "default_non_member_issue_template_id"),
+					resource.TestCheckResourceAttrPair("linear_team_settings.test", "default_project_template_id", "linear_project_template.test", "id"),
+				),
+			},
+			// Settings which are no longer set are left as they are
+			{
+				Config: testAccTeamSettingsResourceConfigUnset(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_settings.test", "no_priority_issues_first", "false"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "enable_issue_default_to_bottom", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "auto_archive_period", "3"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "auto_close_period", "0"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "triage.enabled", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "triage.require_priority", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "cycles.enabled", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "cycles.duration", "2"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "estimation.type", "fibonacci"),
+					resource.TestCheckResourceAttrPair("linear_team_settings.test", "default_issue_template_id", "linear_issue_template.test", "id"),
+					resource.TestCheckResourceAttrPair("linear_team_settings.test", "default_project_template_id", "linear_project_template.test", "id"),
+				),
+			},
+			// Restore the settings of the team, which destroying leaves as they are
+			{
+				Config: testAccTeamSettingsResourceConfigRestore(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_settings.test", "no_priority_issues_first", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "enable_issue_history_grouping", "true"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "enable_issue_default_to_bottom", "false"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "auto_archive_period", "6"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "auto_close_period", "6"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "triage.enabled", "false"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "triage.require_priority", "false"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "cycles.enabled", "false"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "cycles.duration", "1"),
+					resource.TestCheckResourceAttr("linear_team_settings.test", "estimation.type", "notUsed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamSettingsResourceConfigDefault() string {
+	return `
+resource "linear_team_settings" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`
+}
+
+func testAccTeamSettingsResourceConfigNonDefault() string {
+	return `
+resource "linear_team_settings" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  no_priority_issues_first = false
+  enable_issue_default_to_bottom = true
+  auto_archive_period = 3
+  auto_close_period = 0
+
+  triage = {
+    enabled = true
+    require_priority = true
+  }
+
+  cycles = {
+    enabled = true
+    duration = 2
+  }
+
+  estimation = {
+    type = "fibonacci"
+  }
+
+  default_issue_template_id = linear_issue_template.test.id
+  default_project_template_id = linear_project_template.test.id
+}
+
+resource "linear_issue_template" "test" {
+  name = "Team Settings"
+  template_data = jsonencode({ title = "Bug: " })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_project_template" "test" {
+  name = "Team Settings"
+  template_data = jsonencode({ name = "Launch: " })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`
+}
+
+func testAccTeamSettingsResourceConfigUnset() string {
+	return `
+resource "linear_team_settings" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_issue_template" "test" {
+  name = "Team Settings"
+  template_data = jsonencode({ title = "Bug: " })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_project_template" "test" {
+  name = "Team Settings"
+  template_data = jsonencode({ name = "Launch: " })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`
+}
+
+func testAccTeamSettingsResourceConfigRestore() string {
+	return `
+resource "linear_team_settings" "test" {
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  no_priority_issues_first = true
+  enable_issue_history_grouping = true
+  enable_issue_default_to_bottom = false
+  auto_archive_period = 6
+  auto_close_period = 6
+
+  triage = {
+    enabled = false
+    require_priority = false
+  }
+
+  cycles = {
+    enabled = false
+    start_day = 0
+    duration = 1
+    cooldown = 0
+    upcoming = 2
+    auto_add_started = true
+    auto_add_completed = true
+    need_for_active = false
+  }
+
+  estimation = {
+    type = "notUsed"
+    extended = false
+    allow_zero = false
+    default = 1
+  }
+}
+
+resource "linear_issue_template" "test" {
+  name = "Team Settings"
+  template_data = jsonencode({ title = "Bug: " })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_project_template" "test" {
+  name = "Team Settings"
+  template_data = jsonencode({ name = "Launch: " })
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`
+}
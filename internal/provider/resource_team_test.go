@@ -2,9 +2,11 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccTeamResourceDefault(t *testing.T) {
@@ -23,6 +25,7 @@ func TestAccTeamResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "icon", "Bank"),
 					resource.TestMatchResourceAttr("linear_team.test", "color", colorRegex()),
 					resource.TestCheckResourceAttr("linear_team.test", "private", "false"),
+					resource.TestCheckResourceAttr("linear_team.test", "join_by_default", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "timezone", "Etc/GMT"),
 					resource.TestCheckResourceAttr("linear_team.test", "no_priority_issues_first", "true"),
 					resource.TestCheckResourceAttr("linear_team.test", "enable_issue_history_grouping", "true"),
@@ -30,6 +33,7 @@ func TestAccTeamResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "auto_archive_period", "6"),
 					resource.TestCheckResourceAttr("linear_team.test", "auto_close_period", "6"),
 					resource.TestCheckResourceAttr("linear_team.test", "triage.enabled", "false"),
+					resource.TestCheckResourceAttr("linear_team.test", "triage.require_priority", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.enabled", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.start_day", "0"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.duration", "1"),
@@ -87,6 +91,7 @@ func TestAccTeamResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "icon", "Bank"),
 					resource.TestMatchResourceAttr("linear_team.test", "color", colorRegex()),
 					resource.TestCheckResourceAttr("linear_team.test", "private", "false"),
+					resource.TestCheckResourceAttr("linear_team.test", "join_by_default", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "timezone", "Etc/GMT"),
 					resource.TestCheckResourceAttr("linear_team.test", "no_priority_issues_first", "true"),
 					resource.TestCheckResourceAttr("linear_team.test", "enable_issue_history_grouping", "true"),
@@ -94,6 +99,7 @@ func TestAccTeamResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "auto_archive_period", "6"),
 					resource.TestCheckResourceAttr("linear_team.test", "auto_close_period", "6"),
 					resource.TestCheckResourceAttr("linear_team.test", "triage.enabled", "false"),
+					resource.TestCheckResourceAttr("linear_team.test", "triage.require_priority", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.enabled", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.start_day", "0"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.duration", "1"),
@@ -144,6 +150,7 @@ func TestAccTeamResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "icon", "Image"),
 					resource.TestCheckResourceAttr("linear_team.test", "color", "#00ff00"),
 					resource.TestCheckResourceAttr("linear_team.test", "private", "true"),
+					resource.TestCheckResourceAttr("linear_team.test", "join_by_default", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "timezone", "Europe/London"),
 					resource.TestCheckResourceAttr("linear_team.test", "no_priority_issues_first", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "enable_issue_history_grouping", "false"),
@@ -151,6 +158,7 @@ func TestAccTeamResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "auto_archive_period", "3"),
 					resource.TestCheckResourceAttr("linear_team.test", "auto_close_period", "0"),
 					resource.TestCheckResourceAttr("linear_team.test", "triage.enabled", "true"),
+					resource.TestCheckResourceAttr("linear_team.test", "triage.require_priority", "true"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.enabled", "true"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.start_day", "6"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.duration", "3"),
@@ -218,6 +226,7 @@ func TestAccTeamResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "icon", "Image"),
 					resource.TestCheckResourceAttr("linear_team.test", "color", "#00ff00"),
 					resource.TestCheckResourceAttr("linear_team.test", "private", "true"),
+					resource.TestCheckResourceAttr("linear_team.test", "join_by_default", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "timezone", "Europe/London"),
 					resource.TestCheckResourceAttr("linear_team.test", "no_priority_issues_first", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "enable_issue_history_grouping", "false"),
@@ -225,6 +234,7 @@ func TestAccTeamResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "auto_archive_period", "3"),
 					resource.TestCheckResourceAttr("linear_team.test", "auto_close_period", "0"),
 					resource.TestCheckResourceAttr("linear_team.test", "triage.enabled", "true"),
+					resource.TestCheckResourceAttr("linear_team.test", "triage.require_priority", "true"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.enabled", "true"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.start_day", "6"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.duration", "3"),
@@ -282,6 +292,7 @@ func TestAccTeamResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "icon", "Image"),
 					resource.TestCheckResourceAttr("linear_team.test", "color", "#00ff00"),
 					resource.TestCheckResourceAttr("linear_team.test", "private", "true"),
+					resource.TestCheckResourceAttr("linear_team.test", "join_by_default", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "timezone", "Europe/London"),
 					resource.TestCheckResourceAttr("linear_team.test", "no_priority_issues_first", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "enable_issue_history_grouping", "false"),
@@ -289,6 +300,7 @@ func TestAccTeamResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "auto_archive_period", "3"),
 					resource.TestCheckResourceAttr("linear_team.test", "auto_close_period", "0"),
 					resource.TestCheckResourceAttr("linear_team.test", "triage.enabled", "true"),
+					resource.TestCheckResourceAttr("linear_team.test", "triage.require_priority", "true"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.enabled", "true"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.start_day", "6"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.duration", "3"),
@@ -339,6 +351,7 @@ func TestAccTeamResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "icon", "Image"),
 					resource.TestCheckResourceAttr("linear_team.test", "color", "#00ff00"),
 					resource.TestCheckResourceAttr("linear_team.test", "private", "false"),
+					resource.TestCheckResourceAttr("linear_team.test", "join_by_default", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "timezone", "Etc/GMT"),
 					resource.TestCheckResourceAttr("linear_team.test", "no_priority_issues_first", "true"),
 					resource.TestCheckResourceAttr("linear_team.test", "enable_issue_history_grouping", "true"),
@@ -346,6 +359,7 @@ func TestAccTeamResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_team.test", "auto_archive_period", "6"),
 					resource.TestCheckResourceAttr("linear_team.test", "auto_archive_period", "6"),
 					resource.TestCheckResourceAttr("linear_team.test", "triage.enabled", "false"),
+					resource.TestCheckResourceAttr("linear_team.test", "triage.require_priority", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.enabled", "false"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.start_day", "0"),
 					resource.TestCheckResourceAttr("linear_team.test", "cycles.duration", "1"),
@@ -397,6 +411,35 @@ func TestAccTeamResourceNonDefault(t *testing.T) {
 	})
 }
 
+func TestAccTeamResourceRollback(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A team which can not be set up completely is kept
+			{
+				Config:      testAccTeamResourceConfigDuplicateWorkflowState("RBK", "Rollback"),
+				ExpectError: regexp.MustCompile("Unable to update workflow state"),
+			},
+			// The next apply replaces the created team
+			{
+				Config: testAccTeamResourceConfigDefault("RBK", "Rollback"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("linear_team.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_team.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_team.test", "key", "RBK"),
+					resource.TestCheckResourceAttr("linear_team.test", "backlog_workflow_state.name", "Backlog"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccTeamResourceConfigDefault(key string, name string) string {
 	return fmt.Sprintf(`
 resource "linear_team" "test" {
@@ -424,6 +467,7 @@ resource "linear_team" "test" {
 
   triage = {
     enabled = true
+    require_priority = true
   }
 
   cycles = {
@@ -476,3 +520,16 @@ resource "linear_team" "test" {
 }
 `, key, name)
 }
+
+func testAccTeamResourceConfigDuplicateWorkflowState(key string, name string) string {
+	return fmt.Sprintf(`
+resource "linear_team" "test" {
+  key = "%s"
+  name = "%s"
+
+  backlog_workflow_state = {
+    name = "Todo"
+  }
+}
+`, key, name)
+}
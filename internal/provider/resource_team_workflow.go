@@ -128,7 +128,12 @@ func (r *TeamWorkflowResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	tflog.Trace(ctx, "created a team workflow")
+	tflog.Trace(ctx, "created a team workflow", map[string]interface{}{
+		"resource":  "linear_team_workflow",
+		"operation": "create",
+		"id":        response.TeamUpdate.Team.Id,
+		"key":       data.Key.ValueString(),
+	})
 
 	read(data, response)
 
@@ -191,7 +196,12 @@ func (r *TeamWorkflowResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	tflog.Trace(ctx, "updated a team workflow")
+	tflog.Trace(ctx, "updated a team workflow", map[string]interface{}{
+		"resource":  "linear_team_workflow",
+		"operation": "update",
+		"id":        response.TeamUpdate.Team.Id,
+		"key":       data.Key.ValueString(),
+	})
 
 	read(data, response)
 
@@ -214,7 +224,12 @@ func (r *TeamWorkflowResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	tflog.Trace(ctx, "deleted a team workflow")
+	tflog.Trace(ctx, "deleted a team workflow", map[string]interface{}{
+		"resource":  "linear_team_workflow",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+		"key":       data.Key.ValueString(),
+	})
 }
 
 func (r *TeamWorkflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -4,14 +4,21 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,6 +27,7 @@ import (
 
 var _ resource.Resource = &WorkflowStateResource{}
 var _ resource.ResourceWithImportState = &WorkflowStateResource{}
+var _ resource.ResourceWithModifyPlan = &WorkflowStateResource{}
 
 func NewWorkflowStateResource() resource.Resource {
 	return &WorkflowStateResource{}
@@ -30,13 +38,27 @@ type WorkflowStateResource struct {
 }
 
 type WorkflowStateResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Type        types.String `tfsdk:"type"`
-	Description types.String `tfsdk:"description"`
-	Color       types.String `tfsdk:"color"`
-	Position    types.Number `tfsdk:"position"`
-	TeamId      types.String `tfsdk:"team_id"`
+	Id                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Type                    types.String `tfsdk:"type"`
+	Description             types.String `tfsdk:"description"`
+	Color                   types.String `tfsdk:"color"`
+	Position                types.Number `tfsdk:"position"`
+	After                   types.String `tfsdk:"after"`
+	Before                  types.String `tfsdk:"before"`
+	TeamId                  types.String `tfsdk:"team_id"`
+	PreventDestroyWhenInUse types.Bool   `tfsdk:"prevent_destroy_when_in_use"`
+	MoveToStateOnDestroy    types.String `tfsdk:"on_destroy_move_to_state_id"`
+	AdoptExisting           types.Bool   `tfsdk:"adopt_existing"`
+	DefaultForNewIssues     types.Bool   `tfsdk:"default_for_new_issues"`
+	DefaultForDuplicates    types.Bool   `tfsdk:"default_for_duplicates"`
+	DefaultForAutoClosed    types.Bool   `tfsdk:"default_for_auto_closed"`
+	OnArchived              types.String `tfsdk:"on_archived"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+	UpdatedAt               types.String `tfsdk:"updated_at"`
+	CountIssues             types.Bool   `tfsdk:"count_issues"`
+	IssueCount              types.Int64  `tfsdk:"issue_count"`
+	Url                     types.String `tfsdk:"url"`
 }
 
 func (r *WorkflowStateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,8 +94,29 @@ func (r *WorkflowStateResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"position": schema.NumberAttribute{
-				MarkdownDescription: "Position of the workflow state.",
-				Required:            true,
+				MarkdownDescription: "Position of the workflow state. Computed when `after` or `before` is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Number{
+					numberplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Number{
+					numbervalidator.ExactlyOneOf(path.MatchRoot("after"), path.MatchRoot("before")),
+				},
+			},
+			"after": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workflow state to place this workflow state right after, instead of setting its `position`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
+			"before": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workflow state to place this workflow state right before, instead of setting its `position`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
 			},
 			"color": schema.StringAttribute{
 				MarkdownDescription: "Color of the workflow state.",
@@ -87,15 +130,88 @@ func (r *WorkflowStateResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:            true,
 			},
 			"team_id": schema.StringAttribute{
-				MarkdownDescription: "Identifier of the team.",
-				Required:            true,
+				MarkdownDescription: "Identifier of the team. Defaults to the `default_team` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing workflow state in the team with the same name, like the ones Linear creates for new teams, instead of failing to create a new one. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default_for_new_issues": schema.BoolAttribute{
+				MarkdownDescription: "Whether new issues of the team are created in this workflow state. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default_for_duplicates": schema.BoolAttribute{
+				MarkdownDescription: "Whether issues of the team marked as duplicates are moved to this workflow state. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default_for_auto_closed": schema.BoolAttribute{
+				MarkdownDescription: "Whether issues of the team closed automatically are moved to this workflow state, which must be `canceled`. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"on_archived": schema.StringAttribute{
+				MarkdownDescription: "What to do when the workflow state was archived outside of Terraform, either `recreate` to plan creating it again or `error` to fail refreshing. **Default** `recreate`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("recreate"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("recreate", "error"),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Time when the workflow state was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Time when the workflow state was last updated.",
+				Computed:            true,
+			},
+			"count_issues": schema.BoolAttribute{
+				MarkdownDescription: "Whether to set `issue_count`. Counting takes a request per 250 issues in the workflow state. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"issue_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of issues in the workflow state, only set when `count_issues` is enabled.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the workflow settings of the team.",
+				Computed:            true,
+			},
+			"prevent_destroy_when_in_use": schema.BoolAttribute{
+				MarkdownDescription: "Whether to fail destroying the workflow state while it still has issues, reporting how many, unless they are moved with `on_destroy_move_to_state_id`. Set it to `false` to destroy the workflow state anyway. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"on_destroy_move_to_state_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workflow state to move the issues of this workflow state to before destroying it, like the Linear UI does when deleting a workflow state.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+				},
+			},
 		},
 	}
 }
@@ -120,6 +236,140 @@ func (r *WorkflowStateResource) Configure(ctx context.Context, req resource.Conf
 	r.client = client
 }
 
+func (r *WorkflowStateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client != nil {
+		resp.Diagnostics.Append(planDefaultTeam(ctx, *r.client, req, resp)...)
+	}
+
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() || r.client == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state *WorkflowStateResourceModel
+
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DefaultForAutoClosed.ValueBool() && !plan.Type.IsUnknown() && plan.Type.ValueString() != "canceled" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_for_auto_closed"),
+			"Invalid Workflow State Type",
+			fmt.Sprintf("Only a canceled workflow state can be used for issues closed automatically, got type %q.", plan.Type.ValueString()),
+		)
+	}
+
+	resp.Diagnostics.Append(r.planPosition(ctx, plan, state, resp)...)
+
+	if resp.Diagnostics.HasError() || plan.TeamId.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	// Duplicate names are always checked, as applying would fail, the colors
+	// only with check_collisions. Only check when they change.
+	checkCollisions := collisionChecksEnabled(*r.client)
+
+	if state != nil && plan.Name.Equal(state.Name) && (!checkCollisions || plan.Color.Equal(state.Color)) {
+		return
+	}
+
+	response, err := getWorkflowSyncStates(ctx, *r.client, plan.TeamId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check workflow state collisions, got error: %s", err))
+		return
+	}
+
+	candidates := []collisionCandidate{}
+
+	for _, node := range response.WorkflowStates.Nodes {
+		candidates = append(candidates, collisionCandidate{id: node.Id, name: node.Name, color: node.Color})
+	}
+
+	if checkCollisions {
+		resp.Diagnostics.Append(collisionWarnings(*r.client, "Workflow State", plan.Id.ValueString(), "", plan.Color.ValueString(), candidates)...)
+	}
+
+	resp.Diagnostics.Append(r.planDuplicateName(plan, state, candidates)...)
+}
+
+// planDuplicateName warns when an unmanaged workflow state of the team already
+// has the planned name, as creating or renaming the workflow state would fail.
+func (r *WorkflowStateResource) planDuplicateName(plan *WorkflowStateResourceModel, state *WorkflowStateResourceModel, candidates []collisionCandidate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only check when the name changes, an adopted workflow state is
+	// expected to exist.
+	if (state != nil && plan.Name.Equal(state.Name)) || (state == nil && plan.AdoptExisting.ValueBool()) {
+		return diags
+	}
+
+	for _, candidate := range candidates {
+		if candidate.id == plan.Id.ValueString() || isManaged(*r.client, candidate.id) || !strings.EqualFold(candidate.name, plan.Name.ValueString()) {
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			path.Root("name"),
+			"Duplicate Workflow State Name",
+			fmt.Sprintf("Workflow state %q already exists in the team (%s), so applying will fail. Import it, or set adopt_existing to manage it.", candidate.name, candidate.id),
+		)
+
+		break
+	}
+
+	return diags
+}
+
+// planPosition plans a new position for a workflow state which is no longer
+// right after or before the other one, e.g. because it was moved in the UI.
+func (r *WorkflowStateResource) planPosition(ctx context.Context, plan *WorkflowStateResourceModel, state *WorkflowStateResourceModel, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The position is computed on create anyway
+	if state == nil || (plan.After.IsNull() && plan.Before.IsNull()) {
+		return diags
+	}
+
+	if plan.After.IsUnknown() || plan.Before.IsUnknown() || plan.TeamId.IsUnknown() {
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root("position"), types.NumberUnknown())...)
+		return diags
+	}
+
+	if plan.Position.IsUnknown() {
+		return diags
+	}
+
+	workflowStates, err := teamWorkflowStates(ctx, *r.client, plan.TeamId.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read workflow states, got error: %s", err))
+		return diags
+	}
+
+	lower, upper, err := relativeWorkflowStateBounds(workflowStates, plan.Id.ValueString(), plan.After.ValueString(), plan.Before.ValueString())
+
+	if err != nil {
+		diags.AddError("Invalid Workflow State Position", err.Error())
+		return diags
+	}
+
+	position, _ := plan.Position.ValueBigFloat().Float64()
+
+	if position <= lower || position >= upper {
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root("position"), types.NumberUnknown())...)
+	}
+
+	return diags
+}
+
 func (r *WorkflowStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *WorkflowStateResourceModel
 
@@ -129,7 +379,13 @@ func (r *WorkflowStateResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	position, _ := data.Position.ValueBigFloat().Float64()
+	position, diags := r.position(ctx, data)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	input := WorkflowStateCreateInput{
 		Name:        data.Name.ValueString(),
@@ -140,16 +396,69 @@ func (r *WorkflowStateResource) Create(ctx context.Context, req resource.CreateR
 		TeamId:      data.TeamId.ValueString(),
 	}
 
-	response, err := createWorkflowState(ctx, *r.client, input)
+	existingId := ""
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow state, got error: %s", err))
-		return
+	if data.AdoptExisting.ValueBool() {
+		existing, err := findWorkflowStateByTeamId(ctx, *r.client, input.Name, input.TeamId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find existing workflow state, got error: %s", err))
+			return
+		}
+
+		if len(existing.WorkflowStates.Nodes) == 1 {
+			node := existing.WorkflowStates.Nodes[0]
+
+			// The type of a workflow state can not be changed
+			if node.Type != input.Type {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("type"),
+					"Unable to Adopt Workflow State",
+					fmt.Sprintf("Existing workflow state %q has type %q instead of %q, which can not be changed.", input.Name, node.Type, input.Type),
+				)
+
+				return
+			}
+
+			existingId = node.Id
+		}
 	}
 
-	tflog.Trace(ctx, "created a workflow state")
+	var workflowState WorkflowState
+
+	if existingId != "" {
+		response, err := updateWorkflowState(ctx, *r.client, workflowStateCreateToUpdateInput(input), existingId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt workflow state, got error: %s", err))
+			return
+		}
+
+		workflowState = response.WorkflowStateUpdate.WorkflowState.WorkflowState
+
+		tflog.Trace(ctx, "adopted a workflow state", map[string]interface{}{
+			"resource":  "linear_workflow_state",
+			"operation": "create",
+			"id":        workflowState.Id,
+			"team_id":   data.TeamId.ValueString(),
+		})
+	} else {
+		response, err := createWorkflowState(ctx, *r.client, input)
 
-	workflowState := response.WorkflowStateCreate.WorkflowState
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow state, got error: %s", err))
+			return
+		}
+
+		workflowState = response.WorkflowStateCreate.WorkflowState.WorkflowState
+
+		tflog.Trace(ctx, "created a workflow state", map[string]interface{}{
+			"resource":  "linear_workflow_state",
+			"operation": "create",
+			"id":        workflowState.Id,
+			"team_id":   data.TeamId.ValueString(),
+		})
+	}
 
 	data.Id = types.StringValue(workflowState.Id)
 	data.Name = types.StringValue(workflowState.Name)
@@ -158,7 +467,17 @@ func (r *WorkflowStateResource) Create(ctx context.Context, req resource.CreateR
 	data.Color = types.StringValue(workflowState.Color)
 	data.Description = types.StringPointerValue(workflowState.Description)
 
+	resp.Diagnostics.Append(r.readMetadata(ctx, data, workflowState)...)
+	resp.Diagnostics.Append(setTeamDefaultWorkflowStates(ctx, *r.client, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	registerManaged(*r.client, data.Id.ValueString())
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.Plan, &resp.State, workflowStateOptionalAttributes)...)
 }
 
 func (r *WorkflowStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -177,9 +496,34 @@ func (r *WorkflowStateResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	tflog.Trace(ctx, "read a workflow state")
+	tflog.Trace(ctx, "read a workflow state", map[string]interface{}{
+		"resource":  "linear_workflow_state",
+		"operation": "read",
+		"id":        data.Id.ValueString(),
+		"team_id":   response.WorkflowState.Team.Id,
+	})
+
+	workflowState := response.WorkflowState.WorkflowState
+
+	// Archived workflow states can still be read, but can not be restored
+	if workflowState.ArchivedAt != nil {
+		if data.OnArchived.ValueString() == "error" {
+			resp.Diagnostics.AddError(
+				"Workflow State Archived",
+				fmt.Sprintf("Workflow state %q was archived outside of Terraform at %s.", workflowState.Name, workflowState.ArchivedAt.Format(time.RFC3339)),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Workflow State Archived",
+			fmt.Sprintf("Workflow state %q was archived outside of Terraform at %s and will be created again.", workflowState.Name, workflowState.ArchivedAt.Format(time.RFC3339)),
+		)
 
-	workflowState := response.WorkflowState
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	data.Name = types.StringValue(workflowState.Name)
 	data.Type = types.StringValue(workflowState.Type)
@@ -188,7 +532,33 @@ func (r *WorkflowStateResource) Read(ctx context.Context, req resource.ReadReque
 	data.TeamId = types.StringValue(workflowState.Team.Id)
 	data.Description = types.StringPointerValue(workflowState.Description)
 
+	resp.Diagnostics.Append(r.readMetadata(ctx, data, workflowState)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DefaultForNewIssues.ValueBool() || data.DefaultForDuplicates.ValueBool() || data.DefaultForAutoClosed.ValueBool() {
+		response, err := getTeamDefaultWorkflowStates(ctx, *r.client, workflowState.Team.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team default workflow states, got error: %s", err))
+			return
+		}
+
+		team := response.Team
+
+		// Only a workflow state which was made a default is followed, another
+		// one may have become the default since.
+		data.DefaultForNewIssues = types.BoolValue(data.DefaultForNewIssues.ValueBool() && team.DefaultIssueState != nil && team.DefaultIssueState.Id == workflowState.Id)
+		data.DefaultForDuplicates = types.BoolValue(data.DefaultForDuplicates.ValueBool() && team.MarkedAsDuplicateWorkflowState != nil && team.MarkedAsDuplicateWorkflowState.Id == workflowState.Id)
+		data.DefaultForAutoClosed = types.BoolValue(data.DefaultForAutoClosed.ValueBool() && team.AutoCloseStateId != nil && *team.AutoCloseStateId == workflowState.Id)
+	}
+
+	registerManaged(*r.client, data.Id.ValueString())
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.State, &resp.State, workflowStateOptionalAttributes)...)
 }
 
 func (r *WorkflowStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -200,7 +570,13 @@ func (r *WorkflowStateResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	position, _ := data.Position.ValueBigFloat().Float64()
+	position, diags := r.position(ctx, data)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	input := WorkflowStateUpdateInput{
 		Name:        data.Name.ValueString(),
@@ -216,7 +592,12 @@ func (r *WorkflowStateResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	tflog.Trace(ctx, "updated a workflow state")
+	tflog.Trace(ctx, "updated a workflow state", map[string]interface{}{
+		"resource":  "linear_workflow_state",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+		"team_id":   data.TeamId.ValueString(),
+	})
 
 	workflowState := response.WorkflowStateUpdate.WorkflowState
 
@@ -225,7 +606,17 @@ func (r *WorkflowStateResource) Update(ctx context.Context, req resource.UpdateR
 	data.Color = types.StringValue(workflowState.Color)
 	data.Description = types.StringPointerValue(workflowState.Description)
 
+	resp.Diagnostics.Append(r.readMetadata(ctx, data, workflowState.WorkflowState)...)
+	resp.Diagnostics.Append(setTeamDefaultWorkflowStates(ctx, *r.client, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	registerManaged(*r.client, data.Id.ValueString())
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.Plan, &resp.State, workflowStateOptionalAttributes)...)
 }
 
 func (r *WorkflowStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -237,6 +628,47 @@ func (r *WorkflowStateResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	if !data.MoveToStateOnDestroy.IsNull() {
+		moved, err := moveWorkflowStateIssues(ctx, *r.client, data.Id.ValueString(), data.MoveToStateOnDestroy.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move workflow state issues, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "moved workflow state issues", map[string]interface{}{
+			"resource":  "linear_workflow_state",
+			"operation": "delete",
+			"id":        data.Id.ValueString(),
+			"state_id":  data.MoveToStateOnDestroy.ValueString(),
+			"count":     moved,
+		})
+	}
+
+	if data.PreventDestroyWhenInUse.ValueBool() {
+		count, response, err := countWorkflowStateIssues(ctx, *r.client, data.Id.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to count workflow state issues, got error: %s", err))
+			return
+		}
+
+		if count > 0 {
+			resp.Diagnostics.AddError(
+				"Workflow State Not Empty",
+				fmt.Sprintf(
+					"Workflow state %q still has %d issues. Move them to another state first, with on_destroy_move_to_state_id or at https://linear.app/%s/settings/teams/%s/workflow, or set prevent_destroy_when_in_use to false to destroy it anyway.",
+					data.Name.ValueString(),
+					count,
+					response.WorkflowState.Team.Organization.UrlKey,
+					response.WorkflowState.Team.Key,
+				),
+			)
+
+			return
+		}
+	}
+
 	_, err := deleteWorkflowState(ctx, *r.client, data.Id.ValueString())
 
 	if err != nil {
@@ -244,27 +676,345 @@ func (r *WorkflowStateResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	tflog.Trace(ctx, "deleted a workflow state")
+	tflog.Trace(ctx, "deleted a workflow state", map[string]interface{}{
+		"resource":  "linear_workflow_state",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+		"team_id":   data.TeamId.ValueString(),
+	})
+}
+
+// position returns the planned position of the workflow state, or computes it
+// when the workflow state is placed after or before another one.
+func (r *WorkflowStateResource) position(ctx context.Context, data *WorkflowStateResourceModel) (float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.Position.IsUnknown() {
+		position, _ := data.Position.ValueBigFloat().Float64()
+		return position, diags
+	}
+
+	workflowStates, err := teamWorkflowStates(ctx, *r.client, data.TeamId.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read workflow states, got error: %s", err))
+		return 0, diags
+	}
+
+	lower, upper, err := relativeWorkflowStateBounds(workflowStates, data.Id.ValueString(), data.After.ValueString(), data.Before.ValueString())
+
+	if err != nil {
+		diags.AddError("Invalid Workflow State Position", err.Error())
+		return 0, diags
+	}
+
+	// Positions which are too close to place another workflow state between
+	// them are spread out again first.
+	if upper-lower < workflowStatePositionGap {
+		workflowStates, err = normalizeWorkflowStatePositions(ctx, *r.client, workflowStates, data.Id.ValueString())
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to normalize workflow state positions, got error: %s", err))
+			return 0, diags
+		}
+
+		lower, upper, err = relativeWorkflowStateBounds(workflowStates, data.Id.ValueString(), data.After.ValueString(), data.Before.ValueString())
+
+		if err != nil {
+			diags.AddError("Invalid Workflow State Position", err.Error())
+			return 0, diags
+		}
+	}
+
+	return (lower + upper) / 2, diags
+}
+
+// workflowStatePositionGap is the smallest gap between two workflow states
+// which another one is placed in.
+const workflowStatePositionGap = 1e-6
+
+func teamWorkflowStates(ctx context.Context, client graphql.Client, teamId string) ([]WorkflowState, error) {
+	response, err := getWorkflowSyncStates(ctx, client, teamId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	workflowStates := []WorkflowState{}
+
+	for _, node := range response.WorkflowStates.Nodes {
+		workflowStates = append(workflowStates, node.WorkflowState)
+	}
+
+	sort.SliceStable(workflowStates, func(i, j int) bool {
+		return workflowStates[i].Position < workflowStates[j].Position
+	})
+
+	return workflowStates, nil
+}
+
+// relativeWorkflowStateBounds returns the positions between which a workflow
+// state placed right after or before another one has to be.
+func relativeWorkflowStateBounds(workflowStates []WorkflowState, id string, after string, before string) (float64, float64, error) {
+	others := []WorkflowState{}
+
+	for _, workflowState := range workflowStates {
+		if workflowState.Id != id {
+			others = append(others, workflowState)
+		}
+	}
+
+	anchor := after
+
+	if anchor == "" {
+		anchor = before
+	}
+
+	for index, workflowState := range others {
+		if workflowState.Id != anchor {
+			continue
+		}
+
+		if after != "" {
+			if index+1 < len(others) {
+				return workflowState.Position, others[index+1].Position, nil
+			}
+
+			return workflowState.Position, workflowState.Position + 2, nil
+		}
+
+		if index > 0 {
+			return others[index-1].Position, workflowState.Position, nil
+		}
+
+		return workflowState.Position - 2, workflowState.Position, nil
+	}
+
+	return 0, 0, fmt.Errorf("workflow state %s is not part of the team", anchor)
+}
+
+// normalizeWorkflowStatePositions renumbers the positions of the workflow
+// states of a team, except the given one, keeping their order.
+func normalizeWorkflowStatePositions(ctx context.Context, client graphql.Client, workflowStates []WorkflowState, id string) ([]WorkflowState, error) {
+	normalized := []WorkflowState{}
+
+	for _, workflowState := range workflowStates {
+		if workflowState.Id == id {
+			continue
+		}
+
+		position := float64(len(normalized) + 1)
+
+		if workflowState.Position != position {
+			input := WorkflowStateUpdateInput{
+				Name:        workflowState.Name,
+				Color:       workflowState.Color,
+				Description: workflowState.Description,
+				Position:    position,
+			}
+
+			response, err := updateWorkflowState(ctx, client, input, workflowState.Id)
+
+			if err != nil {
+				return nil, err
+			}
+
+			workflowState = response.WorkflowStateUpdate.WorkflowState.WorkflowState
+		}
+
+		normalized = append(normalized, workflowState)
+	}
+
+	return normalized, nil
+}
+
+// readMetadata sets the computed attributes which describe the workflow state
+// without being part of its definition.
+func (r *WorkflowStateResource) readMetadata(ctx context.Context, data *WorkflowStateResourceModel, workflowState WorkflowState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.CreatedAt = types.StringValue(workflowState.CreatedAt.Format(time.RFC3339))
+	data.UpdatedAt = types.StringValue(workflowState.UpdatedAt.Format(time.RFC3339))
+	data.Url = types.StringValue(fmt.Sprintf("https://linear.app/%s/settings/teams/%s/workflow", workflowState.Team.Organization.UrlKey, workflowState.Team.Key))
+	data.IssueCount = types.Int64Null()
+
+	// Counting goes through every issue, so it is only done when asked for.
+	if !data.CountIssues.ValueBool() {
+		return diags
+	}
+
+	count, _, err := countWorkflowStateIssues(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to count workflow state issues, got error: %s", err))
+		return diags
+	}
+
+	data.IssueCount = types.Int64Value(int64(count))
+
+	return diags
+}
+
+// setTeamDefaultWorkflowStates makes the workflow state the defaults of its
+// team which it is marked as.
+func setTeamDefaultWorkflowStates(ctx context.Context, client graphql.Client, data *WorkflowStateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	defaults := []struct {
+		enabled bool
+		name    string
+		set     func(ctx context.Context, client graphql.Client, id string, stateId string) error
+	}{
+		{data.DefaultForNewIssues.ValueBool(), "new issues", func(ctx context.Context, client graphql.Client, id string, stateId string) error {
+			_, err := setTeamDefaultIssueState(ctx, client, id, stateId)
+			return err
+		}},
+		{data.DefaultForDuplicates.ValueBool(), "duplicates", func(ctx context.Context, client graphql.Client, id string, stateId string) error {
+			_, err := setTeamMarkedAsDuplicateWorkflowState(ctx, client, id, stateId)
+			return err
+		}},
+		{data.DefaultForAutoClosed.ValueBool(), "auto closed issues", func(ctx context.Context, client graphql.Client, id string, stateId string) error {
+			_, err := setTeamAutoCloseState(ctx, client, id, stateId)
+			return err
+		}},
+	}
+
+	for _, d := range defaults {
+		if !d.enabled {
+			continue
+		}
+
+		if err := d.set(ctx, client, data.TeamId.ValueString(), data.Id.ValueString()); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to make workflow state the default for %s, got error: %s", d.name, err))
+			return diags
+		}
+
+		tflog.Trace(ctx, "set a team default workflow state", map[string]interface{}{
+			"resource": "linear_workflow_state",
+			"id":       data.Id.ValueString(),
+			"team_id":  data.TeamId.ValueString(),
+			"default":  d.name,
+		})
+	}
+
+	return diags
+}
+
+// countWorkflowStateIssues counts the issues of the workflow state, going
+// through every page of them.
+func countWorkflowStateIssues(ctx context.Context, client graphql.Client, id string) (int, *getWorkflowStateIssuesResponse, error) {
+	count := 0
+	var after *string
+
+	for {
+		response, err := getWorkflowStateIssues(ctx, client, id, after)
+
+		if err != nil {
+			return 0, nil, err
+		}
+
+		issues := response.WorkflowState.Issues
+		count += len(issues.Nodes)
+
+		if !issues.PageInfo.HasNextPage {
+			return count, response, nil
+		}
+
+		cursor := issues.PageInfo.EndCursor
+		after = &cursor
+	}
+}
+
+// moveWorkflowStateIssues moves every issue of the workflow state to another
+// one, and returns how many were moved.
+func moveWorkflowStateIssues(ctx context.Context, client graphql.Client, id string, stateId string) (int, error) {
+	moved := 0
+	seen := map[string]bool{}
+
+	// Moved issues leave the workflow state, so the first page is fetched
+	// until it is empty. An issue which shows up again was not moved after
+	// all, e.g. because it is archived, and would be fetched forever.
+	for {
+		response, err := getWorkflowStateIssues(ctx, client, id, nil)
+
+		if err != nil {
+			return moved, err
+		}
+
+		if len(response.WorkflowState.Issues.Nodes) == 0 {
+			return moved, nil
+		}
+
+		for _, issue := range response.WorkflowState.Issues.Nodes {
+			if seen[issue.Id] {
+				return moved, fmt.Errorf("issue %s is still in the workflow state after being moved", issue.Id)
+			}
+
+			seen[issue.Id] = true
+
+			updated, err := moveIssueToWorkflowState(ctx, client, issue.Id, stateId)
+
+			if err != nil {
+				return moved, err
+			}
+
+			if !updated.IssueUpdate.Success {
+				return moved, fmt.Errorf("issue %s was not moved", issue.Id)
+			}
+
+			moved++
+		}
+	}
 }
 
 func (r *WorkflowStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ":")
+	id := req.ID
 
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: workflow_state_name:team_key. Got: %q", req.ID),
-		)
+	// Workflow states can be imported by their identifier, or by their name
+	// and the key of their team.
+	if !uuidRegex().MatchString(id) {
+		parts := strings.Split(req.ID, ":")
 
-		return
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: workflow_state_id or workflow_state_name:team_key. Got: %q", req.ID),
+			)
+
+			return
+		}
+
+		response, err := findWorkflowState(ctx, *r.client, parts[0], parts[1])
+
+		if err != nil || len(response.WorkflowStates.Nodes) != 1 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import workflow state, got error: %s", err))
+			return
+		}
+
+		id = response.WorkflowStates.Nodes[0].Id
 	}
 
-	response, err := findWorkflowState(ctx, *r.client, parts[0], parts[1])
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("count_issues"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prevent_destroy_when_in_use"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("default_for_new_issues"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("default_for_duplicates"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("default_for_auto_closed"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_archived"), "recreate")...)
+}
 
-	if err != nil || len(response.WorkflowStates.Nodes) != 1 {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import workflow state, got error: %s", err))
-		return
+func workflowStateCreateToUpdateInput(input WorkflowStateCreateInput) WorkflowStateUpdateInput {
+	return WorkflowStateUpdateInput{
+		Name:        input.Name,
+		Color:       input.Color,
+		Description: input.Description,
+		Position:    input.Position,
 	}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.WorkflowStates.Nodes[0].Id)...)
+// workflowStateOptionalAttributes are the attributes of a workflow state backed by the optional
+// fields of the API.
+var workflowStateOptionalAttributes = []optionalAttribute{
+	{path.MatchRoot("description"), "WorkflowState", "description"},
 }
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -23,6 +24,18 @@ func TestAccWorkflowStateResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_workflow_state.test", "color", "#ffff00"),
 					resource.TestCheckResourceAttr("linear_workflow_state.test", "position", "10"),
 					resource.TestCheckResourceAttr("linear_workflow_state.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "prevent_destroy_when_in_use", "true"),
+					resource.TestCheckNoResourceAttr("linear_workflow_state.test", "on_destroy_move_to_state_id"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "adopt_existing", "false"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "default_for_new_issues", "false"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "default_for_duplicates", "false"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "default_for_auto_closed", "false"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "on_archived", "recreate"),
+					resource.TestCheckResourceAttrSet("linear_workflow_state.test", "created_at"),
+					resource.TestCheckResourceAttrSet("linear_workflow_state.test", "updated_at"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "count_issues", "false"),
+					resource.TestCheckNoResourceAttr("linear_workflow_state.test", "issue_count"),
+					resource.TestCheckResourceAttrSet("linear_workflow_state.test", "url"),
 				),
 			},
 			// ImportState testing
@@ -32,6 +45,12 @@ func TestAccWorkflowStateResourceDefault(t *testing.T) {
 				ImportStateId:     "Draft:DEF",
 				ImportStateVerify: true,
 			},
+			// ImportState by identifier testing
+			{
+				ResourceName:      "linear_workflow_state.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Update with null values
 			{
 				Config: testAccWorkflowStateResourceConfigDefault("Draft", "started"),
@@ -56,14 +75,17 @@ func TestAccWorkflowStateResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("linear_workflow_state.test", "color", "#00ffff"),
 					resource.TestCheckResourceAttr("linear_workflow_state.test", "position", "20"),
 					resource.TestCheckResourceAttr("linear_workflow_state.test", "team_id", "ff0a060a-eceb-4b34-9140-fd7231f0cd28"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "count_issues", "true"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "issue_count", "0"),
 				),
 			},
 			// ImportState testing
 			{
-				ResourceName:      "linear_workflow_state.test",
-				ImportState:       true,
-				ImportStateId:     "In review:DEF",
-				ImportStateVerify: true,
+				ResourceName:            "linear_workflow_state.test",
+				ImportState:             true,
+				ImportStateId:           "In review:DEF",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"count_issues", "issue_count"},
 			},
 			// Delete testing automatically occurs in TestCase
 		},
@@ -90,10 +112,11 @@ func TestAccWorkflowStateResourceNonDefault(t *testing.T) {
 			},
 			// ImportState testing
 			{
-				ResourceName:      "linear_workflow_state.test",
-				ImportState:       true,
-				ImportStateId:     "Deployed:DEF",
-				ImportStateVerify: true,
+				ResourceName:            "linear_workflow_state.test",
+				ImportState:             true,
+				ImportStateId:           "Deployed:DEF",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"count_issues", "issue_count"},
 			},
 			// Update with same values
 			{
@@ -133,6 +156,95 @@ func TestAccWorkflowStateResourceNonDefault(t *testing.T) {
 	})
 }
 
+func TestAccWorkflowStateResourceMoveOnDestroy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkflowStateResourceConfigMoveOnDestroy(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_workflow_state.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrPair("linear_workflow_state.test", "on_destroy_move_to_state_id", "linear_workflow_state.fallback", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccWorkflowStateResourceAdoptExisting(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkflowStateResourceConfigAdoptExisting(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_workflow_state.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "name", "In Progress"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "color", "#00ffff"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "position", "20"),
+					resource.TestCheckResourceAttr("linear_workflow_state.test", "adopt_existing", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccWorkflowStateResourceDuplicateName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The team must be known when planning the workflow state
+			{
+				Config: testAccWorkflowStateResourceConfigDuplicateName(false),
+			},
+			// Planning warns about the unmanaged "In Progress" of the team,
+			// applying fails then.
+			{
+				Config:      testAccWorkflowStateResourceConfigDuplicateName(true),
+				ExpectError: regexp.MustCompile("Unable to create workflow state"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccWorkflowStateResourceRelativePosition(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkflowStateResourceConfigRelativePosition("after"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_workflow_state.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrPair("linear_workflow_state.test", "after", "linear_workflow_state.anchor", "id"),
+					resource.TestCheckNoResourceAttr("linear_workflow_state.test", "before"),
+					resource.TestCheckResourceAttrSet("linear_workflow_state.test", "position"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkflowStateResourceConfigRelativePosition("before"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("linear_workflow_state.test", "id", uuidRegex()),
+					resource.TestCheckNoResourceAttr("linear_workflow_state.test", "after"),
+					resource.TestCheckResourceAttrPair("linear_workflow_state.test", "before", "linear_workflow_state.anchor", "id"),
+					resource.TestCheckResourceAttrSet("linear_workflow_state.test", "position"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccWorkflowStateResourceConfigDefault(name string, ty string) string {
 	return fmt.Sprintf(`
 resource "linear_workflow_state" "test" {
@@ -154,6 +266,97 @@ resource "linear_workflow_state" "test" {
   color = "#00ffff"
   position = 20
   team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  count_issues = true
 }
 `, name, ty, description)
 }
+
+func testAccWorkflowStateResourceConfigMoveOnDestroy() string {
+	return `
+resource "linear_workflow_state" "fallback" {
+  name = "Fallback"
+  type = "started"
+  color = "#ffff00"
+  position = 10
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_workflow_state" "test" {
+  name = "Draft"
+  type = "started"
+  color = "#00ffff"
+  position = 20
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+  on_destroy_move_to_state_id = linear_workflow_state.fallback.id
+}
+`
+}
+
+func testAccWorkflowStateResourceConfigAdoptExisting() string {
+	return `
+resource "linear_team" "test" {
+  key = "ADOPT"
+  name = "Adopt"
+}
+
+resource "linear_workflow_state" "other" {
+  name = "Doing"
+  type = "started"
+  color = "#ffff00"
+  position = 10
+  team_id = linear_team.test.id
+}
+
+resource "linear_workflow_state" "test" {
+  name = "In Progress"
+  type = "started"
+  color = "#00ffff"
+  position = 20
+  team_id = linear_team.test.id
+  adopt_existing = true
+}
+`
+}
+
+func testAccWorkflowStateResourceConfigRelativePosition(placement string) string {
+	return fmt.Sprintf(`
+resource "linear_workflow_state" "anchor" {
+  name = "Anchor"
+  type = "started"
+  color = "#ffff00"
+  position = 10
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+
+resource "linear_workflow_state" "test" {
+  name = "Relative"
+  type = "started"
+  color = "#00ffff"
+  %s = linear_workflow_state.anchor.id
+  team_id = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+}
+`, placement)
+}
+
+func testAccWorkflowStateResourceConfigDuplicateName(state bool) string {
+	config := `
+resource "linear_team" "test" {
+  key = "DUP"
+  name = "Duplicates"
+}
+`
+
+	if state {
+		config += `
+resource "linear_workflow_state" "test" {
+  name = "In Progress"
+  type = "started"
+  color = "#00ffff"
+  position = 20
+  team_id = linear_team.test.id
+}
+`
+	}
+
+	return config
+}
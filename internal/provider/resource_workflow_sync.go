@@ -0,0 +1,589 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &WorkflowSyncResource{}
+var _ resource.ResourceWithModifyPlan = &WorkflowSyncResource{}
+var _ resource.ResourceWithImportState = &WorkflowSyncResource{}
+
+func NewWorkflowSyncResource() resource.Resource {
+	return &WorkflowSyncResource{}
+}
+
+type WorkflowSyncResource struct {
+	client *graphql.Client
+}
+
+type WorkflowSyncResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	TeamIds types.Set    `tfsdk:"team_ids"`
+	States  types.List   `tfsdk:"states"`
+	Drift   types.Map    `tfsdk:"drift"`
+	Remove  types.Bool   `tfsdk:"remove_extra_states"`
+}
+
+type WorkflowSyncResourceStateModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Color       types.String `tfsdk:"color"`
+	Description types.String `tfsdk:"description"`
+}
+
+var workflowSyncStateAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"type":        types.StringType,
+	"color":       types.StringType,
+	"description": types.StringType,
+}
+
+var driftType = types.ListType{ElemType: types.StringType}
+
+func (r *WorkflowSyncResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_sync"
+}
+
+func (r *WorkflowSyncResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Keeps the workflow states of one or several Linear teams in sync with one ordered definition. *States which are not part of the definition are left untouched unless `remove_extra_states` is set, and destroying this resource does not delete any workflow state.*",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workflow sync.",
+				Computed:            true,
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the teams to keep in sync.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
+					),
+				},
+			},
+			"states": schema.ListNestedAttribute{
+				MarkdownDescription: "Ordered list of workflow states every team should have. *Positions are assigned from the order of the list.*",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the workflow state.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the workflow state.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf([]string{"triage", "backlog", "unstarted", "started", "completed", "canceled"}...),
+							},
+						},
+						"color": schema.StringAttribute{
+							MarkdownDescription: "Color of the workflow state.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(colorRegex(), "must be a hex color"),
+							},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the workflow state.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"remove_extra_states": schema.BoolAttribute{
+				MarkdownDescription: "Whether to archive the workflow states of the teams which are not part of the definition. A workflow state which still has issues is never archived. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"drift": schema.MapAttribute{
+				MarkdownDescription: "Names of the workflow states which are missing or differ from the definition, or are extra when `remove_extra_states` is set, by team identifier.",
+				Computed:            true,
+				ElementType:         driftType,
+			},
+		},
+	}
+}
+
+func (r *WorkflowSyncResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkflowSyncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var drift map[string][]string
+	var plannedTeamIds, stateTeamIds types.Set
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("drift"), &drift)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("team_ids"), &plannedTeamIds)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("team_ids"), &stateTeamIds)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The drift is keyed by team, so it is left unknown when the teams change
+	if !plannedTeamIds.Equal(stateTeamIds) {
+		return
+	}
+
+	drifted := false
+
+	for teamId, names := range drift {
+		if len(names) > 0 {
+			drifted = true
+		}
+
+		drift[teamId] = []string{}
+	}
+
+	// Plan an update which brings the drifted teams back in sync
+	if drifted {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("drift"), drift)...)
+	}
+}
+
+func (r *WorkflowSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *WorkflowSyncResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, data, "create")...)
+
+	// Keep the changes made before a failure
+	if resp.Diagnostics.HasError() && data.Id.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *WorkflowSyncResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamIds, states, diags := workflowSyncDefinition(ctx, data)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	drift := map[string][]string{}
+
+	for _, teamId := range teamIds {
+		response, err := getWorkflowSyncStates(ctx, *r.client, teamId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow states of team %s, got error: %s", teamId, err))
+			return
+		}
+
+		drift[teamId] = []string{}
+
+		for index, state := range states {
+			existing := findWorkflowSyncState(response.WorkflowStates.Nodes, state.Name.ValueString())
+
+			if existing == nil || workflowSyncStateDiffers(*r.client, state, float64(index), *existing) {
+				drift[teamId] = append(drift[teamId], state.Name.ValueString())
+			}
+		}
+
+		if data.Remove.ValueBool() {
+			for _, extra := range workflowSyncExtraStates(response.WorkflowStates.Nodes, states) {
+				drift[teamId] = append(drift[teamId], extra.Name)
+			}
+		}
+	}
+
+	driftValue, diags := types.MapValueFrom(ctx, driftType, drift)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Drift = driftValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *WorkflowSyncResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, data, "update")...)
+
+	// Keep the changes made before a failure
+	if resp.Diagnostics.HasError() && data.Id.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowSyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The workflow states stay with their teams, so there is nothing to delete
+}
+
+func (r *WorkflowSyncResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	key := strings.TrimPrefix(req.ID, "team:")
+
+	if !strings.HasPrefix(req.ID, "team:") || key == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team:team_key. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	response, err := getTeamWorkflowStates(ctx, *r.client, key)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import workflow sync, got error: %s", err))
+		return
+	}
+
+	if len(response.WorkflowStates.Nodes) == 0 {
+		resp.Diagnostics.AddError(
+			"Team Not Found",
+			fmt.Sprintf("Unable to import workflow sync, no workflow states were found for team %q.", key),
+		)
+
+		return
+	}
+
+	workflowStates := response.WorkflowStates.Nodes
+
+	sort.SliceStable(workflowStates, func(i, j int) bool {
+		return workflowStates[i].Position < workflowStates[j].Position
+	})
+
+	teamIds := []string{workflowStates[0].Team.Id}
+	states := []WorkflowSyncResourceStateModel{}
+
+	for _, workflowState := range workflowStates {
+		states = append(states, WorkflowSyncResourceStateModel{
+			Name:        types.StringValue(workflowState.Name),
+			Type:        types.StringValue(workflowState.Type),
+			Color:       types.StringValue(workflowState.Color),
+			Description: types.StringPointerValue(workflowState.Description),
+		})
+	}
+
+	teamIdsValue, diags := types.SetValueFrom(ctx, types.StringType, teamIds)
+	resp.Diagnostics.Append(diags...)
+
+	statesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workflowSyncStateAttrTypes}, states)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := &WorkflowSyncResourceModel{
+		Id:      types.StringValue(workflowSyncId(teamIds)),
+		TeamIds: teamIdsValue,
+		States:  statesValue,
+		Drift:   types.MapNull(driftType),
+		Remove:  types.BoolValue(false),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sync reconciles the workflow states of every team against the definition.
+// When it fails after changing workflow states, the id and drift are still set
+// so the changes made so far can be saved, and the rest is planned again.
+func (r *WorkflowSyncResource) sync(ctx context.Context, data *WorkflowSyncResourceModel, operation string) diag.Diagnostics {
+	teamIds, states, diags := workflowSyncDefinition(ctx, data)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	drift := map[string]attr.Value{}
+	changed := false
+
+	// fail records the given names of the failing team, and every state of
+	// the teams which were not synced yet, as drifted.
+	fail := func(teamIndex int, names []string) diag.Diagnostics {
+		if !changed {
+			return diags
+		}
+
+		for index, teamId := range teamIds[teamIndex:] {
+			if index > 0 {
+				names = workflowSyncStateNames(states)
+			}
+
+			pending := []attr.Value{}
+
+			for _, name := range names {
+				pending = append(pending, types.StringValue(name))
+			}
+
+			drift[teamId] = types.ListValueMust(types.StringType, pending)
+		}
+
+		data.Id = types.StringValue(workflowSyncId(teamIds))
+		data.Drift = types.MapValueMust(driftType, drift)
+
+		return diags
+	}
+
+	for teamIndex, teamId := range teamIds {
+		response, err := getWorkflowSyncStates(ctx, *r.client, teamId)
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read workflow states of team %s, got error: %s", teamId, err))
+			return fail(teamIndex, workflowSyncStateNames(states))
+		}
+
+		for index, state := range states {
+			position := float64(index)
+			existing := findWorkflowSyncState(response.WorkflowStates.Nodes, state.Name.ValueString())
+
+			if existing == nil {
+				input := WorkflowStateCreateInput{
+					Name:        state.Name.ValueString(),
+					Type:        state.Type.ValueString(),
+					Color:       state.Color.ValueString(),
+					Description: state.Description.ValueStringPointer(),
+					Position:    position,
+					TeamId:      teamId,
+				}
+
+				_, err := createWorkflowState(ctx, *r.client, input)
+
+				if err != nil {
+					diags.AddError("Client Error", fmt.Sprintf("Unable to create workflow state %q in team %s, got error: %s", state.Name.ValueString(), teamId, err))
+					return fail(teamIndex, workflowSyncStateNames(states[index:]))
+				}
+
+				changed = true
+
+				continue
+			}
+
+			if existing.Type != state.Type.ValueString() {
+				diags.AddError(
+					"Workflow State Type Mismatch",
+					fmt.Sprintf("Workflow state %q in team %s has type %q, but the definition expects %q. The type of a workflow state can not be changed.", existing.Name, teamId, existing.Type, state.Type.ValueString()),
+				)
+
+				return fail(teamIndex, workflowSyncStateNames(states[index:]))
+			}
+
+			if !workflowSyncStateDiffers(*r.client, state, position, *existing) {
+				continue
+			}
+
+			input := WorkflowStateUpdateInput{
+				Name:        state.Name.ValueString(),
+				Color:       state.Color.ValueString(),
+				Description: state.Description.ValueStringPointer(),
+				Position:    position,
+			}
+
+			_, err := updateWorkflowState(ctx, *r.client, input, existing.Id)
+
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to update workflow state %q in team %s, got error: %s", state.Name.ValueString(), teamId, err))
+				return fail(teamIndex, workflowSyncStateNames(states[index:]))
+			}
+
+			changed = true
+		}
+
+		if data.Remove.ValueBool() {
+			extras := workflowSyncExtraStates(response.WorkflowStates.Nodes, states)
+
+			for index, extra := range extras {
+				count, _, err := countWorkflowStateIssues(ctx, *r.client, extra.Id)
+
+				if err != nil {
+					diags.AddError("Client Error", fmt.Sprintf("Unable to count issues of workflow state %q in team %s, got error: %s", extra.Name, teamId, err))
+					return fail(teamIndex, workflowSyncExtraNames(extras[index:]))
+				}
+
+				if count > 0 {
+					diags.AddError(
+						"Workflow State Not Empty",
+						fmt.Sprintf("Workflow state %q in team %s is not part of the definition but still has %d issues. Move them to another state first.", extra.Name, teamId, count),
+					)
+
+					return fail(teamIndex, workflowSyncExtraNames(extras[index:]))
+				}
+
+				_, err = deleteWorkflowState(ctx, *r.client, extra.Id)
+
+				if err != nil {
+					diags.AddError("Client Error", fmt.Sprintf("Unable to delete workflow state %q in team %s, got error: %s", extra.Name, teamId, err))
+					return fail(teamIndex, workflowSyncExtraNames(extras[index:]))
+				}
+
+				changed = true
+			}
+		}
+
+		drift[teamId] = types.ListValueMust(types.StringType, []attr.Value{})
+
+		tflog.Trace(ctx, "synced team workflow states", map[string]interface{}{
+			"resource":  "linear_workflow_sync",
+			"operation": operation,
+			"team_id":   teamId,
+		})
+	}
+
+	data.Id = types.StringValue(workflowSyncId(teamIds))
+	data.Drift = types.MapValueMust(driftType, drift)
+
+	return diags
+}
+
+func workflowSyncDefinition(ctx context.Context, data *WorkflowSyncResourceModel) ([]string, []WorkflowSyncResourceStateModel, diag.Diagnostics) {
+	var teamIds []string
+	var states []WorkflowSyncResourceStateModel
+
+	diags := data.TeamIds.ElementsAs(ctx, &teamIds, false)
+	diags.Append(data.States.ElementsAs(ctx, &states, false)...)
+
+	sort.Strings(teamIds)
+
+	return teamIds, states, diags
+}
+
+func workflowSyncStateNames(states []WorkflowSyncResourceStateModel) []string {
+	names := []string{}
+
+	for _, state := range states {
+		names = append(names, state.Name.ValueString())
+	}
+
+	return names
+}
+
+func workflowSyncExtraNames(extras []getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) []string {
+	names := []string{}
+
+	for _, extra := range extras {
+		names = append(names, extra.Name)
+	}
+
+	return names
+}
+
+func workflowSyncId(teamIds []string) string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(strings.Join(teamIds, ","))))
+}
+
+func findWorkflowSyncState(workflowStates []getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, name string) *getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
+	for _, workflowState := range workflowStates {
+		if workflowState.Name == name {
+			return &workflowState
+		}
+	}
+
+	return nil
+}
+
+// workflowSyncExtraStates returns the workflow states which are not part of
+// the definition.
+func workflowSyncExtraStates(workflowStates []getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, states []WorkflowSyncResourceStateModel) []getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState {
+	names := map[string]bool{}
+
+	for _, state := range states {
+		names[state.Name.ValueString()] = true
+	}
+
+	extras := []getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState{}
+
+	for _, workflowState := range workflowStates {
+		if !names[workflowState.Name] {
+			extras = append(extras, workflowState)
+		}
+	}
+
+	return extras
+}
+
+func workflowSyncStateDiffers(client graphql.Client, state WorkflowSyncResourceStateModel, position float64, existing getWorkflowSyncStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) bool {
+	description := state.Description.ValueString()
+
+	// The description can not be compared when the API does not support it.
+	if existing.Description != nil {
+		description = *existing.Description
+	} else if fieldSupported(client, "WorkflowState", "description") {
+		description = ""
+	}
+
+	return existing.Type != state.Type.ValueString() ||
+		existing.Color != state.Color.ValueString() ||
+		description != state.Description.ValueString() ||
+		existing.Position != position
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccWorkflowSyncResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkflowSyncResourceConfig("#ffff00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("linear_workflow_sync.test", "id"),
+					resource.TestCheckResourceAttr("linear_workflow_sync.test", "team_ids.#", "1"),
+					resource.TestCheckResourceAttr("linear_workflow_sync.test", "states.#", "2"),
+					resource.TestCheckResourceAttr("linear_workflow_sync.test", "states.1.color", "#ffff00"),
+					resource.TestCheckResourceAttr("linear_workflow_sync.test", "drift.ff0a060a-eceb-4b34-9140-fd7231f0cd28.#", "0"),
+					resource.TestCheckResourceAttr("linear_workflow_sync.test", "remove_extra_states", "false"),
+				),
+			},
+			// ImportState testing, which takes every workflow state of the team
+			{
+				ResourceName:  "linear_workflow_sync.test",
+				ImportState:   true,
+				ImportStateId: "team:DEF",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported workflow sync, got %d", len(states))
+					}
+
+					attributes := states[0].Attributes
+
+					if attributes["team_ids.#"] != "1" || attributes["team_ids.0"] != "ff0a060a-eceb-4b34-9140-fd7231f0cd28" {
+						return fmt.Errorf("expected the team to be imported, got %v", attributes)
+					}
+
+					for i := 0; attributes[fmt.Sprintf("states.%d.name", i)] != ""; i++ {
+						if attributes[fmt.Sprintf("states.%d.name", i)] == "Shipped" {
+							return nil
+						}
+					}
+
+					return fmt.Errorf("expected the synced workflow states to be imported, got %v", attributes)
+				},
+			},
+			{
+				ResourceName:  "linear_workflow_sync.test",
+				ImportState:   true,
+				ImportStateId: "team:NOPE",
+				ExpectError:   regexp.MustCompile(`no\s+workflow\s+states\s+were\s+found\s+for\s+team`),
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkflowSyncResourceConfig("#00ffff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("linear_workflow_sync.test", "id"),
+					resource.TestCheckResourceAttr("linear_workflow_sync.test", "states.1.color", "#00ffff"),
+					resource.TestCheckResourceAttr("linear_workflow_sync.test", "drift.ff0a060a-eceb-4b34-9140-fd7231f0cd28.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccWorkflowSyncResourceRemoveExtraStates(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkflowSyncResourceConfigRemoveExtraStates(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("linear_workflow_sync.test", "id"),
+					resource.TestCheckResourceAttr("linear_workflow_sync.test", "remove_extra_states", "true"),
+					resource.TestCheckResourceAttrPair("linear_workflow_sync.test", "team_ids.0", "linear_team.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestWorkflowSyncKeepsPartialProgress(t *testing.T) {
+	const teamId = "ff0a060a-eceb-4b34-9140-fd7231f0cd28"
+	const workflowState = `{"id": "todo", "name": "Todo", "color": "#000000", "description": null, "type": "unstarted", "position": 0, "archivedAt": null, "createdAt": "2023-01-01T00:00:00Z", "updatedAt": "2023-01-01T00:00:00Z", "team": {"id": "` + teamId + `", "key": "DEF", "organization": {"urlKey": "org"}}}`
+
+	sync := func(t *testing.T, updateFails bool) *WorkflowSyncResourceModel {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				OpName string `json:"operationName"`
+			}
+
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("unable to decode request: %s", err)
+			}
+
+			switch {
+			case body.OpName == "getWorkflowSyncStates":
+				w.Write([]byte(`{"data": {"workflowStates": {"nodes": [` + workflowState + `]}}}`))
+			case body.OpName == "updateWorkflowState" && !updateFails:
+				w.Write([]byte(`{"data": {"workflowStateUpdate": {"workflowState": ` + workflowState + `}}}`))
+			case body.OpName == "updateWorkflowState" || body.OpName == "createWorkflowState":
+				w.Write([]byte(`{"errors": [{"message": "internal error"}]}`))
+			default:
+				t.Fatalf("unexpected operation %s", body.OpName)
+			}
+		}))
+
+		t.Cleanup(server.Close)
+
+		var client graphql.Client = &linearClient{wrapped: graphql.NewClient(server.URL, server.Client())}
+
+		states := []WorkflowSyncResourceStateModel{
+			{Name: types.StringValue("Todo"), Type: types.StringValue("unstarted"), Color: types.StringValue("#ffffff"), Description: types.StringNull()},
+			{Name: types.StringValue("Done"), Type: types.StringValue("completed"), Color: types.StringValue("#ffffff"), Description: types.StringNull()},
+		}
+
+		statesValue, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: workflowSyncStateAttrTypes}, states)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		data := &WorkflowSyncResourceModel{
+			Id:      types.StringUnknown(),
+			TeamIds: types.SetValueMust(types.StringType, []attr.Value{types.StringValue(teamId)}),
+			States:  statesValue,
+			Drift:   types.MapUnknown(driftType),
+			Remove:  types.BoolValue(false),
+		}
+
+		r := &WorkflowSyncResource{client: &client}
+
+		if diags := r.sync(context.Background(), data, "create"); !diags.HasError() {
+			t.Fatal("expected the sync to fail")
+		}
+
+		return data
+	}
+
+	t.Run("nothing changed", func(t *testing.T) {
+		if data := sync(t, true); !data.Id.IsUnknown() {
+			t.Fatalf("expected nothing to be saved, got id %s", data.Id)
+		}
+	})
+
+	t.Run("partially synced", func(t *testing.T) {
+		data := sync(t, false)
+
+		if data.Id.IsUnknown() {
+			t.Fatal("expected the changes made so far to be saved")
+		}
+
+		var drift map[string][]string
+
+		if diags := data.Drift.ElementsAs(context.Background(), &drift, false); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if len(drift[teamId]) != 1 || drift[teamId][0] != "Done" {
+			t.Fatalf("expected only the failed state to be drifted, got %v", drift)
+		}
+	})
+}
+
+func testAccWorkflowSyncResourceConfig(color string) string {
+	return fmt.Sprintf(`
+resource "linear_workflow_sync" "test" {
+  team_ids = ["ff0a060a-eceb-4b34-9140-fd7231f0cd28"]
+
+  states = [
+    {
+      name = "Synced"
+      type = "unstarted"
+      color = "#e2e2e2"
+    },
+    {
+      name = "Shipped"
+      type = "completed"
+      color = "%s"
+      description = "Synced across teams"
+    },
+  ]
+}
+`, color)
+}
+
+func testAccWorkflowSyncResourceConfigRemoveExtraStates() string {
+	return `
+resource "linear_team" "test" {
+  key = "SYNC"
+  name = "Sync"
+}
+
+resource "linear_workflow_sync" "test" {
+  team_ids = [linear_team.test.id]
+  remove_extra_states = true
+
+  states = [
+    {
+      name = "Backlog"
+      type = "backlog"
+      color = "#bec2c8"
+    },
+    {
+      name = "Todo"
+      type = "unstarted"
+      color = "#e2e2e2"
+    },
+    {
+      name = "Doing"
+      type = "started"
+      color = "#f2c94c"
+    },
+    {
+      name = "Done"
+      type = "completed"
+      color = "#5e6ad2"
+    },
+    {
+      name = "Canceled"
+      type = "canceled"
+      color = "#95a2b3"
+    },
+  ]
+}
+`
+}
@@ -6,9 +6,11 @@ import (
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -18,6 +20,7 @@ import (
 
 var _ resource.Resource = &WorkspaceLabelResource{}
 var _ resource.ResourceWithImportState = &WorkspaceLabelResource{}
+var _ resource.ResourceWithModifyPlan = &WorkspaceLabelResource{}
 
 func NewWorkspaceLabelResource() resource.Resource {
 	return &WorkspaceLabelResource{}
@@ -28,11 +31,13 @@ type WorkspaceLabelResource struct {
 }
 
 type WorkspaceLabelResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Color       types.String `tfsdk:"color"`
-	ParentId    types.String `tfsdk:"parent_id"`
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Color         types.String `tfsdk:"color"`
+	ParentId      types.String `tfsdk:"parent_id"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	AutoCorrect   types.Bool   `tfsdk:"auto_correct"`
 }
 
 func (r *WorkspaceLabelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -79,6 +84,18 @@ func (r *WorkspaceLabelResource) Schema(ctx context.Context, req resource.Schema
 					stringvalidator.RegexMatches(uuidRegex(), "must be an uuid"),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing workspace label with the same name instead of failing to create a new one. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"auto_correct": schema.BoolAttribute{
+				MarkdownDescription: "Whether to restore the color of the label on the next apply when it was changed outside of Terraform. Refreshing only detects the change. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -103,6 +120,12 @@ func (r *WorkspaceLabelResource) Configure(ctx context.Context, req resource.Con
 	r.client = client
 }
 
+func (r *WorkspaceLabelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client != nil {
+		resp.Diagnostics.Append(planLabelDrift(ctx, *r.client, req, resp)...)
+	}
+}
+
 func (r *WorkspaceLabelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *WorkspaceLabelResourceModel
 
@@ -123,16 +146,56 @@ func (r *WorkspaceLabelResource) Create(ctx context.Context, req resource.Create
 		input.Color = &value
 	}
 
-	response, err := createLabel(ctx, *r.client, input)
+	existingId := ""
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workspace label, got error: %s", err))
-		return
+	if data.AdoptExisting.ValueBool() {
+		existing, err := findWorkspaceLabel(ctx, *r.client, input.Name)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find existing workspace label, got error: %s", err))
+			return
+		}
+
+		for _, node := range existing.IssueLabels.Nodes {
+			if node.Team.Id == "" {
+				existingId = node.Id
+			}
+		}
 	}
 
-	tflog.Trace(ctx, "created a workspace label")
+	var issueLabel IssueLabel
+
+	if existingId != "" {
+		response, err := updateLabel(ctx, *r.client, labelCreateToUpdateInput(input), existingId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt workspace label, got error: %s", err))
+			return
+		}
+
+		issueLabel = response.IssueLabelUpdate.IssueLabel.IssueLabel
+
+		tflog.Trace(ctx, "adopted a workspace label", map[string]interface{}{
+			"resource":  "linear_workspace_label",
+			"operation": "create",
+			"id":        issueLabel.Id,
+		})
+	} else {
+		response, err := createLabel(ctx, *r.client, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workspace label, got error: %s", err))
+			return
+		}
+
+		issueLabel = response.IssueLabelCreate.IssueLabel.IssueLabel
 
-	issueLabel := response.IssueLabelCreate.IssueLabel
+		tflog.Trace(ctx, "created a workspace label", map[string]interface{}{
+			"resource":  "linear_workspace_label",
+			"operation": "create",
+			"id":        issueLabel.Id,
+		})
+	}
 
 	data.Id = types.StringValue(issueLabel.Id)
 	data.Name = types.StringValue(issueLabel.Name)
@@ -144,6 +207,7 @@ func (r *WorkspaceLabelResource) Create(ctx context.Context, req resource.Create
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.Plan, &resp.State, labelOptionalAttributes)...)
 }
 
 func (r *WorkspaceLabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -162,7 +226,30 @@ func (r *WorkspaceLabelResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	issueLabel := response.IssueLabel
+	issueLabel := response.IssueLabel.IssueLabel
+
+	// The drift is only recorded here, refreshing must not change anything
+	if data.AutoCorrect.ValueBool() {
+		drifted, diags := recordDrift(ctx, resp.Private, driftedValues{Color: data.Color.ValueStringPointer()}, driftedValues{Color: issueLabel.Color})
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if drifted && readOnlyEnabled(*r.client) {
+			resp.Diagnostics.AddWarning(
+				"Label Drifted",
+				fmt.Sprintf("Color of label %q was changed outside of Terraform and is not restored, as the provider is read-only.", issueLabel.Name),
+			)
+		} else if drifted {
+			resp.Diagnostics.AddWarning(
+				"Label Drifted",
+				fmt.Sprintf("Color of label %q was changed outside of Terraform and will be restored on the next apply.", issueLabel.Name),
+			)
+		}
+	}
 
 	data.Id = types.StringValue(issueLabel.Id)
 	data.Name = types.StringValue(issueLabel.Name)
@@ -174,6 +261,7 @@ func (r *WorkspaceLabelResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.State, &resp.State, labelOptionalAttributes)...)
 }
 
 func (r *WorkspaceLabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -203,7 +291,11 @@ func (r *WorkspaceLabelResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	tflog.Trace(ctx, "updated a workspace label")
+	tflog.Trace(ctx, "updated a workspace label", map[string]interface{}{
+		"resource":  "linear_workspace_label",
+		"operation": "update",
+		"id":        data.Id.ValueString(),
+	})
 
 	issueLabel := response.IssueLabelUpdate.IssueLabel
 
@@ -217,6 +309,8 @@ func (r *WorkspaceLabelResource) Update(ctx context.Context, req resource.Update
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(keepUnsupportedAttributes(ctx, *r.client, req.Plan, &resp.State, labelOptionalAttributes)...)
+	resp.Diagnostics.Append(clearDrift(ctx, resp.Private)...)
 }
 
 func (r *WorkspaceLabelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -235,7 +329,11 @@ func (r *WorkspaceLabelResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	tflog.Trace(ctx, "deleted a workspace label")
+	tflog.Trace(ctx, "deleted a workspace label", map[string]interface{}{
+		"resource":  "linear_workspace_label",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
 }
 
 func (r *WorkspaceLabelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -257,4 +355,54 @@ func (r *WorkspaceLabelResource) ImportState(ctx context.Context, req resource.I
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), response.IssueLabels.Nodes[0].Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("auto_correct"), false)...)
+}
+
+func labelCreateToUpdateInput(input IssueLabelCreateInput) IssueLabelUpdateInput {
+	return IssueLabelUpdateInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Color:       input.Color,
+		ParentId:    input.ParentId,
+	}
+}
+
+// planLabelDrift plans to restore the color recorded while refreshing when
+// auto_correct is enabled and the color is not configured, unless the
+// provider is read-only.
+func planLabelDrift(ctx context.Context, client graphql.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var autoCorrect types.Bool
+	var color types.String
+	var diags diag.Diagnostics
+
+	// Restoring would fail in read-only mode
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || readOnlyEnabled(client) {
+		return diags
+	}
+
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("auto_correct"), &autoCorrect)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("color"), &color)...)
+
+	if diags.HasError() || !autoCorrect.ValueBool() || !color.IsNull() {
+		return diags
+	}
+
+	drift, driftDiags := readDrift(ctx, req.Private)
+
+	diags.Append(driftDiags...)
+
+	if diags.HasError() || drift == nil || drift.Color == nil {
+		return diags
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("color"), types.StringValue(*drift.Color))...)
+
+	return diags
+}
+
+// labelOptionalAttributes are the attributes of a label backed by the optional
+// fields of the API.
+var labelOptionalAttributes = []optionalAttribute{
+	{path.MatchRoot("description"), "IssueLabel", "description"},
 }
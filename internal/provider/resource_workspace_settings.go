@@ -5,12 +5,14 @@ import (
 	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -28,6 +30,10 @@ type WorkspaceSettingsResource struct {
 
 type WorkspaceSettingsResourceModel struct {
 	Id                              types.String `tfsdk:"id"`
+	Name                            types.String `tfsdk:"name"`
+	UrlKey                          types.String `tfsdk:"url_key"`
+	LogoUrl                         types.String `tfsdk:"logo_url"`
+	GitBranchFormat                 types.String `tfsdk:"git_branch_format"`
 	AllowMembersToInvite            types.Bool   `tfsdk:"allow_members_to_invite"`
 	EnableRoadmap                   types.Bool   `tfsdk:"enable_roadmap"`
 	EnableGitLinkbackMessages       types.Bool   `tfsdk:"enable_git_linkback_messages"`
@@ -49,6 +55,44 @@ func (r *WorkspaceSettingsResource) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the workspace. Left unchanged on destroy.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"url_key": schema.StringAttribute{
+				MarkdownDescription: "URL key of the workspace. Left unchanged on destroy.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"logo_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the workspace logo. Left unchanged on destroy.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"git_branch_format": schema.StringAttribute{
+				MarkdownDescription: "Format of the git branch names generated for issues. Left unchanged on destroy.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"allow_members_to_invite": schema.BoolAttribute{
 				MarkdownDescription: "Allow members to invite new members to the workspace. **Default** `true`.",
 				Optional:            true,
@@ -107,6 +151,10 @@ func (r *WorkspaceSettingsResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	input := OrganizationUpdateInput{
+		Name:                             data.Name.ValueString(),
+		UrlKey:                           data.UrlKey.ValueString(),
+		LogoUrl:                          data.LogoUrl.ValueString(),
+		GitBranchFormat:                  data.GitBranchFormat.ValueString(),
 		AllowMembersToInvite:             data.AllowMembersToInvite.ValueBool(),
 		RoadmapEnabled:                   data.EnableRoadmap.ValueBool(),
 		GitLinkbackMessagesEnabled:       data.EnableGitLinkbackMessages.ValueBool(),
@@ -120,13 +168,7 @@ func (r *WorkspaceSettingsResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	organization := response.OrganizationUpdate.Organization
-
-	data.Id = types.StringValue(organization.Id)
-	data.AllowMembersToInvite = types.BoolValue(organization.AllowMembersToInvite)
-	data.EnableRoadmap = types.BoolValue(organization.RoadmapEnabled)
-	data.EnableGitLinkbackMessages = types.BoolValue(organization.GitLinkbackMessagesEnabled)
-	data.EnableGitLinkbackMessagesPublic = types.BoolValue(organization.GitPublicLinkbackMessagesEnabled)
+	readWorkspaceSettings(data, response.OrganizationUpdate.Organization.Organization)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -147,13 +189,7 @@ func (r *WorkspaceSettingsResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	organization := response.Organization
-
-	data.Id = types.StringValue(organization.Id)
-	data.AllowMembersToInvite = types.BoolValue(organization.AllowMembersToInvite)
-	data.EnableRoadmap = types.BoolValue(organization.RoadmapEnabled)
-	data.EnableGitLinkbackMessages = types.BoolValue(organization.GitLinkbackMessagesEnabled)
-	data.EnableGitLinkbackMessagesPublic = types.BoolValue(organization.GitPublicLinkbackMessagesEnabled)
+	readWorkspaceSettings(data, response.Organization.Organization)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -168,6 +204,10 @@ func (r *WorkspaceSettingsResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	input := OrganizationUpdateInput{
+		Name:                             data.Name.ValueString(),
+		UrlKey:                           data.UrlKey.ValueString(),
+		LogoUrl:                          data.LogoUrl.ValueString(),
+		GitBranchFormat:                  data.GitBranchFormat.ValueString(),
 		AllowMembersToInvite:             data.AllowMembersToInvite.ValueBool(),
 		RoadmapEnabled:                   data.EnableRoadmap.ValueBool(),
 		GitLinkbackMessagesEnabled:       data.EnableGitLinkbackMessages.ValueBool(),
@@ -181,15 +221,13 @@ func (r *WorkspaceSettingsResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	tflog.Trace(ctx, "updated workspace settings")
+	tflog.Trace(ctx, "updated workspace settings", map[string]interface{}{
+		"resource":  "linear_workspace_settings",
+		"operation": "update",
+		"id":        response.OrganizationUpdate.Organization.Id,
+	})
 
-	organization := response.OrganizationUpdate.Organization
-
-	data.Id = types.StringValue(organization.Id)
-	data.AllowMembersToInvite = types.BoolValue(organization.AllowMembersToInvite)
-	data.EnableRoadmap = types.BoolValue(organization.RoadmapEnabled)
-	data.EnableGitLinkbackMessages = types.BoolValue(organization.GitLinkbackMessagesEnabled)
-	data.EnableGitLinkbackMessagesPublic = types.BoolValue(organization.GitPublicLinkbackMessagesEnabled)
+	readWorkspaceSettings(data, response.OrganizationUpdate.Organization.Organization)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -217,9 +255,25 @@ func (r *WorkspaceSettingsResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	tflog.Trace(ctx, "deleted workspace settings")
+	tflog.Trace(ctx, "deleted workspace settings", map[string]interface{}{
+		"resource":  "linear_workspace_settings",
+		"operation": "delete",
+		"id":        data.Id.ValueString(),
+	})
 }
 
 func (r *WorkspaceSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func readWorkspaceSettings(data *WorkspaceSettingsResourceModel, organization Organization) {
+	data.Id = types.StringValue(organization.Id)
+	data.Name = types.StringValue(organization.Name)
+	data.UrlKey = types.StringValue(organization.UrlKey)
+	data.LogoUrl = types.StringPointerValue(organization.LogoUrl)
+	data.GitBranchFormat = types.StringPointerValue(organization.GitBranchFormat)
+	data.AllowMembersToInvite = types.BoolValue(organization.AllowMembersToInvite)
+	data.EnableRoadmap = types.BoolValue(organization.RoadmapEnabled)
+	data.EnableGitLinkbackMessages = types.BoolValue(organization.GitLinkbackMessagesEnabled)
+	data.EnableGitLinkbackMessagesPublic = types.BoolValue(organization.GitPublicLinkbackMessagesEnabled)
+}
@@ -16,6 +16,8 @@ func TestAccWorkspaceSettingsResourceDefault(t *testing.T) {
 				Config: testAccWorkspaceSettingsResourceConfigDefault(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestMatchResourceAttr("linear_workspace_settings.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrSet("linear_workspace_settings.test", "name"),
+					resource.TestCheckResourceAttrSet("linear_workspace_settings.test", "url_key"),
 					resource.TestCheckResourceAttr("linear_workspace_settings.test", "allow_members_to_invite", "true"),
 					resource.TestCheckResourceAttr("linear_workspace_settings.test", "enable_roadmap", "false"),
 					resource.TestCheckResourceAttr("linear_workspace_settings.test", "enable_git_linkback_messages", "true"),
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries   = 4
+	defaultMaxRetryTime = 2 * time.Minute
+
+	retryBaseDelay = time.Second
+	retryMaxDelay  = 30 * time.Second
+)
+
+// mutationKey marks the context of a request which sends a mutation.
+type mutationKey struct{}
+
+// retryTransport retries the requests which were rate limited or failed
+// with a transient error, waiting an exponentially growing and jittered
+// delay between the attempts. Mutations are only retried when they were
+// certainly not applied, as sending them twice may apply them twice.
+type retryTransport struct {
+	maxRetries   int
+	maxRetryTime time.Duration
+
+	// usage counts the retries and the time waited for them, it is nil when
+	// they are not counted.
+	usage *apiUsage
+
+	wrapped http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	mutation, _ := req.Context().Value(mutationKey{}).(bool)
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+
+			if err != nil {
+				return nil, err
+			}
+
+			req.Body = body
+		}
+
+		response, err := t.wrapped.RoundTrip(req)
+
+		if meta, ok := req.Context().Value(responseMetaKey{}).(*responseMeta); ok {
+			meta.attempts = attempt + 1
+		}
+
+		retry, reason := shouldRetry(response, err, mutation)
+
+		if !retry || attempt >= t.maxRetries || (attempt > 0 && req.GetBody == nil) {
+			return response, err
+		}
+
+		delay := retryDelay(attempt, response)
+
+		if time.Since(start)+delay > t.maxRetryTime {
+			return response, err
+		}
+
+		if response != nil {
+			response.Body.Close()
+		}
+
+		tflog.Warn(req.Context(), "retrying api request", map[string]interface{}{
+			"attempt": attempt + 1,
+			"reason":  reason,
+			"delay":   delay.String(),
+		})
+
+		if t.usage != nil {
+			t.usage.retries.Add(1)
+			t.usage.waitMs.Add(delay.Milliseconds())
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// shouldRetry reports whether a request may succeed when sent again, and why.
+// A mutation which failed in transit or with a gateway error may have been
+// applied already, so it is only retried when it was rate limited or never
+// reached the API.
+func shouldRetry(response *http.Response, err error, mutation bool) (bool, string) {
+	if err != nil {
+		if mutation && !notSent(err) {
+			return false, ""
+		}
+
+		return true, err.Error()
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true, response.Status
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if mutation {
+			return false, ""
+		}
+
+		return true, response.Status
+	case http.StatusBadRequest:
+		// Linear reports rate limiting as a GraphQL error of a bad request.
+		body, readErr := io.ReadAll(response.Body)
+		response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewReader(body))
+
+		if readErr == nil && bytes.Contains(body, []byte("RATELIMITED")) {
+			return true, "rate limited"
+		}
+	}
+
+	return false, ""
+}
+
+// notSent reports whether the request failed before it was sent, because
+// the API could not be resolved or connected to.
+func notSent(err error) bool {
+	var dnsErr *net.DNSError
+
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// retryDelay is the time to wait before sending the request again. The
+// Retry-After header is honored when the API sets it.
+func retryDelay(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	delay := retryBaseDelay << attempt
+
+	if delay > retryMaxDelay || delay <= 0 {
+		delay = retryMaxDelay
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	dialErr := &url.Error{Op: "Post", URL: "https://api.linear.app/graphql", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}
+	dnsErr := &url.Error{Op: "Post", URL: "https://api.linear.app/graphql", Err: &net.DNSError{Err: "no such host", Name: "api.linear.app"}}
+	readErr := &url.Error{Op: "Post", URL: "https://api.linear.app/graphql", Err: &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}}
+
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		err      error
+		mutation bool
+		retry    bool
+	}{
+		{name: "query ok", status: http.StatusOK, body: `{"data":{}}`},
+		{name: "query rate limited", status: http.StatusTooManyRequests, retry: true},
+		{name: "query rate limited error", status: http.StatusBadRequest, body: `{"errors":[{"extensions":{"code":"RATELIMITED"}}]}`, retry: true},
+		{name: "query bad request", status: http.StatusBadRequest, body: `{"errors":[{"message":"invalid"}]}`},
+		{name: "query bad gateway", status: http.StatusBadGateway, retry: true},
+		{name: "query service unavailable", status: http.StatusServiceUnavailable, retry: true},
+		{name: "query gateway timeout", status: http.StatusGatewayTimeout, retry: true},
+		{name: "query internal error", status: http.StatusInternalServerError},
+		{name: "query dial error", err: dialErr, retry: true},
+		{name: "query read error", err: readErr, retry: true},
+		{name: "mutation ok", status: http.StatusOK, body: `{"data":{}}`, mutation: true},
+		{name: "mutation rate limited", status: http.StatusTooManyRequests, mutation: true, retry: true},
+		{name: "mutation rate limited error", status: http.StatusBadRequest, body: `{"errors":[{"extensions":{"code":"RATELIMITED"}}]}`, mutation: true, retry: true},
+		{name: "mutation bad gateway", status: http.StatusBadGateway, mutation: true},
+		{name: "mutation service unavailable", status: http.StatusServiceUnavailable, mutation: true},
+		{name: "mutation gateway timeout", status: http.StatusGatewayTimeout, mutation: true},
+		{name: "mutation dial error", err: dialErr, mutation: true, retry: true},
+		{name: "mutation dns error", err: dnsErr, mutation: true, retry: true},
+		{name: "mutation read error", err: readErr, mutation: true},
+		{name: "mutation timeout", err: errors.New("context deadline exceeded"), mutation: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var response *http.Response
+
+			if test.err == nil {
+				response = &http.Response{
+					StatusCode: test.status,
+					Status:     http.StatusText(test.status),
+					Body:       io.NopCloser(strings.NewReader(test.body)),
+				}
+			}
+
+			retry, reason := shouldRetry(response, test.err, test.mutation)
+
+			if retry != test.retry {
+				t.Fatalf("expected retry to be %t, got %t (%s)", test.retry, retry, reason)
+			}
+
+			if retry && reason == "" {
+				t.Fatal("expected a reason to retry")
+			}
+
+			if response != nil {
+				body, err := io.ReadAll(response.Body)
+
+				if err != nil || string(body) != test.body {
+					t.Fatalf("expected the response body to be kept, got %q", body)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	t.Run("retry after", func(t *testing.T) {
+		response := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+
+		if delay := retryDelay(0, response); delay != 7*time.Second {
+			t.Fatalf("expected a delay of 7s, got %s", delay)
+		}
+	})
+
+	t.Run("invalid retry after", func(t *testing.T) {
+		response := &http.Response{Header: http.Header{"Retry-After": []string{"soon"}}}
+
+		if delay := retryDelay(0, response); delay < retryBaseDelay/2 || delay > retryBaseDelay {
+			t.Fatalf("expected a delay between %s and %s, got %s", retryBaseDelay/2, retryBaseDelay, delay)
+		}
+	})
+
+	t.Run("backoff", func(t *testing.T) {
+		for attempt := 0; attempt < 5; attempt++ {
+			max := retryBaseDelay << attempt
+
+			if delay := retryDelay(attempt, nil); delay < max/2 || delay > max {
+				t.Fatalf("expected a delay between %s and %s for attempt %d, got %s", max/2, max, attempt, delay)
+			}
+		}
+	})
+
+	t.Run("capped", func(t *testing.T) {
+		for _, attempt := range []int{5, 10, 63, 100} {
+			if delay := retryDelay(attempt, nil); delay < retryMaxDelay/2 || delay > retryMaxDelay {
+				t.Fatalf("expected a delay between %s and %s for attempt %d, got %s", retryMaxDelay/2, retryMaxDelay, attempt, delay)
+			}
+		}
+	})
+}
+
+func TestRetryTransportCountsRetries(t *testing.T) {
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Write([]byte(`{"data":{}}`))
+	}))
+
+	defer server.Close()
+
+	usage := &apiUsage{}
+	meta := &responseMeta{}
+	transport := &retryTransport{maxRetries: 2, maxRetryTime: time.Minute, usage: usage, wrapped: http.DefaultTransport}
+
+	send := func(mutation bool) *http.Response {
+		ctx := context.WithValue(context.Background(), mutationKey{}, mutation)
+		ctx = context.WithValue(ctx, responseMetaKey{}, meta)
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(`{}`))
+
+		response, err := transport.RoundTrip(req)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		response.Body.Close()
+
+		return response
+	}
+
+	if response := send(false); response.StatusCode != http.StatusOK {
+		t.Fatalf("expected the query to be retried, got %s", response.Status)
+	}
+
+	if meta.attempts != 2 {
+		t.Fatalf("expected the query to be sent twice, got %d", meta.attempts)
+	}
+
+	if usage.retries.Load() != 1 || usage.waitMs.Load() <= 0 {
+		t.Fatalf("expected one retry with a wait to be counted, got %d retries and %dms", usage.retries.Load(), usage.waitMs.Load())
+	}
+
+	requests.Store(0)
+
+	if response := send(true); response.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected the mutation not to be retried, got %s", response.Status)
+	}
+
+	if meta.attempts != 1 {
+		t.Fatalf("expected the mutation to be sent once, got %d", meta.attempts)
+	}
+
+	if usage.retries.Load() != 1 {
+		t.Fatalf("expected no more retries to be counted, got %d", usage.retries.Load())
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// NewServer returns the protocol server of the provider, which logs a summary
+// of the API usage as it goes.
+func NewServer(version string) func() tfprotov6.ProviderServer {
+	server := providerserver.NewProtocol6(New(version)())
+
+	return func() tfprotov6.ProviderServer {
+		return &usageServer{ProviderServer: server()}
+	}
+}
+
+// usageServer logs the API usage of the run so far at the end of every
+// operation which called the API. Terraform stops the provider without
+// notice, so the last summary logged is the one of the whole run.
+type usageServer struct {
+	tfprotov6.ProviderServer
+}
+
+func (s *usageServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	defer logUsage(ctx)
+
+	return s.ProviderServer.ConfigureProvider(ctx, req)
+}
+
+func (s *usageServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	defer logUsage(ctx)
+
+	return s.ProviderServer.ReadResource(ctx, req)
+}
+
+func (s *usageServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	defer logUsage(ctx)
+
+	return s.ProviderServer.PlanResourceChange(ctx, req)
+}
+
+func (s *usageServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	defer logUsage(ctx)
+
+	return s.ProviderServer.ApplyResourceChange(ctx, req)
+}
+
+func (s *usageServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	defer logUsage(ctx)
+
+	return s.ProviderServer.ImportResourceState(ctx, req)
+}
+
+func (s *usageServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	defer logUsage(ctx)
+
+	return s.ProviderServer.ReadDataSource(ctx, req)
+}
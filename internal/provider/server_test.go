@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+// testUsageServer calls the API as many times as asked for on every read.
+type testUsageServer struct {
+	tfprotov6.ProviderServer
+
+	client *linearClient
+	calls  int64
+}
+
+func (s *testUsageServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	s.client.usage.calls.Add(s.calls)
+
+	return &tfprotov6.ReadResourceResponse{}, nil
+}
+
+func TestUsageServer(t *testing.T) {
+	previous := configuredClients.clients
+	configuredClients.clients = nil
+
+	t.Cleanup(func() { configuredClients.clients = previous })
+
+	client := &linearClient{}
+	registerClient(client)
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	downstream := &testUsageServer{client: client}
+	server := &usageServer{ProviderServer: downstream}
+
+	for _, calls := range []int64{2, 0, 1} {
+		downstream.calls = calls
+
+		if _, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to decode the logs: %s", err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("expected a summary for every read which called the API, got %v", entries)
+	}
+
+	for index, expected := range []float64{2, 3} {
+		if entries[index]["@message"] != "api usage summary" || entries[index]["@level"] != "info" || entries[index]["api_calls"] != expected {
+			t.Fatalf("expected a summary of %v calls at info, got %v", expected, entries[index])
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// tlsConfig builds the TLS configuration of the requests to Linear. The
+// certificates are trusted on top of the ones of the system.
+func tlsConfig(certificates string, minVersion string) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if minVersion != "" {
+		config.MinVersion = tlsVersions[minVersion]
+	}
+
+	if certificates != "" {
+		pool, err := x509.SystemCertPool()
+
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM([]byte(certificates)) {
+			return nil, fmt.Errorf("no PEM encoded certificate found")
+		}
+
+		config.RootCAs = pool
+	}
+
+	return config, nil
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTlsConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": {}}`))
+	}))
+
+	defer server.Close()
+
+	certificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	get := func(config *tls.Config) error {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+		response, err := client.Get(server.URL)
+
+		if err == nil {
+			response.Body.Close()
+		}
+
+		return err
+	}
+
+	t.Run("system certificates", func(t *testing.T) {
+		config, err := tlsConfig("", "")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if config.RootCAs != nil || config.MinVersion != tls.VersionTLS12 {
+			t.Fatalf("expected the system certificates and TLS 1.2, got %+v", config)
+		}
+
+		if get(config) == nil {
+			t.Fatal("expected the certificate of the test server not to be trusted")
+		}
+	})
+
+	t.Run("ca bundle", func(t *testing.T) {
+		config, err := tlsConfig(certificate, "")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := get(config); err != nil {
+			t.Fatalf("expected the certificate of the test server to be trusted, got error: %s", err)
+		}
+	})
+
+	t.Run("invalid ca bundle", func(t *testing.T) {
+		if _, err := tlsConfig("not a certificate", ""); err == nil {
+			t.Fatal("expected an error without a PEM encoded certificate")
+		}
+	})
+
+	t.Run("min version", func(t *testing.T) {
+		config, err := tlsConfig(certificate, "1.3")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if config.MinVersion != tls.VersionTLS13 {
+			t.Fatalf("expected TLS 1.3, got %x", config.MinVersion)
+		}
+
+		server.TLS.MaxVersion = tls.VersionTLS12
+		defer func() { server.TLS.MaxVersion = 0 }()
+
+		if get(config) == nil {
+			t.Fatal("expected a server limited to TLS 1.2 to be refused")
+		}
+	})
+}
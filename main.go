@@ -1,11 +1,10 @@
 package main
 
 import (
-	"context"
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/terraform-community-providers/terraform-provider-linear/internal/provider"
 )
 
@@ -33,12 +32,13 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/terraform-community-providers/linear",
-		Debug:   debug,
+	var opts []tf6server.ServeOpt
+
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	err := tf6server.Serve("registry.terraform.io/terraform-community-providers/linear", provider.NewServer(version), opts...)
 
 	if err != nil {
 		log.Fatal(err.Error())